@@ -43,6 +43,7 @@ func EvmCmd() *cobra.Command {
 	cmd.AddCommand(
 		createContractCmd(),
 		callContractCmd(),
+		queryCallResultCmd(),
 		abiCmd(),
 		estimateContractCmd(),
 		checkContractAddrCmd(),
@@ -427,6 +428,83 @@ func callContract(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// 查询合约调用交易的执行结果，合约revert时输出解析后的原因
+func queryCallResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query the execution result of an EVM contract transaction",
+		Run:   queryCallResult,
+	}
+	cmd.Flags().StringP("hash", "s", "", "transaction hash")
+	cmd.MarkFlagRequired("hash")
+	return cmd
+}
+
+type evmCallResult struct {
+	Caller       string `json:"caller,omitempty"`
+	ContractName string `json:"contractName,omitempty"`
+	ContractAddr string `json:"contractAddr,omitempty"`
+	UsedGas      uint64 `json:"usedGas,omitempty"`
+	Ret          string `json:"ret,omitempty"`
+	JSONRet      string `json:"jsonRet,omitempty"`
+	Error        string `json:"error,omitempty"`
+	RevertReason string `json:"revertReason,omitempty"`
+}
+
+func queryCallResult(cmd *cobra.Command, args []string) {
+	hash, _ := cmd.Flags().GetString("hash")
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+
+	jsonrpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var detail rpctypes.TransactionDetail
+	err = jsonrpc.Call("Chain33.QueryTransaction", rpctypes.QueryParm{Hash: hash}, &detail)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if detail.Receipt == nil {
+		fmt.Fprintln(os.Stderr, "transaction receipt not found")
+		return
+	}
+
+	var result evmCallResult
+	for _, l := range detail.Receipt.Logs {
+		raw, err := common.FromHex(l.RawLog)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "parse receipt log error", err)
+			return
+		}
+		switch l.Ty {
+		case types.TyLogErr:
+			result.Error = string(raw)
+		case evmtypes.TyLogCallContract:
+			var contract evmtypes.ReceiptEVMContract
+			if err := types.Decode(raw, &contract); err != nil {
+				fmt.Fprintln(os.Stderr, "decode receipt log error", err)
+				return
+			}
+			result.Caller = contract.Caller
+			result.ContractName = contract.ContractName
+			result.ContractAddr = contract.ContractAddr
+			result.UsedGas = contract.UsedGas
+			result.Ret = common.ToHex(contract.Ret)
+			result.JSONRet = contract.JsonRet
+			result.RevertReason = contract.RevertReason
+		}
+	}
+
+	data, err := json.MarshalIndent(&result, "", "  ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
 func addCallContractFlags(cmd *cobra.Command) {
 	addCommonFlags(cmd)
 	cmd.Flags().StringP("exec", "e", "", "evm contract name, like user.evm.xxxxx")
@@ -520,6 +598,9 @@ func callAbi(cmd *cobra.Command, args []string) {
 	query := sendQuery(rpcLaddr, "Query", &req, &resp)
 
 	if query {
+		if resp.RevertReason != "" {
+			fmt.Fprintln(os.Stderr, "execution reverted:", resp.RevertReason)
+		}
 		data, err := json.MarshalIndent(&resp, "", "  ")
 		if err != nil {
 			fmt.Println(resp.String())
@@ -633,7 +714,8 @@ func evmDebugCmd() *cobra.Command {
 	cmd.AddCommand(
 		evmDebugQueryCmd(),
 		evmDebugSetCmd(),
-		evmDebugClearCmd())
+		evmDebugClearCmd(),
+		evmDebugTraceCmd())
 
 	return cmd
 }
@@ -684,6 +766,45 @@ func evmDebugRPC(cmd *cobra.Command, flag int32) {
 	}
 }
 
+// 重新执行历史交易，返回调用树或者交易执行前后的账户状态
+func evmDebugTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Trace a historic evm transaction",
+		Run:   evmDebugTrace,
+	}
+	addEvmDebugTraceFlags(cmd)
+	return cmd
+}
+
+func addEvmDebugTraceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "s", "", "transaction hash")
+	cmd.MarkFlagRequired("hash")
+	cmd.Flags().StringP("tracer", "t", "callTracer", "tracer name, callTracer or prestateTracer")
+	cmd.Flags().BoolP("diff", "d", false, "only show changed accounts (prestateTracer only)")
+}
+
+func evmDebugTrace(cmd *cobra.Command, args []string) {
+	hash, _ := cmd.Flags().GetString("hash")
+	tracer, _ := cmd.Flags().GetString("tracer")
+	diff, _ := cmd.Flags().GetBool("diff")
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+
+	var traceReq = evmtypes.EvmTraceTxReq{TxHash: hash, Tracer: tracer, DiffMode: diff}
+	var traceResp evmtypes.EvmTraceTxResp
+	query := sendQuery(rpcLaddr, "TraceTransaction", &traceReq, &traceResp)
+	if query {
+		data, err := types.PBToJSON(&traceResp)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		fmt.Println(string(data))
+	} else {
+		fmt.Fprintln(os.Stderr, "error")
+	}
+}
+
 // 向EVM合约地址转账
 func evmTransferCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,6 +1,7 @@
 package abi
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -11,9 +12,31 @@ import (
 	"errors"
 
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
 	"github.com/golang-collections/collections/stack"
 )
 
+var (
+	// revertSelector 合约中revert("reason")或require(cond, "reason")返回数据的方法签名，即Error(string)
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	// panicSelector 合约中assert失败、算术溢出等情况返回数据的方法签名，即Panic(uint256)
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	// panicReasons Panic(uint256)中各错误码的含义
+	panicReasons = map[uint64]string{
+		0x00: "generic panic",
+		0x01: "assert(false)",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "enum overflow",
+		0x22: "invalid encoded storage byte array accessed",
+		0x31: "out-of-bounds array access; popping on an empty array",
+		0x32: "out-of-bounds access of an array or bytesN",
+		0x41: "out of memory",
+		0x51: "uninitialized function",
+	}
+)
+
 // Pack 使用ABI方式调用时，将调用方式转换为EVM底层处理的十六进制编码
 // abiData 完整的ABI定义
 // param 调用方法及参数
@@ -112,6 +135,65 @@ func Unpack(data []byte, methodName, abiData string) (output string, err error)
 	return string(jsondata), err
 }
 
+// UnpackRevert 解析合约revert时返回的数据，依次尝试按Error(string)、Panic(uint256)解析，
+// 都不匹配时使用合约ABI（abiData）中定义的自定义错误解析，返回可读的错误信息
+func UnpackRevert(data []byte, abiData string) (string, error) {
+	if len(data) < 4 {
+		return "", errors.New("invalid revert data")
+	}
+	switch {
+	case bytes.Equal(data[:4], revertSelector):
+		var reason string
+		if err := unpackSingle("string", &reason, data[4:]); err != nil {
+			return "", err
+		}
+		return reason, nil
+	case bytes.Equal(data[:4], panicSelector):
+		code := new(big.Int)
+		if err := unpackSingle("uint256", &code, data[4:]); err != nil {
+			return "", err
+		}
+		if reason, ok := panicReasons[code.Uint64()]; code.IsUint64() && ok {
+			return fmt.Sprintf("panic: %s (0x%x)", reason, code), nil
+		}
+		return fmt.Sprintf("panic: unknown panic code 0x%x", code), nil
+	}
+
+	if len(abiData) == 0 {
+		return "", fmt.Errorf("unknown revert data: %#x", data[:4])
+	}
+	abi, err := JSON(strings.NewReader(abiData))
+	if err != nil {
+		return "", err
+	}
+	customErr, err := abi.ErrorByID(data)
+	if err != nil {
+		return "", err
+	}
+	values, err := customErr.Unpack(data)
+	if err != nil {
+		return "", err
+	}
+	args := make([]string, len(values))
+	for i, v := range values {
+		if str, ok := v.(string); ok {
+			args[i] = strconv.Quote(str)
+		} else {
+			args[i] = fmt.Sprintf("%v", v)
+		}
+	}
+	return fmt.Sprintf("%s(%s)", customErr.Name, strings.Join(args, ", ")), nil
+}
+
+// 按指定类型解析单个返回值
+func unpackSingle(typeName string, v interface{}, data []byte) error {
+	typ, err := NewType(typeName)
+	if err != nil {
+		return err
+	}
+	return Arguments{{Type: typ}}.Unpack(v, data)
+}
+
 // Param 返回值参数结构定义
 type Param struct {
 	// Name 参数名称
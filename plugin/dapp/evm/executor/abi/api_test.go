@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
@@ -81,6 +82,54 @@ func TestABI_Unpack(t *testing.T) {
 	}
 }
 
+func TestUnpackRevert(t *testing.T) {
+	// revert("Not enough Ether provided.")
+	data := common.FromHex("0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000001a4e6f7420656e6f7567682045746865722070726f76696465642e000000000000")
+	reason, err := UnpackRevert(data, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "Not enough Ether provided.", reason)
+
+	_, err = UnpackRevert(data[1:], "")
+	assert.Error(t, err)
+	_, err = UnpackRevert(nil, "")
+	assert.Error(t, err)
+
+	// Panic(0x11)，算术溢出
+	data = common.FromHex("0x4e487b710000000000000000000000000000000000000000000000000000000000000011")
+	reason, err = UnpackRevert(data, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "panic: arithmetic underflow or overflow (0x11)", reason)
+	data = common.FromHex("0x4e487b7100000000000000000000000000000000000000000000000000000000000000ff")
+	reason, err = UnpackRevert(data, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "panic: unknown panic code 0xff", reason)
+}
+
+func TestUnpackRevertCustomError(t *testing.T) {
+	abiData := `[{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]},
+		{"type":"error","name":"Unauthorized","inputs":[{"name":"caller","type":"address"},{"name":"role","type":"string"}]}]`
+	parsed, err := JSON(strings.NewReader(abiData))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(parsed.Errors))
+
+	insufficient := parsed.Errors["InsufficientBalance"]
+	assert.Equal(t, "InsufficientBalance(uint256,uint256)", insufficient.Sig())
+	data := append(insufficient.ID(), common.FromHex("0x0000000000000000000000000000000000000000000000000000000000000064000000000000000000000000000000000000000000000000000000000000012c")...)
+	reason, err := UnpackRevert(data, abiData)
+	assert.NoError(t, err)
+	assert.Equal(t, "InsufficientBalance(100, 300)", reason)
+
+	found, err := parsed.ErrorByID(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "InsufficientBalance", found.Name)
+
+	// 没有提供ABI或ABI中不存在对应的错误定义时无法解析
+	_, err = UnpackRevert(data, "")
+	assert.Error(t, err)
+	_, err = parsed.ErrorByID([]byte{1, 2, 3, 4})
+	assert.Error(t, err)
+}
+
 func TestProcFuncCall(t *testing.T) {
 	for _, test := range []struct {
 		input    string
@@ -17,15 +17,49 @@
 package abi
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
 )
 
 var (
 	errBadBool = errors.New("abi: improperly encoded boolean value")
 )
 
+// Error is a custom error declared in a contract (solidity >= 0.8.4) and
+// returned as revert data: the 4-byte id followed by the abi encoded inputs.
+type Error struct {
+	Name   string
+	Inputs Arguments
+}
+
+// Sig returns the error signature, like InsufficientBalance(uint256,uint256)
+func (e Error) Sig() string {
+	types := make([]string, len(e.Inputs))
+	for i, input := range e.Inputs {
+		types[i] = input.Type.String()
+	}
+	return fmt.Sprintf("%v(%v)", e.Name, strings.Join(types, ","))
+}
+
+// ID returns the 4-byte id of the error, which is the first 4 bytes of the
+// keccak256 hash of its signature.
+func (e Error) ID() []byte {
+	return crypto.Keccak256([]byte(e.Sig()))[:4]
+}
+
+// Unpack decodes the revert data (including the 4-byte id) into values.
+func (e Error) Unpack(data []byte) ([]interface{}, error) {
+	if len(data) < 4 || !bytes.Equal(data[:4], e.ID()) {
+		return nil, fmt.Errorf("abi: revert data does not match error %v", e.Name)
+	}
+	return e.Inputs.UnpackValues(data[4:])
+}
+
 // formatSliceString formats the reflection kind with the given slice size
 // and returns a formatted string representation.
 func formatSliceString(kind reflect.Kind, sliceSize int) string {
@@ -27,7 +27,14 @@ func (evm *EVMExecutor) Exec(tx *types.Transaction, index int) (*types.Receipt,
 	if err != nil {
 		return nil, err
 	}
-	return evm.innerExec(msg, tx.Hash(), index, tx.Fee, false)
+	receipt, err := evm.innerExec(msg, tx.Hash(), index, tx.Fee, false)
+	// 合约revert时交易按失败打包，在回执中同时保留错误信息和带有revert原因的合约调用日志
+	if _, ok := err.(*model.RevertError); ok && receipt != nil &&
+		evm.GetAPI().GetConfig().IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMRevertReason) {
+		errLog := &types.ReceiptLog{Ty: types.TyLogErr, Log: []byte(err.Error())}
+		return &types.Receipt{Ty: types.ExecPack, Logs: append([]*types.ReceiptLog{errLog}, receipt.Logs...)}, nil
+	}
+	return receipt, err
 }
 
 // 通用的EVM合约执行逻辑封装
@@ -101,6 +108,20 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 
 	if vmerr != nil {
 		log.Error("evm contract exec error", "error info", vmerr)
+		// 合约revert时解析返回数据中的原因，附带在错误信息中，最终记录到交易回执的错误日志里
+		if vmerr == model.ErrExecutionReverted && cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMRevertReason) {
+			abiData := msg.ABI()
+			if !isCreate {
+				abiData = evm.mStateDB.GetAbi(contractAddr.String())
+			}
+			if reason, err := abi.UnpackRevert(ret, abiData); err == nil {
+				vmerr = &model.RevertError{Reason: reason}
+				// 回执中记录revert原因，仅在交易执行时使用
+				contractReceipt := &evmtypes.ReceiptEVMContract{Caller: msg.From().String(), ContractName: execName, ContractAddr: contractAddr.String(),
+					UsedGas: usedGas, Ret: ret, RevertReason: reason}
+				receipt = &types.Receipt{Ty: types.ExecPack, Logs: []*types.ReceiptLog{{Ty: evmtypes.TyLogCallContract, Log: types.Encode(contractReceipt)}}}
+			}
+		}
 		return receipt, vmerr
 	}
 
@@ -137,6 +158,9 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 	}
 	logs = append(logs, &types.ReceiptLog{Ty: evmtypes.TyLogCallContract, Log: types.Encode(contractReceipt)})
 	logs = append(logs, evm.mStateDB.GetReceiptLogs(contractAddr.String())...)
+	if cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMTxLog) {
+		logs = append(logs, evm.mStateDB.GetEventLogs()...)
+	}
 
 	if cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMKVHash) {
 		// 将执行时生成的合约状态数据变更信息也计算哈希并保存
@@ -26,5 +26,10 @@ func (evm *EVMExecutor) ExecDelLocal(tx *types.Transaction, receipt *types.Recei
 		}
 		set.KV = kvs
 	}
-	return set, err
+	indexKVs, err := evm.logIndexKVs(tx, receipt, index, true)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, indexKVs...)
+	return set, nil
 }
@@ -43,6 +43,19 @@ func (evm *EVMExecutor) ExecLocal(tx *types.Transaction, receipt *types.ReceiptD
 			}
 		}
 	}
+	// 合约事件日志索引，回滚时直接删除，不需要记录回滚数据；
+	// 日志在区块中的序号依赖区块已有的日志数，需要在AddRollbackKV写入新的汇总信息之前生成
+	indexKVs, err := evm.logIndexKVs(tx, receipt, index, false)
+	if err != nil {
+		return set, err
+	}
+	// 区块的日志汇总信息由多笔交易累加，需要记录回滚数据
+	blockKV, err := evm.logBlockKV(receipt)
+	if err != nil {
+		return set, err
+	}
+	set.KV = append(set.KV, blockKV...)
 	set.KV = evm.AddRollbackKV(tx, []byte(evmtypes.ExecutorName), set.KV)
-	return set, err
+	set.KV = append(set.KV, indexKVs...)
+	return set, nil
 }
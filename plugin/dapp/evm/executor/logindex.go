@@ -0,0 +1,401 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	dbm "github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// 合约事件日志在localdb中的索引：
+// 日志数据按照 区块高度:交易序号:日志序号 保存，另外按照合约地址和topic0~topic3分别建立索引，索引的值为日志数据的key；
+// 所有索引key都以日志位置结尾，可以按照区块高度范围查询，并且使用日志位置作为分页游标；
+// 每个区块另外保存所有日志的布隆过滤器，无法使用单一索引时按区块跳过不可能匹配的日志
+const (
+	// 最多支持LOG4指令生成的4个主题
+	maxLogTopics = 4
+	// 单次查询默认返回的日志数
+	defaultLogCount = 100
+	// 单次查询最多返回的日志数
+	maxLogCount = 1000
+)
+
+var (
+	logKeyPrefix      = "LODB-" + evmtypes.ExecutorName + "-log:"
+	logAddrKeyPrefix  = "LODB-" + evmtypes.ExecutorName + "-log-addr:"
+	logTopicKeyPrefix = "LODB-" + evmtypes.ExecutorName + "-log-topic"
+	logBlockKeyPrefix = "LODB-" + evmtypes.ExecutorName + "-log-block:"
+)
+
+// 日志位置，格式为 区块高度:交易序号:日志序号
+func logPosition(height int64, txIndex, logIndex int) string {
+	return fmt.Sprintf("%012d:%06d:%04d", height, txIndex, logIndex)
+}
+
+func logKey(position string) []byte {
+	return []byte(logKeyPrefix + position)
+}
+
+func logAddrPrefix(addr string) string {
+	return logAddrKeyPrefix + addr + ":"
+}
+
+func logTopicPrefix(i int, topic string) string {
+	return fmt.Sprintf("%s%d:%s:", logTopicKeyPrefix, i, topic)
+}
+
+func logBlockKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s%012d", logBlockKeyPrefix, height))
+}
+
+// 从日志位置中解析区块高度
+func logPositionHeight(position string) (int64, error) {
+	if len(position) < 12 {
+		return 0, types.ErrInvalidParam
+	}
+	return strconv.ParseInt(position[:12], 10, 64)
+}
+
+// 读取区块已有的日志汇总信息，同一区块中前面交易写入的数据在执行后续交易时可见
+func (evm *EVMExecutor) getBlockLogInfo(height int64) (*evmtypes.EvmBlockLogInfo, error) {
+	info := &evmtypes.EvmBlockLogInfo{}
+	value, err := evm.GetLocalDB().Get(logBlockKey(height))
+	if err == types.ErrNotFound || (err == nil && len(value) == 0) {
+		return info, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := types.Decode(value, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// 将交易中的事件日志合并到区块的日志汇总信息，回滚时通过AddRollbackKV恢复原来的值
+func (evm *EVMExecutor) logBlockKV(receipt *types.ReceiptData) ([]*types.KeyValue, error) {
+	var logs []*evmtypes.EVMLog
+	for _, item := range receipt.Logs {
+		if item.Ty != evmtypes.TyLogEVMEventData {
+			continue
+		}
+		var evmLog evmtypes.EVMLog
+		if err := types.Decode(item.Log, &evmLog); err != nil {
+			continue
+		}
+		logs = append(logs, &evmLog)
+	}
+	if len(logs) == 0 {
+		return nil, nil
+	}
+	info, err := evm.getBlockLogInfo(evm.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	bloom := common.BytesToBloom(info.Bloom)
+	for _, evmLog := range logs {
+		if addr := common.StringToAddress(evmLog.Address); addr != nil {
+			bloom.Add(addr.Bytes())
+		}
+		for _, topic := range evmLog.Topic {
+			bloom.Add(topic)
+		}
+	}
+	info.Bloom = bloom.Bytes()
+	info.LogCount += int32(len(logs))
+	return []*types.KeyValue{{Key: logBlockKey(evm.GetHeight()), Value: types.Encode(info)}}, nil
+}
+
+// 根据交易回执中的事件日志生成localdb索引，value为nil时用于回滚
+func (evm *EVMExecutor) logIndexKVs(tx *types.Transaction, receipt *types.ReceiptData, index int, rollback bool) (kvs []*types.KeyValue, err error) {
+	var logIndex int
+	// 日志在区块中的序号从前面交易已生成的日志数开始
+	var blockLogCount int32
+	if !rollback {
+		info, err := evm.getBlockLogInfo(evm.GetHeight())
+		if err != nil {
+			return nil, err
+		}
+		blockLogCount = info.LogCount
+	}
+	for _, item := range receipt.Logs {
+		if item.Ty != evmtypes.TyLogEVMEventData {
+			continue
+		}
+		var evmLog evmtypes.EVMLog
+		if err := types.Decode(item.Log, &evmLog); err != nil {
+			log.Error("logIndexKVs", "decode evm log error", err)
+			continue
+		}
+		position := logPosition(evm.GetHeight(), index, logIndex)
+		key := logKey(position)
+		var value []byte
+		if !rollback {
+			data := &evmtypes.EvmLogItem{
+				Address:       evmLog.Address,
+				Data:          common.Bytes2Hex(evmLog.Data),
+				Height:        evm.GetHeight(),
+				TxIndex:       int32(index),
+				LogIndex:      int32(logIndex),
+				TxHash:        common.Bytes2Hex(tx.Hash()),
+				BlockLogIndex: blockLogCount + int32(logIndex),
+			}
+			for _, topic := range evmLog.Topic {
+				data.Topic = append(data.Topic, common.Bytes2Hex(topic))
+			}
+			value = types.Encode(data)
+		}
+		kvs = append(kvs, &types.KeyValue{Key: key, Value: value})
+
+		indexValue := key
+		if rollback {
+			indexValue = nil
+		}
+		kvs = append(kvs, &types.KeyValue{Key: []byte(logAddrPrefix(evmLog.Address) + position), Value: indexValue})
+		for i, topic := range evmLog.Topic {
+			if i >= maxLogTopics {
+				break
+			}
+			kvs = append(kvs, &types.KeyValue{Key: []byte(logTopicPrefix(i, common.Bytes2Hex(topic)) + position), Value: indexValue})
+		}
+		logIndex++
+	}
+	return kvs, nil
+}
+
+// 事件日志过滤条件，地址和每个位置的主题分别满足其一即可
+type logFilter struct {
+	fromBlock int64
+	toBlock   int64
+	addresses map[string]bool
+	topics    []map[string]bool
+}
+
+func newLogFilter(in *evmtypes.EvmGetLogsReq) (*logFilter, error) {
+	if len(in.Topics) > maxLogTopics {
+		return nil, types.ErrInvalidParam
+	}
+	filter := &logFilter{fromBlock: in.FromBlock, toBlock: in.ToBlock}
+	if len(in.Addresses) > 0 {
+		filter.addresses = make(map[string]bool)
+		for _, addr := range in.Addresses {
+			filter.addresses[addr] = true
+		}
+	}
+	filter.topics = make([]map[string]bool, len(in.Topics))
+	for i, topics := range in.Topics {
+		if len(topics.GetTopic()) == 0 {
+			continue
+		}
+		filter.topics[i] = make(map[string]bool)
+		for _, topic := range topics.Topic {
+			filter.topics[i][common.Bytes2Hex(common.BytesToHash(common.FromHex(topic)).Bytes())] = true
+		}
+	}
+	return filter, nil
+}
+
+func (f *logFilter) match(item *evmtypes.EvmLogItem) bool {
+	if f.addresses != nil && !f.addresses[item.Address] {
+		return false
+	}
+	if len(f.topics) > len(item.Topic) {
+		for _, topics := range f.topics[len(item.Topic):] {
+			if topics != nil {
+				return false
+			}
+		}
+	}
+	for i, topics := range f.topics {
+		if topics != nil && !topics[item.Topic[i]] {
+			return false
+		}
+	}
+	return true
+}
+
+// 根据区块的布隆过滤器判断区块中是否可能存在满足条件的日志
+func (f *logFilter) matchBloom(bloom common.Bloom) bool {
+	if f.addresses != nil {
+		found := false
+		for addr := range f.addresses {
+			if a := common.StringToAddress(addr); a != nil && bloom.Test(a.Bytes()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, topics := range f.topics {
+		if topics == nil {
+			continue
+		}
+		found := false
+		for topic := range topics {
+			if bloom.Test(common.FromHex(topic)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// 根据区块的日志汇总信息判断区块中是否可能存在满足条件的日志，没有汇总信息的区块不做过滤
+func (evm *EVMExecutor) blockLogsMayMatch(filter *logFilter, height int64) (bool, error) {
+	info, err := evm.getBlockLogInfo(height)
+	if err != nil {
+		return false, err
+	}
+	if len(info.Bloom) == 0 {
+		return true, nil
+	}
+	return filter.matchBloom(common.BytesToBloom(info.Bloom)), nil
+}
+
+// 是否指定了合约地址或主题条件
+func (f *logFilter) hasCondition() bool {
+	if f.addresses != nil {
+		return true
+	}
+	for _, topics := range f.topics {
+		if topics != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// 选择用于遍历的索引：优先使用单个合约地址，其次使用只有一个取值的主题，都不满足时遍历所有日志
+func (f *logFilter) indexPrefix() (prefix string, isIndex bool) {
+	if len(f.addresses) == 1 {
+		for addr := range f.addresses {
+			return logAddrPrefix(addr), true
+		}
+	}
+	for i, topics := range f.topics {
+		if len(topics) == 1 {
+			for topic := range topics {
+				return logTopicPrefix(i, topic), true
+			}
+		}
+	}
+	return logKeyPrefix, false
+}
+
+// 按照区块高度从高到低遍历索引，返回满足过滤条件的日志
+func (evm *EVMExecutor) getLogs(in *evmtypes.EvmGetLogsReq) (*evmtypes.EvmGetLogsResp, error) {
+	filter, err := newLogFilter(in)
+	if err != nil {
+		return nil, err
+	}
+	count := int(in.Count)
+	if count <= 0 {
+		count = defaultLogCount
+	}
+	if count > maxLogCount {
+		count = maxLogCount
+	}
+
+	localdb := evm.GetLocalDB()
+	prefix, isIndex := filter.indexPrefix()
+	// 游标为上一次遍历到的日志位置，List不包含游标所在的key
+	var start []byte
+	if len(in.Cursor) > 0 {
+		start = []byte(prefix + in.Cursor)
+	} else if filter.toBlock > 0 {
+		start = []byte(prefix + fmt.Sprintf("%012d", filter.toBlock+1))
+	}
+
+	// 遍历所有日志时，使用区块的布隆过滤器跳过不可能匹配的区块
+	useBloom := !isIndex && filter.hasCondition()
+	checkedHeight := int64(-1)
+	resp := &evmtypes.EvmGetLogsResp{}
+	for first := true; ; first = false {
+		values, err := localdb.List([]byte(prefix), start, int32(count), dbm.ListDESC|dbm.ListWithKey)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		// 起始位置之后不存在任何key时，List返回空，这时所有日志都在查询范围内，从最后一个key开始遍历
+		if first && len(values) == 0 && len(in.Cursor) == 0 && len(start) > 0 {
+			values, err = localdb.List([]byte(prefix), nil, int32(count), dbm.ListDESC|dbm.ListWithKey)
+			if err != nil && err != types.ErrNotFound {
+				return nil, err
+			}
+		}
+
+		skipBlock := false
+		for _, value := range values {
+			var kv types.KeyValue
+			if err := types.Decode(value, &kv); err != nil {
+				return nil, err
+			}
+			resp.Cursor = strings.TrimPrefix(string(kv.Key), prefix)
+			if useBloom {
+				height, err := logPositionHeight(resp.Cursor)
+				if err != nil {
+					return nil, err
+				}
+				if height < filter.fromBlock {
+					resp.Cursor = ""
+					return resp, nil
+				}
+				if height != checkedHeight {
+					checkedHeight = height
+					match, err := evm.blockLogsMayMatch(filter, height)
+					if err != nil {
+						return nil, err
+					}
+					if !match {
+						// 下一次从该区块之前的日志开始遍历
+						resp.Cursor = fmt.Sprintf("%012d", height)
+						skipBlock = true
+						break
+					}
+				}
+			}
+
+			data := kv.Value
+			if isIndex {
+				data, err = localdb.Get(kv.Value)
+				if err != nil {
+					return nil, err
+				}
+			}
+			var item evmtypes.EvmLogItem
+			if err := types.Decode(data, &item); err != nil {
+				return nil, err
+			}
+			if item.Height < filter.fromBlock {
+				resp.Cursor = ""
+				return resp, nil
+			}
+			if (filter.toBlock > 0 && item.Height > filter.toBlock) || !filter.match(&item) {
+				continue
+			}
+			resp.Logs = append(resp.Logs, &item)
+			if len(resp.Logs) == count {
+				return resp, nil
+			}
+		}
+		if !skipBlock && len(values) < count {
+			resp.Cursor = ""
+			return resp, nil
+		}
+		start = []byte(prefix + resp.Cursor)
+	}
+}
@@ -160,6 +160,9 @@ func (evm *EVMExecutor) Query_Query(in *evmtypes.EvmQueryReq) (types.Message, er
 	receipt, err := evm.innerExec(msg, txHash, 1, evmtypes.MaxGasLimit, true)
 	if err != nil {
 		ret.JsonData = fmt.Sprintf("%v", err)
+		if revertErr, ok := err.(*model.RevertError); ok {
+			ret.RevertReason = revertErr.Reason
+		}
 		return ret, nil
 	}
 	if receipt.Ty == types.ExecOk {
@@ -186,3 +189,78 @@ func (evm *EVMExecutor) Query_QueryABI(in *evmtypes.EvmQueryAbiReq) (types.Messa
 
 	return &evmtypes.EvmQueryAbiResp{Address: in.GetAddress(), Abi: abiData}, nil
 }
+
+// Query_TraceTransaction 重新执行历史交易，按指定的跟踪器返回调用树或者交易执行前后的账户状态，不修改原有执行器的状态数据
+func (evm *EVMExecutor) Query_TraceTransaction(in *evmtypes.EvmTraceTxReq) (types.Message, error) {
+	evm.CheckInit()
+	return evm.traceTransaction(in)
+}
+
+// Query_GetLogs 根据合约地址和主题查询合约事件日志，支持按区块高度范围和游标分页查询
+func (evm *EVMExecutor) Query_GetLogs(in *evmtypes.EvmGetLogsReq) (types.Message, error) {
+	return evm.getLogs(in)
+}
+
+// Query_GetCode 查询合约代码
+func (evm *EVMExecutor) Query_GetCode(in *evmtypes.EvmGetCodeReq) (types.Message, error) {
+	evm.CheckInit()
+
+	addr := common.StringToAddress(in.GetAddress())
+	if addr == nil {
+		return nil, fmt.Errorf("invalid address: %v", in.GetAddress())
+	}
+	return &evmtypes.EvmGetCodeResp{Address: in.GetAddress(), Code: evm.mStateDB.GetCode(addr.String())}, nil
+}
+
+// Query_GetStorageAt 查询合约指定存储位置的数据
+func (evm *EVMExecutor) Query_GetStorageAt(in *evmtypes.EvmGetStorageAtReq) (types.Message, error) {
+	evm.CheckInit()
+
+	addr := common.StringToAddress(in.GetAddress())
+	if addr == nil {
+		return nil, fmt.Errorf("invalid address: %v", in.GetAddress())
+	}
+	value := evm.mStateDB.GetState(addr.String(), common.BytesToHash(common.FromHex(in.GetKey())))
+	return &evmtypes.EvmGetStorageAtResp{Value: value.Hex()}, nil
+}
+
+// Query_Call 使用十六进制调用数据调用合约，返回原始的返回数据，不修改原有执行器的状态数据
+func (evm *EVMExecutor) Query_Call(in *evmtypes.EvmCallReq) (types.Message, error) {
+	evm.CheckInit()
+
+	to := common.StringToAddress(in.GetTo())
+	if to == nil {
+		return nil, fmt.Errorf("invalid address: %v", in.GetTo())
+	}
+	var caller common.Address
+	if len(in.Caller) > 0 {
+		callAddr := common.StringToAddress(in.Caller)
+		if callAddr == nil {
+			return nil, fmt.Errorf("invalid address: %v", in.Caller)
+		}
+		caller = *callAddr
+	} else {
+		caller = common.ExecAddress(evm.GetAPI().GetConfig().ExecName(evmtypes.ExecutorName))
+	}
+
+	msg := common.NewMessage(caller, to, 0, in.Amount, evmtypes.MaxGasLimit, 1, in.Data, "estimateGas", "")
+	txHash := common.BigToHash(big.NewInt(evmtypes.MaxGasLimit)).Bytes()
+
+	ret := &evmtypes.EvmCallResp{}
+	receipt, err := evm.innerExec(msg, txHash, 1, evmtypes.MaxGasLimit, true)
+	if err != nil {
+		ret.Error = err.Error()
+		if revertErr, ok := err.(*model.RevertError); ok {
+			ret.RevertReason = revertErr.Reason
+		}
+		return ret, nil
+	}
+	if receipt != nil && receipt.Ty == types.ExecOk {
+		callData := getCallReceipt(receipt.GetLogs())
+		if callData != nil {
+			ret.Ret = callData.Ret
+			ret.UsedGas = callData.UsedGas
+		}
+	}
+	return ret, nil
+}
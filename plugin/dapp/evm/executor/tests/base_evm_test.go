@@ -6,10 +6,17 @@ package tests
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
 )
 
 // 正常创建合约逻辑
@@ -113,6 +120,226 @@ func TestCreateContract4(t *testing.T) {
 	test.assertEqualsS(err.Error(), "evm: max code size exceeded")
 }
 
+// 伊斯坦布尔指令在分叉前不可用，分叉后正常执行
+func TestIstanbulOpcodes(t *testing.T) {
+	forkHeight := chainTestCfg.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMIstanbul)
+	cases := []struct {
+		name string
+		code string
+		op   string
+		want []byte
+	}{
+		// CHAINID PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+		{"chainid", "4660005260206000f3", "0x46", common.LeftPadBytes(big.NewInt(int64(chainTestCfg.GetChainID())).Bytes(), 32)},
+		// SELFBALANCE PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+		{"selfbalance", "4760005260206000f3", "0x47", make([]byte, 32)},
+		// CALLER EXTCODEHASH PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN （有余额的外部账户，代码哈希为空代码的哈希）
+		{"extcodehash", "333f60005260206000f3", "0x3f", crypto.Keccak256(nil)},
+	}
+	privKey := getPrivKey()
+	for _, c := range cases {
+		deployCode, _ := hex.DecodeString(c.code)
+		tx := createTx(privKey, deployCode, 210000, 10000000)
+
+		test := NewTester(t)
+		mdb := buildStateDB(getAddr(privKey).String(), 500000000)
+		_, _, _, _, err := createContractAtHeight(mdb, tx, 0, forkHeight-1)
+		test.assertNotNil(err)
+		test.assertEqualsS(err.Error(), "invalid OpCode "+c.op)
+
+		mdb = buildStateDB(getAddr(privKey).String(), 500000000)
+		ret, _, _, _, err := createContractAtHeight(mdb, tx, 0, forkHeight)
+		test.assertNil(err)
+		test.assertEqualsB(ret, c.want)
+	}
+}
+
+// CREATE2地址计算，使用EIP1014中的测试数据
+func TestCreateAddress2(t *testing.T) {
+	cases := []struct {
+		caller string
+		salt   string
+		code   string
+		want   string
+	}{
+		{"0000000000000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "00", "4d1a2e2bb4f88f0250f26ffff098b0b30b26bf38"},
+		{"deadbeef00000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "00", "b928f69bb1d91cd65274e3c79d8986362984fda3"},
+		{"00000000000000000000000000000000deadbeef", "00000000000000000000000000000000000000000000000000000000cafebabe", "deadbeef", "60f3f640a8508fc6a86d45df051962668e1e8ac7"},
+		{"0000000000000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "", "e33c0c7f7df4809055c3eba6c09cfe4baf1bd9e0"},
+	}
+	test := NewTester(t)
+	for _, c := range cases {
+		caller := common.BytesToAddress(getBin(c.caller))
+		salt := common.BytesToHash(getBin(c.salt))
+		addr := runtime.CreateAddress2(caller, salt, getBin(c.code))
+		test.assertEqualsS(hex.EncodeToString(addr.Bytes()), c.want)
+	}
+}
+
+// CREATE2的目标地址只有余额时仍然可以创建合约，已经有合约代码时返回地址冲突
+func TestCreate2Collision(t *testing.T) {
+	privKey := getPrivKey()
+	caller := common.StringToAddress(getAddr(privKey).String())
+	// 初始化代码返回1字节的合约代码
+	code := getBin("60016000f3")
+	salt := big.NewInt(1)
+	contractAddr := runtime.CreateAddress2(*caller, common.BigToHash(salt), code)
+
+	mdb := buildStateDB(caller.String(), 500000000)
+	addAccount(mdb, "", &types.Account{Addr: contractAddr.String(), Balance: 100})
+	tx := createTx(privKey, code, 210000, 0)
+	forkHeight := chainTestCfg.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMIstanbul)
+	env, msg, statedb := newEVMAtHeight(mdb, tx, forkHeight, nil)
+
+	test := NewTester(t)
+	test.assertEqualsV(0, len(statedb.GetCode(contractAddr.String())))
+	_, addr, _, err := env.Create2(runtime.AccountRef(msg.From()), code, msg.GasLimit(), salt, "")
+	test.assertNil(err)
+	test.assertEqualsS(addr.String(), contractAddr.String())
+	test.assertEqualsV(1, statedb.GetCodeSize(contractAddr.String()))
+
+	_, _, _, err = env.Create2(runtime.AccountRef(msg.From()), code, msg.GasLimit(), salt, "")
+	test.assertEqualsE(model.ErrContractAddressCollision, err)
+}
+
+// 伊斯坦布尔预编译合约：blake2F使用EIP152中的测试数据，bn256系列按EIP1108重新计费
+func TestIstanbulPrecompiles(t *testing.T) {
+	test := NewTester(t)
+	test.assertEqualsV(len(runtime.PrecompiledContractsByzantium), 8)
+	test.assertEqualsV(len(runtime.PrecompiledContractsIstanbul), 9)
+
+	blake2F := runtime.PrecompiledContractsIstanbul[common.BytesToHash160Address([]byte{9})]
+	test.assertNotNil(blake2F)
+	cases := []struct {
+		input string
+		want  string
+		err   string
+	}{
+		{"", "", "invalid input length"},
+		{"00000c48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b61626300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000001", "", "invalid input length"},
+		{"0000000c48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b61626300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000002", "", "invalid final flag"},
+		{"0000000048c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b61626300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000001", "08c9bcf367e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d282e6ad7f520e511f6c3e2b8c68059b9442be0454267ce079217e1319cde05b", ""},
+		{"0000000c48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b61626300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000001", "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923", ""},
+		{"0000000c48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b61626300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000", "75ab69d3190a562c51aef8d88f1c2775876944407270c42c9844252c26d2875298743e7f6d5ea2f2d3e8d226039cd31b4e426ac4f2d3d666a610c2116fde4735", ""},
+	}
+	for _, c := range cases {
+		input := getBin(c.input)
+		ret, err := blake2F.Run(input)
+		if c.err != "" {
+			test.assertNotNil(err)
+			test.assertEqualsS(err.Error(), c.err)
+			continue
+		}
+		test.assertNil(err)
+		test.assertEqualsS(hex.EncodeToString(ret), c.want)
+		test.assertEqualsV(int(blake2F.RequiredGas(input)), int(new(big.Int).SetBytes(input[:4]).Int64()))
+	}
+
+	// 两组bn256点对的配对输入长度
+	pairing := make([]byte, 384)
+	for i, gas := range []int{150, 6000, 113000} {
+		addr := common.BytesToHash160Address([]byte{byte(6 + i)})
+		test.assertEqualsV(int(runtime.PrecompiledContractsIstanbul[addr].RequiredGas(pairing)), gas)
+	}
+}
+
+// 伊斯坦布尔分叉后合约内部可以调用预编译合约
+func TestCallPrecompiledContract(t *testing.T) {
+	forkHeight := chainTestCfg.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMIstanbul)
+	// PUSH1 0x2a PUSH1 0x00 MSTORE，再以STATICCALL调用0x04（dataCopy）将内存[0,32)拷贝到[32,64)，最后返回[32,64)
+	deployCode := getBin("602a600052602060206020600060045afa5060206020f3")
+	privKey := getPrivKey()
+	tx := createTx(privKey, deployCode, 210000, 0)
+	test := NewTester(t)
+
+	// 分叉前预编译合约不生效，调用空代码账户，返回数据不变
+	mdb := buildStateDB(getAddr(privKey).String(), 500000000)
+	ret, _, _, _, err := createContractAtHeight(mdb, tx, 0, forkHeight-1)
+	test.assertNil(err)
+	test.assertEqualsB(ret, make([]byte, 32))
+
+	mdb = buildStateDB(getAddr(privKey).String(), 500000000)
+	ret, _, _, _, err = createContractAtHeight(mdb, tx, 0, forkHeight)
+	test.assertNil(err)
+	test.assertEqualsB(ret, common.LeftPadBytes([]byte{0x2a}, 32))
+}
+
+// 调用树跟踪：合约构造函数中STATICCALL预编译合约0x04后，以revert("no")结束
+func TestCallTracer(t *testing.T) {
+	forkHeight := chainTestCfg.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMIstanbul)
+	// 构造函数：PUSH1 0x2a PUSH1 0x00 MSTORE，STATICCALL 0x04拷贝内存[0,32)，
+	// 然后在内存中构造Error("no")并REVERT
+	code := "602a600052602060206020600060045afa50" +
+		"7f08c379a000000000000000000000000000000000000000000000000000000000600052" +
+		"6020600452" +
+		"6002602452" +
+		"7f6e6f000000000000000000000000000000000000000000000000000000000000604452" +
+		"60646000fd"
+	privKey := getPrivKey()
+	tx := createTx(privKey, getBin(code), 210000, 0)
+	mdb := buildStateDB(getAddr(privKey).String(), 500000000)
+
+	tracer := runtime.NewCallTracer()
+	_, addr, _, _, err := createContractWithTracer(mdb, tx, 0, forkHeight, func(*state.MemoryStateDB) runtime.Tracer { return tracer })
+	test := NewTester(t)
+	test.assertEqualsE(err, model.ErrExecutionReverted)
+
+	root := tracer.Result()
+	test.assertNotNil(root)
+	test.assertEqualsS(root.Type, "CREATE")
+	test.assertEqualsS(root.From, getAddr(privKey).String())
+	test.assertEqualsS(root.To, addr.String())
+	test.assertEqualsS(root.Error, model.ErrExecutionReverted.Error())
+	reason, err := abi.UnpackRevert(common.FromHex(root.Output), "")
+	test.assertNil(err)
+	test.assertEqualsS(reason, "no")
+
+	test.assertEqualsV(len(root.Calls), 1)
+	call := root.Calls[0]
+	test.assertEqualsS(call.Type, "STATICCALL")
+	test.assertEqualsS(call.From, addr.String())
+	test.assertEqualsS(call.To, common.BytesToAddress([]byte{4}).String())
+	test.assertEqualsS(call.Input, common.Bytes2Hex(common.LeftPadBytes([]byte{0x2a}, 32)))
+	test.assertEqualsS(call.Output, call.Input)
+	test.assertEqualsS(call.Error, "")
+}
+
+// 账户前置状态跟踪：合约构造函数中将存储0位置设置为1
+func TestPrestateTracer(t *testing.T) {
+	// PUSH1 0x01 PUSH1 0x00 SSTORE STOP
+	deployCode := getBin("600160005500")
+	privKey := getPrivKey()
+	tx := createTx(privKey, deployCode, 210000, 0)
+
+	for _, diffMode := range []bool{false, true} {
+		mdb := buildStateDB(getAddr(privKey).String(), 500000000)
+		var tracer *runtime.PrestateTracer
+		_, addr, _, _, err := createContractWithTracer(mdb, tx, 0, 10, func(db *state.MemoryStateDB) runtime.Tracer {
+			tracer = runtime.NewPrestateTracer(db)
+			return tracer
+		})
+		test := NewTester(t)
+		test.assertNil(err)
+
+		pre, post := tracer.Result(diffMode)
+		if diffMode {
+			// 调用者账户没有变化，只返回合约账户
+			test.assertEqualsV(len(pre), 1)
+			test.assertEqualsV(len(post), 1)
+		} else {
+			test.assertEqualsV(len(pre), 2)
+			test.assertEqualsV(len(post), 2)
+			test.assertEqualsS(pre[0].Address, getAddr(privKey).String())
+			pre, post = pre[1:], post[1:]
+		}
+		test.assertEqualsS(pre[0].Address, addr.String())
+		test.assertEqualsV(len(pre[0].Storage), 1)
+		test.assertEqualsS(pre[0].Storage[0].Key, common.Hash{}.Hex())
+		test.assertEqualsS(pre[0].Storage[0].Value, common.Hash{}.Hex())
+		test.assertEqualsS(post[0].Storage[0].Value, common.BytesToHash([]byte{1}).Hex())
+	}
+}
+
 // 下面测试合约调用时的合约代码
 // 对应二进制：608060405234801561001057600080fd5b506298967f60008190555060df806100296000396000f3006080604052600436106049576000357c0100000000000000000000000000000000000000000000000000000000900463ffffffff16806360fe47b114604e5780636d4ce63c146078575b600080fd5b348015605957600080fd5b5060766004803603810190808035906020019092919050505060a0565b005b348015608357600080fd5b50608a60aa565b6040518082815260200191505060405180910390f35b8060008190555050565b600080549050905600a165627a7a72305820b3ccec4d8cbe393844da31834b7464f23d3b81b24f36ce7e18bb09601f2eb8660029
 //contract MyStore {
@@ -128,3 +355,26 @@ func TestCreateContract4(t *testing.T) {
 //        return value;
 //    }
 //}
+
+// 合约构造函数中通过LOG1生成事件日志，日志可以转换为交易回执日志
+func TestEventLogs(t *testing.T) {
+	// PUSH1 0x2a PUSH1 0 MSTORE PUSH1 0x07 PUSH1 0x20 PUSH1 0 LOG1 STOP
+	code := "602a600052600760206000a100"
+	privKey := getPrivKey()
+	tx := createTx(privKey, getBin(code), 210000, 0)
+	mdb := buildStateDB(getAddr(privKey).String(), 500000000)
+
+	_, addr, _, statedb, err := createContract(mdb, tx, 0)
+	test := NewTester(t)
+	test.assertNil(err)
+
+	logs := statedb.GetEventLogs()
+	test.assertEqualsV(len(logs), 1)
+	test.assertEqualsV(int(logs[0].Ty), evmtypes.TyLogEVMEventData)
+	var evmLog evmtypes.EVMLog
+	test.assertNil(types.Decode(logs[0].Log, &evmLog))
+	test.assertEqualsS(evmLog.Address, addr.String())
+	test.assertEqualsV(len(evmLog.Topic), 1)
+	test.assertEqualsB(evmLog.Topic[0], common.BytesToHash([]byte{7}).Bytes())
+	test.assertEqualsB(evmLog.Data, common.LeftPadBytes([]byte{0x2a}, 32))
+}
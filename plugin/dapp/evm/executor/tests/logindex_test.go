@@ -0,0 +1,190 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	evm "github.com/33cn/plugin/plugin/dapp/evm/executor"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	logContractA = common.BytesToAddress([]byte{0x0a}).String()
+	logContractB = common.BytesToAddress([]byte{0x0b}).String()
+	// Transfer(address,address,uint256)
+	transferTopic = common.FromHex("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	approvalTopic = common.FromHex("0x8c5be1e5ebec7d5bd14f71427e1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+	holderTopic   = common.BytesToHash([]byte{0x01}).Bytes()
+)
+
+type logIndexTester struct {
+	t       *testing.T
+	localdb db.KVDB
+	api     client.QueueProtocolAPI
+}
+
+func newLogIndexTester(t *testing.T) *logIndexTester {
+	q := queue.New("channel")
+	q.SetConfig(chainTestCfg)
+	api, _ := client.New(q.Client(), nil)
+	memdb, err := db.NewGoMemDB("test", "", 0)
+	assert.Nil(t, err)
+	return &logIndexTester{t: t, localdb: db.NewLocalDB(memdb, false), api: api}
+}
+
+func (lt *logIndexTester) newExecutor(height int64) *evm.EVMExecutor {
+	inst := evm.NewEVMExecutor()
+	inst.SetAPI(lt.api)
+	inst.SetLocalDB(lt.localdb)
+	inst.SetEnv(height, 0, 0)
+	return inst
+}
+
+// 在指定高度执行一笔生成事件日志的交易，并写入localdb
+func (lt *logIndexTester) execLocal(height int64, index int, logs ...*evmtypes.EVMLog) (*types.Transaction, *types.ReceiptData) {
+	tx := &types.Transaction{Execer: []byte(evmtypes.ExecutorName), Nonce: height*100 + int64(index)}
+	receipt := &types.ReceiptData{Ty: types.ExecOk}
+	for _, l := range logs {
+		receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: evmtypes.TyLogEVMEventData, Log: types.Encode(l)})
+	}
+	set, err := lt.newExecutor(height).ExecLocal(tx, receipt, index)
+	assert.Nil(lt.t, err)
+	for _, kv := range set.KV {
+		assert.Nil(lt.t, lt.localdb.Set(kv.Key, kv.Value))
+	}
+	return tx, receipt
+}
+
+func (lt *logIndexTester) execDelLocal(height int64, index int, tx *types.Transaction, receipt *types.ReceiptData) {
+	set, err := lt.newExecutor(height).ExecDelLocal(tx, receipt, index)
+	assert.Nil(lt.t, err)
+	for _, kv := range set.KV {
+		assert.Nil(lt.t, lt.localdb.Set(kv.Key, kv.Value))
+	}
+}
+
+func (lt *logIndexTester) getLogs(req *evmtypes.EvmGetLogsReq) *evmtypes.EvmGetLogsResp {
+	resp, err := lt.newExecutor(100).Query_GetLogs(req)
+	assert.Nil(lt.t, err)
+	return resp.(*evmtypes.EvmGetLogsResp)
+}
+
+func topics(values ...[]byte) *evmtypes.EvmTopicFilter {
+	filter := &evmtypes.EvmTopicFilter{}
+	for _, v := range values {
+		filter.Topic = append(filter.Topic, common.Bytes2Hex(v))
+	}
+	return filter
+}
+
+func TestGetLogs(t *testing.T) {
+	lt := newLogIndexTester(t)
+	lt.execLocal(10, 0, &evmtypes.EVMLog{Address: logContractA, Topic: [][]byte{transferTopic, holderTopic}, Data: []byte{1}})
+	lt.execLocal(20, 1,
+		&evmtypes.EVMLog{Address: logContractB, Topic: [][]byte{transferTopic}, Data: []byte{2}},
+		&evmtypes.EVMLog{Address: logContractA, Topic: [][]byte{approvalTopic, holderTopic}, Data: []byte{3}})
+	tx, receipt := lt.execLocal(30, 0, &evmtypes.EVMLog{Address: logContractA, Topic: [][]byte{transferTopic}, Data: []byte{4}})
+
+	// 不指定条件时按高度从高到低返回所有日志
+	resp := lt.getLogs(&evmtypes.EvmGetLogsReq{})
+	assert.Equal(t, 4, len(resp.Logs))
+	assert.Equal(t, int64(30), resp.Logs[0].Height)
+	assert.Equal(t, int32(1), resp.Logs[1].LogIndex)
+	assert.Equal(t, common.Bytes2Hex(tx.Hash()), resp.Logs[0].TxHash)
+	assert.Equal(t, "", resp.Cursor)
+
+	// 按合约地址查询
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{Addresses: []string{logContractA}})
+	assert.Equal(t, 3, len(resp.Logs))
+
+	// 按主题查询，多个合约地址满足其一即可
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{Addresses: []string{logContractA, logContractB}, Topics: []*evmtypes.EvmTopicFilter{topics(transferTopic)}})
+	assert.Equal(t, 3, len(resp.Logs))
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{Topics: []*evmtypes.EvmTopicFilter{topics(transferTopic, approvalTopic), topics(holderTopic)}})
+	assert.Equal(t, 2, len(resp.Logs))
+	assert.Equal(t, []byte{3}, common.FromHex(resp.Logs[0].Data))
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{Topics: []*evmtypes.EvmTopicFilter{{}, topics(holderTopic)}, Addresses: []string{logContractB}})
+	assert.Equal(t, 0, len(resp.Logs))
+
+	// 按高度范围查询
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{FromBlock: 15, ToBlock: 25})
+	assert.Equal(t, 2, len(resp.Logs))
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{FromBlock: 15, ToBlock: 1000, Addresses: []string{logContractA}})
+	assert.Equal(t, 2, len(resp.Logs))
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{ToBlock: 5})
+	assert.Equal(t, 0, len(resp.Logs))
+
+	// 分页查询
+	var all []*evmtypes.EvmLogItem
+	req := &evmtypes.EvmGetLogsReq{Topics: []*evmtypes.EvmTopicFilter{topics(transferTopic)}, Count: 2}
+	for {
+		resp = lt.getLogs(req)
+		all = append(all, resp.Logs...)
+		if resp.Cursor == "" {
+			break
+		}
+		req.Cursor = resp.Cursor
+	}
+	assert.Equal(t, 3, len(all))
+	assert.Equal(t, int64(10), all[2].Height)
+
+	// 回滚最后一个区块后对应的日志索引被删除
+	lt.execDelLocal(30, 0, tx, receipt)
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{Addresses: []string{logContractA}})
+	assert.Equal(t, 2, len(resp.Logs))
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{})
+	assert.Equal(t, 3, len(resp.Logs))
+}
+
+func TestGetLogsBloom(t *testing.T) {
+	lt := newLogIndexTester(t)
+	logContractC := common.BytesToAddress([]byte{0x0c}).String()
+	lt.execLocal(10, 0, &evmtypes.EVMLog{Address: logContractA, Topic: [][]byte{transferTopic}, Data: []byte{1}})
+	lt.execLocal(20, 0, &evmtypes.EVMLog{Address: logContractB, Topic: [][]byte{approvalTopic}, Data: []byte{2}})
+	lt.execLocal(20, 3,
+		&evmtypes.EVMLog{Address: logContractC, Topic: [][]byte{transferTopic}, Data: []byte{3}},
+		&evmtypes.EVMLog{Address: logContractA, Topic: [][]byte{approvalTopic}, Data: []byte{4}})
+	for h := int64(21); h < 30; h++ {
+		lt.execLocal(h, 0, &evmtypes.EVMLog{Address: logContractB, Topic: [][]byte{holderTopic}, Data: []byte{5}})
+	}
+
+	// 日志在区块中的序号包含同一区块前面交易生成的日志
+	resp := lt.getLogs(&evmtypes.EvmGetLogsReq{FromBlock: 20, ToBlock: 20})
+	assert.Equal(t, 3, len(resp.Logs))
+	assert.Equal(t, int32(2), resp.Logs[0].BlockLogIndex)
+	assert.Equal(t, int32(1), resp.Logs[0].LogIndex)
+	assert.Equal(t, int32(1), resp.Logs[1].BlockLogIndex)
+	assert.Equal(t, int32(0), resp.Logs[2].BlockLogIndex)
+
+	// 多个合约地址无法使用单一索引，遍历时跳过布隆过滤器不匹配的区块，分页游标保持可用
+	var all []*evmtypes.EvmLogItem
+	req := &evmtypes.EvmGetLogsReq{Addresses: []string{logContractA, logContractC}, Count: 1}
+	for {
+		resp = lt.getLogs(req)
+		all = append(all, resp.Logs...)
+		if resp.Cursor == "" {
+			break
+		}
+		req.Cursor = resp.Cursor
+	}
+	assert.Equal(t, 3, len(all))
+	assert.Equal(t, []byte{4}, common.FromHex(all[0].Data))
+	assert.Equal(t, []byte{3}, common.FromHex(all[1].Data))
+	assert.Equal(t, int64(10), all[2].Height)
+
+	// 多个主题取值时同样按区块过滤
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{Topics: []*evmtypes.EvmTopicFilter{topics(transferTopic, approvalTopic)}, ToBlock: 25})
+	assert.Equal(t, 4, len(resp.Logs))
+	resp = lt.getLogs(&evmtypes.EvmGetLogsReq{Addresses: []string{logContractA, logContractB}, Topics: []*evmtypes.EvmTopicFilter{topics(transferTopic, holderTopic)}, FromBlock: 11})
+	assert.Equal(t, 9, len(resp.Logs))
+	assert.Equal(t, int64(29), resp.Logs[0].Height)
+}
@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	evm "github.com/33cn/plugin/plugin/dapp/evm/executor"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// 任何调用都以Error("boom")的形式revert的合约
+const revertCode = "6070600c60003960706000f36064600c60003960646000fd08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000004626f6f6d00000000000000000000000000000000000000000000000000000000"
+
+func TestRevertReasonReceipt(t *testing.T) {
+	privKey := getPrivKey()
+	caller := getAddr(privKey).String()
+	mdb := buildStateDB(caller, 500000000)
+	localdb := db.NewLocalDB(mdb, false)
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+	forkHeight := chainTestCfg.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMRevertReason)
+	newExecutor := func(height int64) *evm.EVMExecutor {
+		inst := evm.NewEVMExecutor()
+		inst.SetAPI(api)
+		inst.SetStateDB(mdb)
+		inst.SetLocalDB(localdb)
+		inst.SetEnv(height, 0, 0)
+		return inst
+	}
+
+	deployTx := createTx(privKey, getBin(revertCode), 500000, 0)
+	receipt, err := newExecutor(forkHeight).Exec(&deployTx, 0)
+	assert.Nil(t, err)
+	var contract, execName string
+	for _, l := range receipt.Logs {
+		if l.Ty == evmtypes.TyLogCallContract {
+			var cr evmtypes.ReceiptEVMContract
+			assert.Nil(t, types.Decode(l.Log, &cr))
+			contract, execName = cr.ContractAddr, cr.ContractName
+		}
+	}
+	for _, kv := range receipt.KV {
+		assert.Nil(t, mdb.Set(kv.Key, kv.Value))
+	}
+
+	// 分叉之前不解析revert原因，保持原有的错误
+	callTx := createSetTx(privKey, execName, contract, 1, 1)
+	_, err = newExecutor(forkHeight-1).Exec(callTx, 0)
+	assert.Equal(t, model.ErrExecutionReverted, err)
+
+	// 分叉之后交易按失败打包，回执中记录revert原因
+	receipt, err = newExecutor(forkHeight).Exec(callTx, 0)
+	assert.Nil(t, err)
+	revertErr := &model.RevertError{Reason: "boom"}
+	assert.True(t, errors.Is(revertErr, model.ErrExecutionReverted))
+	assert.Equal(t, int32(types.ExecPack), receipt.Ty)
+	assert.Equal(t, 0, len(receipt.KV))
+	assert.Equal(t, 2, len(receipt.Logs))
+	assert.Equal(t, int32(types.TyLogErr), receipt.Logs[0].Ty)
+	assert.Equal(t, revertErr.Error(), string(receipt.Logs[0].Log))
+	var cr evmtypes.ReceiptEVMContract
+	assert.Nil(t, types.Decode(receipt.Logs[1].Log, &cr))
+	assert.Equal(t, "boom", cr.RevertReason)
+	assert.Equal(t, caller, cr.Caller)
+	assert.Equal(t, contract, cr.ContractAddr)
+}
@@ -0,0 +1,114 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	evm "github.com/33cn/plugin/plugin/dapp/evm/executor"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MyStore合约，见base_evm_test.go中的合约代码说明
+const myStoreCode = "608060405234801561001057600080fd5b506298967f60008190555060df806100296000396000f3006080604052600436106049576000357c0100000000000000000000000000000000000000000000000000000000900463ffffffff16806360fe47b114604e5780636d4ce63c146078575b600080fd5b348015605957600080fd5b5060766004803603810190808035906020019092919050505060a0565b005b348015608357600080fd5b50608a60aa565b6040518082815260200191505060405180910390f35b8060008190555050565b600080549050905600a165627a7a72305820b3ccec4d8cbe393844da31834b7464f23d3b81b24f36ce7e18bb09601f2eb8660029"
+
+// 调用MyStore合约的set方法
+func createSetTx(privKey crypto.PrivKey, execName string, contract string, value int64, nonce int64) *types.Transaction {
+	data := append(getBin("60fe47b1"), common.LeftPadBytes(big.NewInt(value).Bytes(), 32)...)
+	action := evmtypes.EVMContractAction{Code: data}
+	tx := &types.Transaction{Execer: []byte(execName), Payload: types.Encode(&action), Fee: 210000, To: contract, Nonce: nonce}
+	tx.Sign(types.SECP256K1, privKey)
+	return tx
+}
+
+// 第1个区块部署合约，第2个区块中先后两次调用set方法，在第1个区块的状态上重新执行第2笔调用
+func TestTraceTransaction(t *testing.T) {
+	privKey := getPrivKey()
+	caller := getAddr(privKey).String()
+	mdb := buildStateDB(caller, 500000000)
+	localdb := db.NewLocalDB(mdb, false)
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+	newExecutor := func(height int64) *evm.EVMExecutor {
+		inst := evm.NewEVMExecutor()
+		inst.SetAPI(api)
+		inst.SetStateDB(mdb)
+		inst.SetLocalDB(localdb)
+		inst.SetEnv(height, 0, 0)
+		return inst
+	}
+
+	deployTx := createTx(privKey, getBin(myStoreCode), 500000, 0)
+	receipt, err := newExecutor(1).Exec(&deployTx, 0)
+	assert.Nil(t, err)
+	var contract, execName string
+	for _, l := range receipt.Logs {
+		if l.Ty == evmtypes.TyLogCallContract {
+			var cr evmtypes.ReceiptEVMContract
+			assert.Nil(t, types.Decode(l.Log, &cr))
+			contract, execName = cr.ContractAddr, cr.ContractName
+		}
+	}
+	assert.NotEqual(t, "", contract)
+	for _, kv := range receipt.KV {
+		assert.Nil(t, mdb.Set(kv.Key, kv.Value))
+	}
+
+	// 第1个区块的状态哈希对应当前数据库中的状态
+	parentHash := []byte("parent state hash")
+	api.On("StoreGet", mock.Anything).Return(func(req *types.StoreGet) *types.StoreReplyValue {
+		assert.Equal(t, parentHash, req.StateHash)
+		reply := &types.StoreReplyValue{}
+		for _, key := range req.Keys {
+			value, _ := mdb.Get(key)
+			reply.Values = append(reply.Values, value)
+		}
+		return reply
+	}, nil)
+
+	setTx1 := createSetTx(privKey, execName, contract, 7, 1)
+	setTx2 := createSetTx(privKey, execName, contract, 42, 2)
+	block := &types.Block{Height: 2, Txs: []*types.Transaction{setTx1, setTx2}}
+	api.On("QueryTx", &types.ReqHash{Hash: setTx2.Hash()}).Return(&types.TransactionDetail{Height: 2, Index: 1}, nil)
+	api.On("GetBlocks", &types.ReqBlocks{Start: 1, End: 2}).Return(&types.BlockDetails{Items: []*types.BlockDetail{
+		{Block: &types.Block{Height: 1, StateHash: parentHash}},
+		{Block: block},
+	}}, nil)
+
+	// 调用跟踪
+	query := newExecutor(3)
+	msg, err := query.Query_TraceTransaction(&evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(setTx2.Hash()), Tracer: runtime.CallTracerName})
+	assert.Nil(t, err)
+	resp := msg.(*evmtypes.EvmTraceTxResp)
+	assert.NotNil(t, resp.CallTrace)
+	assert.Equal(t, "CALL", resp.CallTrace.Type)
+	assert.Equal(t, caller, resp.CallTrace.From)
+	assert.Equal(t, contract, resp.CallTrace.To)
+	assert.Equal(t, "", resp.CallTrace.Error)
+
+	// 前置状态跟踪，存储中的旧值是区块内前一笔交易写入的7，而不是部署时的9999999
+	msg, err = query.Query_TraceTransaction(&evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(setTx2.Hash()), Tracer: runtime.PrestateTracerName, DiffMode: true})
+	assert.Nil(t, err)
+	resp = msg.(*evmtypes.EvmTraceTxResp)
+	assert.Equal(t, 1, len(resp.Pre))
+	assert.Equal(t, contract, resp.Pre[0].Address)
+	assert.Equal(t, common.BytesToHash([]byte{7}).Hex(), resp.Pre[0].Storage[0].Value)
+	assert.Equal(t, common.BytesToHash([]byte{42}).Hex(), resp.Post[0].Storage[0].Value)
+
+	// 重新执行交易不会修改真实的状态数据
+	state := newExecutor(3)
+	state.CheckInit()
+	assert.Equal(t, common.BigToHash(big.NewInt(9999999)), state.GetMStateDB().GetState(contract, common.Hash{}))
+}
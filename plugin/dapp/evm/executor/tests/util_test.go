@@ -258,6 +258,30 @@ func buildStateDB(addr string, balance int64) *db.GoMemDB {
 }
 
 func createContract(mdb *db.GoMemDB, tx types.Transaction, maxCodeSize int) (ret []byte, contractAddr common.Address, leftOverGas uint64, statedb *state.MemoryStateDB, err error) {
+	return createContractAtHeight(mdb, tx, maxCodeSize, 10)
+}
+
+// 在指定区块高度上创建合约，用于测试分叉前后的执行逻辑
+func createContractAtHeight(mdb *db.GoMemDB, tx types.Transaction, maxCodeSize int, height int64) (ret []byte, contractAddr common.Address, leftOverGas uint64, statedb *state.MemoryStateDB, err error) {
+	return createContractWithTracer(mdb, tx, maxCodeSize, height, nil)
+}
+
+// 在指定区块高度上创建合约，tracer不为空时开启调试跟踪；
+// tracer为函数形式，以便跟踪器可以使用合约执行时的状态数据库
+func createContractWithTracer(mdb *db.GoMemDB, tx types.Transaction, maxCodeSize int, height int64, tracer func(*state.MemoryStateDB) runtime.Tracer) (ret []byte, contractAddr common.Address, leftOverGas uint64, statedb *state.MemoryStateDB, err error) {
+	env, msg, statedb := newEVMAtHeight(mdb, tx, height, tracer)
+	if maxCodeSize != 0 {
+		env.SetMaxCodeSize(maxCodeSize)
+	}
+
+	addr := *crypto2.RandomContractAddress()
+	ret, _, leftGas, err := env.Create(runtime.AccountRef(msg.From()), addr, msg.Data(), msg.GasLimit(), fmt.Sprintf("%s%s", evmtypes.EvmPrefix, common.BytesToHash(tx.Hash()).Hex()), "", "")
+
+	return ret, addr, leftGas, statedb, err
+}
+
+// 在指定区块高度上以tx构造EVM运行时对象
+func newEVMAtHeight(mdb *db.GoMemDB, tx types.Transaction, height int64, tracer func(*state.MemoryStateDB) runtime.Tracer) (*runtime.EVM, *common.Message, *state.MemoryStateDB) {
 	inst := evm.NewEVMExecutor()
 	q := queue.New("channel")
 	q.SetConfig(chainTestCfg)
@@ -266,8 +290,8 @@ func createContract(mdb *db.GoMemDB, tx types.Transaction, maxCodeSize int) (ret
 	inst.CheckInit()
 	msg, _ := inst.GetMessage(&tx)
 
-	inst.SetEnv(10, 0, uint64(10))
-	statedb = inst.GetMStateDB()
+	inst.SetEnv(height, 0, uint64(10))
+	statedb := inst.GetMStateDB()
 
 	statedb.StateDB = mdb
 
@@ -275,17 +299,13 @@ func createContract(mdb *db.GoMemDB, tx types.Transaction, maxCodeSize int) (ret
 	statedb.CoinsAccount.SetDB(statedb.StateDB)
 
 	vmcfg := inst.GetVMConfig()
+	if tracer != nil {
+		vmcfg.Debug = true
+		vmcfg.Tracer = tracer(statedb)
+	}
 
 	context := inst.NewEVMContext(msg)
 
 	// 创建EVM运行时对象
-	env := runtime.NewEVM(context, statedb, *vmcfg)
-	if maxCodeSize != 0 {
-		env.SetMaxCodeSize(maxCodeSize)
-	}
-
-	addr := *crypto2.RandomContractAddress()
-	ret, _, leftGas, err := env.Create(runtime.AccountRef(msg.From()), addr, msg.Data(), msg.GasLimit(), fmt.Sprintf("%s%s", evmtypes.EvmPrefix, common.BytesToHash(tx.Hash()).Hex()), "", "")
-
-	return ret, addr, leftGas, statedb, err
+	return runtime.NewEVM(context, statedb, *vmcfg), msg, statedb
 }
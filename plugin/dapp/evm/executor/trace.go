@@ -0,0 +1,164 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/client"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// historyStateDB 基于历史状态哈希的状态数据库，用于重新执行历史交易；
+// 读取时优先从内存缓存获取，缓存中不存在时从指定状态哈希的状态树中读取；
+// 交易重新执行时产生的写操作只保存在内存中，不会影响真实的状态数据
+type historyStateDB struct {
+	api       client.QueueProtocolAPI
+	stateHash []byte
+	cache     map[string][]byte
+}
+
+func newHistoryStateDB(api client.QueueProtocolAPI, stateHash []byte) *historyStateDB {
+	return &historyStateDB{api: api, stateHash: stateHash, cache: make(map[string][]byte)}
+}
+
+// Get 读取指定key的数据
+func (db *historyStateDB) Get(key []byte) ([]byte, error) {
+	if value, ok := db.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	values, err := db.api.StoreGet(&types.StoreGet{StateHash: db.stateHash, Keys: [][]byte{key}})
+	if err != nil {
+		return nil, err
+	}
+	if len(values.Values) == 0 || values.Values[0] == nil {
+		return nil, types.ErrNotFound
+	}
+	db.cache[string(key)] = values.Values[0]
+	return values.Values[0], nil
+}
+
+// Set 写入数据，只保存在内存中
+func (db *historyStateDB) Set(key []byte, value []byte) error {
+	db.cache[string(key)] = value
+	return nil
+}
+
+// Begin 不支持事务，实现为空
+func (db *historyStateDB) Begin() {}
+
+// Commit 不支持事务，实现为空
+func (db *historyStateDB) Commit() error { return nil }
+
+// Rollback 不支持事务，实现为空
+func (db *historyStateDB) Rollback() {}
+
+// 在交易所在区块的前一个区块状态上，依次执行区块内该交易之前的EVM交易，再使用指定的跟踪器执行该交易；
+// 区块内之前的非EVM交易不会被执行，如果它们修改了本交易依赖的账户数据，跟踪结果可能和实际执行结果存在差异
+func (evm *EVMExecutor) traceTransaction(in *evmtypes.EvmTraceTxReq) (*evmtypes.EvmTraceTxResp, error) {
+	if in.Tracer != runtime.CallTracerName && in.Tracer != runtime.PrestateTracerName {
+		return nil, model.ErrUnsupportedTracer
+	}
+	hash := common.FromHex(in.TxHash)
+	if len(hash) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	api := evm.GetAPI()
+	detail, err := api.QueryTx(&types.ReqHash{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	if detail.Height <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	blocks, err := api.GetBlocks(&types.ReqBlocks{Start: detail.Height - 1, End: detail.Height, IsDetail: false})
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks.Items) != 2 {
+		return nil, types.ErrBlockNotFound
+	}
+	parent, block := blocks.Items[0].Block, blocks.Items[1].Block
+	if int(detail.Index) >= len(block.Txs) {
+		return nil, types.ErrTxNotExist
+	}
+
+	// 构造一个独立的执行器，使用历史状态执行交易
+	tracer := NewEVMExecutor()
+	tracer.SetAPI(api)
+	statedb := newHistoryStateDB(api, parent.StateHash)
+	tracer.SetStateDB(statedb)
+	tracer.SetLocalDB(evm.GetLocalDB())
+	tracer.SetEnv(block.Height, block.BlockTime, uint64(block.Difficulty))
+	tracer.CheckInit()
+
+	tx := block.Txs[detail.Index]
+	if tracer.Allow(tx, int(detail.Index)) != nil {
+		return nil, model.ErrNotEVMTx
+	}
+	for i := 0; i < int(detail.Index); i++ {
+		if tracer.Allow(block.Txs[i], i) != nil {
+			continue
+		}
+		receipt, err := tracer.Exec(block.Txs[i], i)
+		if err != nil || receipt == nil {
+			continue
+		}
+		for _, kv := range receipt.KV {
+			statedb.Set(kv.Key, kv.Value)
+		}
+	}
+
+	resp := &evmtypes.EvmTraceTxResp{TxHash: in.TxHash, Tracer: in.Tracer}
+	var (
+		callTracer     *runtime.CallTracer
+		prestateTracer *runtime.PrestateTracer
+	)
+	if in.Tracer == runtime.CallTracerName {
+		callTracer = runtime.NewCallTracer()
+		tracer.vmCfg.Tracer = callTracer
+	} else {
+		prestateTracer = runtime.NewPrestateTracer(tracer.GetMStateDB())
+		tracer.vmCfg.Tracer = prestateTracer
+	}
+	tracer.vmCfg.Debug = true
+
+	// 执行出错时跟踪器中已经记录了错误信息，这里不需要返回错误
+	_, err = tracer.Exec(tx, int(detail.Index))
+	if err != nil {
+		log.Debug("trace evm transaction", "hash", in.TxHash, "error", err)
+	}
+
+	if callTracer != nil {
+		resp.CallTrace = callTracer.Result()
+		fillRevertReason(resp.CallTrace, tracer.GetMStateDB())
+	} else {
+		resp.Pre, resp.Post = prestateTracer.Result(in.DiffMode)
+	}
+	return resp, nil
+}
+
+// 解析调用树中各调用帧revert时返回的错误信息，自定义错误使用被调用合约的ABI解析
+func fillRevertReason(frame *evmtypes.EvmCallFrame, statedb *state.MemoryStateDB) {
+	if frame == nil {
+		return
+	}
+	if frame.Error == model.ErrExecutionReverted.Error() {
+		if reason, err := abi.UnpackRevert(common.FromHex(frame.Output), statedb.GetAbi(frame.To)); err == nil {
+			frame.RevertReason = reason
+		}
+	}
+	for _, call := range frame.Calls {
+		fillRevertReason(call, statedb)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"github.com/33cn/chain33/common/crypto/sha3"
+)
+
+// BloomByteLength 布隆过滤器的字节长度
+const BloomByteLength = 256
+
+// Bloom 以太坊格式的2048位布隆过滤器，用于快速判断日志中是否可能包含指定的合约地址或主题
+type Bloom [BloomByteLength]byte
+
+// BytesToBloom 将字节数组转换为布隆过滤器，长度不足时左侧补零
+func BytesToBloom(b []byte) Bloom {
+	var bloom Bloom
+	if len(b) > BloomByteLength {
+		b = b[len(b)-BloomByteLength:]
+	}
+	copy(bloom[BloomByteLength-len(b):], b)
+	return bloom
+}
+
+// Add 将数据加入布隆过滤器
+func (b *Bloom) Add(data []byte) {
+	for _, bit := range bloomBits(data) {
+		b[BloomByteLength-1-bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test 判断数据是否可能在布隆过滤器中，返回false时一定不在
+func (b Bloom) Test(data []byte) bool {
+	for _, bit := range bloomBits(data) {
+		if b[BloomByteLength-1-bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Bytes 二进制形式
+func (b Bloom) Bytes() []byte { return b[:] }
+
+// 取数据哈希的前三组双字节，各自对应2048位中的一位
+func bloomBits(data []byte) (bits [3]uint) {
+	sha := sha3.NewLegacyKeccak256()
+	sha.Write(data)
+	hash := sha.Sum(nil)
+	for i := 0; i < 3; i++ {
+		bits[i] = (uint(hash[2*i])<<8 | uint(hash[2*i+1])) & 2047
+	}
+	return bits
+}
@@ -0,0 +1,38 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blake2b
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	xblake2b "golang.org/x/crypto/blake2b"
+)
+
+// 使用压缩函数F对单块消息计算BLAKE2b-512摘要，并与标准实现对比
+func TestFMatchesBlake2b512(t *testing.T) {
+	for _, msg := range [][]byte{nil, []byte("abc"), bytes.Repeat([]byte{0x5a}, 128)} {
+		h := iv
+		h[0] ^= 0x01010040
+
+		var block [128]byte
+		copy(block[:], msg)
+		var m [16]uint64
+		for i := range m {
+			m[i] = binary.LittleEndian.Uint64(block[i*8:])
+		}
+		F(&h, m, [2]uint64{uint64(len(msg)), 0}, true, 12)
+
+		var out [64]byte
+		for i := range h {
+			binary.LittleEndian.PutUint64(out[i*8:], h[i])
+		}
+		want := xblake2b.Sum512(msg)
+		if !bytes.Equal(out[:], want[:]) {
+			t.Errorf("msg %x: got %x, want %x", msg, out, want)
+		}
+	}
+}
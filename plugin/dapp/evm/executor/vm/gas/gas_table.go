@@ -25,6 +25,8 @@ type Table struct {
 	ExtcodeSize uint64
 	// ExtcodeCopy 代码复制价格
 	ExtcodeCopy uint64
+	// ExtcodeHash 获取代码哈希价格
+	ExtcodeHash uint64
 	// Balance 账户计价
 	Balance uint64
 	// SLoad 加载数据计价
@@ -48,6 +50,18 @@ var (
 		Suicide:     0,
 		ExpByte:     10,
 	}
+
+	// TableIstanbul 伊斯坦布尔版本的Gas定价（包含EIP150、EIP158以及EIP1884的重新定价）
+	TableIstanbul = Table{
+		ExtcodeSize: 700,
+		ExtcodeCopy: 700,
+		ExtcodeHash: 700,
+		Balance:     700,
+		SLoad:       800,
+		Calls:       700,
+		Suicide:     5000,
+		ExpByte:     50,
+	}
 )
 
 // 计算新开辟内存空间需要使用多少Gas
@@ -166,6 +180,55 @@ func SStore(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack
 	}
 }
 
+// SStoreEIP2200 伊斯坦布尔版本计算数据存储的价格
+// 根据存储槽在本交易开始时的原始值、当前值以及新值计算Gas，并调整奖励
+func SStoreEIP2200(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+	// 剩余Gas不足时不允许修改存储，防止重入
+	if contractGas.Gas <= params.SstoreSentryGasEIP2200 {
+		return 0, model.ErrOutOfGas
+	}
+	var (
+		y, x    = stack.Back(1), common.BigToHash(stack.Back(0))
+		addr    = contractGas.Address.String()
+		current = evm.StateDB.GetState(addr, x)
+		value   = common.BigToHash(y)
+	)
+
+	// 写入值和当前值相同，不做任何变更
+	if current == value {
+		return params.SstoreNoopGasEIP2200, nil
+	}
+	original := evm.StateDB.GetCommittedState(addr, x)
+	if original == current {
+		// 本交易中第一次修改此存储槽
+		if original == (common.Hash{}) {
+			return params.SstoreInitGasEIP2200, nil
+		}
+		if value == (common.Hash{}) {
+			evm.StateDB.AddRefund(params.SstoreClearRefundEIP2200)
+		}
+		return params.SstoreCleanGasEIP2200, nil
+	}
+
+	// 存储槽在本交易中已经被修改过（脏数据），需要修正之前给予的奖励
+	if original != (common.Hash{}) {
+		if current == (common.Hash{}) {
+			evm.StateDB.SubRefund(params.SstoreClearRefundEIP2200)
+		} else if value == (common.Hash{}) {
+			evm.StateDB.AddRefund(params.SstoreClearRefundEIP2200)
+		}
+	}
+	// 恢复为原始值
+	if original == value {
+		if original == (common.Hash{}) {
+			evm.StateDB.AddRefund(params.SstoreInitRefundEIP2200)
+		} else {
+			evm.StateDB.AddRefund(params.SstoreCleanRefundEIP2200)
+		}
+	}
+	return params.SstoreDirtyGasEIP2200, nil
+}
+
 // MakeGasLog 生成Gas计算方法
 func MakeGasLog(n uint64) CalcGasFunc {
 	return func(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
@@ -326,6 +389,30 @@ func Create(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack
 	return gas, nil
 }
 
+// Create2 创建合约计费，需要额外计算合约代码的哈希
+func Create2(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+	var overflow bool
+	gas, err := memoryGasCost(mem, memorySize)
+	if err != nil {
+		return 0, err
+	}
+	if gas, overflow = common.SafeAdd(gas, params.Create2Gas); overflow {
+		return 0, model.ErrGasUintOverflow
+	}
+
+	wordGas, overflow := common.BigUint64(stack.Back(2))
+	if overflow {
+		return 0, model.ErrGasUintOverflow
+	}
+	if wordGas, overflow = common.SafeMul(common.ToWordSize(wordGas), params.Sha3WordGas); overflow {
+		return 0, model.ErrGasUintOverflow
+	}
+	if gas, overflow = common.SafeAdd(gas, wordGas); overflow {
+		return 0, model.ErrGasUintOverflow
+	}
+	return gas, nil
+}
+
 // Balance 获取余额计费
 func Balance(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
 	return gt.Balance, nil
@@ -336,6 +423,11 @@ func ExtCodeSize(gt Table, evm *params.EVMParam, contractGas *params.GasParam, s
 	return gt.ExtcodeSize, nil
 }
 
+// ExtCodeHash 获取代码哈希计费
+func ExtCodeHash(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+	return gt.ExtcodeHash, nil
+}
+
 // SLoad 加载存储计费
 func SLoad(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
 	return gt.SLoad, nil
@@ -424,7 +516,7 @@ func Revert(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack
 
 // Suicide 自杀操作计费
 func Suicide(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
-	var gas uint64
+	gas := gt.Suicide
 	if !evm.StateDB.HasSuicided(contractGas.Address.String()) {
 		evm.StateDB.AddRefund(params.SuicideRefundGas)
 	}
@@ -6,6 +6,22 @@ package model
 
 import "errors"
 
+// RevertError 合约revert时返回的错误，包含从revert数据中解析出的原因
+type RevertError struct {
+	// Reason 解析后的revert原因
+	Reason string
+}
+
+// Error 错误信息中附带revert原因
+func (e *RevertError) Error() string {
+	return ErrExecutionReverted.Error() + ": " + e.Reason
+}
+
+// Unwrap 返回ErrExecutionReverted，便于通过errors.Is判断合约是否revert
+func (e *RevertError) Unwrap() error {
+	return ErrExecutionReverted
+}
+
 var (
 	// ErrOutOfGas                 out of gas
 	ErrOutOfGas = errors.New("out of gas")
@@ -41,4 +57,9 @@ var (
 
 	// ErrNoCoinsAccount no coins account in executor!
 	ErrNoCoinsAccount = errors.New("no coins account in executor")
+
+	// ErrUnsupportedTracer unsupported tracer
+	ErrUnsupportedTracer = errors.New("unsupported tracer")
+	// ErrNotEVMTx          transaction is not an evm transaction
+	ErrNotEVMTx = errors.New("transaction is not an evm transaction")
 )
@@ -37,6 +37,23 @@ const (
 	SstoreClearGas uint64 = 5000
 	// SstoreRefundGas SSTORE 删除值时给予的奖励
 	SstoreRefundGas uint64 = 15000
+
+	// SstoreSentryGasEIP2200 EIP2200 SSTORE 执行时剩余Gas必须大于此值（防止重入）
+	SstoreSentryGasEIP2200 uint64 = 2300
+	// SstoreNoopGasEIP2200 EIP2200 SSTORE 写入值和当前值相同
+	SstoreNoopGasEIP2200 uint64 = 800
+	// SstoreDirtyGasEIP2200 EIP2200 SSTORE 当前值已在本交易中被修改过（脏数据）
+	SstoreDirtyGasEIP2200 uint64 = 800
+	// SstoreInitGasEIP2200 EIP2200 SSTORE 原始值为零，写入非零值
+	SstoreInitGasEIP2200 uint64 = 20000
+	// SstoreInitRefundEIP2200 EIP2200 SSTORE 脏数据恢复为原始零值时给予的奖励
+	SstoreInitRefundEIP2200 uint64 = 19200
+	// SstoreCleanGasEIP2200 EIP2200 SSTORE 原始值非零，且本交易中未被修改过
+	SstoreCleanGasEIP2200 uint64 = 5000
+	// SstoreCleanRefundEIP2200 EIP2200 SSTORE 脏数据恢复为原始非零值时给予的奖励
+	SstoreCleanRefundEIP2200 uint64 = 4200
+	// SstoreClearRefundEIP2200 EIP2200 SSTORE 删除值时给予的奖励
+	SstoreClearRefundEIP2200 uint64 = 15000
 	// JumpdestGas JUMPDEST 指令
 	JumpdestGas uint64 = 1
 	// LogGas LOGN 操作计费
@@ -47,6 +64,8 @@ const (
 	LogTopicGas uint64 = 375
 	// CreateGas CREATE 指令
 	CreateGas uint64 = 32000
+	// Create2Gas CREATE2 指令（另外还需要按字长收取代码哈希的费用）
+	Create2Gas uint64 = 32000
 	// SuicideRefundGas  SUICIDE 操作时给予的奖励
 	SuicideRefundGas uint64 = 24000
 	// MemoryGas 开辟新内存时按字收费
@@ -76,4 +95,14 @@ const (
 	Bn256PairingBaseGas uint64 = 100000
 	// Bn256PairingPerPointGas  bn256Pairing 按point计费（总计费等于两者相加）
 	Bn256PairingPerPointGas uint64 = 80000
+	// Bn256AddGasIstanbul 伊斯坦布尔版本（EIP-1108）Bn256Add 计费
+	Bn256AddGasIstanbul uint64 = 150
+	// Bn256ScalarMulGasIstanbul 伊斯坦布尔版本（EIP-1108）Bn256ScalarMul 计费
+	Bn256ScalarMulGasIstanbul uint64 = 6000
+	// Bn256PairingBaseGasIstanbul 伊斯坦布尔版本（EIP-1108）bn256Pairing 基础计费
+	Bn256PairingBaseGasIstanbul uint64 = 45000
+	// Bn256PairingPerPointGasIstanbul 伊斯坦布尔版本（EIP-1108）bn256Pairing 按point计费（总计费等于两者相加）
+	Bn256PairingPerPointGasIstanbul uint64 = 34000
+	// Blake2FRoundGas blake2F 按轮数计费（EIP-152）
+	Blake2FRoundGas uint64 = 1
 )
@@ -55,6 +55,14 @@ func (c *bn256Add) Run(input []byte) ([]byte, error) {
 	return res.Marshal(), nil
 }
 
+// bn256AddIstanbul 伊斯坦布尔版本的bn256Add，逻辑不变，按EIP-1108重新计费
+type bn256AddIstanbul struct{ bn256Add }
+
+// RequiredGas Returns the gas required to Execute the pre-compiled contract.
+func (c *bn256AddIstanbul) RequiredGas(input []byte) uint64 {
+	return params.Bn256AddGasIstanbul
+}
+
 // bn256ScalarMul implements a native elliptic curve scalar multiplication.
 type bn256ScalarMul struct{}
 
@@ -73,6 +81,14 @@ func (c *bn256ScalarMul) Run(input []byte) ([]byte, error) {
 	return res.Marshal(), nil
 }
 
+// bn256ScalarMulIstanbul 伊斯坦布尔版本的bn256ScalarMul，逻辑不变，按EIP-1108重新计费
+type bn256ScalarMulIstanbul struct{ bn256ScalarMul }
+
+// RequiredGas Returns the gas required to Execute the pre-compiled contract.
+func (c *bn256ScalarMulIstanbul) RequiredGas(input []byte) uint64 {
+	return params.Bn256ScalarMulGasIstanbul
+}
+
 var (
 	// true32Byte is returned if the bn256 pairing check succeeds.
 	true32Byte = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
@@ -122,3 +138,11 @@ func (c *bn256Pairing) Run(input []byte) ([]byte, error) {
 	}
 	return false32Byte, nil
 }
+
+// bn256PairingIstanbul 伊斯坦布尔版本的bn256Pairing，逻辑不变，按EIP-1108重新计费
+type bn256PairingIstanbul struct{ bn256Pairing }
+
+// RequiredGas Returns the gas required to Execute the pre-compiled contract.
+func (c *bn256PairingIstanbul) RequiredGas(input []byte) uint64 {
+	return params.Bn256PairingBaseGasIstanbul + uint64(len(input)/192)*params.Bn256PairingPerPointGasIstanbul
+}
@@ -6,11 +6,14 @@ package runtime
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
+	"errors"
 	"math"
 	"math/big"
 
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto/blake2b"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
 
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
@@ -46,6 +49,21 @@ var PrecompiledContractsByzantium = map[common.Address]PrecompiledContract{
 	common.BytesToAddress([]byte{8}): &bn256Pairing{},
 }
 
+// PrecompiledContractsIstanbul 伊斯坦布尔版本支持的所有预编译合约；
+// 在拜占庭版本基础上按EIP-1108降低bn256相关合约的计费，并按EIP-152新增blake2F合约；
+// 自ForkEVMIstanbul分叉高度开始启用，以地址值（Hash160Address）作为key
+var PrecompiledContractsIstanbul = map[common.Hash160Address]PrecompiledContract{
+	common.BytesToHash160Address([]byte{1}): &ecrecover{},
+	common.BytesToHash160Address([]byte{2}): &sha256hash{},
+	common.BytesToHash160Address([]byte{3}): &ripemd160hash{},
+	common.BytesToHash160Address([]byte{4}): &dataCopy{},
+	common.BytesToHash160Address([]byte{5}): &bigModExp{},
+	common.BytesToHash160Address([]byte{6}): &bn256AddIstanbul{},
+	common.BytesToHash160Address([]byte{7}): &bn256ScalarMulIstanbul{},
+	common.BytesToHash160Address([]byte{8}): &bn256PairingIstanbul{},
+	common.BytesToHash160Address([]byte{9}): &blake2F{},
+}
+
 // RunPrecompiledContract 调用预编译的合约逻辑并返回结果
 func RunPrecompiledContract(p PrecompiledContract, input []byte, contract *Contract) (ret []byte, err error) {
 	gas := p.RequiredGas(input)
@@ -246,3 +264,66 @@ func (c *bigModExp) Run(input []byte) ([]byte, error) {
 	}
 	return common.LeftPadBytes(base.Exp(base, exp, mod).Bytes(), int(modLen)), nil
 }
+
+const (
+	// blake2F合约的入参固定长度：rounds(4) + h(64) + m(128) + t(16) + f(1)
+	blake2FInputLength = 213
+	// 最后一块标志位取值
+	blake2FFinalBlockBytes    = byte(1)
+	blake2FNonFinalBlockBytes = byte(0)
+)
+
+var (
+	errBlake2FInvalidInputLength = errors.New("invalid input length")
+	errBlake2FInvalidFinalFlag   = errors.New("invalid final flag")
+)
+
+// 预编译合约 blake2F，提供BLAKE2b压缩函数F（EIP-152）
+type blake2F struct{}
+
+// RequiredGas 按入参中指定的轮数计费
+func (c *blake2F) RequiredGas(input []byte) uint64 {
+	// 入参非法时不收费，由Run返回错误
+	if len(input) != blake2FInputLength {
+		return 0
+	}
+	return uint64(binary.BigEndian.Uint32(input[0:4])) * params.Blake2FRoundGas
+}
+
+// Run 运算
+func (c *blake2F) Run(input []byte) ([]byte, error) {
+	if len(input) != blake2FInputLength {
+		return nil, errBlake2FInvalidInputLength
+	}
+	if input[212] != blake2FNonFinalBlockBytes && input[212] != blake2FFinalBlockBytes {
+		return nil, errBlake2FInvalidFinalFlag
+	}
+
+	var (
+		rounds = binary.BigEndian.Uint32(input[0:4])
+		final  = input[212] == blake2FFinalBlockBytes
+
+		h [8]uint64
+		m [16]uint64
+		t [2]uint64
+	)
+	for i := 0; i < 8; i++ {
+		offset := 4 + i*8
+		h[i] = binary.LittleEndian.Uint64(input[offset : offset+8])
+	}
+	for i := 0; i < 16; i++ {
+		offset := 68 + i*8
+		m[i] = binary.LittleEndian.Uint64(input[offset : offset+8])
+	}
+	t[0] = binary.LittleEndian.Uint64(input[196:204])
+	t[1] = binary.LittleEndian.Uint64(input[204:212])
+
+	blake2b.F(&h, m, t, final, rounds)
+
+	output := make([]byte, 64)
+	for i := 0; i < 8; i++ {
+		offset := i * 8
+		binary.LittleEndian.PutUint64(output[offset:offset+8], h[i])
+	}
+	return output, nil
+}
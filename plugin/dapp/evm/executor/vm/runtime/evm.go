@@ -12,6 +12,7 @@ import (
 
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/gas"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
@@ -34,9 +35,8 @@ type (
 // 依据合约地址判断是否为预编译合约，如果不是，则全部通过解释器解释执行
 func run(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
 	if contract.CodeAddr != nil {
-		// 预编译合约以拜占庭分支为初始版本，后继如有分叉，需要在此处理
-		precompiles := PrecompiledContractsByzantium
-		if p := precompiles[*contract.CodeAddr]; p != nil {
+		// 预编译合约以拜占庭分支为初始版本，伊斯坦布尔分叉之后使用新的合约集合
+		if p := evm.precompile(*contract.CodeAddr); p != nil {
 			return RunPrecompiledContract(p, input, contract)
 		}
 	}
@@ -126,9 +126,37 @@ func NewEVM(ctx Context, statedb state.EVMStateDB, vmConfig Config) *EVM {
 // GasTable 返回不同操作消耗的Gas定价表
 // 接收区块高度作为参数，方便以后在这里作分叉处理
 func (evm *EVM) GasTable(num *big.Int) gas.Table {
+	cfg := evm.StateDB.GetConfig()
+	if cfg.IsDappFork(num.Int64(), "evm", evmtypes.ForkEVMIstanbul) {
+		return gas.TableIstanbul
+	}
 	return gas.TableHomestead
 }
 
+// 根据当前区块高度查找地址对应的预编译合约，不存在时返回nil
+// 拜占庭合约集合以带指针的Address作为key，无法按地址值匹配，为保持分叉前的执行结果不变，仅在伊斯坦布尔分叉后按地址值查找
+func (evm *EVM) precompile(addr common.Address) PrecompiledContract {
+	cfg := evm.StateDB.GetConfig()
+	if cfg.IsDappFork(evm.BlockNumber.Int64(), "evm", evmtypes.ForkEVMIstanbul) {
+		return PrecompiledContractsIstanbul[addr.ToHash160()]
+	}
+	return PrecompiledContractsByzantium[addr]
+}
+
+// 调试模式下记录内部调用的开始，顶层调用由CaptureStart记录
+func (evm *EVM) captureEnter(typ OpCode, from, to common.Address, input []byte, gas uint64, value uint64) {
+	if evm.VMConfig.Debug && evm.depth > 0 {
+		evm.VMConfig.Tracer.CaptureEnter(typ, from, to, input, gas, value)
+	}
+}
+
+// 调试模式下记录内部调用的结束
+func (evm *EVM) captureExit(output []byte, gasUsed uint64, err error) {
+	if evm.VMConfig.Debug && evm.depth > 0 {
+		evm.VMConfig.Tracer.CaptureExit(output, gasUsed, err)
+	}
+}
+
 // Cancel 调用此操作会在任意时刻取消此EVM的解释运行逻辑，支持重复调用
 func (evm *EVM) Cancel() {
 	atomic.StoreInt32(&evm.abort, 1)
@@ -176,9 +204,8 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	}
 
 	if !evm.StateDB.Exist(addr.String()) {
-		precompiles := PrecompiledContractsByzantium
 		// 合约地址在自定义合约和预编译合约中都不存在时，可能为外部账户
-		if precompiles[addr] == nil {
+		if evm.precompile(addr) == nil {
 			// 只有一种情况会走到这里来，就是合约账户向外部账户转账的情况
 			if len(input) > 0 || value == 0 {
 				// 其它情况要求地址必须存在，所以需要报错
@@ -186,6 +213,8 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 					evm.VMConfig.Tracer.CaptureStart(caller.Address(), addr, false, input, gas, value)
 					evm.VMConfig.Tracer.CaptureEnd(ret, 0, 0, nil)
 				}
+				evm.captureEnter(CALL, caller.Address(), addr, input, gas, value)
+				evm.captureExit(nil, 0, model.ErrAddrNotExists)
 				return nil, -1, gas, model.ErrAddrNotExists
 			}
 		} else {
@@ -204,6 +233,8 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	snapshot = evm.StateDB.Snapshot()
 	to := AccountRef(addr)
 
+	evm.captureEnter(CALL, caller.Address(), addr, input, gas, value)
+
 	// 向合约地址转账
 	evm.Transfer(evm.StateDB, caller.Address(), to.Address(), value)
 
@@ -237,9 +268,30 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 			contract.UseGas(contract.Gas)
 		}
 	}
+	evm.captureExit(ret, gas-contract.Gas, err)
 	return ret, snapshot, contract.Gas, err
 }
 
+// Create2 合约内部使用CREATE2指令创建合约的入口
+// 合约地址由调用者地址、盐值以及合约代码哈希共同决定，和以太坊的计算方式保持一致：
+// keccak256(0xff ++ caller ++ salt ++ keccak256(code))[12:]
+func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, salt *big.Int, execName string) (ret []byte, contractAddr common.Address, leftOverGas uint64, err error) {
+	contractAddr = CreateAddress2(caller.Address(), common.BigToHash(salt), code)
+
+	// 地址上已经有合约代码或者nonce时不允许创建，和以太坊一致，只有余额的地址可以创建
+	if evm.StateDB.GetNonce(contractAddr.String()) != 0 || evm.StateDB.GetCodeSize(contractAddr.String()) != 0 {
+		return nil, contractAddr, 0, model.ErrContractAddressCollision
+	}
+	ret, _, leftOverGas, err = evm.create(caller, contractAddr, code, gas, execName, "", "", CREATE2)
+	return ret, contractAddr, leftOverGas, err
+}
+
+// CreateAddress2 计算CREATE2指令创建的合约地址
+func CreateAddress2(caller common.Address, salt common.Hash, code []byte) common.Address {
+	data := crypto.Keccak256([]byte{0xff}, caller.Bytes(), salt.Bytes(), crypto.Keccak256(code))
+	return common.BytesToAddress(data[12:])
+}
+
 // CallCode 合约内部调用合约的入口
 // 执行逻辑同Call方法，但是有以下几点不同：
 // 在创建合约对象时，合约对象的上下文地址（合约对象的self属性）被设置为caller的地址
@@ -264,6 +316,7 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 	// 正常从合约地址加载合约代码
 	contract.SetCallCode(&addr, evm.StateDB.GetCodeHash(addr.String()), evm.StateDB.GetCode(addr.String()))
 
+	evm.captureEnter(CALLCODE, caller.Address(), addr, input, gas, value)
 	ret, err = run(evm, contract, input)
 	if err != nil {
 		evm.StateDB.RevertToSnapshot(snapshot)
@@ -271,6 +324,7 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 			contract.UseGas(contract.Gas)
 		}
 	}
+	evm.captureExit(ret, gas-contract.Gas, err)
 	return ret, contract.Gas, err
 }
 
@@ -299,6 +353,7 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 	contract.SetCallCode(&addr, evm.StateDB.GetCodeHash(addr.String()), evm.StateDB.GetCode(addr.String()))
 
 	// 其它逻辑同StaticCall
+	evm.captureEnter(DELEGATECALL, caller.Address(), addr, input, gas, 0)
 	ret, err = run(evm, contract, input)
 	if err != nil {
 		evm.StateDB.RevertToSnapshot(snapshot)
@@ -306,6 +361,7 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 			contract.UseGas(contract.Gas)
 		}
 	}
+	evm.captureExit(ret, gas-contract.Gas, err)
 	return ret, contract.Gas, err
 }
 
@@ -340,6 +396,7 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 	contract.SetCallCode(&addr, evm.StateDB.GetCodeHash(addr.String()), evm.StateDB.GetCode(addr.String()))
 
 	// 执行合约指令时如果出错，需要进行回滚，并且扣除剩余的Gas
+	evm.captureEnter(STATICCALL, caller.Address(), addr, input, gas, 0)
 	ret, err = run(evm, contract, input)
 	if err != nil {
 		// 合约执行出错时进行回滚
@@ -351,6 +408,7 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 			contract.UseGas(contract.Gas)
 		}
 	}
+	evm.captureExit(ret, gas-contract.Gas, err)
 	return ret, contract.Gas, err
 }
 
@@ -359,6 +417,11 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 // 目前chain33为了保证账户安全，不允许合约中涉及到外部账户的转账操作，
 // 所以，本步骤不接收转账金额参数
 func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []byte, gas uint64, execName, alias, abi string) (ret []byte, snapshot int, leftOverGas uint64, err error) {
+	return evm.create(caller, contractAddr, code, gas, execName, alias, abi, CREATE)
+}
+
+// 创建合约的具体逻辑，typ用于区分CREATE和CREATE2，仅在调试跟踪时使用
+func (evm *EVM) create(caller ContractRef, contractAddr common.Address, code []byte, gas uint64, execName, alias, abi string, typ OpCode) (ret []byte, snapshot int, leftOverGas uint64, err error) {
 	pass, err := evm.preCheck(caller, contractAddr, 0)
 	if !pass {
 		return nil, -1, gas, err
@@ -375,6 +438,7 @@ func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []b
 	if evm.VMConfig.Debug && evm.depth == 0 {
 		evm.VMConfig.Tracer.CaptureStart(caller.Address(), contractAddr, true, code, gas, 0)
 	}
+	evm.captureEnter(typ, caller.Address(), contractAddr, code, gas, 0)
 	start := types.Now()
 
 	// 通过预编译指令和解释器执行合约
@@ -418,6 +482,7 @@ func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []b
 	if evm.VMConfig.Debug && evm.depth == 0 {
 		evm.VMConfig.Tracer.CaptureEnd(ret, gas-contract.Gas, types.Since(start), err)
 	}
+	evm.captureExit(ret, gas-contract.Gas, err)
 
 	return ret, snapshot, contract.Gas, err
 }
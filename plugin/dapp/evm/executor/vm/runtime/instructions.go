@@ -14,6 +14,7 @@ import (
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/mm"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
 )
 
 // 加法操作
@@ -504,6 +505,19 @@ func opExtCodeSize(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory,
 	return nil, nil
 }
 
+// 获取指定合约地址的合约代码哈希（使用keccak256计算，和以太坊保持一致）
+// 地址不存在或者为空时返回0
+func opExtCodeHash(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
+	slot := stack.Peek()
+	addr := common.BigToAddress(slot).String()
+	if evm.StateDB.Empty(addr) {
+		slot.SetUint64(0)
+	} else {
+		slot.SetBytes(crypto.Keccak256(evm.StateDB.GetCode(addr)))
+	}
+	return nil, nil
+}
+
 // 获取合约代码大小
 func opCodeSize(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
 	l := evm.Interpreter.IntPool.Get().SetInt64(int64(len(contract.Code)))
@@ -598,6 +612,20 @@ func opGasLimit(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, sta
 	return nil, nil
 }
 
+// 获取当前链的ChainID
+func opChainID(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
+	chainID := evm.StateDB.GetConfig().GetChainID()
+	stack.Push(evm.Interpreter.IntPool.Get().SetInt64(int64(chainID)))
+	return nil, nil
+}
+
+// 获取当前合约自身的余额
+func opSelfBalance(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
+	balance := evm.StateDB.GetBalance(contract.Address().String())
+	stack.Push(evm.Interpreter.IntPool.Get().SetUint64(balance))
+	return nil, nil
+}
+
 // 弹出栈顶数据
 func opPop(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
 	evm.Interpreter.IntPool.Put(stack.Pop())
@@ -742,6 +770,52 @@ func opCreate(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack
 	return nil, nil
 }
 
+// 使用盐值创建合约，合约地址由调用者地址、盐值和合约代码共同确定
+func opCreate2(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
+	var (
+		endowment    = stack.Pop()
+		offset, size = stack.Pop(), stack.Pop()
+		salt         = stack.Pop()
+		inPut        = memory.Get(offset.Int64(), size.Int64())
+		gas          = contract.Gas
+	)
+
+	// 保留1/64的Gas给当前合约继续执行
+	gas -= gas / 64
+	contract.UseGas(gas)
+
+	res, addr, returnGas, suberr := evm.Create2(contract, inPut, gas, salt, "innerContract")
+
+	// 出错时压栈0，否则压栈创建出来的合约对象的地址
+	if suberr != nil && suberr != model.ErrCodeStoreOutOfGas {
+		log15.Error("evm contract opCreate2 instruction error", "error", suberr)
+		stack.Push(evm.Interpreter.IntPool.GetZero())
+	} else {
+		stack.Push(addr.Big())
+	}
+
+	// 剩余的Gas再返还给合约对象
+	contract.Gas += returnGas
+
+	evm.Interpreter.IntPool.Put(endowment, offset, size, salt)
+
+	if suberr == model.ErrExecutionReverted {
+		return res, nil
+	}
+	return nil, nil
+}
+
+// 将栈中的调用目标转换为地址
+// 原有的BigToAddress转换会把高位为0的地址右补齐，导致无法调用0x01~0x09等预编译合约地址，
+// 伊斯坦布尔分叉之后按以太坊的方式取低20字节并左补齐
+func (evm *EVM) callAddress(addr *big.Int) common.Address {
+	cfg := evm.StateDB.GetConfig()
+	if !cfg.IsDappFork(evm.BlockNumber.Int64(), "evm", evmtypes.ForkEVMIstanbul) {
+		return common.BigToAddress(addr)
+	}
+	return common.BytesToAddress(common.BigToHash(addr).Bytes()[common.HashLength-common.Hash160Length:])
+}
+
 // 合约调用操作
 func opCall(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
 	// 弹出可用的gas，并放入整数池
@@ -751,7 +825,7 @@ func opCall(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *
 
 	// 从栈中一次弹出其它参数
 	addr, value, inOffset, inSize, retOffset, retSize := stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop()
-	toAddr := common.BigToAddress(addr)
+	toAddr := evm.callAddress(addr)
 	value = common.U256(value)
 
 	// 从内存中读取调用合约时需要的输入参数，
@@ -798,7 +872,7 @@ func opCallCode(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, sta
 	evm.Interpreter.IntPool.Put(stack.Pop())
 	gas := evm.CallGasTemp
 	addr, value, inOffset, inSize, retOffset, retSize := stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop()
-	toAddr := common.BigToAddress(addr)
+	toAddr := evm.callAddress(addr)
 	value = common.U256(value)
 	args := memory.Get(inOffset.Int64(), inSize.Int64())
 
@@ -830,7 +904,7 @@ func opDelegateCall(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory,
 	evm.Interpreter.IntPool.Put(stack.Pop())
 	gas := evm.CallGasTemp
 	addr, inOffset, inSize, retOffset, retSize := stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop()
-	toAddr := common.BigToAddress(addr)
+	toAddr := evm.callAddress(addr)
 	args := memory.Get(inOffset.Int64(), inSize.Int64())
 
 	ret, returnGas, err := evm.DelegateCall(contract, toAddr, args, gas)
@@ -857,7 +931,7 @@ func opStaticCall(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, s
 	evm.Interpreter.IntPool.Put(stack.Pop())
 	gas := evm.CallGasTemp
 	addr, inOffset, inSize, retOffset, retSize := stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop()
-	toAddr := common.BigToAddress(addr)
+	toAddr := evm.callAddress(addr)
 	args := memory.Get(inOffset.Int64(), inSize.Int64())
 
 	ret, returnGas, err := evm.StaticCall(contract, toAddr, args, gas)
@@ -0,0 +1,254 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"sort"
+	"time"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/mm"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// CallTracerName 调用树跟踪器名称
+	CallTracerName = "callTracer"
+	// PrestateTracerName 账户前置状态跟踪器名称
+	PrestateTracerName = "prestateTracer"
+)
+
+// CallTracer 调用树跟踪器，记录交易执行过程中所有的CALL/CALLCODE/DELEGATECALL/STATICCALL/CREATE/CREATE2调用，
+// 以嵌套的调用帧形式返回每次调用的输入、输出、Gas消耗以及错误信息
+type CallTracer struct {
+	// 当前尚未结束的调用帧，栈底为顶层调用
+	callStack []*evmtypes.EvmCallFrame
+	root      *evmtypes.EvmCallFrame
+}
+
+// NewCallTracer 创建调用树跟踪器
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+// CaptureStart 记录顶层调用
+func (t *CallTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value uint64) error {
+	typ := CALL
+	if create {
+		typ = CREATE
+	}
+	t.root = newCallFrame(typ, from, to, input, gas, value)
+	t.callStack = []*evmtypes.EvmCallFrame{t.root}
+	return nil
+}
+
+// CaptureState 调用树跟踪器不关心具体指令
+func (t *CallTracer) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureFault 调用树跟踪器不关心具体指令
+func (t *CallTracer) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnter 记录内部调用，压入调用栈
+func (t *CallTracer) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) error {
+	t.callStack = append(t.callStack, newCallFrame(typ, from, to, input, gas, value))
+	return nil
+}
+
+// CaptureExit 内部调用结束，弹出调用栈并挂到上层调用帧下
+func (t *CallTracer) CaptureExit(output []byte, gasUsed uint64, err error) error {
+	size := len(t.callStack)
+	if size <= 1 {
+		return nil
+	}
+	frame := t.callStack[size-1]
+	t.callStack = t.callStack[:size-1]
+	finishCallFrame(frame, output, gasUsed, err)
+
+	parent := t.callStack[size-2]
+	parent.Calls = append(parent.Calls, frame)
+	return nil
+}
+
+// CaptureEnd 记录顶层调用的结果
+func (t *CallTracer) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, err error) error {
+	if t.root != nil {
+		finishCallFrame(t.root, output, gasUsed, err)
+	}
+	return nil
+}
+
+// Result 返回调用树，没有发生任何调用时返回nil
+func (t *CallTracer) Result() *evmtypes.EvmCallFrame {
+	return t.root
+}
+
+func newCallFrame(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) *evmtypes.EvmCallFrame {
+	return &evmtypes.EvmCallFrame{
+		Type:  typ.String(),
+		From:  from.String(),
+		To:    to.String(),
+		Value: value,
+		Gas:   gas,
+		Input: common.Bytes2Hex(input),
+	}
+}
+
+func finishCallFrame(frame *evmtypes.EvmCallFrame, output []byte, gasUsed uint64, err error) {
+	frame.GasUsed = gasUsed
+	frame.Output = common.Bytes2Hex(output)
+	if err != nil {
+		frame.Error = err.Error()
+	}
+}
+
+// PrestateTracer 账户前置状态跟踪器，记录交易执行过程中访问到的所有账户在交易执行前的余额、代码以及存储数据，
+// 交易执行结束后同时记录这些账户的最终状态，用于对比交易带来的状态变化
+type PrestateTracer struct {
+	statedb state.EVMStateDB
+	// 按首次访问顺序记录的账户地址
+	addrs []string
+	pre   map[string]*evmtypes.EvmAccountState
+	// 每个账户访问过的存储key
+	keys map[string]map[common.Hash]bool
+}
+
+// NewPrestateTracer 创建账户前置状态跟踪器，statedb为执行交易时使用的状态数据库
+func NewPrestateTracer(statedb state.EVMStateDB) *PrestateTracer {
+	return &PrestateTracer{
+		statedb: statedb,
+		pre:     make(map[string]*evmtypes.EvmAccountState),
+		keys:    make(map[string]map[common.Hash]bool),
+	}
+}
+
+// CaptureStart 记录调用双方账户；
+// 顶层调用时转账已经完成，这里需要还原转账金额得到执行前的余额
+func (t *PrestateTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value uint64) error {
+	t.lookupAccount(from.String())
+	t.lookupAccount(to.String())
+	if value > 0 && from.String() != to.String() {
+		t.pre[from.String()].Balance += value
+		t.pre[to.String()].Balance -= value
+	}
+	// 新创建的合约在执行前不存在代码
+	if create {
+		t.pre[to.String()].Code = ""
+	}
+	return nil
+}
+
+// CaptureState 记录指令访问到的账户和存储
+func (t *PrestateTracer) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error {
+	stackLen := len(stack.Data())
+	switch {
+	case stackLen >= 1 && (op == SLOAD || op == SSTORE):
+		t.lookupStorage(contract.Address().String(), common.BigToHash(stack.Back(0)))
+	case stackLen >= 1 && (op == EXTCODECOPY || op == EXTCODEHASH || op == EXTCODESIZE || op == BALANCE || op == SELFDESTRUCT):
+		t.lookupAccount(common.BigToAddress(stack.Back(0)).String())
+	case stackLen >= 5 && (op == DELEGATECALL || op == CALL || op == STATICCALL || op == CALLCODE):
+		t.lookupAccount(env.callAddress(stack.Back(1)).String())
+	}
+	return nil
+}
+
+// CaptureFault 目前实现为空
+func (t *PrestateTracer) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnter 记录内部调用的目标账户
+func (t *PrestateTracer) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) error {
+	t.lookupAccount(to.String())
+	return nil
+}
+
+// CaptureExit 目前实现为空
+func (t *PrestateTracer) CaptureExit(output []byte, gasUsed uint64, err error) error {
+	return nil
+}
+
+// CaptureEnd 目前实现为空
+func (t *PrestateTracer) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, err error) error {
+	return nil
+}
+
+// Result 返回账户执行前和执行后的状态，需要在交易执行结束后调用；
+// diffMode为true时只返回发生变化的账户，且账户中只保留发生变化的存储数据
+func (t *PrestateTracer) Result(diffMode bool) (pre []*evmtypes.EvmAccountState, post []*evmtypes.EvmAccountState) {
+	for _, addr := range t.addrs {
+		before := t.pre[addr]
+		after := &evmtypes.EvmAccountState{
+			Address: addr,
+			Balance: t.statedb.GetBalance(addr),
+			Nonce:   t.statedb.GetNonce(addr),
+			Code:    common.Bytes2Hex(t.statedb.GetCode(addr)),
+		}
+		for _, item := range before.Storage {
+			value := t.statedb.GetState(addr, common.BytesToHash(common.FromHex(item.Key)))
+			after.Storage = append(after.Storage, &evmtypes.EvmStorageItem{Key: item.Key, Value: value.Hex()})
+		}
+		if !diffMode {
+			pre = append(pre, before)
+			post = append(post, after)
+			continue
+		}
+
+		changedPre := &evmtypes.EvmAccountState{Address: addr, Balance: before.Balance, Nonce: before.Nonce}
+		changedPost := &evmtypes.EvmAccountState{Address: addr, Balance: after.Balance, Nonce: after.Nonce}
+		changed := before.Balance != after.Balance || before.Nonce != after.Nonce
+		if before.Code != after.Code {
+			changedPre.Code, changedPost.Code = before.Code, after.Code
+			changed = true
+		}
+		for i, item := range before.Storage {
+			if item.Value != after.Storage[i].Value {
+				changedPre.Storage = append(changedPre.Storage, item)
+				changedPost.Storage = append(changedPost.Storage, after.Storage[i])
+				changed = true
+			}
+		}
+		if changed {
+			pre = append(pre, changedPre)
+			post = append(post, changedPost)
+		}
+	}
+	return pre, post
+}
+
+// 首次访问账户时记录账户状态
+func (t *PrestateTracer) lookupAccount(addr string) {
+	if _, ok := t.pre[addr]; ok {
+		return
+	}
+	t.addrs = append(t.addrs, addr)
+	t.pre[addr] = &evmtypes.EvmAccountState{
+		Address: addr,
+		Balance: t.statedb.GetBalance(addr),
+		Nonce:   t.statedb.GetNonce(addr),
+		Code:    common.Bytes2Hex(t.statedb.GetCode(addr)),
+	}
+	t.keys[addr] = make(map[common.Hash]bool)
+}
+
+// 首次访问存储时记录交易执行前的存储值，存储按key排序
+func (t *PrestateTracer) lookupStorage(addr string, key common.Hash) {
+	t.lookupAccount(addr)
+	if t.keys[addr][key] {
+		return
+	}
+	t.keys[addr][key] = true
+
+	account := t.pre[addr]
+	value := t.statedb.GetCommittedState(addr, key)
+	account.Storage = append(account.Storage, &evmtypes.EvmStorageItem{Key: key.Hex(), Value: value.Hex()})
+	sort.Slice(account.Storage, func(i, j int) bool {
+		return account.Storage[i].Key < account.Storage[j].Key
+	})
+}
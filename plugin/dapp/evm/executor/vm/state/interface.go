@@ -45,6 +45,8 @@ type EVMStateDB interface {
 
 	// AddRefund 合约Gas奖励回馈
 	AddRefund(uint64)
+	// SubRefund 扣减合约Gas奖励
+	SubRefund(uint64)
 	// GetRefund 获取合约Gas奖励
 	GetRefund() uint64
 
@@ -52,6 +54,8 @@ type EVMStateDB interface {
 	GetState(string, common.Hash) common.Hash
 	// SetState 设置合约状态数据
 	SetState(string, common.Hash, common.Hash)
+	// GetCommittedState 获取合约状态数据在当前交易开始执行时的原始值
+	GetCommittedState(string, common.Hash) common.Hash
 
 	// Suicide 合约自销毁
 	Suicide(string) bool
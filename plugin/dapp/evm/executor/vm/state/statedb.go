@@ -59,6 +59,9 @@ type MemoryStateDB struct {
 	// 当前区块高度
 	blockHeight int64
 
+	// 当前交易开始执行时，被修改过的合约状态数据的原始值
+	committedState map[string]common.Hash
+
 	// 用户保存合约账户的状态数据或合约代码数据有没有发生变更
 	stateDirty map[string]interface{}
 	dataDirty  map[string]interface{}
@@ -70,18 +73,19 @@ type MemoryStateDB struct {
 // 开始执行下一个区块时（执行器框架调用setEnv设置的区块高度发生变更时），会重新创建此DB对象
 func NewMemoryStateDB(StateDB db.KV, LocalDB db.KVDB, CoinsAccount *account.DB, blockHeight int64, api client.QueueProtocolAPI) *MemoryStateDB {
 	mdb := &MemoryStateDB{
-		StateDB:      StateDB,
-		LocalDB:      LocalDB,
-		CoinsAccount: CoinsAccount,
-		accounts:     make(map[string]*ContractAccount),
-		logs:         make(map[common.Hash][]*model.ContractLog),
-		preimages:    make(map[common.Hash][]byte),
-		stateDirty:   make(map[string]interface{}),
-		dataDirty:    make(map[string]interface{}),
-		blockHeight:  blockHeight,
-		refund:       0,
-		txIndex:      0,
-		api:          api,
+		StateDB:        StateDB,
+		LocalDB:        LocalDB,
+		CoinsAccount:   CoinsAccount,
+		accounts:       make(map[string]*ContractAccount),
+		logs:           make(map[common.Hash][]*model.ContractLog),
+		preimages:      make(map[common.Hash][]byte),
+		stateDirty:     make(map[string]interface{}),
+		dataDirty:      make(map[string]interface{}),
+		blockHeight:    blockHeight,
+		committedState: make(map[string]common.Hash),
+		refund:         0,
+		txIndex:        0,
+		api:            api,
 	}
 	return mdb
 }
@@ -91,6 +95,7 @@ func NewMemoryStateDB(StateDB db.KV, LocalDB db.KVDB, CoinsAccount *account.DB,
 func (mdb *MemoryStateDB) Prepare(txHash common.Hash, txIndex int) {
 	mdb.txHash = txHash
 	mdb.txIndex = txIndex
+	mdb.committedState = make(map[string]common.Hash)
 }
 
 // CreateAccount 创建一个新的合约账户对象
@@ -236,6 +241,16 @@ func (mdb *MemoryStateDB) AddRefund(gas uint64) {
 	mdb.refund += gas
 }
 
+// SubRefund 扣减奖励（EIP2200中，被清空的存储槽重新赋值时需要收回之前的奖励）
+func (mdb *MemoryStateDB) SubRefund(gas uint64) {
+	mdb.addChange(refundChange{baseChange: baseChange{}, prev: mdb.refund})
+	if gas > mdb.refund {
+		mdb.refund = 0
+		return
+	}
+	mdb.refund -= gas
+}
+
 // GetRefund 获取奖励
 func (mdb *MemoryStateDB) GetRefund() uint64 {
 	return mdb.refund
@@ -270,6 +285,11 @@ func (mdb *MemoryStateDB) GetState(addr string, key common.Hash) common.Hash {
 func (mdb *MemoryStateDB) SetState(addr string, key common.Hash, value common.Hash) {
 	acc := mdb.GetAccount(addr)
 	if acc != nil {
+		// 记录本交易中第一次修改前的原始值
+		itemKey := getStateItemKey(addr, key.Hex())
+		if _, ok := mdb.committedState[itemKey]; !ok {
+			mdb.committedState[itemKey] = acc.GetState(key)
+		}
 		acc.SetState(key, value)
 		// 新的分叉中状态数据变更不需要单独进行标识
 		cfg := mdb.api.GetConfig()
@@ -279,6 +299,15 @@ func (mdb *MemoryStateDB) SetState(addr string, key common.Hash, value common.Ha
 	}
 }
 
+// GetCommittedState 获取合约状态数据在当前交易开始执行时的原始值
+// 如果本交易中没有修改过此数据，则原始值即为当前值
+func (mdb *MemoryStateDB) GetCommittedState(addr string, key common.Hash) common.Hash {
+	if val, ok := mdb.committedState[getStateItemKey(addr, key.Hex())]; ok {
+		return val
+	}
+	return mdb.GetState(addr, key)
+}
+
 // TransferStateData 转换合约状态数据存储
 func (mdb *MemoryStateDB) TransferStateData(addr string) {
 	acc := mdb.GetAccount(addr)
@@ -721,6 +750,18 @@ func (mdb *MemoryStateDB) PrintLogs() {
 	}
 }
 
+// GetEventLogs 获取当前交易中合约生成的事件日志，转换为交易回执日志
+func (mdb *MemoryStateDB) GetEventLogs() (logs []*types.ReceiptLog) {
+	for _, item := range mdb.logs[mdb.txHash] {
+		data := &evmtypes.EVMLog{Address: item.Address.String(), Data: item.Data}
+		for _, topic := range item.Topics {
+			data.Topic = append(data.Topic, topic.Bytes())
+		}
+		logs = append(logs, &types.ReceiptLog{Ty: evmtypes.TyLogEVMEventData, Log: types.Encode(data)})
+	}
+	return
+}
+
 // WritePreimages 打印本区块内生成的preimages日志
 func (mdb *MemoryStateDB) WritePreimages(number int64) {
 	for k, v := range mdb.preimages {
@@ -0,0 +1,181 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+
+	"github.com/33cn/chain33/types"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// 以太坊JSON-RPC错误码
+const (
+	ethErrParse          = -32700
+	ethErrInvalidRequest = -32600
+	ethErrMethodNotFound = -32601
+	ethErrInvalidParams  = -32602
+	ethErrServer         = -32000
+	// 合约执行revert，和geth保持一致
+	ethErrExecutionReverted = 3
+)
+
+// evm执行器子配置中以太坊兼容接口相关的配置
+type subConfig struct {
+	// EthRPCBindAddr 以太坊JSON-RPC兼容接口的监听地址，为空时不启动
+	EthRPCBindAddr string `json:"ethRPCBindAddr"`
+}
+
+type ethRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type ethResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *ethError       `json:"error,omitempty"`
+}
+
+type ethError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *ethError) Error() string {
+	return e.Message
+}
+
+// ethHandler 以太坊JSON-RPC兼容接口，方便web3/ethers等工具直接访问evm合约，
+// 支持单个请求和批量请求，请求中的方法名转换为对ethAPI对应方法的调用
+type ethHandler struct {
+	methods map[string]func(params []json.RawMessage) (interface{}, error)
+}
+
+func newEthHandler(cli *channelClient) *ethHandler {
+	api := &ethAPI{cli: cli}
+	return &ethHandler{methods: map[string]func(params []json.RawMessage) (interface{}, error){
+		"eth_chainId":               api.chainID,
+		"eth_blockNumber":           api.blockNumber,
+		"eth_call":                  api.call,
+		"eth_estimateGas":           api.estimateGas,
+		"eth_getCode":               api.getCode,
+		"eth_getStorageAt":          api.getStorageAt,
+		"eth_getLogs":               api.getLogs,
+		"eth_getTransactionReceipt": api.getTransactionReceipt,
+	}}
+}
+
+// ServeHTTP 处理以太坊JSON-RPC请求
+func (h *ethHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "can't get request body", http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	data = bytes.TrimSpace(data)
+	var out interface{}
+	if len(data) > 0 && data[0] == '[' {
+		var reqs []*ethRequest
+		if err := json.Unmarshal(data, &reqs); err != nil {
+			out = newEthErrorResponse(nil, &ethError{Code: ethErrParse, Message: err.Error()})
+		} else if len(reqs) == 0 {
+			out = newEthErrorResponse(nil, &ethError{Code: ethErrInvalidRequest, Message: "empty batch request"})
+		} else {
+			resps := make([]*ethResponse, len(reqs))
+			for i, req := range reqs {
+				resps[i] = h.handle(req)
+			}
+			out = resps
+		}
+	} else {
+		var req ethRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			out = newEthErrorResponse(nil, &ethError{Code: ethErrParse, Message: err.Error()})
+		} else {
+			out = h.handle(&req)
+		}
+	}
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		log.Error("ethHandler", "encode response error", err)
+	}
+}
+
+func (h *ethHandler) handle(req *ethRequest) *ethResponse {
+	if req == nil || len(req.Method) == 0 {
+		return newEthErrorResponse(nil, &ethError{Code: ethErrInvalidRequest, Message: "invalid request"})
+	}
+	method, ok := h.methods[req.Method]
+	if !ok {
+		return newEthErrorResponse(req.ID, &ethError{Code: ethErrMethodNotFound, Message: fmt.Sprintf("the method %s does not exist/is not available", req.Method)})
+	}
+	var params []json.RawMessage
+	if len(req.Params) > 0 && string(req.Params) != "null" {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return newEthErrorResponse(req.ID, &ethError{Code: ethErrInvalidParams, Message: err.Error()})
+		}
+	}
+
+	result, err := method(params)
+	if err != nil {
+		ethErr, ok := err.(*ethError)
+		if !ok {
+			ethErr = &ethError{Code: ethErrServer, Message: err.Error()}
+		}
+		return newEthErrorResponse(req.ID, ethErr)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return newEthErrorResponse(req.ID, &ethError{Code: ethErrServer, Message: err.Error()})
+	}
+	return &ethResponse{JSONRPC: "2.0", ID: req.ID, Result: data}
+}
+
+func newEthErrorResponse(id json.RawMessage, err *ethError) *ethResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &ethResponse{JSONRPC: "2.0", ID: id, Error: err}
+}
+
+// 按照evm执行器子配置启动以太坊JSON-RPC兼容接口
+func startEthServer(cli *channelClient) {
+	cfg := cli.GetConfig()
+	var subcfg subConfig
+	if sub, ok := cfg.GetSubConfig().Exec[evmtypes.ExecutorName]; ok {
+		types.MustDecode(sub, &subcfg)
+	}
+	if len(subcfg.EthRPCBindAddr) == 0 {
+		return
+	}
+	listener, err := net.Listen("tcp", subcfg.EthRPCBindAddr)
+	if err != nil {
+		log.Error("startEthServer", "addr", subcfg.EthRPCBindAddr, "listen error", err)
+		return
+	}
+	log.Info("startEthServer", "addr", listener.Addr().String())
+	go func() {
+		err := http.Serve(listener, newEthHandler(cli))
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("startEthServer", "serve error", err)
+		}
+	}()
+}
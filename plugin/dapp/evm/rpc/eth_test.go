@@ -0,0 +1,182 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/client/mocks"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testContract = "0x5b3d2c1e7f1a2b3c4d5e6f708192a3b4c5d6e7f8"
+	testCaller   = "0x1111111111111111111111111111111111111111"
+)
+
+var testTopic = common.BytesToHash([]byte{0x07}).Bytes()
+
+func newTestEthServer(t *testing.T) (*httptest.Server, *mocks.QueueProtocolAPI) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := &mocks.QueueProtocolAPI{}
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	cli := &channelClient{ChannelClient: rpctypes.ChannelClient{QueueProtocolAPI: api}}
+	server := httptest.NewServer(newEthHandler(cli))
+	t.Cleanup(server.Close)
+	return server, api
+}
+
+func callEth(t *testing.T, url string, method string, params ...interface{}) *ethResponse {
+	if params == nil {
+		params = []interface{}{}
+	}
+	data, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	assert.Nil(t, err)
+	resp, err := http.Post(url, "application/json", bytes.NewReader(data))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	var out ethResponse
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&out))
+	return &out
+}
+
+// 构造包含一笔EVM合约调用交易的区块，交易回执中包含一条事件日志
+func testBlockDetail(t *testing.T) (*types.BlockDetail, *types.Transaction) {
+	contract, err := ethToAddress(testContract)
+	assert.Nil(t, err)
+	tx := &types.Transaction{Execer: []byte("evm"), Payload: []byte("call"), Nonce: 1}
+	evmLog := &evmtypes.EVMLog{Address: contract, Topic: [][]byte{testTopic}, Data: common.LeftPadBytes([]byte{0x2a}, 32)}
+	receipt := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{
+		{Ty: evmtypes.TyLogCallContract, Log: types.Encode(&evmtypes.ReceiptEVMContract{ContractAddr: contract, UsedGas: 21000})},
+		{Ty: evmtypes.TyLogEVMEventData, Log: types.Encode(evmLog)},
+	}}
+	block := &types.Block{Height: 5, Txs: []*types.Transaction{tx}}
+	return &types.BlockDetail{Block: block, Receipts: []*types.ReceiptData{receipt}}, tx
+}
+
+func TestEthBlockNumber(t *testing.T) {
+	server, api := newTestEthServer(t)
+	api.On("GetLastHeader").Return(&types.Header{Height: 10}, nil)
+
+	resp := callEth(t, server.URL, "eth_blockNumber")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, `"0xa"`, string(resp.Result))
+
+	resp = callEth(t, server.URL, "eth_sendTransaction")
+	assert.NotNil(t, resp.Error)
+	assert.Equal(t, ethErrMethodNotFound, resp.Error.Code)
+}
+
+func TestEthCall(t *testing.T) {
+	server, api := newTestEthServer(t)
+	contract, _ := ethToAddress(testContract)
+	caller, _ := ethToAddress(testCaller)
+	ret := common.LeftPadBytes([]byte{0x2a}, 32)
+	api.On("Query", "evm", "Call", &evmtypes.EvmCallReq{Caller: caller, To: contract, Data: []byte{0x6d, 0x4c, 0xe6, 0x3c}}).Return(&evmtypes.EvmCallResp{Ret: ret}, nil)
+	api.On("Query", "evm", "Call", &evmtypes.EvmCallReq{To: contract, Data: []byte{0x01}}).Return(&evmtypes.EvmCallResp{Error: "evm: execution reverted: no", RevertReason: "no"}, nil)
+
+	resp := callEth(t, server.URL, "eth_call", map[string]string{"from": testCaller, "to": testContract, "data": "0x6d4ce63c"}, "latest")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, `"`+common.Bytes2Hex(ret)+`"`, string(resp.Result))
+
+	resp = callEth(t, server.URL, "eth_call", map[string]string{"to": testContract, "data": "0x01"})
+	assert.NotNil(t, resp.Error)
+	assert.Equal(t, ethErrExecutionReverted, resp.Error.Code)
+	assert.Equal(t, "no", resp.Error.Data)
+
+	// 不支持查询历史区块状态
+	resp = callEth(t, server.URL, "eth_call", map[string]string{"to": testContract}, "0x1")
+	assert.NotNil(t, resp.Error)
+	assert.Equal(t, ethErrInvalidParams, resp.Error.Code)
+}
+
+func TestEthGetTransactionReceipt(t *testing.T) {
+	server, api := newTestEthServer(t)
+	detail, tx := testBlockDetail(t)
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(&types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx, Height: 5, Index: 0}}}, nil)
+	// 不存在的交易返回空的交易详情
+	api.On("GetTransactionByHash", mock.Anything).Return(&types.TransactionDetails{Txs: []*types.TransactionDetail{{}}}, nil)
+	api.On("GetBlocks", &types.ReqBlocks{Start: 5, End: 5, IsDetail: true}).Return(&types.BlockDetails{Items: []*types.BlockDetail{detail}}, nil)
+
+	resp := callEth(t, server.URL, "eth_getTransactionReceipt", common.Bytes2Hex(tx.Hash()))
+	assert.Nil(t, resp.Error)
+	var receipt ethReceipt
+	assert.Nil(t, json.Unmarshal(resp.Result, &receipt))
+	assert.Equal(t, "0x1", receipt.Status)
+	assert.Equal(t, "0x5", receipt.BlockNumber)
+	assert.Equal(t, "0x5208", receipt.GasUsed)
+	assert.Equal(t, testContract, *receipt.To)
+	assert.Nil(t, receipt.ContractAddress)
+	assert.Equal(t, 1, len(receipt.Logs))
+	assert.Equal(t, testContract, receipt.Logs[0].Address)
+	assert.Equal(t, common.Bytes2Hex(testTopic), receipt.Logs[0].Topics[0])
+	assert.Equal(t, 512+2, len(receipt.LogsBloom))
+
+	resp = callEth(t, server.URL, "eth_getTransactionReceipt", "0x01")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "null", string(resp.Result))
+}
+
+func TestEthGetLogs(t *testing.T) {
+	server, api := newTestEthServer(t)
+	contract, _ := ethToAddress(testContract)
+	topic := common.Bytes2Hex(testTopic)
+	api.On("GetLastHeader").Return(&types.Header{Height: 5}, nil)
+	api.On("GetBlockHash", &types.ReqInt{Height: 5}).Return(&types.ReplyHash{Hash: []byte{0x05}}, nil)
+	api.On("GetBlockHash", &types.ReqInt{Height: 3}).Return(&types.ReplyHash{Hash: []byte{0x03}}, nil)
+	// 日志索引分两页返回，按区块高度从高到低
+	req := &evmtypes.EvmGetLogsReq{FromBlock: 0, ToBlock: 5, Addresses: []string{contract}, Topics: []*evmtypes.EvmTopicFilter{{Topic: []string{topic}}}, Count: ethLogPageSize}
+	api.On("Query", "evm", "GetLogs", req).Return(&evmtypes.EvmGetLogsResp{Logs: []*evmtypes.EvmLogItem{
+		{Address: contract, Topic: []string{topic}, Data: "0x2a", Height: 5, TxIndex: 1, LogIndex: 0, BlockLogIndex: 2, TxHash: "0x55"},
+	}, Cursor: "000000000005:000001:0000"}, nil)
+	next := *req
+	next.Cursor = "000000000005:000001:0000"
+	api.On("Query", "evm", "GetLogs", &next).Return(&evmtypes.EvmGetLogsResp{Logs: []*evmtypes.EvmLogItem{
+		{Address: contract, Topic: []string{topic}, Data: "0x01", Height: 3, TxIndex: 0, LogIndex: 0, BlockLogIndex: 0, TxHash: "0x33"},
+	}}, nil)
+
+	resp := callEth(t, server.URL, "eth_getLogs", map[string]interface{}{"fromBlock": "earliest", "address": testContract, "topics": []interface{}{topic}})
+	assert.Nil(t, resp.Error)
+	var logs []*ethLog
+	assert.Nil(t, json.Unmarshal(resp.Result, &logs))
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, "0x3", logs[0].BlockNumber)
+	assert.Equal(t, "0x03", logs[0].BlockHash)
+	assert.Equal(t, "0x5", logs[1].BlockNumber)
+	assert.Equal(t, "0x2", logs[1].LogIndex)
+	assert.Equal(t, "0x1", logs[1].TransactionIndex)
+	assert.Equal(t, testContract, logs[1].Address)
+	assert.Equal(t, []string{topic}, logs[1].Topics)
+
+	// 只有创世区块时不需要查询
+	resp = callEth(t, server.URL, "eth_getLogs", map[string]interface{}{"fromBlock": "0x0", "toBlock": "0x0"})
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "[]", string(resp.Result))
+}
+
+func TestEthBatchRequest(t *testing.T) {
+	server, api := newTestEthServer(t)
+	api.On("GetLastHeader").Return(&types.Header{Height: 1}, nil)
+
+	body := `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]},{"jsonrpc":"2.0","id":2,"method":"eth_getCode","params":["0x12"]}]`
+	resp, err := http.Post(server.URL, "application/json", strings.NewReader(body))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	var out []*ethResponse
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&out))
+	assert.Equal(t, 2, len(out))
+	assert.Equal(t, `"0x1"`, string(out[0].Result))
+	assert.Equal(t, ethErrInvalidParams, out[1].Error.Code)
+}
@@ -0,0 +1,575 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// eth_getLogs单次查询最多返回的日志数
+	maxEthLogResults = 10000
+	// 每次从日志索引中读取的日志数
+	ethLogPageSize = 1000
+)
+
+// 以太坊格式的地址是20字节十六进制字符串，和chain33地址共用Hash160部分
+type ethCallArgs struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Gas   string `json:"gas"`
+	Value string `json:"value"`
+	Data  string `json:"data"`
+	Input string `json:"input"`
+}
+
+type ethFilter struct {
+	BlockHash string            `json:"blockHash"`
+	FromBlock string            `json:"fromBlock"`
+	ToBlock   string            `json:"toBlock"`
+	Address   json.RawMessage   `json:"address"`
+	Topics    []json.RawMessage `json:"topics"`
+}
+
+type ethLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
+
+type ethReceipt struct {
+	TransactionHash   string    `json:"transactionHash"`
+	TransactionIndex  string    `json:"transactionIndex"`
+	BlockHash         string    `json:"blockHash"`
+	BlockNumber       string    `json:"blockNumber"`
+	From              string    `json:"from"`
+	To                *string   `json:"to"`
+	GasUsed           string    `json:"gasUsed"`
+	CumulativeGasUsed string    `json:"cumulativeGasUsed"`
+	ContractAddress   *string   `json:"contractAddress"`
+	Logs              []*ethLog `json:"logs"`
+	LogsBloom         string    `json:"logsBloom"`
+	Status            string    `json:"status"`
+}
+
+// ethAPI 以太坊JSON-RPC接口的具体实现，合约相关的查询转换为evm执行器的Query_*调用，区块和交易相关的查询直接访问chain33区块数据；
+// 状态查询只支持最新区块
+type ethAPI struct {
+	cli *channelClient
+}
+
+func (e *ethAPI) chainID(params []json.RawMessage) (interface{}, error) {
+	return encodeUint64(uint64(e.cli.GetConfig().GetChainID())), nil
+}
+
+func (e *ethAPI) blockNumber(params []json.RawMessage) (interface{}, error) {
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	return encodeUint64(uint64(header.Height)), nil
+}
+
+func (e *ethAPI) call(params []json.RawMessage) (interface{}, error) {
+	var args ethCallArgs
+	var tag string
+	if err := parseParams(params, 1, &args, &tag); err != nil {
+		return nil, err
+	}
+	if err := checkLatestBlock(tag); err != nil {
+		return nil, err
+	}
+	req, err := args.toCallReq()
+	if err != nil {
+		return nil, err
+	}
+	if len(req.To) == 0 {
+		return nil, &ethError{Code: ethErrInvalidParams, Message: "missing contract address"}
+	}
+	msg, err := e.query("Call", req)
+	if err != nil {
+		return nil, err
+	}
+	resp := msg.(*evmtypes.EvmCallResp)
+	if len(resp.Error) > 0 {
+		ethErr := &ethError{Code: ethErrServer, Message: resp.Error}
+		if len(resp.RevertReason) > 0 {
+			ethErr.Code = ethErrExecutionReverted
+			ethErr.Data = resp.RevertReason
+		}
+		return nil, ethErr
+	}
+	return common.Bytes2Hex(resp.Ret), nil
+}
+
+func (e *ethAPI) estimateGas(params []json.RawMessage) (interface{}, error) {
+	var args ethCallArgs
+	var tag string
+	if err := parseParams(params, 1, &args, &tag); err != nil {
+		return nil, err
+	}
+	req, err := args.toCallReq()
+	if err != nil {
+		return nil, err
+	}
+	// 没有指定to时为估算创建合约消耗的Gas
+	msg, err := e.query("EstimateGas", &evmtypes.EstimateEVMGasReq{To: req.To, Code: req.Data, Caller: req.Caller, Amount: req.Amount})
+	if err != nil {
+		return nil, err
+	}
+	return encodeUint64(msg.(*evmtypes.EstimateEVMGasResp).Gas), nil
+}
+
+func (e *ethAPI) getCode(params []json.RawMessage) (interface{}, error) {
+	var addr, tag string
+	if err := parseParams(params, 1, &addr, &tag); err != nil {
+		return nil, err
+	}
+	if err := checkLatestBlock(tag); err != nil {
+		return nil, err
+	}
+	chainAddr, err := ethToAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := e.query("GetCode", &evmtypes.EvmGetCodeReq{Address: chainAddr})
+	if err != nil {
+		return nil, err
+	}
+	return common.Bytes2Hex(msg.(*evmtypes.EvmGetCodeResp).Code), nil
+}
+
+func (e *ethAPI) getStorageAt(params []json.RawMessage) (interface{}, error) {
+	var addr, key, tag string
+	if err := parseParams(params, 2, &addr, &key, &tag); err != nil {
+		return nil, err
+	}
+	if err := checkLatestBlock(tag); err != nil {
+		return nil, err
+	}
+	chainAddr, err := ethToAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := e.query("GetStorageAt", &evmtypes.EvmGetStorageAtReq{Address: chainAddr, Key: key})
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*evmtypes.EvmGetStorageAtResp).Value, nil
+}
+
+func (e *ethAPI) getLogs(params []json.RawMessage) (interface{}, error) {
+	var filter ethFilter
+	if err := parseParams(params, 1, &filter); err != nil {
+		return nil, err
+	}
+	addrs, err := parseFilterAddress(filter.Address)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := parseFilterTopics(filter.Topics)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.EvmGetLogsReq{Count: ethLogPageSize}
+	for addr := range addrs {
+		chainAddr, err := ethToAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		req.Addresses = append(req.Addresses, chainAddr)
+	}
+	sort.Strings(req.Addresses)
+	for _, set := range topics {
+		topicFilter := &evmtypes.EvmTopicFilter{}
+		for topic := range set {
+			topicFilter.Topic = append(topicFilter.Topic, topic)
+		}
+		sort.Strings(topicFilter.Topic)
+		req.Topics = append(req.Topics, topicFilter)
+	}
+
+	if len(filter.BlockHash) > 0 {
+		overview, err := e.cli.GetBlockOverview(&types.ReqHash{Hash: common.FromHex(filter.BlockHash)})
+		if err != nil {
+			return nil, err
+		}
+		req.FromBlock, req.ToBlock = overview.GetHead().GetHeight(), overview.GetHead().GetHeight()
+	} else {
+		req.FromBlock, req.ToBlock, err = e.blockRange(filter.FromBlock, filter.ToBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// 创世区块中没有合约日志，toBlock为0时日志索引会查询到最新区块
+	if req.FromBlock > req.ToBlock || req.ToBlock <= 0 {
+		return []*ethLog{}, nil
+	}
+
+	// 通过执行器的日志索引查询，结果按区块高度从高到低返回
+	logs := make([]*ethLog, 0)
+	blockHashes := make(map[int64]string)
+	for {
+		msg, err := e.query("GetLogs", req)
+		if err != nil {
+			return nil, err
+		}
+		resp := msg.(*evmtypes.EvmGetLogsResp)
+		for _, item := range resp.Logs {
+			blockHash, ok := blockHashes[item.Height]
+			if !ok {
+				reply, err := e.cli.GetBlockHash(&types.ReqInt{Height: item.Height})
+				if err != nil {
+					return nil, err
+				}
+				blockHash = common.Bytes2Hex(reply.Hash)
+				blockHashes[item.Height] = blockHash
+			}
+			logs = append(logs, &ethLog{
+				Address:          addressToEth(item.Address),
+				Topics:           item.Topic,
+				Data:             item.Data,
+				BlockNumber:      encodeUint64(uint64(item.Height)),
+				BlockHash:        blockHash,
+				TransactionHash:  item.TxHash,
+				TransactionIndex: encodeUint64(uint64(item.TxIndex)),
+				LogIndex:         encodeUint64(uint64(item.BlockLogIndex)),
+			})
+		}
+		if len(logs) > maxEthLogResults {
+			return nil, &ethError{Code: ethErrServer, Message: fmt.Sprintf("query returned more than %d results", maxEthLogResults)}
+		}
+		if resp.Cursor == "" {
+			break
+		}
+		req.Cursor = resp.Cursor
+	}
+	for i, j := 0, len(logs)-1; i < j; i, j = i+1, j-1 {
+		logs[i], logs[j] = logs[j], logs[i]
+	}
+	return logs, nil
+}
+
+func (e *ethAPI) getTransactionReceipt(params []json.RawMessage) (interface{}, error) {
+	var hash string
+	if err := parseParams(params, 1, &hash); err != nil {
+		return nil, err
+	}
+	txHash := common.FromHex(hash)
+	if len(txHash) == 0 {
+		return nil, &ethError{Code: ethErrInvalidParams, Message: "invalid transaction hash"}
+	}
+	// 按哈希批量查询时，不存在的交易返回空的交易详情而不是错误
+	txs, err := e.cli.GetTransactionByHash(&types.ReqHashes{Hashes: [][]byte{txHash}})
+	if err != nil {
+		return nil, err
+	}
+	if len(txs.GetTxs()) != 1 || txs.Txs[0].GetTx() == nil {
+		// 交易不存在时返回null
+		return nil, nil
+	}
+	tx := txs.Txs[0]
+	details, err := e.cli.GetBlocks(&types.ReqBlocks{Start: tx.Height, End: tx.Height, IsDetail: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(details.Items) != 1 || details.Items[0] == nil || details.Items[0].Block == nil {
+		return nil, types.ErrBlockNotFound
+	}
+	detail := details.Items[0]
+	if int(tx.Index) >= len(detail.Block.Txs) || int(tx.Index) >= len(detail.Receipts) {
+		return nil, types.ErrTxNotExist
+	}
+
+	blockHash := common.Bytes2Hex(detail.Block.Hash(e.cli.GetConfig()))
+	var cumulativeGas uint64
+	var logIndex int
+	for i := 0; i < int(tx.Index); i++ {
+		contract := getEVMContractReceipt(detail.Receipts[i])
+		if contract != nil {
+			cumulativeGas += contract.UsedGas
+		}
+		logIndex += len(getEVMLogs(detail.Receipts[i]))
+	}
+
+	target := detail.Block.Txs[tx.Index]
+	receiptData := detail.Receipts[tx.Index]
+	receipt := &ethReceipt{
+		TransactionHash:  common.Bytes2Hex(txHash),
+		TransactionIndex: encodeUint64(uint64(tx.Index)),
+		BlockHash:        blockHash,
+		BlockNumber:      encodeUint64(uint64(tx.Height)),
+		From:             addressToEth(target.From()),
+		Logs:             e.txLogs(detail, int(tx.Index), blockHash, logIndex),
+		Status:           "0x0",
+	}
+	if receiptData.Ty == types.ExecOk {
+		receipt.Status = "0x1"
+	}
+	if contract := getEVMContractReceipt(receiptData); contract != nil {
+		cumulativeGas += contract.UsedGas
+		receipt.GasUsed = encodeUint64(contract.UsedGas)
+		contractAddr := addressToEth(contract.ContractAddr)
+		// 只有创建合约时才会生成合约名称
+		if len(contract.ContractName) > 0 {
+			receipt.ContractAddress = &contractAddr
+		} else {
+			receipt.To = &contractAddr
+		}
+	} else {
+		to := addressToEth(target.GetRealToAddr())
+		receipt.To = &to
+		receipt.GasUsed = encodeUint64(0)
+	}
+	receipt.CumulativeGasUsed = encodeUint64(cumulativeGas)
+	receipt.LogsBloom = common.Bytes2Hex(logsBloom(receipt.Logs))
+	return receipt, nil
+}
+
+func (e *ethAPI) query(funcName string, param types.Message) (types.Message, error) {
+	cfg := e.cli.GetConfig()
+	return e.cli.Query(cfg.ExecName(evmtypes.ExecutorName), funcName, param)
+}
+
+// 解析eth_getLogs的区块范围，未指定时使用最新区块
+func (e *ethAPI) blockRange(from, to string) (start int64, end int64, err error) {
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return 0, 0, err
+	}
+	start, err = parseBlockNumber(from, header.Height)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err = parseBlockNumber(to, header.Height)
+	if err != nil {
+		return 0, 0, err
+	}
+	if end > header.Height {
+		end = header.Height
+	}
+	return start, end, nil
+}
+
+func (e *ethAPI) txLogs(detail *types.BlockDetail, index int, blockHash string, logIndex int) []*ethLog {
+	logs := make([]*ethLog, 0)
+	tx := detail.Block.Txs[index]
+	for _, item := range getEVMLogs(detail.Receipts[index]) {
+		entry := &ethLog{
+			Address:          addressToEth(item.Address),
+			Topics:           make([]string, len(item.Topic)),
+			Data:             common.Bytes2Hex(item.Data),
+			BlockNumber:      encodeUint64(uint64(detail.Block.Height)),
+			BlockHash:        blockHash,
+			TransactionHash:  common.Bytes2Hex(tx.Hash()),
+			TransactionIndex: encodeUint64(uint64(index)),
+			LogIndex:         encodeUint64(uint64(logIndex + len(logs))),
+		}
+		for i, topic := range item.Topic {
+			entry.Topics[i] = common.Bytes2Hex(topic)
+		}
+		logs = append(logs, entry)
+	}
+	return logs
+}
+
+func (args *ethCallArgs) toCallReq() (*evmtypes.EvmCallReq, error) {
+	req := &evmtypes.EvmCallReq{}
+	var err error
+	if len(args.From) > 0 {
+		if req.Caller, err = ethToAddress(args.From); err != nil {
+			return req, err
+		}
+	}
+	if len(args.Value) > 0 {
+		if req.Amount, err = decodeUint64(args.Value); err != nil {
+			return req, err
+		}
+	}
+	// input和data同时存在时以input为准
+	if len(args.Input) > 0 {
+		req.Data = common.FromHex(args.Input)
+	} else {
+		req.Data = common.FromHex(args.Data)
+	}
+	if len(args.To) > 0 {
+		if req.To, err = ethToAddress(args.To); err != nil {
+			return req, err
+		}
+	}
+	return req, nil
+}
+
+// 按位置解析请求参数，required为必须提供的参数个数
+func parseParams(params []json.RawMessage, required int, args ...interface{}) error {
+	if len(params) < required {
+		return &ethError{Code: ethErrInvalidParams, Message: fmt.Sprintf("missing value for required argument %d", len(params))}
+	}
+	if len(params) > len(args) {
+		return &ethError{Code: ethErrInvalidParams, Message: fmt.Sprintf("too many arguments, want at most %d", len(args))}
+	}
+	for i, param := range params {
+		if err := json.Unmarshal(param, args[i]); err != nil {
+			return &ethError{Code: ethErrInvalidParams, Message: fmt.Sprintf("invalid argument %d: %v", i, err)}
+		}
+	}
+	return nil
+}
+
+// 状态查询只支持最新区块
+func checkLatestBlock(tag string) error {
+	switch tag {
+	case "", "latest", "pending":
+		return nil
+	}
+	return &ethError{Code: ethErrInvalidParams, Message: "only the latest block is supported"}
+}
+
+func parseBlockNumber(tag string, latest int64) (int64, error) {
+	switch tag {
+	case "", "latest", "pending":
+		return latest, nil
+	case "earliest":
+		return 0, nil
+	}
+	number, err := decodeUint64(tag)
+	if err != nil {
+		return 0, err
+	}
+	return int64(number), nil
+}
+
+func parseFilterAddress(raw json.RawMessage) (map[string]bool, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		var single string
+		if err := json.Unmarshal(raw, &single); err != nil {
+			return nil, &ethError{Code: ethErrInvalidParams, Message: "invalid address filter"}
+		}
+		list = []string{single}
+	}
+	addrs := make(map[string]bool)
+	for _, addr := range list {
+		chainAddr, err := ethToAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		addrs[addressToEth(chainAddr)] = true
+	}
+	return addrs, nil
+}
+
+// 每个位置的主题可以为null（任意值）、单个主题或者多个主题（满足其一即可）
+func parseFilterTopics(raws []json.RawMessage) ([]map[string]bool, error) {
+	topics := make([]map[string]bool, len(raws))
+	for i, raw := range raws {
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+		var list []string
+		if err := json.Unmarshal(raw, &list); err != nil {
+			var single string
+			if err := json.Unmarshal(raw, &single); err != nil {
+				return nil, &ethError{Code: ethErrInvalidParams, Message: "invalid topic filter"}
+			}
+			list = []string{single}
+		}
+		if len(list) == 0 {
+			continue
+		}
+		topics[i] = make(map[string]bool)
+		for _, topic := range list {
+			topics[i][common.BytesToHash(common.FromHex(topic)).Hex()] = true
+		}
+	}
+	return topics, nil
+}
+
+func getEVMContractReceipt(receipt *types.ReceiptData) *evmtypes.ReceiptEVMContract {
+	for _, item := range receipt.GetLogs() {
+		if item.Ty == evmtypes.TyLogCallContract {
+			var contract evmtypes.ReceiptEVMContract
+			if types.Decode(item.Log, &contract) == nil {
+				return &contract
+			}
+		}
+	}
+	return nil
+}
+
+func getEVMLogs(receipt *types.ReceiptData) (logs []*evmtypes.EVMLog) {
+	for _, item := range receipt.GetLogs() {
+		if item.Ty == evmtypes.TyLogEVMEventData {
+			var evmLog evmtypes.EVMLog
+			if types.Decode(item.Log, &evmLog) == nil {
+				logs = append(logs, &evmLog)
+			}
+		}
+	}
+	return logs
+}
+
+// 按照以太坊规则计算日志的布隆过滤器，对合约地址和每个主题分别计算
+func logsBloom(logs []*ethLog) []byte {
+	var bloom common.Bloom
+	for _, entry := range logs {
+		bloom.Add(common.FromHex(entry.Address))
+		for _, topic := range entry.Topics {
+			bloom.Add(common.FromHex(topic))
+		}
+	}
+	return bloom.Bytes()
+}
+
+// 以太坊格式地址转换为chain33地址
+func ethToAddress(addr string) (string, error) {
+	data := common.FromHex(addr)
+	if len(data) != common.Hash160Length {
+		return "", &ethError{Code: ethErrInvalidParams, Message: fmt.Sprintf("invalid address: %s", addr)}
+	}
+	return common.BytesToAddress(data).String(), nil
+}
+
+// chain33地址转换为以太坊格式地址，转换失败时返回原地址
+func addressToEth(addr string) string {
+	chainAddr := common.StringToAddress(addr)
+	if chainAddr == nil {
+		return addr
+	}
+	return strings.ToLower(chainAddr.ToHash160().Hex())
+}
+
+func encodeUint64(n uint64) string {
+	return "0x" + strconv.FormatUint(n, 16)
+}
+
+func decodeUint64(s string) (uint64, error) {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return 0, &ethError{Code: ethErrInvalidParams, Message: fmt.Sprintf("hex string without 0x prefix: %s", s)}
+	}
+	n, err := strconv.ParseUint(s[2:], 16, 64)
+	if err != nil {
+		return 0, &ethError{Code: ethErrInvalidParams, Message: fmt.Sprintf("invalid hex number: %s", s)}
+	}
+	return n, nil
+}
@@ -5,9 +5,12 @@
 package rpc
 
 import (
+	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/rpc/types"
 )
 
+var log = log15.New("module", "evm.rpc")
+
 // Jrpc json rpc struct
 type Jrpc struct {
 	cli *channelClient
@@ -27,4 +30,5 @@ func Init(name string, s types.RPCServer) {
 	cli := &channelClient{}
 	grpc := &Grpc{channelClient: cli}
 	cli.Init(name, s, &Jrpc{cli: cli}, grpc)
+	startEthServer(cli)
 }
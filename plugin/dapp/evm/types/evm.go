@@ -42,6 +42,12 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ExecutorName, ForkEVMABI, 1250000)
 	// EEVM合约用户金额冻结
 	cfg.RegisterDappFork(ExecutorName, ForkEVMFrozen, 1300000)
+	// EVM合约支持伊斯坦布尔指令集（CREATE2、EXTCODEHASH、CHAINID、SELFBALANCE）和Gas定价
+	cfg.RegisterDappFork(ExecutorName, ForkEVMIstanbul, 1400000)
+	// EVM合约生成的事件日志写入交易回执
+	cfg.RegisterDappFork(ExecutorName, ForkEVMTxLog, 1500000)
+	// EVM合约revert时交易回执中记录revert原因
+	cfg.RegisterDappFork(ExecutorName, ForkEVMRevertReason, 1600000)
 }
 
 func InitExecutor(cfg *types.Chain33Config) {
@@ -334,7 +334,9 @@ type ReceiptEVMContract struct {
 	// 创建合约返回的代码
 	Ret []byte `protobuf:"bytes,5,opt,name=ret,proto3" json:"ret,omitempty"`
 	// json格式化后的返回值
-	JsonRet              string   `protobuf:"bytes,6,opt,name=jsonRet,proto3" json:"jsonRet,omitempty"`
+	JsonRet string `protobuf:"bytes,6,opt,name=jsonRet,proto3" json:"jsonRet,omitempty"`
+	// 合约revert时解析出的原因 ForkEVMRevertReason
+	RevertReason         string   `protobuf:"bytes,7,opt,name=revertReason,proto3" json:"revertReason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -407,6 +409,70 @@ func (m *ReceiptEVMContract) GetJsonRet() string {
 	return ""
 }
 
+func (m *ReceiptEVMContract) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+// 合约通过LOG0~LOG4指令生成的事件日志 ForkEVMTxLog
+type EVMLog struct {
+	// 生成日志的合约地址
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topic                [][]byte `protobuf:"bytes,2,rep,name=topic,proto3" json:"topic,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMLog) Reset()         { *m = EVMLog{} }
+func (m *EVMLog) String() string { return proto.CompactTextString(m) }
+func (*EVMLog) ProtoMessage()    {}
+func (*EVMLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{5}
+}
+
+func (m *EVMLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMLog.Unmarshal(m, b)
+}
+func (m *EVMLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMLog.Marshal(b, m, deterministic)
+}
+func (m *EVMLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMLog.Merge(m, src)
+}
+func (m *EVMLog) XXX_Size() int {
+	return xxx_messageInfo_EVMLog.Size(m)
+}
+func (m *EVMLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMLog proto.InternalMessageInfo
+
+func (m *EVMLog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EVMLog) GetTopic() [][]byte {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+func (m *EVMLog) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // 用于保存EVM只能合约中的状态数据变更
 type EVMStateChangeItem struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -421,7 +487,7 @@ func (m *EVMStateChangeItem) Reset()         { *m = EVMStateChangeItem{} }
 func (m *EVMStateChangeItem) String() string { return proto.CompactTextString(m) }
 func (*EVMStateChangeItem) ProtoMessage()    {}
 func (*EVMStateChangeItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{5}
+	return fileDescriptor_74353de561acd7c6, []int{6}
 }
 
 func (m *EVMStateChangeItem) XXX_Unmarshal(b []byte) error {
@@ -480,7 +546,7 @@ func (m *EVMContractDataCmd) Reset()         { *m = EVMContractDataCmd{} }
 func (m *EVMContractDataCmd) String() string { return proto.CompactTextString(m) }
 func (*EVMContractDataCmd) ProtoMessage()    {}
 func (*EVMContractDataCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{6}
+	return fileDescriptor_74353de561acd7c6, []int{7}
 }
 
 func (m *EVMContractDataCmd) XXX_Unmarshal(b []byte) error {
@@ -558,7 +624,7 @@ func (m *EVMContractStateCmd) Reset()         { *m = EVMContractStateCmd{} }
 func (m *EVMContractStateCmd) String() string { return proto.CompactTextString(m) }
 func (*EVMContractStateCmd) ProtoMessage()    {}
 func (*EVMContractStateCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{7}
+	return fileDescriptor_74353de561acd7c6, []int{8}
 }
 
 func (m *EVMContractStateCmd) XXX_Unmarshal(b []byte) error {
@@ -625,7 +691,7 @@ func (m *ReceiptEVMContractCmd) Reset()         { *m = ReceiptEVMContractCmd{} }
 func (m *ReceiptEVMContractCmd) String() string { return proto.CompactTextString(m) }
 func (*ReceiptEVMContractCmd) ProtoMessage()    {}
 func (*ReceiptEVMContractCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{8}
+	return fileDescriptor_74353de561acd7c6, []int{9}
 }
 
 func (m *ReceiptEVMContractCmd) XXX_Unmarshal(b []byte) error {
@@ -692,7 +758,7 @@ func (m *CheckEVMAddrReq) Reset()         { *m = CheckEVMAddrReq{} }
 func (m *CheckEVMAddrReq) String() string { return proto.CompactTextString(m) }
 func (*CheckEVMAddrReq) ProtoMessage()    {}
 func (*CheckEVMAddrReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{9}
+	return fileDescriptor_74353de561acd7c6, []int{10}
 }
 
 func (m *CheckEVMAddrReq) XXX_Unmarshal(b []byte) error {
@@ -734,7 +800,7 @@ func (m *CheckEVMAddrResp) Reset()         { *m = CheckEVMAddrResp{} }
 func (m *CheckEVMAddrResp) String() string { return proto.CompactTextString(m) }
 func (*CheckEVMAddrResp) ProtoMessage()    {}
 func (*CheckEVMAddrResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{10}
+	return fileDescriptor_74353de561acd7c6, []int{11}
 }
 
 func (m *CheckEVMAddrResp) XXX_Unmarshal(b []byte) error {
@@ -798,7 +864,7 @@ func (m *EstimateEVMGasReq) Reset()         { *m = EstimateEVMGasReq{} }
 func (m *EstimateEVMGasReq) String() string { return proto.CompactTextString(m) }
 func (*EstimateEVMGasReq) ProtoMessage()    {}
 func (*EstimateEVMGasReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{11}
+	return fileDescriptor_74353de561acd7c6, []int{12}
 }
 
 func (m *EstimateEVMGasReq) XXX_Unmarshal(b []byte) error {
@@ -865,7 +931,7 @@ func (m *EstimateEVMGasResp) Reset()         { *m = EstimateEVMGasResp{} }
 func (m *EstimateEVMGasResp) String() string { return proto.CompactTextString(m) }
 func (*EstimateEVMGasResp) ProtoMessage()    {}
 func (*EstimateEVMGasResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{12}
+	return fileDescriptor_74353de561acd7c6, []int{13}
 }
 
 func (m *EstimateEVMGasResp) XXX_Unmarshal(b []byte) error {
@@ -905,7 +971,7 @@ func (m *EvmDebugReq) Reset()         { *m = EvmDebugReq{} }
 func (m *EvmDebugReq) String() string { return proto.CompactTextString(m) }
 func (*EvmDebugReq) ProtoMessage()    {}
 func (*EvmDebugReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{13}
+	return fileDescriptor_74353de561acd7c6, []int{14}
 }
 
 func (m *EvmDebugReq) XXX_Unmarshal(b []byte) error {
@@ -944,7 +1010,7 @@ func (m *EvmDebugResp) Reset()         { *m = EvmDebugResp{} }
 func (m *EvmDebugResp) String() string { return proto.CompactTextString(m) }
 func (*EvmDebugResp) ProtoMessage()    {}
 func (*EvmDebugResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{14}
+	return fileDescriptor_74353de561acd7c6, []int{15}
 }
 
 func (m *EvmDebugResp) XXX_Unmarshal(b []byte) error {
@@ -983,7 +1049,7 @@ func (m *EvmQueryAbiReq) Reset()         { *m = EvmQueryAbiReq{} }
 func (m *EvmQueryAbiReq) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryAbiReq) ProtoMessage()    {}
 func (*EvmQueryAbiReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{15}
+	return fileDescriptor_74353de561acd7c6, []int{16}
 }
 
 func (m *EvmQueryAbiReq) XXX_Unmarshal(b []byte) error {
@@ -1023,7 +1089,7 @@ func (m *EvmQueryAbiResp) Reset()         { *m = EvmQueryAbiResp{} }
 func (m *EvmQueryAbiResp) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryAbiResp) ProtoMessage()    {}
 func (*EvmQueryAbiResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{16}
+	return fileDescriptor_74353de561acd7c6, []int{17}
 }
 
 func (m *EvmQueryAbiResp) XXX_Unmarshal(b []byte) error {
@@ -1071,7 +1137,7 @@ func (m *EvmQueryReq) Reset()         { *m = EvmQueryReq{} }
 func (m *EvmQueryReq) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryReq) ProtoMessage()    {}
 func (*EvmQueryReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{17}
+	return fileDescriptor_74353de561acd7c6, []int{18}
 }
 
 func (m *EvmQueryReq) XXX_Unmarshal(b []byte) error {
@@ -1119,6 +1185,7 @@ type EvmQueryResp struct {
 	Caller               string   `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
 	RawData              string   `protobuf:"bytes,4,opt,name=rawData,proto3" json:"rawData,omitempty"`
 	JsonData             string   `protobuf:"bytes,5,opt,name=jsonData,proto3" json:"jsonData,omitempty"`
+	RevertReason         string   `protobuf:"bytes,6,opt,name=revertReason,proto3" json:"revertReason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1128,7 +1195,7 @@ func (m *EvmQueryResp) Reset()         { *m = EvmQueryResp{} }
 func (m *EvmQueryResp) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryResp) ProtoMessage()    {}
 func (*EvmQueryResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{18}
+	return fileDescriptor_74353de561acd7c6, []int{19}
 }
 
 func (m *EvmQueryResp) XXX_Unmarshal(b []byte) error {
@@ -1184,221 +1251,1215 @@ func (m *EvmQueryResp) GetJsonData() string {
 	return ""
 }
 
-type EvmContractCreateReq struct {
-	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Fee                  int64    `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
-	Note                 string   `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
-	Alias                string   `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
-	Caller               string   `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
-	Abi                  string   `protobuf:"bytes,6,opt,name=abi,proto3" json:"abi,omitempty"`
-	Expire               string   `protobuf:"bytes,7,opt,name=expire,proto3" json:"expire,omitempty"`
-	ParaName             string   `protobuf:"bytes,8,opt,name=paraName,proto3" json:"paraName,omitempty"`
+func (m *EvmQueryResp) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+// 重新执行历史交易并返回跟踪结果
+type EvmTraceTxReq struct {
+	TxHash string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	// callTracer 返回调用树，prestateTracer 返回交易执行前（后）的账户状态
+	Tracer string `protobuf:"bytes,2,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	// 仅对prestateTracer有效，为true时只返回发生变化的账户和存储
+	DiffMode             bool     `protobuf:"varint,3,opt,name=diffMode,proto3" json:"diffMode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EvmContractCreateReq) Reset()         { *m = EvmContractCreateReq{} }
-func (m *EvmContractCreateReq) String() string { return proto.CompactTextString(m) }
-func (*EvmContractCreateReq) ProtoMessage()    {}
-func (*EvmContractCreateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{19}
+func (m *EvmTraceTxReq) Reset()         { *m = EvmTraceTxReq{} }
+func (m *EvmTraceTxReq) String() string { return proto.CompactTextString(m) }
+func (*EvmTraceTxReq) ProtoMessage()    {}
+func (*EvmTraceTxReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{20}
 }
 
-func (m *EvmContractCreateReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EvmContractCreateReq.Unmarshal(m, b)
+func (m *EvmTraceTxReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmTraceTxReq.Unmarshal(m, b)
 }
-func (m *EvmContractCreateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EvmContractCreateReq.Marshal(b, m, deterministic)
+func (m *EvmTraceTxReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmTraceTxReq.Marshal(b, m, deterministic)
 }
-func (m *EvmContractCreateReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmContractCreateReq.Merge(m, src)
+func (m *EvmTraceTxReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTraceTxReq.Merge(m, src)
 }
-func (m *EvmContractCreateReq) XXX_Size() int {
-	return xxx_messageInfo_EvmContractCreateReq.Size(m)
+func (m *EvmTraceTxReq) XXX_Size() int {
+	return xxx_messageInfo_EvmTraceTxReq.Size(m)
 }
-func (m *EvmContractCreateReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_EvmContractCreateReq.DiscardUnknown(m)
+func (m *EvmTraceTxReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTraceTxReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EvmContractCreateReq proto.InternalMessageInfo
+var xxx_messageInfo_EvmTraceTxReq proto.InternalMessageInfo
 
-func (m *EvmContractCreateReq) GetCode() string {
+func (m *EvmTraceTxReq) GetTxHash() string {
 	if m != nil {
-		return m.Code
+		return m.TxHash
 	}
 	return ""
 }
 
-func (m *EvmContractCreateReq) GetFee() int64 {
+func (m *EvmTraceTxReq) GetTracer() string {
 	if m != nil {
-		return m.Fee
+		return m.Tracer
 	}
-	return 0
+	return ""
 }
 
-func (m *EvmContractCreateReq) GetNote() string {
+func (m *EvmTraceTxReq) GetDiffMode() bool {
 	if m != nil {
-		return m.Note
+		return m.DiffMode
+	}
+	return false
+}
+
+// 合约调用树中的一个调用帧
+type EvmCallFrame struct {
+	Type                 string          `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From                 string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string          `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value                uint64          `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Gas                  uint64          `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed              uint64          `protobuf:"varint,6,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Input                string          `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Output               string          `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	Error                string          `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	RevertReason         string          `protobuf:"bytes,10,opt,name=revertReason,proto3" json:"revertReason,omitempty"`
+	Calls                []*EvmCallFrame `protobuf:"bytes,11,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EvmCallFrame) Reset()         { *m = EvmCallFrame{} }
+func (m *EvmCallFrame) String() string { return proto.CompactTextString(m) }
+func (*EvmCallFrame) ProtoMessage()    {}
+func (*EvmCallFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{21}
+}
+
+func (m *EvmCallFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmCallFrame.Unmarshal(m, b)
+}
+func (m *EvmCallFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmCallFrame.Marshal(b, m, deterministic)
+}
+func (m *EvmCallFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmCallFrame.Merge(m, src)
+}
+func (m *EvmCallFrame) XXX_Size() int {
+	return xxx_messageInfo_EvmCallFrame.Size(m)
+}
+func (m *EvmCallFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmCallFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmCallFrame proto.InternalMessageInfo
+
+func (m *EvmCallFrame) GetType() string {
+	if m != nil {
+		return m.Type
 	}
 	return ""
 }
 
-func (m *EvmContractCreateReq) GetAlias() string {
+func (m *EvmCallFrame) GetFrom() string {
 	if m != nil {
-		return m.Alias
+		return m.From
 	}
 	return ""
 }
 
-func (m *EvmContractCreateReq) GetCaller() string {
+func (m *EvmCallFrame) GetTo() string {
 	if m != nil {
-		return m.Caller
+		return m.To
 	}
 	return ""
 }
 
-func (m *EvmContractCreateReq) GetAbi() string {
+func (m *EvmCallFrame) GetValue() uint64 {
 	if m != nil {
-		return m.Abi
+		return m.Value
+	}
+	return 0
+}
+
+func (m *EvmCallFrame) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *EvmCallFrame) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EvmCallFrame) GetInput() string {
+	if m != nil {
+		return m.Input
 	}
 	return ""
 }
 
-func (m *EvmContractCreateReq) GetExpire() string {
+func (m *EvmCallFrame) GetOutput() string {
 	if m != nil {
-		return m.Expire
+		return m.Output
 	}
 	return ""
 }
 
-func (m *EvmContractCreateReq) GetParaName() string {
+func (m *EvmCallFrame) GetError() string {
 	if m != nil {
-		return m.ParaName
+		return m.Error
 	}
 	return ""
 }
 
-type EvmContractCallReq struct {
-	Amount               uint64   `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Fee                  int64    `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
-	Note                 string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
-	Caller               string   `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
-	Abi                  string   `protobuf:"bytes,6,opt,name=abi,proto3" json:"abi,omitempty"`
-	Exec                 string   `protobuf:"bytes,7,opt,name=exec,proto3" json:"exec,omitempty"`
-	Expire               string   `protobuf:"bytes,8,opt,name=expire,proto3" json:"expire,omitempty"`
-	ParaName             string   `protobuf:"bytes,9,opt,name=paraName,proto3" json:"paraName,omitempty"`
+func (m *EvmCallFrame) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+func (m *EvmCallFrame) GetCalls() []*EvmCallFrame {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+type EvmStorageItem struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EvmContractCallReq) Reset()         { *m = EvmContractCallReq{} }
-func (m *EvmContractCallReq) String() string { return proto.CompactTextString(m) }
-func (*EvmContractCallReq) ProtoMessage()    {}
-func (*EvmContractCallReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{20}
+func (m *EvmStorageItem) Reset()         { *m = EvmStorageItem{} }
+func (m *EvmStorageItem) String() string { return proto.CompactTextString(m) }
+func (*EvmStorageItem) ProtoMessage()    {}
+func (*EvmStorageItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{22}
 }
 
-func (m *EvmContractCallReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EvmContractCallReq.Unmarshal(m, b)
+func (m *EvmStorageItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmStorageItem.Unmarshal(m, b)
 }
-func (m *EvmContractCallReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EvmContractCallReq.Marshal(b, m, deterministic)
+func (m *EvmStorageItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmStorageItem.Marshal(b, m, deterministic)
 }
-func (m *EvmContractCallReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmContractCallReq.Merge(m, src)
+func (m *EvmStorageItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmStorageItem.Merge(m, src)
 }
-func (m *EvmContractCallReq) XXX_Size() int {
-	return xxx_messageInfo_EvmContractCallReq.Size(m)
+func (m *EvmStorageItem) XXX_Size() int {
+	return xxx_messageInfo_EvmStorageItem.Size(m)
 }
-func (m *EvmContractCallReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_EvmContractCallReq.DiscardUnknown(m)
+func (m *EvmStorageItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmStorageItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EvmContractCallReq proto.InternalMessageInfo
+var xxx_messageInfo_EvmStorageItem proto.InternalMessageInfo
 
-func (m *EvmContractCallReq) GetAmount() uint64 {
+func (m *EvmStorageItem) GetKey() string {
 	if m != nil {
-		return m.Amount
+		return m.Key
 	}
-	return 0
+	return ""
 }
 
-func (m *EvmContractCallReq) GetCode() string {
+func (m *EvmStorageItem) GetValue() string {
 	if m != nil {
-		return m.Code
+		return m.Value
 	}
 	return ""
 }
 
-func (m *EvmContractCallReq) GetFee() int64 {
+type EvmAccountState struct {
+	Address              string            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance              uint64            `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce                uint64            `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Code                 string            `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Storage              []*EvmStorageItem `protobuf:"bytes,5,rep,name=storage,proto3" json:"storage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *EvmAccountState) Reset()         { *m = EvmAccountState{} }
+func (m *EvmAccountState) String() string { return proto.CompactTextString(m) }
+func (*EvmAccountState) ProtoMessage()    {}
+func (*EvmAccountState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{23}
+}
+
+func (m *EvmAccountState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmAccountState.Unmarshal(m, b)
+}
+func (m *EvmAccountState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmAccountState.Marshal(b, m, deterministic)
+}
+func (m *EvmAccountState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmAccountState.Merge(m, src)
+}
+func (m *EvmAccountState) XXX_Size() int {
+	return xxx_messageInfo_EvmAccountState.Size(m)
+}
+func (m *EvmAccountState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmAccountState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmAccountState proto.InternalMessageInfo
+
+func (m *EvmAccountState) GetAddress() string {
 	if m != nil {
-		return m.Fee
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *EvmContractCallReq) GetNote() string {
+func (m *EvmAccountState) GetBalance() uint64 {
 	if m != nil {
-		return m.Note
+		return m.Balance
 	}
-	return ""
+	return 0
 }
 
-func (m *EvmContractCallReq) GetCaller() string {
+func (m *EvmAccountState) GetNonce() uint64 {
 	if m != nil {
-		return m.Caller
+		return m.Nonce
 	}
-	return ""
+	return 0
 }
 
-func (m *EvmContractCallReq) GetAbi() string {
+func (m *EvmAccountState) GetCode() string {
 	if m != nil {
-		return m.Abi
+		return m.Code
 	}
 	return ""
 }
 
-func (m *EvmContractCallReq) GetExec() string {
+func (m *EvmAccountState) GetStorage() []*EvmStorageItem {
 	if m != nil {
-		return m.Exec
+		return m.Storage
 	}
-	return ""
+	return nil
 }
 
-func (m *EvmContractCallReq) GetExpire() string {
+type EvmTraceTxResp struct {
+	TxHash               string             `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Tracer               string             `protobuf:"bytes,2,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	CallTrace            *EvmCallFrame      `protobuf:"bytes,3,opt,name=callTrace,proto3" json:"callTrace,omitempty"`
+	Pre                  []*EvmAccountState `protobuf:"bytes,4,rep,name=pre,proto3" json:"pre,omitempty"`
+	Post                 []*EvmAccountState `protobuf:"bytes,5,rep,name=post,proto3" json:"post,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EvmTraceTxResp) Reset()         { *m = EvmTraceTxResp{} }
+func (m *EvmTraceTxResp) String() string { return proto.CompactTextString(m) }
+func (*EvmTraceTxResp) ProtoMessage()    {}
+func (*EvmTraceTxResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{24}
+}
+
+func (m *EvmTraceTxResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmTraceTxResp.Unmarshal(m, b)
+}
+func (m *EvmTraceTxResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmTraceTxResp.Marshal(b, m, deterministic)
+}
+func (m *EvmTraceTxResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTraceTxResp.Merge(m, src)
+}
+func (m *EvmTraceTxResp) XXX_Size() int {
+	return xxx_messageInfo_EvmTraceTxResp.Size(m)
+}
+func (m *EvmTraceTxResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTraceTxResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTraceTxResp proto.InternalMessageInfo
+
+func (m *EvmTraceTxResp) GetTxHash() string {
 	if m != nil {
-		return m.Expire
+		return m.TxHash
 	}
 	return ""
 }
 
-func (m *EvmContractCallReq) GetParaName() string {
+func (m *EvmTraceTxResp) GetTracer() string {
 	if m != nil {
-		return m.ParaName
+		return m.Tracer
 	}
 	return ""
 }
 
-type EvmContractTransferReq struct {
-	Caller               string   `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
-	Amount               float32  `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Exec                 string   `protobuf:"bytes,3,opt,name=exec,proto3" json:"exec,omitempty"`
-	Expire               string   `protobuf:"bytes,4,opt,name=expire,proto3" json:"expire,omitempty"`
-	IsWithdraw           bool     `protobuf:"varint,5,opt,name=isWithdraw,proto3" json:"isWithdraw,omitempty"`
-	ParaName             string   `protobuf:"bytes,6,opt,name=paraName,proto3" json:"paraName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *EvmTraceTxResp) GetCallTrace() *EvmCallFrame {
+	if m != nil {
+		return m.CallTrace
+	}
+	return nil
+}
+
+func (m *EvmTraceTxResp) GetPre() []*EvmAccountState {
+	if m != nil {
+		return m.Pre
+	}
+	return nil
+}
+
+func (m *EvmTraceTxResp) GetPost() []*EvmAccountState {
+	if m != nil {
+		return m.Post
+	}
+	return nil
+}
+
+type EvmGetCodeReq struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetCodeReq) Reset()         { *m = EvmGetCodeReq{} }
+func (m *EvmGetCodeReq) String() string { return proto.CompactTextString(m) }
+func (*EvmGetCodeReq) ProtoMessage()    {}
+func (*EvmGetCodeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{25}
+}
+
+func (m *EvmGetCodeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetCodeReq.Unmarshal(m, b)
+}
+func (m *EvmGetCodeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetCodeReq.Marshal(b, m, deterministic)
+}
+func (m *EvmGetCodeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetCodeReq.Merge(m, src)
+}
+func (m *EvmGetCodeReq) XXX_Size() int {
+	return xxx_messageInfo_EvmGetCodeReq.Size(m)
+}
+func (m *EvmGetCodeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetCodeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetCodeReq proto.InternalMessageInfo
+
+func (m *EvmGetCodeReq) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type EvmGetCodeResp struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Code                 []byte   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetCodeResp) Reset()         { *m = EvmGetCodeResp{} }
+func (m *EvmGetCodeResp) String() string { return proto.CompactTextString(m) }
+func (*EvmGetCodeResp) ProtoMessage()    {}
+func (*EvmGetCodeResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{26}
+}
+
+func (m *EvmGetCodeResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetCodeResp.Unmarshal(m, b)
+}
+func (m *EvmGetCodeResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetCodeResp.Marshal(b, m, deterministic)
+}
+func (m *EvmGetCodeResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetCodeResp.Merge(m, src)
+}
+func (m *EvmGetCodeResp) XXX_Size() int {
+	return xxx_messageInfo_EvmGetCodeResp.Size(m)
+}
+func (m *EvmGetCodeResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetCodeResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetCodeResp proto.InternalMessageInfo
+
+func (m *EvmGetCodeResp) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EvmGetCodeResp) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+type EvmGetStorageAtReq struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// 32字节的存储位置，十六进制格式
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetStorageAtReq) Reset()         { *m = EvmGetStorageAtReq{} }
+func (m *EvmGetStorageAtReq) String() string { return proto.CompactTextString(m) }
+func (*EvmGetStorageAtReq) ProtoMessage()    {}
+func (*EvmGetStorageAtReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{27}
+}
+
+func (m *EvmGetStorageAtReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetStorageAtReq.Unmarshal(m, b)
+}
+func (m *EvmGetStorageAtReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetStorageAtReq.Marshal(b, m, deterministic)
+}
+func (m *EvmGetStorageAtReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetStorageAtReq.Merge(m, src)
+}
+func (m *EvmGetStorageAtReq) XXX_Size() int {
+	return xxx_messageInfo_EvmGetStorageAtReq.Size(m)
+}
+func (m *EvmGetStorageAtReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetStorageAtReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetStorageAtReq proto.InternalMessageInfo
+
+func (m *EvmGetStorageAtReq) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EvmGetStorageAtReq) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type EvmGetStorageAtResp struct {
+	// 32字节的存储数据，十六进制格式
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetStorageAtResp) Reset()         { *m = EvmGetStorageAtResp{} }
+func (m *EvmGetStorageAtResp) String() string { return proto.CompactTextString(m) }
+func (*EvmGetStorageAtResp) ProtoMessage()    {}
+func (*EvmGetStorageAtResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{28}
+}
+
+func (m *EvmGetStorageAtResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetStorageAtResp.Unmarshal(m, b)
+}
+func (m *EvmGetStorageAtResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetStorageAtResp.Marshal(b, m, deterministic)
+}
+func (m *EvmGetStorageAtResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetStorageAtResp.Merge(m, src)
+}
+func (m *EvmGetStorageAtResp) XXX_Size() int {
+	return xxx_messageInfo_EvmGetStorageAtResp.Size(m)
+}
+func (m *EvmGetStorageAtResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetStorageAtResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetStorageAtResp proto.InternalMessageInfo
+
+func (m *EvmGetStorageAtResp) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// 使用十六进制调用数据（不经过ABI转换）调用合约
+type EvmCallReq struct {
+	Caller               string   `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Amount               uint64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmCallReq) Reset()         { *m = EvmCallReq{} }
+func (m *EvmCallReq) String() string { return proto.CompactTextString(m) }
+func (*EvmCallReq) ProtoMessage()    {}
+func (*EvmCallReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{29}
+}
+
+func (m *EvmCallReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmCallReq.Unmarshal(m, b)
+}
+func (m *EvmCallReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmCallReq.Marshal(b, m, deterministic)
+}
+func (m *EvmCallReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmCallReq.Merge(m, src)
+}
+func (m *EvmCallReq) XXX_Size() int {
+	return xxx_messageInfo_EvmCallReq.Size(m)
+}
+func (m *EvmCallReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmCallReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmCallReq proto.InternalMessageInfo
+
+func (m *EvmCallReq) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EvmCallReq) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EvmCallReq) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *EvmCallReq) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type EvmCallResp struct {
+	Ret                  []byte   `protobuf:"bytes,1,opt,name=ret,proto3" json:"ret,omitempty"`
+	UsedGas              uint64   `protobuf:"varint,2,opt,name=usedGas,proto3" json:"usedGas,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	RevertReason         string   `protobuf:"bytes,4,opt,name=revertReason,proto3" json:"revertReason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmCallResp) Reset()         { *m = EvmCallResp{} }
+func (m *EvmCallResp) String() string { return proto.CompactTextString(m) }
+func (*EvmCallResp) ProtoMessage()    {}
+func (*EvmCallResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{30}
+}
+
+func (m *EvmCallResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmCallResp.Unmarshal(m, b)
+}
+func (m *EvmCallResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmCallResp.Marshal(b, m, deterministic)
+}
+func (m *EvmCallResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmCallResp.Merge(m, src)
+}
+func (m *EvmCallResp) XXX_Size() int {
+	return xxx_messageInfo_EvmCallResp.Size(m)
+}
+func (m *EvmCallResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmCallResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmCallResp proto.InternalMessageInfo
+
+func (m *EvmCallResp) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *EvmCallResp) GetUsedGas() uint64 {
+	if m != nil {
+		return m.UsedGas
+	}
+	return 0
+}
+
+func (m *EvmCallResp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EvmCallResp) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+// 事件日志查询条件中一个主题位置的取值，满足其一即可，为空时匹配任意主题
+type EvmTopicFilter struct {
+	Topic                []string `protobuf:"bytes,1,rep,name=topic,proto3" json:"topic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmTopicFilter) Reset()         { *m = EvmTopicFilter{} }
+func (m *EvmTopicFilter) String() string { return proto.CompactTextString(m) }
+func (*EvmTopicFilter) ProtoMessage()    {}
+func (*EvmTopicFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{31}
+}
+
+func (m *EvmTopicFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmTopicFilter.Unmarshal(m, b)
+}
+func (m *EvmTopicFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmTopicFilter.Marshal(b, m, deterministic)
+}
+func (m *EvmTopicFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTopicFilter.Merge(m, src)
+}
+func (m *EvmTopicFilter) XXX_Size() int {
+	return xxx_messageInfo_EvmTopicFilter.Size(m)
+}
+func (m *EvmTopicFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTopicFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTopicFilter proto.InternalMessageInfo
+
+func (m *EvmTopicFilter) GetTopic() []string {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+type EvmGetLogsReq struct {
+	// 查询的区块高度范围，toBlock小于等于0时表示查询到最新区块
+	FromBlock int64 `protobuf:"varint,1,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	ToBlock   int64 `protobuf:"varint,2,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	// 合约地址，满足其一即可，为空时匹配任意合约
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// 按位置匹配日志的topic0~topic3
+	Topics []*EvmTopicFilter `protobuf:"bytes,4,rep,name=topics,proto3" json:"topics,omitempty"`
+	// 单次返回的最大日志数
+	Count int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// 上一次查询返回的游标，为空时从toBlock开始查询
+	Cursor               string   `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetLogsReq) Reset()         { *m = EvmGetLogsReq{} }
+func (m *EvmGetLogsReq) String() string { return proto.CompactTextString(m) }
+func (*EvmGetLogsReq) ProtoMessage()    {}
+func (*EvmGetLogsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{32}
+}
+
+func (m *EvmGetLogsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetLogsReq.Unmarshal(m, b)
+}
+func (m *EvmGetLogsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetLogsReq.Marshal(b, m, deterministic)
+}
+func (m *EvmGetLogsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetLogsReq.Merge(m, src)
+}
+func (m *EvmGetLogsReq) XXX_Size() int {
+	return xxx_messageInfo_EvmGetLogsReq.Size(m)
+}
+func (m *EvmGetLogsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetLogsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetLogsReq proto.InternalMessageInfo
+
+func (m *EvmGetLogsReq) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *EvmGetLogsReq) GetToBlock() int64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+func (m *EvmGetLogsReq) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *EvmGetLogsReq) GetTopics() []*EvmTopicFilter {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *EvmGetLogsReq) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *EvmGetLogsReq) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type EvmLogItem struct {
+	Address  string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topic    []string `protobuf:"bytes,2,rep,name=topic,proto3" json:"topic,omitempty"`
+	Data     string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Height   int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	TxIndex  int32    `protobuf:"varint,5,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	LogIndex int32    `protobuf:"varint,6,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	TxHash   string   `protobuf:"bytes,7,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	// 日志在区块所有日志中的序号
+	BlockLogIndex        int32    `protobuf:"varint,8,opt,name=blockLogIndex,proto3" json:"blockLogIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmLogItem) Reset()         { *m = EvmLogItem{} }
+func (m *EvmLogItem) String() string { return proto.CompactTextString(m) }
+func (*EvmLogItem) ProtoMessage()    {}
+func (*EvmLogItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{33}
+}
+
+func (m *EvmLogItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmLogItem.Unmarshal(m, b)
+}
+func (m *EvmLogItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmLogItem.Marshal(b, m, deterministic)
+}
+func (m *EvmLogItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmLogItem.Merge(m, src)
+}
+func (m *EvmLogItem) XXX_Size() int {
+	return xxx_messageInfo_EvmLogItem.Size(m)
+}
+func (m *EvmLogItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmLogItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmLogItem proto.InternalMessageInfo
+
+func (m *EvmLogItem) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetTopic() []string {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+func (m *EvmLogItem) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EvmLogItem) GetTxIndex() int32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *EvmLogItem) GetLogIndex() int32 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *EvmLogItem) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetBlockLogIndex() int32 {
+	if m != nil {
+		return m.BlockLogIndex
+	}
+	return 0
+}
+
+// 区块中事件日志的汇总信息，按区块过滤日志时先检查布隆过滤器
+type EvmBlockLogInfo struct {
+	// 以太坊格式的布隆过滤器，包含所有日志的合约地址和主题
+	Bloom []byte `protobuf:"bytes,1,opt,name=bloom,proto3" json:"bloom,omitempty"`
+	// 区块中的日志数
+	LogCount             int32    `protobuf:"varint,2,opt,name=logCount,proto3" json:"logCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmBlockLogInfo) Reset()         { *m = EvmBlockLogInfo{} }
+func (m *EvmBlockLogInfo) String() string { return proto.CompactTextString(m) }
+func (*EvmBlockLogInfo) ProtoMessage()    {}
+func (*EvmBlockLogInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{34}
+}
+
+func (m *EvmBlockLogInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmBlockLogInfo.Unmarshal(m, b)
+}
+func (m *EvmBlockLogInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmBlockLogInfo.Marshal(b, m, deterministic)
+}
+func (m *EvmBlockLogInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmBlockLogInfo.Merge(m, src)
+}
+func (m *EvmBlockLogInfo) XXX_Size() int {
+	return xxx_messageInfo_EvmBlockLogInfo.Size(m)
+}
+func (m *EvmBlockLogInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmBlockLogInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmBlockLogInfo proto.InternalMessageInfo
+
+func (m *EvmBlockLogInfo) GetBloom() []byte {
+	if m != nil {
+		return m.Bloom
+	}
+	return nil
+}
+
+func (m *EvmBlockLogInfo) GetLogCount() int32 {
+	if m != nil {
+		return m.LogCount
+	}
+	return 0
+}
+
+// 日志按区块高度从高到低返回
+type EvmGetLogsResp struct {
+	Logs []*EvmLogItem `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	// 下一次查询使用的游标，为空时表示没有更多数据
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetLogsResp) Reset()         { *m = EvmGetLogsResp{} }
+func (m *EvmGetLogsResp) String() string { return proto.CompactTextString(m) }
+func (*EvmGetLogsResp) ProtoMessage()    {}
+func (*EvmGetLogsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{35}
+}
+
+func (m *EvmGetLogsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetLogsResp.Unmarshal(m, b)
+}
+func (m *EvmGetLogsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetLogsResp.Marshal(b, m, deterministic)
+}
+func (m *EvmGetLogsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetLogsResp.Merge(m, src)
+}
+func (m *EvmGetLogsResp) XXX_Size() int {
+	return xxx_messageInfo_EvmGetLogsResp.Size(m)
+}
+func (m *EvmGetLogsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetLogsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetLogsResp proto.InternalMessageInfo
+
+func (m *EvmGetLogsResp) GetLogs() []*EvmLogItem {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *EvmGetLogsResp) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type EvmContractCreateReq struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Fee                  int64    `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	Note                 string   `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Alias                string   `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	Caller               string   `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
+	Abi                  string   `protobuf:"bytes,6,opt,name=abi,proto3" json:"abi,omitempty"`
+	Expire               string   `protobuf:"bytes,7,opt,name=expire,proto3" json:"expire,omitempty"`
+	ParaName             string   `protobuf:"bytes,8,opt,name=paraName,proto3" json:"paraName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmContractCreateReq) Reset()         { *m = EvmContractCreateReq{} }
+func (m *EvmContractCreateReq) String() string { return proto.CompactTextString(m) }
+func (*EvmContractCreateReq) ProtoMessage()    {}
+func (*EvmContractCreateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{36}
+}
+
+func (m *EvmContractCreateReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmContractCreateReq.Unmarshal(m, b)
+}
+func (m *EvmContractCreateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmContractCreateReq.Marshal(b, m, deterministic)
+}
+func (m *EvmContractCreateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmContractCreateReq.Merge(m, src)
+}
+func (m *EvmContractCreateReq) XXX_Size() int {
+	return xxx_messageInfo_EvmContractCreateReq.Size(m)
+}
+func (m *EvmContractCreateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmContractCreateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmContractCreateReq proto.InternalMessageInfo
+
+func (m *EvmContractCreateReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *EvmContractCreateReq) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *EvmContractCreateReq) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *EvmContractCreateReq) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *EvmContractCreateReq) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EvmContractCreateReq) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *EvmContractCreateReq) GetExpire() string {
+	if m != nil {
+		return m.Expire
+	}
+	return ""
+}
+
+func (m *EvmContractCreateReq) GetParaName() string {
+	if m != nil {
+		return m.ParaName
+	}
+	return ""
+}
+
+type EvmContractCallReq struct {
+	Amount               uint64   `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Fee                  int64    `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	Note                 string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Caller               string   `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
+	Abi                  string   `protobuf:"bytes,6,opt,name=abi,proto3" json:"abi,omitempty"`
+	Exec                 string   `protobuf:"bytes,7,opt,name=exec,proto3" json:"exec,omitempty"`
+	Expire               string   `protobuf:"bytes,8,opt,name=expire,proto3" json:"expire,omitempty"`
+	ParaName             string   `protobuf:"bytes,9,opt,name=paraName,proto3" json:"paraName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmContractCallReq) Reset()         { *m = EvmContractCallReq{} }
+func (m *EvmContractCallReq) String() string { return proto.CompactTextString(m) }
+func (*EvmContractCallReq) ProtoMessage()    {}
+func (*EvmContractCallReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{37}
+}
+
+func (m *EvmContractCallReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmContractCallReq.Unmarshal(m, b)
+}
+func (m *EvmContractCallReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmContractCallReq.Marshal(b, m, deterministic)
+}
+func (m *EvmContractCallReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmContractCallReq.Merge(m, src)
+}
+func (m *EvmContractCallReq) XXX_Size() int {
+	return xxx_messageInfo_EvmContractCallReq.Size(m)
+}
+func (m *EvmContractCallReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmContractCallReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmContractCallReq proto.InternalMessageInfo
+
+func (m *EvmContractCallReq) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *EvmContractCallReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *EvmContractCallReq) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *EvmContractCallReq) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *EvmContractCallReq) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EvmContractCallReq) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *EvmContractCallReq) GetExec() string {
+	if m != nil {
+		return m.Exec
+	}
+	return ""
+}
+
+func (m *EvmContractCallReq) GetExpire() string {
+	if m != nil {
+		return m.Expire
+	}
+	return ""
+}
+
+func (m *EvmContractCallReq) GetParaName() string {
+	if m != nil {
+		return m.ParaName
+	}
+	return ""
+}
+
+type EvmContractTransferReq struct {
+	Caller               string   `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	Amount               float32  `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Exec                 string   `protobuf:"bytes,3,opt,name=exec,proto3" json:"exec,omitempty"`
+	Expire               string   `protobuf:"bytes,4,opt,name=expire,proto3" json:"expire,omitempty"`
+	IsWithdraw           bool     `protobuf:"varint,5,opt,name=isWithdraw,proto3" json:"isWithdraw,omitempty"`
+	ParaName             string   `protobuf:"bytes,6,opt,name=paraName,proto3" json:"paraName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EvmContractTransferReq) Reset()         { *m = EvmContractTransferReq{} }
 func (m *EvmContractTransferReq) String() string { return proto.CompactTextString(m) }
 func (*EvmContractTransferReq) ProtoMessage()    {}
 func (*EvmContractTransferReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{21}
+	return fileDescriptor_74353de561acd7c6, []int{38}
 }
 
 func (m *EvmContractTransferReq) XXX_Unmarshal(b []byte) error {
@@ -1468,6 +2529,7 @@ func init() {
 	proto.RegisterMapType((map[string][]byte)(nil), "types.EVMContractState.StorageEntry")
 	proto.RegisterType((*EVMContractAction)(nil), "types.EVMContractAction")
 	proto.RegisterType((*ReceiptEVMContract)(nil), "types.ReceiptEVMContract")
+	proto.RegisterType((*EVMLog)(nil), "types.EVMLog")
 	proto.RegisterType((*EVMStateChangeItem)(nil), "types.EVMStateChangeItem")
 	proto.RegisterType((*EVMContractDataCmd)(nil), "types.EVMContractDataCmd")
 	proto.RegisterType((*EVMContractStateCmd)(nil), "types.EVMContractStateCmd")
@@ -1483,6 +2545,22 @@ func init() {
 	proto.RegisterType((*EvmQueryAbiResp)(nil), "types.EvmQueryAbiResp")
 	proto.RegisterType((*EvmQueryReq)(nil), "types.EvmQueryReq")
 	proto.RegisterType((*EvmQueryResp)(nil), "types.EvmQueryResp")
+	proto.RegisterType((*EvmTraceTxReq)(nil), "types.EvmTraceTxReq")
+	proto.RegisterType((*EvmCallFrame)(nil), "types.EvmCallFrame")
+	proto.RegisterType((*EvmStorageItem)(nil), "types.EvmStorageItem")
+	proto.RegisterType((*EvmAccountState)(nil), "types.EvmAccountState")
+	proto.RegisterType((*EvmTraceTxResp)(nil), "types.EvmTraceTxResp")
+	proto.RegisterType((*EvmGetCodeReq)(nil), "types.EvmGetCodeReq")
+	proto.RegisterType((*EvmGetCodeResp)(nil), "types.EvmGetCodeResp")
+	proto.RegisterType((*EvmGetStorageAtReq)(nil), "types.EvmGetStorageAtReq")
+	proto.RegisterType((*EvmGetStorageAtResp)(nil), "types.EvmGetStorageAtResp")
+	proto.RegisterType((*EvmCallReq)(nil), "types.EvmCallReq")
+	proto.RegisterType((*EvmCallResp)(nil), "types.EvmCallResp")
+	proto.RegisterType((*EvmTopicFilter)(nil), "types.EvmTopicFilter")
+	proto.RegisterType((*EvmGetLogsReq)(nil), "types.EvmGetLogsReq")
+	proto.RegisterType((*EvmLogItem)(nil), "types.EvmLogItem")
+	proto.RegisterType((*EvmBlockLogInfo)(nil), "types.EvmBlockLogInfo")
+	proto.RegisterType((*EvmGetLogsResp)(nil), "types.EvmGetLogsResp")
 	proto.RegisterType((*EvmContractCreateReq)(nil), "types.EvmContractCreateReq")
 	proto.RegisterType((*EvmContractCallReq)(nil), "types.EvmContractCallReq")
 	proto.RegisterType((*EvmContractTransferReq)(nil), "types.EvmContractTransferReq")
@@ -1493,68 +2571,108 @@ func init() {
 }
 
 var fileDescriptor_74353de561acd7c6 = []byte{
-	// 1004 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x96, 0x63, 0x27, 0x1b, 0xbf, 0x0d, 0xed, 0xae, 0x29, 0x4b, 0xb4, 0x42, 0x28, 0xb2, 0x28,
-	0xac, 0x2a, 0xb1, 0x42, 0xe5, 0x82, 0x2a, 0x81, 0xb4, 0x4a, 0xa3, 0x82, 0xc4, 0xf2, 0x63, 0x0a,
-	0xe1, 0x3c, 0xb1, 0x67, 0xb3, 0x6e, 0xe3, 0x1f, 0xcc, 0x4c, 0xd2, 0xe6, 0xca, 0x99, 0x23, 0x47,
-	0x6e, 0x1c, 0xb9, 0x20, 0x71, 0xe3, 0xcc, 0x1f, 0xc1, 0x99, 0x13, 0x7f, 0x06, 0x7a, 0xcf, 0x63,
-	0x7b, 0xec, 0x4d, 0x2a, 0x90, 0x2a, 0xc4, 0x29, 0xf3, 0xcd, 0x3c, 0xcf, 0x7c, 0xdf, 0xbc, 0xef,
-	0xbd, 0x09, 0x1c, 0x8b, 0x4d, 0x1a, 0xe5, 0x99, 0x96, 0x3c, 0xd2, 0xe7, 0x85, 0xcc, 0x75, 0x1e,
-	0xf4, 0xf5, 0xb6, 0x10, 0x2a, 0xfc, 0xce, 0x81, 0xe3, 0xd9, 0xfc, 0x72, 0x6a, 0x16, 0x3f, 0x5f,
-	0x3c, 0x11, 0x91, 0x0e, 0x02, 0xf0, 0x78, 0x1c, 0xcb, 0xb1, 0x33, 0x71, 0xce, 0x7c, 0x46, 0xe3,
-	0xe0, 0x1e, 0x78, 0x31, 0xd7, 0x7c, 0xdc, 0x9b, 0x38, 0x67, 0x87, 0xf7, 0x4f, 0xce, 0xe9, 0xfb,
-	0x73, 0xeb, 0xdb, 0x87, 0x5c, 0x73, 0x46, 0x31, 0xc1, 0xbb, 0xd0, 0x57, 0x9a, 0x6b, 0x31, 0x76,
-	0x29, 0xf8, 0xf5, 0x9b, 0xc1, 0x8f, 0x71, 0x99, 0x95, 0x51, 0xe1, 0xcf, 0x0e, 0xdc, 0xee, 0x6c,
-	0x14, 0x8c, 0xe1, 0x20, 0x92, 0x82, 0xeb, 0xbc, 0x62, 0x51, 0x41, 0x24, 0x97, 0xf1, 0x54, 0x10,
-	0x11, 0x9f, 0xd1, 0x38, 0xb8, 0x03, 0x7d, 0xbe, 0x4a, 0xb8, 0xa2, 0x03, 0x7d, 0x56, 0x82, 0x5a,
-	0x86, 0x67, 0xc9, 0x08, 0xc0, 0x8b, 0xf2, 0x58, 0x8c, 0xfb, 0x13, 0xe7, 0x6c, 0xc4, 0x68, 0x1c,
-	0x9c, 0xc2, 0x10, 0x7f, 0x3f, 0xe6, 0xea, 0x7a, 0x3c, 0xa0, 0xf9, 0x1a, 0x07, 0x47, 0xe0, 0xf2,
-	0x45, 0x32, 0x3e, 0xa0, 0x2d, 0x70, 0x18, 0xfe, 0xe9, 0xc0, 0x51, 0x57, 0x09, 0x12, 0xc8, 0xf2,
-	0x2c, 0x12, 0x44, 0xd6, 0x63, 0x25, 0xc0, 0x8d, 0xd5, 0x3a, 0x89, 0x92, 0x58, 0xc4, 0x44, 0x77,
-	0xc8, 0x6a, 0x1c, 0x4c, 0xe0, 0x50, 0xe9, 0x5c, 0xf2, 0x65, 0x79, 0xae, 0x4b, 0xe7, 0xda, 0x53,
-	0xc1, 0x47, 0x70, 0x60, 0xe0, 0xd8, 0x9b, 0xb8, 0x67, 0x87, 0xf7, 0xdf, 0xda, 0x73, 0x8f, 0xe7,
-	0x8f, 0xcb, 0xb0, 0x59, 0xa6, 0xe5, 0x96, 0x55, 0x1f, 0x9d, 0x3e, 0x80, 0x91, 0xbd, 0x80, 0x52,
-	0x9e, 0x8a, 0xad, 0xb9, 0x4e, 0x1c, 0x22, 0xeb, 0x0d, 0x5f, 0xad, 0xcb, 0xbb, 0x1c, 0xb1, 0x12,
-	0x3c, 0xe8, 0x7d, 0xe0, 0x84, 0xbf, 0xb6, 0x7d, 0x71, 0x11, 0xe9, 0x24, 0xcf, 0x82, 0x13, 0x18,
-	0xf0, 0x34, 0x5f, 0x67, 0xda, 0xc8, 0x34, 0x08, 0x75, 0x2e, 0xb9, 0xfa, 0x34, 0x49, 0x13, 0x4d,
-	0x5b, 0x79, 0xac, 0xc6, 0x66, 0xed, 0x0b, 0x99, 0x44, 0xa5, 0x1d, 0x5e, 0x61, 0x35, 0xae, 0x93,
-	0xe1, 0x59, 0xc9, 0xa8, 0x53, 0xd9, 0xef, 0xa4, 0x32, 0xcb, 0xb5, 0xa0, 0xf4, 0x60, 0xd2, 0x73,
-	0x2d, 0x76, 0xa4, 0xe6, 0x37, 0x07, 0x02, 0x26, 0x22, 0x91, 0x14, 0xda, 0x22, 0x8f, 0xb4, 0x23,
-	0xbe, 0x5a, 0x89, 0xca, 0x4a, 0x06, 0x05, 0x21, 0x8c, 0xaa, 0xaa, 0xf8, 0xac, 0x71, 0x54, 0x6b,
-	0xce, 0x8e, 0xb9, 0x40, 0x2f, 0xb9, 0xed, 0x18, 0x9c, 0x43, 0xaf, 0xae, 0x95, 0x88, 0x1f, 0x71,
-	0x45, 0x4a, 0x3c, 0x56, 0x41, 0xa4, 0x28, 0x85, 0x36, 0x66, 0xc3, 0x21, 0xc6, 0x3e, 0x51, 0x79,
-	0xc6, 0x84, 0x36, 0x5a, 0x2a, 0x18, 0x5e, 0x41, 0x30, 0x9b, 0x5f, 0x52, 0x42, 0xa7, 0xd7, 0x3c,
-	0x5b, 0x8a, 0x4f, 0xb4, 0x48, 0x77, 0x24, 0xed, 0x14, 0x86, 0x85, 0x14, 0x73, 0x2b, 0x6f, 0x35,
-	0x26, 0xb6, 0x6b, 0x29, 0x45, 0xa6, 0xcb, 0xf5, 0xd2, 0x55, 0xad, 0xb9, 0xf0, 0x47, 0x87, 0x0e,
-	0xb2, 0xab, 0x6d, 0x9a, 0xc6, 0xff, 0x49, 0xc1, 0xf9, 0x7b, 0x0a, 0xce, 0x6f, 0x0a, 0x2e, 0xfc,
-	0xcb, 0x81, 0x57, 0xbb, 0x06, 0x47, 0x7e, 0x2f, 0xa5, 0xc2, 0xfc, 0x76, 0x85, 0x5d, 0x74, 0x2b,
-	0xec, 0x9d, 0x3d, 0x15, 0x36, 0x4d, 0xe3, 0x97, 0x53, 0x64, 0xbe, 0x5d, 0x64, 0x3f, 0x39, 0xf0,
-	0xda, 0x4d, 0xbb, 0xa2, 0xd8, 0xff, 0x85, 0x63, 0x7d, 0x72, 0x6c, 0x78, 0x17, 0x6e, 0x4f, 0xaf,
-	0x45, 0xf4, 0x74, 0x36, 0xbf, 0xc4, 0x6f, 0x99, 0xf8, 0x76, 0xd7, 0xfb, 0x10, 0xfe, 0xe0, 0xc0,
-	0x51, 0x3b, 0x4e, 0x15, 0x65, 0xa2, 0xcb, 0x73, 0x29, 0x78, 0xc8, 0x6a, 0x7c, 0x83, 0x67, 0x6f,
-	0x07, 0xcf, 0xae, 0x5e, 0x77, 0x87, 0xde, 0x37, 0xc0, 0x27, 0xf7, 0x51, 0x40, 0xe9, 0xbc, 0x66,
-	0x22, 0xdc, 0xc2, 0xf1, 0x4c, 0xe9, 0x24, 0xe5, 0x5a, 0xcc, 0xe6, 0x97, 0x8f, 0xb8, 0x42, 0xfe,
-	0xb7, 0xa0, 0xa7, 0x73, 0xc3, 0xbe, 0xa7, 0xf3, 0xda, 0xa3, 0x3d, 0xab, 0x0f, 0x35, 0x29, 0x70,
-	0x5b, 0x29, 0x68, 0x7a, 0xa0, 0xd7, 0xea, 0x81, 0xa6, 0x1b, 0xf5, 0x9b, 0x6e, 0xf4, 0x36, 0x04,
-	0xdd, 0xa3, 0x55, 0x81, 0x71, 0x4b, 0xae, 0x8c, 0x8b, 0x71, 0x18, 0xde, 0x85, 0xc3, 0xd9, 0x26,
-	0x7d, 0x28, 0x16, 0xeb, 0x25, 0x92, 0x3b, 0x81, 0x41, 0x5e, 0xa0, 0x0d, 0x29, 0xa6, 0xcf, 0x0c,
-	0x0a, 0xdf, 0x83, 0x51, 0x13, 0xa6, 0x0a, 0xb4, 0x77, 0x8c, 0x00, 0x0d, 0xba, 0x56, 0x46, 0x8d,
-	0x3d, 0x15, 0xde, 0x83, 0x5b, 0xb3, 0x4d, 0xfa, 0xe5, 0x5a, 0xc8, 0xed, 0xc5, 0x22, 0xc1, 0xbd,
-	0xc7, 0x70, 0x80, 0xc9, 0x12, 0xaa, 0x8a, 0xaf, 0x60, 0xf8, 0x21, 0xdc, 0x6e, 0xc5, 0xaa, 0x62,
-	0x7f, 0x70, 0xa5, 0xb5, 0xd7, 0x68, 0xfd, 0x9a, 0x34, 0xd0, 0xe7, 0x2f, 0x3c, 0x07, 0xab, 0x21,
-	0xc9, 0x8a, 0xb5, 0xae, 0xaa, 0x81, 0xc0, 0xbe, 0xcb, 0x0e, 0xbf, 0x77, 0x48, 0xb4, 0xd9, 0xf7,
-	0x85, 0x9c, 0xfe, 0xd5, 0xc6, 0xb8, 0x8f, 0xe4, 0xcf, 0xb0, 0xf7, 0x19, 0xcb, 0x54, 0x10, 0x2d,
-	0x8b, 0x1d, 0x99, 0x96, 0xca, 0x64, 0xd6, 0x38, 0xfc, 0xdd, 0x81, 0x3b, 0xb3, 0x4d, 0x5a, 0x57,
-	0x2a, 0x36, 0x48, 0x61, 0x0a, 0x82, 0x0c, 0xe4, 0x58, 0x4d, 0xee, 0x08, 0xdc, 0x2b, 0x51, 0x7a,
-	0xca, 0x65, 0x38, 0xac, 0x1f, 0x31, 0xd7, 0x7a, 0xc4, 0xea, 0x46, 0xea, 0xd9, 0x8d, 0xb4, 0xa1,
-	0xdd, 0x6f, 0xd1, 0x36, 0x17, 0x3f, 0xa8, 0x2f, 0x1e, 0x23, 0xc5, 0xf3, 0x22, 0x91, 0xc2, 0xbc,
-	0x83, 0x06, 0xd1, 0x2b, 0xc1, 0x25, 0xa7, 0xa2, 0x18, 0x96, 0x32, 0x2a, 0x1c, 0xfe, 0x81, 0x2f,
-	0x80, 0x25, 0x83, 0xaf, 0x56, 0xc6, 0x78, 0x3b, 0x5f, 0x77, 0xbb, 0x3a, 0x3a, 0xe2, 0xdc, 0x9b,
-	0xe2, 0x3c, 0x4b, 0xdc, 0x3f, 0x97, 0x11, 0x80, 0x27, 0x9e, 0x8b, 0xc8, 0x88, 0xa0, 0xb1, 0x25,
-	0x6d, 0xb8, 0x57, 0x9a, 0xdf, 0x91, 0xf6, 0x8b, 0x03, 0x27, 0x96, 0xb4, 0xaf, 0x24, 0xcf, 0xd4,
-	0x95, 0x90, 0x46, 0xde, 0xce, 0x9e, 0xda, 0xc8, 0x46, 0x81, 0x3d, 0x5b, 0x36, 0x51, 0x72, 0x77,
-	0x52, 0xf2, 0x5a, 0x94, 0xde, 0x04, 0x48, 0xd4, 0x37, 0x89, 0xbe, 0x8e, 0x25, 0x7f, 0x46, 0x62,
-	0x87, 0xcc, 0x9a, 0x69, 0x51, 0x1e, 0xb4, 0x29, 0x2f, 0x06, 0xf4, 0x87, 0xfc, 0xfd, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x77, 0x17, 0xf5, 0x15, 0xa5, 0x0b, 0x00, 0x00,
+	// 1635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6e, 0x1c, 0xc7,
+	0x11, 0xc6, 0xfc, 0xec, 0x72, 0xa7, 0x96, 0xa2, 0xc8, 0x91, 0xc4, 0x2c, 0x04, 0x21, 0x20, 0x06,
+	0x92, 0x42, 0x29, 0x10, 0x93, 0x28, 0x17, 0x41, 0x40, 0x84, 0x50, 0xab, 0x95, 0x22, 0x80, 0x8c,
+	0x92, 0x91, 0xc4, 0x1c, 0x72, 0x49, 0xef, 0x6c, 0x73, 0x39, 0xd2, 0xee, 0xf4, 0x64, 0xba, 0x97,
+	0x22, 0xaf, 0x79, 0x86, 0xdc, 0xec, 0x83, 0x01, 0x1f, 0x7d, 0x11, 0xe0, 0x37, 0x30, 0x7c, 0xf1,
+	0x1b, 0xf8, 0x60, 0xf8, 0xe0, 0x93, 0x1f, 0xc3, 0xa8, 0xea, 0x9e, 0x99, 0x9e, 0xfd, 0x21, 0x64,
+	0x40, 0x30, 0x7c, 0xda, 0xfe, 0xba, 0xab, 0xbb, 0xeb, 0xe7, 0xab, 0xaa, 0x9e, 0x85, 0x2d, 0x7e,
+	0x3a, 0x4d, 0x44, 0xa6, 0x0a, 0x96, 0xa8, 0xbd, 0xbc, 0x10, 0x4a, 0x84, 0x2d, 0x75, 0x9e, 0x73,
+	0x19, 0xfd, 0xcf, 0x81, 0xad, 0xc1, 0xd1, 0x61, 0xdf, 0x2c, 0xbe, 0x18, 0xbe, 0xe1, 0x89, 0x0a,
+	0x43, 0xf0, 0xd9, 0x68, 0x54, 0xf4, 0x9c, 0x1d, 0x67, 0x37, 0x88, 0x69, 0x1c, 0xde, 0x05, 0x7f,
+	0xc4, 0x14, 0xeb, 0xb9, 0x3b, 0xce, 0x6e, 0xf7, 0xfe, 0xf6, 0x1e, 0xed, 0xdf, 0xb3, 0xf6, 0x3e,
+	0x61, 0x8a, 0xc5, 0x24, 0x13, 0xde, 0x83, 0x96, 0x54, 0x4c, 0xf1, 0x9e, 0x47, 0xc2, 0xbf, 0x59,
+	0x14, 0x7e, 0x89, 0xcb, 0xb1, 0x96, 0x8a, 0xbe, 0x70, 0xe0, 0xf2, 0xdc, 0x41, 0x61, 0x0f, 0xd6,
+	0x92, 0x82, 0x33, 0x25, 0x4a, 0x2d, 0x4a, 0x88, 0xca, 0x65, 0x6c, 0xca, 0x49, 0x91, 0x20, 0xa6,
+	0x71, 0x78, 0x15, 0x5a, 0x6c, 0x92, 0x32, 0x49, 0x17, 0x06, 0xb1, 0x06, 0x95, 0x19, 0xbe, 0x65,
+	0x46, 0x08, 0x7e, 0x22, 0x46, 0xbc, 0xd7, 0xda, 0x71, 0x76, 0xd7, 0x63, 0x1a, 0x87, 0xd7, 0xa1,
+	0x83, 0xbf, 0x7f, 0x63, 0xf2, 0xa4, 0xd7, 0xa6, 0xf9, 0x0a, 0x87, 0x9b, 0xe0, 0xb1, 0x61, 0xda,
+	0x5b, 0xa3, 0x23, 0x70, 0x18, 0xfd, 0xe0, 0xc0, 0xe6, 0xbc, 0x25, 0xa8, 0x40, 0x26, 0xb2, 0x84,
+	0x93, 0xb2, 0x7e, 0xac, 0x01, 0x1e, 0x2c, 0x67, 0x69, 0x92, 0x8e, 0xf8, 0x88, 0xd4, 0xed, 0xc4,
+	0x15, 0x0e, 0x77, 0xa0, 0x2b, 0x95, 0x28, 0xd8, 0x58, 0xdf, 0xeb, 0xd1, 0xbd, 0xf6, 0x54, 0xf8,
+	0x08, 0xd6, 0x0c, 0xec, 0xf9, 0x3b, 0xde, 0x6e, 0xf7, 0xfe, 0xcd, 0x15, 0x7e, 0xdc, 0x7b, 0xa9,
+	0xc5, 0x06, 0x99, 0x2a, 0xce, 0xe3, 0x72, 0xd3, 0xf5, 0x87, 0xb0, 0x6e, 0x2f, 0xa0, 0x29, 0x6f,
+	0xf9, 0xb9, 0x71, 0x27, 0x0e, 0x51, 0xeb, 0x53, 0x36, 0x99, 0x69, 0x5f, 0xae, 0xc7, 0x1a, 0x3c,
+	0x74, 0x1f, 0x38, 0xd1, 0x97, 0x4d, 0x5e, 0xec, 0x27, 0x2a, 0x15, 0x59, 0xb8, 0x0d, 0x6d, 0x36,
+	0x15, 0xb3, 0x4c, 0x19, 0x33, 0x0d, 0x42, 0x3b, 0xc7, 0x4c, 0x1e, 0xa4, 0xd3, 0x54, 0xd1, 0x51,
+	0x7e, 0x5c, 0x61, 0xb3, 0xf6, 0x8f, 0x22, 0x4d, 0x34, 0x1d, 0x2e, 0xc5, 0x15, 0xae, 0x82, 0xe1,
+	0x5b, 0xc1, 0xa8, 0x42, 0xd9, 0x9a, 0x0b, 0x65, 0x26, 0x14, 0xef, 0xb5, 0x4d, 0xd0, 0x85, 0xe2,
+	0x4b, 0x42, 0xf3, 0xbd, 0x03, 0x61, 0xcc, 0x13, 0x9e, 0xe6, 0xca, 0x52, 0x1e, 0xd5, 0x4e, 0xd8,
+	0x64, 0xc2, 0x4b, 0x2a, 0x19, 0x14, 0x46, 0xb0, 0x5e, 0x66, 0xc5, 0xdf, 0x6b, 0x46, 0x35, 0xe6,
+	0x6c, 0x99, 0x7d, 0xe4, 0x92, 0xd7, 0x94, 0xc1, 0x39, 0xe4, 0xea, 0x4c, 0xf2, 0xd1, 0x33, 0x26,
+	0xc9, 0x12, 0x3f, 0x2e, 0x21, 0xaa, 0x58, 0x70, 0x65, 0xc8, 0x86, 0x43, 0x94, 0x7d, 0x23, 0x45,
+	0x16, 0x73, 0x65, 0x6c, 0x29, 0x21, 0xde, 0x54, 0xf0, 0x53, 0x5e, 0xa8, 0x98, 0x33, 0x29, 0x32,
+	0x63, 0x57, 0x63, 0x2e, 0x3a, 0x80, 0xf6, 0xe0, 0xe8, 0xf0, 0x40, 0x8c, 0xf1, 0x1c, 0xe4, 0x33,
+	0x97, 0xb2, 0xcc, 0x0f, 0x03, 0xd1, 0x81, 0x4a, 0xe4, 0x69, 0xd2, 0x73, 0x77, 0x3c, 0x0c, 0x2a,
+	0x01, 0x74, 0x20, 0xa5, 0xaf, 0xe6, 0x19, 0x8d, 0xa3, 0x63, 0x08, 0x07, 0x47, 0x87, 0x44, 0xa1,
+	0xfe, 0x09, 0xcb, 0xc6, 0xfc, 0xb9, 0xe2, 0xd3, 0x25, 0x34, 0xb9, 0x0e, 0x9d, 0xbc, 0xe0, 0x47,
+	0x16, 0x53, 0x2a, 0x4c, 0xfe, 0x99, 0x15, 0x05, 0xcf, 0x94, 0x5e, 0xd7, 0xe7, 0x37, 0xe6, 0xa2,
+	0x4f, 0x1d, 0x08, 0xad, 0x78, 0x60, 0x7e, 0xf7, 0xa7, 0xa3, 0x5f, 0x24, 0xc5, 0x83, 0x15, 0x29,
+	0x1e, 0xd4, 0x29, 0x1e, 0xfd, 0xe8, 0xc0, 0x95, 0xf9, 0x94, 0x42, 0xfd, 0x3e, 0x4a, 0x4e, 0x07,
+	0xcd, 0x9c, 0xde, 0x9f, 0xcf, 0xe9, 0xdf, 0xad, 0xc8, 0xe9, 0xfe, 0x74, 0xf4, 0x71, 0xd2, 0x3a,
+	0xb0, 0xd3, 0xfa, 0x73, 0x07, 0xae, 0x2d, 0x26, 0x08, 0x1a, 0xfb, 0xab, 0xc8, 0x91, 0x80, 0x72,
+	0x24, 0xba, 0x05, 0x97, 0xfb, 0x27, 0x3c, 0x79, 0x3b, 0x38, 0x3a, 0xc4, 0xbd, 0x31, 0xff, 0xef,
+	0xb2, 0x8e, 0x14, 0xfd, 0xdf, 0x81, 0xcd, 0xa6, 0x9c, 0xcc, 0x75, 0xa0, 0xf5, 0xbd, 0x24, 0xdc,
+	0x89, 0x2b, 0xbc, 0xa0, 0xa7, 0xbb, 0x44, 0xcf, 0x79, 0x7b, 0xbd, 0x25, 0xf6, 0xde, 0x80, 0x80,
+	0xd8, 0x47, 0x02, 0x9a, 0x79, 0xf5, 0x44, 0x74, 0x0e, 0x5b, 0x03, 0xa9, 0xd2, 0x29, 0x53, 0x7c,
+	0x70, 0x74, 0xf8, 0x8c, 0x49, 0xd4, 0x7f, 0x03, 0x5c, 0x25, 0x8c, 0xf6, 0xae, 0x12, 0x15, 0x47,
+	0x5d, 0xab, 0xf2, 0xd5, 0x21, 0xf0, 0x1a, 0x21, 0xa8, 0xab, 0xae, 0xdf, 0xa8, 0xba, 0xa6, 0xfe,
+	0xb5, 0xea, 0xfa, 0x77, 0x1b, 0xc2, 0xf9, 0xab, 0x65, 0x8e, 0x72, 0x63, 0x26, 0x0d, 0x8b, 0x71,
+	0x18, 0xdd, 0x82, 0xee, 0xe0, 0x74, 0xfa, 0x84, 0x0f, 0x67, 0x63, 0x54, 0x6e, 0x1b, 0xda, 0x22,
+	0x47, 0x1a, 0x92, 0x4c, 0x2b, 0x36, 0x28, 0xfa, 0x23, 0xac, 0xd7, 0x62, 0x32, 0x47, 0x7a, 0x8f,
+	0x10, 0x20, 0x41, 0x67, 0x65, 0xdd, 0xb1, 0xa7, 0xa2, 0xbb, 0xb0, 0x31, 0x38, 0x9d, 0xfe, 0x73,
+	0xc6, 0x8b, 0xf3, 0xfd, 0x61, 0x8a, 0x67, 0xaf, 0xac, 0x53, 0xd1, 0x5f, 0xe0, 0x72, 0x43, 0x56,
+	0xe6, 0xab, 0x85, 0x4b, 0x5b, 0xdd, 0xda, 0xd6, 0xd7, 0xd0, 0x2d, 0xb7, 0x5f, 0x78, 0x0f, 0x66,
+	0x43, 0x9a, 0xe5, 0x33, 0x55, 0x66, 0x03, 0x81, 0x55, 0xce, 0x8e, 0xde, 0x3b, 0xb0, 0x5e, 0x9f,
+	0x2b, 0xf3, 0x8f, 0x75, 0x30, 0x9e, 0x53, 0xb0, 0x77, 0x58, 0xfb, 0x0c, 0x65, 0x4a, 0x88, 0x94,
+	0xc5, 0x1e, 0x40, 0x4b, 0x3a, 0x98, 0x15, 0x5e, 0x68, 0x0a, 0xed, 0x25, 0x4d, 0xe1, 0xdf, 0x70,
+	0x69, 0x70, 0x3a, 0x7d, 0x55, 0xb0, 0x84, 0xbf, 0x3a, 0x33, 0xf1, 0x54, 0x67, 0x54, 0x81, 0x4c,
+	0x2e, 0x6b, 0x44, 0xf3, 0x28, 0x55, 0x32, 0xdf, 0x20, 0x54, 0x60, 0x94, 0x1e, 0x1f, 0x1f, 0x22,
+	0x21, 0x3d, 0x9d, 0x33, 0x25, 0x8e, 0x3e, 0x71, 0xc9, 0x1f, 0x7d, 0x36, 0x99, 0x3c, 0x2d, 0x90,
+	0xfc, 0x21, 0xf8, 0x15, 0x55, 0x82, 0x98, 0xc6, 0x38, 0x77, 0x5c, 0x88, 0x69, 0x59, 0xaf, 0x71,
+	0x6c, 0x18, 0xef, 0x55, 0x8c, 0xaf, 0x8a, 0x92, 0x26, 0xb1, 0x06, 0x25, 0x37, 0x5b, 0x15, 0x37,
+	0xd1, 0x4f, 0x63, 0x26, 0x5f, 0x4b, 0x3e, 0x22, 0x63, 0xfd, 0xb8, 0x84, 0xb5, 0xbf, 0xd7, 0xe6,
+	0xfc, 0x2d, 0x66, 0x0a, 0xa7, 0x3b, 0xda, 0x28, 0x8d, 0x50, 0x9a, 0x17, 0x85, 0x28, 0x7a, 0x81,
+	0x96, 0x26, 0xb0, 0xe0, 0x4f, 0x58, 0xf4, 0x67, 0x78, 0x07, 0x5a, 0x18, 0x33, 0xd9, 0xeb, 0x52,
+	0x85, 0xbe, 0x52, 0x56, 0x68, 0xcb, 0x0b, 0xb1, 0x96, 0x88, 0x1e, 0x10, 0xdf, 0x4d, 0x39, 0x5e,
+	0xd1, 0x3d, 0x97, 0x56, 0xe3, 0xe8, 0x33, 0x87, 0xe8, 0xbf, 0x9f, 0x24, 0x98, 0xcb, 0xfa, 0x11,
+	0xb9, 0x9a, 0x6a, 0x3d, 0x58, 0x1b, 0xb2, 0x09, 0xcb, 0x12, 0x7d, 0x8a, 0x1f, 0x97, 0xb0, 0x6e,
+	0x52, 0x9e, 0xdd, 0xa4, 0xec, 0x87, 0x55, 0xd9, 0x02, 0xff, 0x50, 0xb7, 0x9e, 0x16, 0x19, 0x76,
+	0xad, 0x36, 0xcc, 0xb2, 0xa0, 0x6a, 0x34, 0xd1, 0x37, 0x0e, 0x6c, 0xd8, 0xc4, 0x92, 0xf9, 0xcf,
+	0x66, 0xd6, 0x9f, 0x20, 0x40, 0x47, 0xd1, 0x11, 0xe6, 0x63, 0x60, 0xa9, 0x3b, 0x6b, 0xa9, 0x70,
+	0x17, 0xbc, 0xbc, 0x28, 0xbb, 0xe3, 0x76, 0x2d, 0x6c, 0x7b, 0x2a, 0x46, 0x11, 0xfc, 0x22, 0xc9,
+	0x85, 0x54, 0xbd, 0xd6, 0x85, 0xa2, 0x24, 0x13, 0xdd, 0xa1, 0x1c, 0x79, 0xc6, 0x55, 0x5f, 0x8c,
+	0xf8, 0xc5, 0x75, 0xe9, 0x11, 0x6c, 0xd8, 0xa2, 0x17, 0x96, 0x80, 0x25, 0x65, 0x3c, 0xfa, 0x2b,
+	0x84, 0x7a, 0xbf, 0x71, 0xea, 0xbe, 0xba, 0xb8, 0x3e, 0x19, 0xc6, 0xb8, 0x15, 0x63, 0xa2, 0xdf,
+	0xc3, 0x95, 0x85, 0x13, 0x64, 0x5e, 0x13, 0xc9, 0xb1, 0x89, 0xf4, 0x1f, 0x00, 0xe3, 0x4a, 0x93,
+	0xfa, 0x4b, 0xdb, 0xb8, 0xce, 0x46, 0xd7, 0xee, 0x3f, 0xf3, 0xcf, 0xc1, 0x55, 0x7d, 0x26, 0x92,
+	0xd0, 0xad, 0x6e, 0x90, 0x79, 0xd9, 0xaf, 0x9d, 0xc6, 0x9b, 0xb6, 0xec, 0xed, 0x6e, 0xb3, 0xb7,
+	0x57, 0x49, 0xe8, 0x5d, 0x94, 0x84, 0xfe, 0x92, 0xa2, 0x76, 0x5b, 0x73, 0x0f, 0xdf, 0xae, 0x4f,
+	0xd3, 0x89, 0xe2, 0x45, 0xfd, 0xae, 0x75, 0x76, 0x3c, 0x3c, 0x8b, 0x40, 0xf4, 0x95, 0x53, 0x46,
+	0xf6, 0x40, 0x8c, 0xa9, 0xd5, 0xde, 0x80, 0x00, 0x0b, 0xd0, 0xe3, 0x89, 0x48, 0xde, 0x92, 0x96,
+	0x5e, 0x5c, 0x4f, 0xa0, 0xae, 0x4a, 0xe8, 0x35, 0x97, 0xd6, 0x4a, 0x88, 0xfb, 0x4c, 0x48, 0x38,
+	0x3e, 0x32, 0x3d, 0xea, 0xea, 0xe5, 0x44, 0x78, 0x0f, 0xda, 0x74, 0xa1, 0xec, 0xf9, 0xf3, 0xc9,
+	0x63, 0x29, 0x19, 0x1b, 0x21, 0x54, 0x96, 0x38, 0x48, 0x95, 0xad, 0x15, 0x6b, 0x40, 0xd1, 0x99,
+	0x15, 0x52, 0x14, 0xa6, 0x8e, 0x1b, 0x14, 0x7d, 0xe7, 0x50, 0x10, 0x0f, 0xc4, 0x98, 0x6a, 0xc8,
+	0x07, 0xbe, 0xed, 0x83, 0x65, 0x6f, 0xfb, 0xa0, 0x0e, 0xe6, 0x09, 0x4f, 0xc7, 0x27, 0x3a, 0x98,
+	0x5e, 0x6c, 0x10, 0xd9, 0x7f, 0xf6, 0x3c, 0x1b, 0xf1, 0x33, 0xa3, 0x5a, 0x09, 0xb1, 0x0b, 0x4c,
+	0xc4, 0x58, 0x2f, 0xb5, 0x69, 0xa9, 0xc2, 0x56, 0xde, 0xaf, 0x35, 0xf2, 0xfe, 0x26, 0x5c, 0x1a,
+	0xa2, 0xf3, 0x0e, 0xca, 0x8d, 0x1d, 0xda, 0xd8, 0x9c, 0x8c, 0xfa, 0x54, 0xea, 0x1e, 0x57, 0x73,
+	0xc7, 0xd4, 0x0d, 0x86, 0x13, 0x21, 0xa6, 0x86, 0x46, 0x1a, 0x18, 0x15, 0xfa, 0xe4, 0x38, 0xb7,
+	0x52, 0x81, 0x70, 0xf4, 0x02, 0x36, 0xec, 0x38, 0xcb, 0x3c, 0xbc, 0x05, 0xfe, 0x44, 0x8c, 0x25,
+	0xf1, 0xa1, 0x7b, 0x7f, 0xab, 0x0e, 0x88, 0xf1, 0x63, 0x4c, 0xcb, 0x96, 0xd3, 0xdd, 0x86, 0xd3,
+	0xbf, 0x76, 0xe0, 0x2a, 0xf2, 0xba, 0x7c, 0x04, 0x17, 0x1c, 0xcb, 0x85, 0x7e, 0x6b, 0x52, 0x52,
+	0x3b, 0x56, 0xf1, 0xdc, 0x04, 0xef, 0x98, 0x73, 0x43, 0x19, 0x1c, 0x56, 0x5f, 0xa4, 0x9e, 0xf5,
+	0x45, 0x5a, 0x7d, 0xa3, 0xf8, 0xf6, 0x37, 0x4a, 0x9d, 0x93, 0xad, 0x46, 0x4e, 0x9a, 0x37, 0x4d,
+	0xbb, 0x7a, 0xd3, 0xa0, 0x24, 0x3f, 0xcb, 0xd3, 0x82, 0x97, 0x6e, 0xd6, 0x88, 0x3e, 0xc0, 0x58,
+	0xc1, 0xe8, 0xbd, 0xa9, 0xbb, 0x5c, 0x85, 0xa3, 0x6f, 0x1d, 0x08, 0x6d, 0x33, 0xea, 0x42, 0xb0,
+	0xf4, 0x53, 0xdd, 0xae, 0x58, 0x73, 0xc6, 0x79, 0x8b, 0xc6, 0xf9, 0x96, 0x71, 0x1f, 0x6e, 0x46,
+	0x08, 0x3e, 0x3f, 0xe3, 0x89, 0x31, 0x82, 0xc6, 0x96, 0x69, 0x9d, 0x95, 0xa6, 0x05, 0x73, 0xa6,
+	0xbd, 0x77, 0x60, 0xdb, 0x32, 0xed, 0x55, 0xc1, 0x32, 0x79, 0xcc, 0x8b, 0x8b, 0xea, 0x5c, 0x6d,
+	0x36, 0x1a, 0xe8, 0xda, 0x66, 0x93, 0x4a, 0xde, 0x52, 0x95, 0xfc, 0x86, 0x4a, 0xbf, 0x05, 0x48,
+	0xe5, 0xbf, 0x52, 0x75, 0x32, 0x2a, 0xd8, 0x3b, 0x32, 0xb6, 0x13, 0x5b, 0x33, 0x0d, 0x95, 0xdb,
+	0x4d, 0x95, 0x87, 0x6d, 0xfa, 0x77, 0xed, 0xcf, 0x3f, 0x0d, 0x00, 0x9f, 0xbb, 0xb3, 0x6e, 0x72,
+	0x13, 0x00, 0x00,
 }
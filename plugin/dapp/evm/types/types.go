@@ -24,6 +24,8 @@ const (
 	TyLogCallContract = 603
 	// TyLogEVMStateChangeItem  合约状态数据变更项日志
 	TyLogEVMStateChangeItem = 604
+	// TyLogEVMEventData  合约生成的事件日志
+	TyLogEVMEventData = 605
 
 	// MaxGasLimit  最大Gas消耗上限
 	MaxGasLimit = 10000000
@@ -40,6 +42,12 @@ const (
 	ForkEVMABI = "ForkEVMABI"
 	// ForkEVMFrozen EVM合约用户金额冻结
 	ForkEVMFrozen = "ForkEVMFrozen"
+	// ForkEVMIstanbul EVM合约支持伊斯坦布尔指令集和Gas定价
+	ForkEVMIstanbul = "ForkEVMIstanbul"
+	// ForkEVMTxLog EVM合约生成的事件日志写入交易回执
+	ForkEVMTxLog = "ForkEVMTxLog"
+	// ForkEVMRevertReason EVM合约revert时交易按失败打包，回执中记录解析出的revert原因
+	ForkEVMRevertReason = "ForkEVMRevertReason"
 )
 
 var (
@@ -58,5 +66,6 @@ var (
 		TyLogContractData:       {Ty: reflect.TypeOf(EVMContractData{}), Name: "LogContractData"},
 		TyLogContractState:      {Ty: reflect.TypeOf(EVMContractState{}), Name: "LogContractState"},
 		TyLogEVMStateChangeItem: {Ty: reflect.TypeOf(EVMStateChangeItem{}), Name: "LogEVMStateChangeItem"},
+		TyLogEVMEventData:       {Ty: reflect.TypeOf(EVMLog{}), Name: "LogEVMEventData"},
 	}
 )
@@ -19,8 +19,34 @@ var (
 
 var driverName = exchangetypes.ExchangeX
 
+type subConfig struct {
+	//手续费收取地址，为空时不收取手续费
+	FeeAddr string `json:"feeAddr"`
+	//默认的挂单方(maker)和吃单方(taker)费率，以1e8为基数
+	MakerRate int64 `json:"makerRate"`
+	TakerRate int64 `json:"takerRate"`
+	//按交易对单独设置的费率
+	Pairs []*pairFeeConfig `json:"pairs"`
+}
+
+type pairFeeConfig struct {
+	//交易对资产的symbol
+	Left      string `json:"left"`
+	Right     string `json:"right"`
+	MakerRate int64  `json:"makerRate"`
+	TakerRate int64  `json:"takerRate"`
+}
+
+var subCfg subConfig
+
 // Init register dapp
 func Init(name string, cfg *types.Chain33Config, sub []byte) {
+	if sub != nil {
+		types.MustDecode(sub, &subCfg)
+		if err := checkFeeConfig(&subCfg); err != nil {
+			panic(err)
+		}
+	}
 	drivers.Register(cfg, GetName(), NewExchange, cfg.GetDappFork(driverName, "Enable"))
 	InitExecType()
 }
@@ -75,9 +101,27 @@ func (e *exchange) CheckTx(tx *types.Transaction, index int) error {
 		if !CheckOp(op) {
 			return exchangetypes.ErrAssetOp
 		}
+		if !CheckTimeInForce(limitOrder.GetTimeInForce()) {
+			return exchangetypes.ErrTimeInForce
+		}
+		if limitOrder.GetExpireHeight() < 0 {
+			return exchangetypes.ErrExpireHeight
+		}
 	}
 	if exchange.Ty == exchangetypes.TyMarketOrderAction {
-		return types.ErrActionNotSupport
+		marketOrder := exchange.GetMarketOrder()
+		if !CheckExchangeAsset(marketOrder.GetLeftAsset(), marketOrder.GetRightAsset()) {
+			return exchangetypes.ErrAsset
+		}
+		if marketOrder.GetWorstPrice() != 0 && !CheckPrice(marketOrder.GetWorstPrice()) {
+			return exchangetypes.ErrAssetPrice
+		}
+		if !CheckAmount(marketOrder.GetAmount()) {
+			return exchangetypes.ErrAssetAmount
+		}
+		if !CheckOp(marketOrder.GetOp()) {
+			return exchangetypes.ErrAssetOp
+		}
 	}
 	return nil
 }
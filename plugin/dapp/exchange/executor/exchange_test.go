@@ -692,6 +692,26 @@ func CreateLimitOrder(limitOrder *et.LimitOrder, privKey string) (tx *types.Tran
 	}
 	return tx, nil
 }
+
+func CreateMarketOrder(marketOrder *et.MarketOrder, privKey string) (tx *types.Transaction, err error) {
+	ety := types.LoadExecutorType(et.ExchangeX)
+	tx, err = ety.Create("MarketOrder", marketOrder)
+	if err != nil {
+		return nil, err
+	}
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	tx, err = types.FormatTx(cfg, et.ExchangeX, tx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err = signTx(tx, privKey)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
 func CreateRevokeOrder(orderID int64, privKey string) (tx *types.Transaction, err error) {
 	ety := types.LoadExecutorType(et.ExchangeX)
 	tx, err = ety.Create("RevokeOrder", &et.RevokeOrder{OrderID: orderID})
@@ -767,6 +787,14 @@ func Exec_LimitOrder(t *testing.T, limitOrder *et.LimitOrder, privKey string, st
 	return Exec_Block(t, stateDB, kvdb, env, tx)
 }
 
+func Exec_MarketOrder(t *testing.T, marketOrder *et.MarketOrder, privKey string, stateDB db.DB, kvdb db.KVDB, env *execEnv) error {
+	tx, err := CreateMarketOrder(marketOrder, privKey)
+	if err != nil {
+		return err
+	}
+	return Exec_Block(t, stateDB, kvdb, env, tx)
+}
+
 func Exec_RevokeOrder(t *testing.T, orderID int64, privKey string, stateDB db.DB, kvdb db.KVDB, env *execEnv) error {
 	tx, err := CreateRevokeOrder(orderID, privKey)
 	if err != nil {
@@ -889,3 +917,458 @@ func TestSafeMul(t *testing.T) {
 	t.Log(SafeMul(1e10, 1e16))
 	t.Log(SafeMul(1e7, 1e6))
 }
+
+func TestExchangeFee(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	//吃单费率千分之二，挂单费率千分之一，其余交易对不收取挂单手续费
+	sub := []byte(`{"feeAddr":"` + Nodes[3] + `","makerRate":0,"takerRate":100000,"pairs":[{"left":"bty","right":"CCNY","makerRate":100000,"takerRate":200000}]}`)
+	types.MustDecode(sub, &subCfg)
+	defer func() { subCfg = subConfig{} }()
+	assert.Nil(t, checkFeeConfig(&subCfg))
+
+	bty := &et.Asset{Execer: "coins", Symbol: "bty"}
+	ccny := &et.Asset{Execer: "token", Symbol: "CCNY"}
+	makerRate, takerRate := getFeeRate(bty, &et.Asset{Execer: "token", Symbol: "USDT"})
+	assert.Equal(t, int64(0), makerRate)
+	assert.Equal(t, int64(100000), takerRate)
+
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes {
+		btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+		ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+	}
+	env := &execEnv{10, cfg.GetDappFork(et.ExchangeX, et.ForkFee), 1539918074}
+
+	//A挂买单10个，价格为4
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 4 * types.Coin, Amount: 10 * types.Coin, Op: et.OpBuy}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err := Exec_QueryOrderList(et.Ordered, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	orderID := orderList.List[0].OrderID
+
+	//B吃单5个，B收到的CCNY中扣除吃单手续费，A收到的bty中扣除挂单手续费
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 4 * types.Coin, Amount: 5 * types.Coin, Op: et.OpSell}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	assert.Equal(t, 120*types.Coin-4e6, ccnyDB.LoadExecAccount(Nodes[1], execAddr).Balance)
+	assert.Equal(t, 95*types.Coin, btyDB.LoadExecAccount(Nodes[1], execAddr).Balance)
+	assert.Equal(t, 105*types.Coin-5e5, btyDB.LoadExecAccount(Nodes[0], execAddr).Balance)
+	assert.Equal(t, 100*types.Coin+4e6, ccnyDB.LoadExecAccount(Nodes[3], execAddr).Balance)
+	assert.Equal(t, 100*types.Coin+5e5, btyDB.LoadExecAccount(Nodes[3], execAddr).Balance)
+	orderList, err = Exec_QueryOrderList(et.Completed, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4e6), orderList.List[0].Fee)
+
+	//C吃掉剩余的5个，A的订单完成，手续费累计
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 3 * types.Coin, Amount: 5 * types.Coin, Op: et.OpSell}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	order, err := Exec_QueryOrder(orderID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.Completed), order.Status)
+	assert.Equal(t, int64(1e6), order.Fee)
+	//卖单以自身价格成交，挂单的成交均价为两次成交价格的平均值
+	assert.Equal(t, int64(35e7), order.AVGPrice)
+	//A冻结的资金按照成交价格结算，多余的部分解冻
+	assert.Equal(t, 65*types.Coin, ccnyDB.LoadExecAccount(Nodes[0], execAddr).Balance)
+	assert.Equal(t, int64(0), ccnyDB.LoadExecAccount(Nodes[0], execAddr).Frozen)
+	assert.Equal(t, 115*types.Coin-3e6, ccnyDB.LoadExecAccount(Nodes[2], execAddr).Balance)
+
+	//买单吃掉两个不同价格的卖单，成交均价按成交数量加权
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 3 * types.Coin, Amount: 2 * types.Coin, Op: et.OpSell}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 5 * types.Coin, Amount: 2 * types.Coin, Op: et.OpSell}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 5 * types.Coin, Amount: 4 * types.Coin, Op: et.OpBuy}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Completed, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 4*types.Coin, orderList.List[0].Executed)
+	assert.Equal(t, 4*types.Coin, orderList.List[0].AVGPrice)
+	assert.Equal(t, int64(8e5), orderList.List[0].Fee)
+}
+
+func TestMarketOrder(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	bty := &et.Asset{Execer: "coins", Symbol: "bty"}
+	ccny := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes {
+		btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+		ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+	}
+	env := &execEnv{10, 1, 1539918074}
+
+	//对手盘为空时市价单无法成交
+	err := Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: bty, RightAsset: ccny, Amount: types.Coin, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrNoMatch, err)
+
+	//卖单挂单 2@3 2@4 5@6
+	for _, order := range []struct {
+		price, amount int64
+		privKey       string
+	}{{3, 2, PrivKeyB}, {4, 2, PrivKeyC}, {6, 5, PrivKeyD}} {
+		err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: order.price * types.Coin, Amount: order.amount * types.Coin, Op: et.OpSell}, order.privKey, stateDB, kvdb, env)
+		assert.Nil(t, err)
+	}
+
+	//市价买5个，最差价格为5，只能吃掉价格为3和4的卖单，未成交的部分不挂单
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: bty, RightAsset: ccny, Amount: 5 * types.Coin, Op: et.OpBuy, WorstPrice: 5 * types.Coin}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err := Exec_QueryOrderList(et.Completed, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	order := orderList.List[0]
+	assert.Equal(t, int32(et.TyMarketOrderAction), order.Ty)
+	assert.Equal(t, 4*types.Coin, order.Executed)
+	assert.Equal(t, types.Coin, order.Balance)
+	assert.Equal(t, int64(35e7), order.AVGPrice)
+	_, err = Exec_QueryOrderList(et.Ordered, Nodes[0], "", stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	assert.Equal(t, 86*types.Coin, ccnyDB.LoadExecAccount(Nodes[0], execAddr).Balance)
+	assert.Equal(t, int64(0), ccnyDB.LoadExecAccount(Nodes[0], execAddr).Frozen)
+	assert.Equal(t, 104*types.Coin, btyDB.LoadExecAccount(Nodes[0], execAddr).Balance)
+	marketDepthList, err := Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: bty, RightAsset: ccny, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(marketDepthList.List))
+	assert.Equal(t, 6*types.Coin, marketDepthList.List[0].Price)
+	historyList, err := Exec_QueryHistoryOrder(&et.QueryHistoryOrderList{LeftAsset: bty, RightAsset: ccny}, stateDB, kvdb)
+	assert.Nil(t, err)
+	//成交记录中包含市价单和被吃掉的两个卖单
+	assert.Equal(t, 3, len(historyList.List))
+	assert.Equal(t, order.OrderID, historyList.List[2].OrderID)
+
+	//市价买10个，不限价格，成交数量受余额限制
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 20 * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: bty, RightAsset: ccny, Amount: 10 * types.Coin, Op: et.OpBuy}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Completed, Nodes[2], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(89e7), orderList.List[0].Executed)
+	assert.Equal(t, int64(0), ccnyDB.LoadExecAccount(Nodes[2], execAddr).Balance)
+	orderList, err = Exec_QueryOrderList(et.Ordered, Nodes[3], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(61e7), orderList.List[0].Balance)
+	marketDepthList, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: bty, RightAsset: ccny, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(marketDepthList.List))
+	assert.Equal(t, int64(61e7), marketDepthList.List[0].Amount)
+}
+
+func TestTimeInForce(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	bty := &et.Asset{Execer: "coins", Symbol: "bty"}
+	ccny := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes {
+		btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+		ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+	}
+	env := &execEnv{10, 1, 1539918074}
+
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 4 * types.Coin, Amount: 2 * types.Coin, Op: et.OpSell}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	_, err = CreateLimitOrder(&et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 4 * types.Coin, Amount: 2 * types.Coin, Op: et.OpSell, TimeInForce: 4}, PrivKeyB)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 4 * types.Coin, Amount: 2 * types.Coin, Op: et.OpSell, TimeInForce: 4}, PrivKeyB, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrTimeInForce, err)
+
+	//post-only会立即成交时失败，不会成交时正常挂单
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 5 * types.Coin, Amount: types.Coin, Op: et.OpBuy, TimeInForce: et.PostOnly}, PrivKeyA, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrPostOnly, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 3 * types.Coin, Amount: types.Coin, Op: et.OpBuy, TimeInForce: et.PostOnly}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err := Exec_QueryOrderList(et.Ordered, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, types.Coin, orderList.List[0].Balance)
+
+	//FOK不能全部成交时失败
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 4 * types.Coin, Amount: 3 * types.Coin, Op: et.OpBuy, TimeInForce: et.FOK}, PrivKeyC, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrNotFilled, err)
+	orderList, err = Exec_QueryOrderList(et.Ordered, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*types.Coin, orderList.List[0].Balance)
+
+	//IOC成交一部分，剩余部分不挂单
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 4 * types.Coin, Amount: 3 * types.Coin, Op: et.OpBuy, TimeInForce: et.IOC}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Completed, Nodes[2], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*types.Coin, orderList.List[0].Executed)
+	assert.Equal(t, types.Coin, orderList.List[0].Balance)
+	assert.Equal(t, 92*types.Coin, ccnyDB.LoadExecAccount(Nodes[2], execAddr).Balance)
+	assert.Equal(t, int64(0), ccnyDB.LoadExecAccount(Nodes[2], execAddr).Frozen)
+	_, err = Exec_QueryOrderList(et.Ordered, Nodes[2], "", stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+
+	//过期高度必须大于当前高度
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 6 * types.Coin, Amount: 2 * types.Coin, Op: et.OpSell, ExpireHeight: env.blockHeight + 1}, PrivKeyD, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrExpireHeight, err)
+	//过期的挂单在撮合时自动撤销并解冻资金
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 6 * types.Coin, Amount: 2 * types.Coin, Op: et.OpSell, ExpireHeight: env.blockHeight + 2}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*types.Coin, btyDB.LoadExecAccount(Nodes[3], execAddr).Frozen)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 7 * types.Coin, Amount: types.Coin, Op: et.OpBuy}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), btyDB.LoadExecAccount(Nodes[3], execAddr).Frozen)
+	assert.Equal(t, 100*types.Coin, btyDB.LoadExecAccount(Nodes[3], execAddr).Balance)
+	orderList, err = Exec_QueryOrderList(et.Revoked, Nodes[3], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orderList.List))
+	_, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: bty, RightAsset: ccny, Op: et.OpSell}, stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	orderList, err = Exec_QueryOrderList(et.Ordered, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(orderList.List))
+
+	//过期的订单任何地址都可以撤销
+	expireHeight := env.blockHeight + 3
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 8 * types.Coin, Amount: types.Coin, Op: et.OpSell, ExpireHeight: expireHeight}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Ordered, Nodes[3], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	orderID := orderList.List[0].OrderID
+	//按过期高度查询可以撤销的挂单
+	_, err = Exec_QueryExpiredOrderList(&et.QueryExpiredOrderList{Height: expireHeight - 1}, stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	orderList, err = Exec_QueryExpiredOrderList(&et.QueryExpiredOrderList{Height: expireHeight}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orderList.List))
+	assert.Equal(t, orderID, orderList.List[0].OrderID)
+	err = Exec_RevokeOrder(t, orderID, PrivKeyA, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrAddr, err)
+	err = Exec_RevokeOrder(t, orderID, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	order, err := Exec_QueryOrder(orderID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.Revoked), order.Status)
+	assert.Equal(t, int64(0), btyDB.LoadExecAccount(Nodes[3], execAddr).Frozen)
+	_, err = Exec_QueryExpiredOrderList(&et.QueryExpiredOrderList{Height: expireHeight}, stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+
+	//post-only判断是否成交时跳过同地址和过期的挂单
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 9 * types.Coin, Amount: types.Coin, Op: et.OpSell, ExpireHeight: env.blockHeight + 2}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 6 * types.Coin, Amount: types.Coin, Op: et.OpSell, TimeInForce: et.PostOnly}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 6 * types.Coin, Amount: types.Coin, Op: et.OpSell, TimeInForce: et.PostOnly}, PrivKeyD, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrPostOnly, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 10 * types.Coin, Amount: types.Coin, Op: et.OpBuy, TimeInForce: et.PostOnly}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+}
+
+func Exec_QueryTradeList(query *et.QueryTradeList, stateDB db.KV, kvdb db.KVDB) (*et.TradeList, error) {
+	msg, err := exec_Query(et.FuncNameQueryTradeList, query, stateDB, kvdb)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*et.TradeList), nil
+}
+
+func Exec_QueryCandleList(query *et.QueryCandleList, stateDB db.KV, kvdb db.KVDB) (*et.CandleList, error) {
+	msg, err := exec_Query(et.FuncNameQueryCandleList, query, stateDB, kvdb)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*et.CandleList), nil
+}
+
+func Exec_QueryExpiredOrderList(query *et.QueryExpiredOrderList, stateDB db.KV, kvdb db.KVDB) (*et.OrderList, error) {
+	msg, err := exec_Query(et.FuncNameQueryExpiredOrderList, query, stateDB, kvdb)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*et.OrderList), nil
+}
+
+func exec_Query(funcName string, query types.Message, stateDB db.KV, kvdb db.KVDB) (types.Message, error) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	return exec.Query(funcName, types.Encode(query))
+}
+
+//执行单笔交易，返回执行器和回执，用于测试ExecDelLocal回滚
+func Exec_Tx(t *testing.T, tx *types.Transaction, stateDB db.DB, kvdb db.KVDB, env *execEnv) (*exchange, *types.ReceiptData) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	env.blockHeight = env.blockHeight + 1
+	env.blockTime = env.blockTime + 20
+	env.difficulty = env.difficulty + 1
+	exec.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	receipt, err := exec.Exec(tx, 0)
+	assert.Nil(t, err)
+	for _, kv := range receipt.KV {
+		stateDB.Set(kv.Key, kv.Value)
+	}
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := exec.ExecLocal(tx, receiptData, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+	return exec.(*exchange), receiptData
+}
+
+func TestTradeHistory(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	bty := &et.Asset{Execer: "coins", Symbol: "bty"}
+	ccny := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes {
+		btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+		ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+	}
+	//区块时间从整分钟开始，每个区块增加20秒
+	start := int64(1539918000)
+	env := &execEnv{blockTime: start, blockHeight: 10, difficulty: 1}
+
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 4 * types.Coin, Amount: 2 * types.Coin, Op: et.OpSell}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	_, err = Exec_QueryTradeList(&et.QueryTradeList{LeftAsset: bty, RightAsset: ccny}, stateDB, kvdb)
+	assert.Nil(t, err)
+	//start+40 按挂单价格成交
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 4 * types.Coin, Amount: types.Coin, Op: et.OpBuy}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	//start+60 成交1个，剩余部分挂单
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 5 * types.Coin, Amount: 2 * types.Coin, Op: et.OpBuy}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	//start+80 限价卖单按自身价格成交
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 3 * types.Coin, Amount: types.Coin, Op: et.OpSell}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+
+	tradeList, err := Exec_QueryTradeList(&et.QueryTradeList{LeftAsset: bty, RightAsset: ccny}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(tradeList.List))
+	assert.Equal(t, start+80, tradeList.List[0].BlockTime)
+	assert.Equal(t, 3*types.Coin, tradeList.List[0].Price)
+	assert.Equal(t, int32(et.OpSell), tradeList.List[0].Op)
+	assert.Equal(t, 4*types.Coin, tradeList.List[2].Price)
+	assert.Equal(t, int32(et.OpBuy), tradeList.List[2].Op)
+	assert.Equal(t, "", tradeList.PrimaryKey)
+
+	//按时间范围查询
+	tradeList, err = Exec_QueryTradeList(&et.QueryTradeList{LeftAsset: bty, RightAsset: ccny, StartTime: start + 60}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tradeList.List))
+	tradeList, err = Exec_QueryTradeList(&et.QueryTradeList{LeftAsset: bty, RightAsset: ccny, StartTime: start + 50, EndTime: start + 70}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tradeList.List))
+	assert.Equal(t, start+60, tradeList.List[0].BlockTime)
+	tradeList, err = Exec_QueryTradeList(&et.QueryTradeList{LeftAsset: bty, RightAsset: ccny, EndTime: start + 1000}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(tradeList.List))
+	tradeList, err = Exec_QueryTradeList(&et.QueryTradeList{LeftAsset: bty, RightAsset: ccny, EndTime: start + 30}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tradeList.List))
+
+	//分页查询
+	tradeList, err = Exec_QueryTradeList(&et.QueryTradeList{LeftAsset: bty, RightAsset: ccny, Count: 2}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tradeList.List))
+	assert.NotEqual(t, "", tradeList.PrimaryKey)
+	tradeList, err = Exec_QueryTradeList(&et.QueryTradeList{LeftAsset: bty, RightAsset: ccny, Count: 2, PrimaryKey: tradeList.PrimaryKey}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tradeList.List))
+	assert.Equal(t, start+40, tradeList.List[0].BlockTime)
+	_, err = Exec_QueryTradeList(&et.QueryTradeList{LeftAsset: bty, RightAsset: ccny, Count: et.MaxQueryCount + 1}, stateDB, kvdb)
+	assert.Equal(t, et.ErrCount, err)
+
+	//K线
+	_, err = Exec_QueryCandleList(&et.QueryCandleList{LeftAsset: bty, RightAsset: ccny, Period: 120}, stateDB, kvdb)
+	assert.Equal(t, et.ErrPeriod, err)
+	candleList, err := Exec_QueryCandleList(&et.QueryCandleList{LeftAsset: bty, RightAsset: ccny, Period: 60}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(candleList.List))
+	candle := candleList.List[0]
+	assert.Equal(t, start+60, candle.Time)
+	assert.Equal(t, 4*types.Coin, candle.Open)
+	assert.Equal(t, 4*types.Coin, candle.High)
+	assert.Equal(t, 3*types.Coin, candle.Low)
+	assert.Equal(t, 3*types.Coin, candle.Close)
+	assert.Equal(t, 2*types.Coin, candle.Volume)
+	assert.Equal(t, 7*types.Coin, candle.Turnover)
+	assert.Equal(t, int64(2), candle.Count)
+	assert.Equal(t, start, candleList.List[1].Time)
+	assert.Equal(t, int64(1), candleList.List[1].Count)
+	candleList, err = Exec_QueryCandleList(&et.QueryCandleList{LeftAsset: bty, RightAsset: ccny, Period: 60, EndTime: start + 59}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(candleList.List))
+	assert.Equal(t, start, candleList.List[0].Time)
+	candleList, err = Exec_QueryCandleList(&et.QueryCandleList{LeftAsset: bty, RightAsset: ccny, Period: 300}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(candleList.List))
+	assert.Equal(t, 3*types.Coin, candleList.List[0].Volume)
+	assert.Equal(t, 11*types.Coin, candleList.List[0].Turnover)
+	assert.Equal(t, int64(3), candleList.List[0].Count)
+
+	//回滚后删除成交记录并恢复K线
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 5 * types.Coin, Amount: types.Coin, Op: et.OpSell}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	tx, err := CreateLimitOrder(&et.LimitOrder{LeftAsset: bty, RightAsset: ccny, Price: 5 * types.Coin, Amount: types.Coin, Op: et.OpBuy}, PrivKeyC)
+	assert.Nil(t, err)
+	exec, receipt := Exec_Tx(t, tx, stateDB, kvdb, env)
+	candleList, err = Exec_QueryCandleList(&et.QueryCandleList{LeftAsset: bty, RightAsset: ccny, Period: 60}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(candleList.List))
+	assert.Equal(t, start+120, candleList.List[0].Time)
+	candleList, err = Exec_QueryCandleList(&et.QueryCandleList{LeftAsset: bty, RightAsset: ccny, Period: 300}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 5*types.Coin, candleList.List[0].High)
+	assert.Equal(t, int64(4), candleList.List[0].Count)
+
+	set, err := exec.ExecDelLocal(tx, receipt, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+	tradeList, err = Exec_QueryTradeList(&et.QueryTradeList{LeftAsset: bty, RightAsset: ccny}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(tradeList.List))
+	candleList, err = Exec_QueryCandleList(&et.QueryCandleList{LeftAsset: bty, RightAsset: ccny, Period: 60}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(candleList.List))
+	candleList, err = Exec_QueryCandleList(&et.QueryCandleList{LeftAsset: bty, RightAsset: ccny, Period: 300}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 4*types.Coin, candleList.List[0].High)
+	assert.Equal(t, 3*types.Coin, candleList.List[0].Close)
+	assert.Equal(t, int64(3), candleList.List[0].Count)
+}
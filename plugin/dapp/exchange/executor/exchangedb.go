@@ -3,6 +3,7 @@ package executor
 import (
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
@@ -75,6 +76,19 @@ func CheckOp(op int32) bool {
 	return false
 }
 
+func CheckTimeInForce(timeInForce int32) bool {
+	return timeInForce >= et.GTC && timeInForce <= et.PostOnly
+}
+
+func CheckPeriod(period int64) bool {
+	for _, p := range et.CandlePeriods {
+		if p == period {
+			return true
+		}
+	}
+	return false
+}
+
 func CheckCount(count int32) bool {
 	return count <= 20 && count >= 0
 }
@@ -103,7 +117,7 @@ func CheckStatus(status int32) bool {
 
 //检查交易得资产是否合法
 func CheckExchangeAsset(left, right *et.Asset) bool {
-	if left.Execer == "" || left.Symbol == "" || right.Execer == "" || right.Symbol == "" {
+	if left.GetExecer() == "" || left.GetSymbol() == "" || right.GetExecer() == "" || right.GetSymbol() == "" {
 		return false
 	}
 	if (left.Execer == "coins" && right.Execer == "coins") || (left.Symbol == right.Symbol) {
@@ -130,6 +144,23 @@ func (a *Action) LimitOrder(payload *et.LimitOrder) (*types.Receipt, error) {
 	}
 	//TODO 这里symbol
 	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.height, et.ExchangeX, et.ForkTimeInForce) {
+		//fork之前不支持有效方式和过期高度
+		payload.TimeInForce = et.GTC
+		payload.ExpireHeight = 0
+	}
+	if !CheckTimeInForce(payload.GetTimeInForce()) {
+		return nil, et.ErrTimeInForce
+	}
+	if payload.GetExpireHeight() != 0 && payload.GetExpireHeight() <= a.height {
+		return nil, et.ErrExpireHeight
+	}
+	if payload.GetTimeInForce() == et.PostOnly && a.isCrossed(payload) {
+		return nil, et.ErrPostOnly
+	}
+	if payload.GetTimeInForce() == et.FOK && !a.canFill(payload) {
+		return nil, et.ErrNotFilled
+	}
 	leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
 	if err != nil {
 		return nil, err
@@ -162,13 +193,12 @@ func (a *Action) LimitOrder(payload *et.LimitOrder) (*types.Receipt, error) {
 }
 
 func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
-	var logs []*types.ReceiptLog
-	var kvs []*types.KeyValue
 	order, err := findOrderByOrderID(a.statedb, a.localDB, payload.GetOrderID())
 	if err != nil {
 		return nil, err
 	}
-	if order.Addr != a.fromaddr {
+	//过期的订单任何地址都可以撤销，资金解冻到订单所有者的账户
+	if order.Addr != a.fromaddr && !isExpired(order, a.height) {
 		elog.Error("RevokeOrder.OrderCheck", "addr", a.fromaddr, "order.addr", order.Addr, "order.status", order.Status)
 		return nil, et.ErrAddr
 	}
@@ -176,56 +206,20 @@ func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 		elog.Error("RevokeOrder.OrderCheck", "addr", a.fromaddr, "order.addr", order.Addr, "order.status", order.Status)
 		return nil, et.ErrOrderSatus
 	}
-	leftAsset := order.GetLimitOrder().GetLeftAsset()
-	rightAsset := order.GetLimitOrder().GetRightAsset()
-	price := order.GetLimitOrder().GetPrice()
-	balance := order.GetBalance()
-
-	cfg := a.api.GetConfig()
-
+	//买单冻结的是rightAsset，卖单冻结的是leftAsset
+	asset := order.GetLimitOrder().GetLeftAsset()
 	if order.GetLimitOrder().GetOp() == et.OpBuy {
-		rightAssetDB, err := account.NewAccountDB(cfg, rightAsset.GetExecer(), rightAsset.GetSymbol(), a.statedb)
-		if err != nil {
-			return nil, err
-		}
-		amount := CalcActualCost(et.OpBuy, balance, price)
-		rightAccount := rightAssetDB.LoadExecAccount(a.fromaddr, a.execaddr)
-		if rightAccount.Frozen < amount {
-			elog.Error("revoke check right frozen", "addr", a.fromaddr, "avail", rightAccount.Frozen, "amount", amount)
-			return nil, et.ErrAssetBalance
-		}
-		receipt, err := rightAssetDB.ExecActive(a.fromaddr, a.execaddr, amount)
-		if err != nil {
-			elog.Error("RevokeOrder.ExecActive", "addr", a.fromaddr, "amount", amount, "err", err.Error())
-			return nil, err
-		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
+		asset = order.GetLimitOrder().GetRightAsset()
 	}
-	if order.GetLimitOrder().GetOp() == et.OpSell {
-		leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
-		if err != nil {
-			return nil, err
-		}
-		amount := CalcActualCost(et.OpSell, balance, price)
-		leftAccount := leftAssetDB.LoadExecAccount(a.fromaddr, a.execaddr)
-		if leftAccount.Frozen < amount {
-			elog.Error("revoke check left frozen", "addr", a.fromaddr, "avail", leftAccount.Frozen, "amount", amount)
-			return nil, et.ErrAssetBalance
-		}
-		receipt, err := leftAssetDB.ExecActive(a.fromaddr, a.execaddr, amount)
-		if err != nil {
-			elog.Error("RevokeOrder.ExecActive", "addr", a.fromaddr, "amount", amount, "err", err.Error())
-			return nil, err
-		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
+	cfg := a.api.GetConfig()
+	accountDB, err := account.NewAccountDB(cfg, asset.GetExecer(), asset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	logs, kvs, err := a.revokeOrder(accountDB, order)
+	if err != nil {
+		return nil, err
 	}
-
-	//更新order状态
-	order.Status = et.Revoked
-	order.UpdateTime = a.blocktime
-	kvs = append(kvs, a.GetKVSet(order)...)
 	re := &et.ReceiptExchange{
 		Order: order,
 		Index: a.GetIndex(),
@@ -237,6 +231,131 @@ func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 
 }
 
+//撤销订单，解冻剩余未成交的资金，accountDB为订单冻结资产对应的账户
+func (a *Action) revokeOrder(accountDB *account.DB, order *et.Order) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	op := order.GetLimitOrder().GetOp()
+	amount := CalcActualCost(op, order.GetBalance(), order.GetLimitOrder().GetPrice())
+	acc := accountDB.LoadExecAccount(order.Addr, a.execaddr)
+	if acc.Frozen < amount {
+		elog.Error("revoke check frozen", "addr", order.Addr, "op", op, "avail", acc.Frozen, "amount", amount)
+		return nil, nil, et.ErrAssetBalance
+	}
+	receipt, err := accountDB.ExecActive(order.Addr, a.execaddr, amount)
+	if err != nil {
+		elog.Error("RevokeOrder.ExecActive", "addr", order.Addr, "amount", amount, "err", err.Error())
+		return nil, nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kvs = append(kvs, receipt.KV...)
+
+	//更新order状态
+	order.Status = et.Revoked
+	order.UpdateTime = a.blocktime
+	kvs = append(kvs, a.GetKVSet(order)...)
+	return logs, kvs, nil
+}
+
+//订单是否已经过期
+func isExpired(order *et.Order, height int64) bool {
+	expireHeight := order.GetLimitOrder().GetExpireHeight()
+	return expireHeight > 0 && height >= expireHeight
+}
+
+//FOK订单撮合前检查对手方满足价格条件的挂单能否全部成交，计算方式和撮合时一致
+func (a *Action) canFill(payload *et.LimitOrder) bool {
+	var filled int64
+	var count int
+	var priceKey string
+	for {
+		marketDepthList, err := QueryMarketDepth(a.localDB, payload.GetLeftAsset(), payload.GetRightAsset(), a.OpSwap(payload.Op), priceKey, et.Count)
+		if err != nil {
+			return false
+		}
+		for _, marketDepth := range marketDepthList.List {
+			if (payload.Op == et.OpBuy && marketDepth.Price > payload.GetPrice()) || (payload.Op == et.OpSell && marketDepth.Price < payload.GetPrice()) {
+				continue
+			}
+			var orderKey string
+			for {
+				orderList, err := findOrderIDListByPrice(a.localDB, payload.GetLeftAsset(), payload.GetRightAsset(), marketDepth.Price, a.OpSwap(payload.Op), et.ListASC, orderKey)
+				if err != nil {
+					break
+				}
+				for _, matchorder := range orderList.List {
+					//同地址的挂单不撮合也不计入撮合深度，过期的挂单会被撤销
+					expired := isExpired(matchorder, a.height)
+					if !expired && matchorder.Addr == a.fromaddr {
+						continue
+					}
+					if count >= et.MaxMatchCount {
+						return false
+					}
+					count++
+					if expired {
+						continue
+					}
+					filled += matchorder.GetBalance()
+					if filled >= payload.GetAmount() {
+						return true
+					}
+				}
+				if orderList.PrimaryKey == "" {
+					break
+				}
+				orderKey = orderList.PrimaryKey
+			}
+		}
+		if marketDepthList.PrimaryKey == "" {
+			return false
+		}
+		priceKey = marketDepthList.PrimaryKey
+	}
+}
+
+//post-only订单的价格是否会和对手方的挂单立即成交，过期和同地址的挂单不参与撮合，不计入判断，
+//跳过的挂单达到最大撮合深度时按会成交处理
+func (a *Action) isCrossed(payload *et.LimitOrder) bool {
+	var count int
+	var priceKey string
+	for {
+		marketDepthList, err := QueryMarketDepth(a.localDB, payload.GetLeftAsset(), payload.GetRightAsset(), a.OpSwap(payload.Op), priceKey, et.Count)
+		if err != nil {
+			return false
+		}
+		for _, marketDepth := range marketDepthList.List {
+			if (payload.Op == et.OpBuy && marketDepth.Price > payload.GetPrice()) || (payload.Op == et.OpSell && marketDepth.Price < payload.GetPrice()) {
+				continue
+			}
+			var orderKey string
+			for {
+				orderList, err := findOrderIDListByPrice(a.localDB, payload.GetLeftAsset(), payload.GetRightAsset(), marketDepth.Price, a.OpSwap(payload.Op), et.ListASC, orderKey)
+				if err != nil {
+					break
+				}
+				for _, matchorder := range orderList.List {
+					if !isExpired(matchorder, a.height) && matchorder.Addr != a.fromaddr {
+						return true
+					}
+					count++
+					if count >= et.MaxMatchCount {
+						return true
+					}
+				}
+				if orderList.PrimaryKey == "" {
+					break
+				}
+				orderKey = orderList.PrimaryKey
+			}
+		}
+		if marketDepthList.PrimaryKey == "" {
+			return false
+		}
+		priceKey = marketDepthList.PrimaryKey
+	}
+}
+
 //撮合交易逻辑方法
 // 规则：
 //1.买单高于市场价，按价格由低往高撮合。
@@ -244,12 +363,6 @@ func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 //3.价格相同按先进先出的原则进行撮合
 //4.买家获利得原则
 func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAccountDB *account.DB) (*types.Receipt, error) {
-	var logs []*types.ReceiptLog
-	var kvs []*types.KeyValue
-	var orderKey string
-	var priceKey string
-	var count int
-
 	or := &et.Order{
 		OrderID:    a.GetIndex(),
 		Value:      &et.Order_LimitOrder{LimitOrder: payload},
@@ -266,6 +379,140 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 		Order: or,
 		Index: a.GetIndex(),
 	}
+	logs, kvs, err := a.matchOrder(leftAccountDB, rightAccountDB, payload, or, re)
+	if err != nil {
+		return nil, err
+	}
+	//订单完成,直接返回
+	if or.Status == et.Completed {
+		receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
+		logs = append(logs, receiptlog)
+		receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+		return receipts, nil
+	}
+	switch payload.GetTimeInForce() {
+	case et.FOK:
+		return nil, et.ErrNotFilled
+	case et.IOC:
+		if or.Executed == 0 {
+			return nil, et.ErrNoMatch
+		}
+		//未成交的部分直接撤销，不冻结资金，balance为未成交的数量
+		or.Status = et.Completed
+		kvs = append(kvs, a.GetKVSet(or)...)
+		re.Order = or
+		receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
+		logs = append(logs, receiptlog)
+		receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+		return receipts, nil
+	}
+
+	//未完成的订单需要冻结剩余未成交的资金
+	if payload.Op == et.OpBuy {
+		amount := CalcActualCost(et.OpBuy, or.Balance, payload.Price)
+		receipt, err := rightAccountDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
+		if err != nil {
+			elog.Error("LimitOrder.ExecFrozen", "addr", a.fromaddr, "amount", amount, "err", err.Error())
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+	}
+	if payload.Op == et.OpSell {
+		amount := CalcActualCost(et.OpSell, or.Balance, payload.Price)
+		receipt, err := leftAccountDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
+		if err != nil {
+			elog.Error("LimitOrder.ExecFrozen", "addr", a.fromaddr, "amount", amount, "err", err.Error())
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+	}
+	//更新order状态
+	kvs = append(kvs, a.GetKVSet(or)...)
+	re.Order = or
+	receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
+	logs = append(logs, receiptlog)
+	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+	return receipts, nil
+}
+
+//MarketOrder 市价单按照对手方挂单的价格逐档撮合，直到订单完成、对手盘中没有满足最差成交价格的挂单或者余额不足，
+//市价单不会挂单，未成交的部分不冻结，直接留在用户账户中
+func (a *Action) MarketOrder(payload *et.MarketOrder) (*types.Receipt, error) {
+	leftAsset := payload.GetLeftAsset()
+	rightAsset := payload.GetRightAsset()
+	if !CheckExchangeAsset(leftAsset, rightAsset) {
+		return nil, et.ErrAsset
+	}
+	if !CheckAmount(payload.GetAmount()) {
+		return nil, et.ErrAssetAmount
+	}
+	if payload.GetWorstPrice() != 0 && !CheckPrice(payload.GetWorstPrice()) {
+		return nil, et.ErrAssetPrice
+	}
+	if !CheckOp(payload.GetOp()) {
+		return nil, et.ErrAssetOp
+	}
+	cfg := a.api.GetConfig()
+	leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	rightAssetDB, err := account.NewAccountDB(cfg, rightAsset.GetExecer(), rightAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	//卖单需要有足够的资产，买单的成交数量受余额限制，撮合时再计算
+	if payload.GetOp() == et.OpSell {
+		leftAccount := leftAssetDB.LoadExecAccount(a.fromaddr, a.execaddr)
+		if leftAccount.Balance < payload.GetAmount() {
+			elog.Error("market check left balance", "addr", a.fromaddr, "avail", leftAccount.Balance, "need", payload.GetAmount())
+			return nil, et.ErrAssetBalance
+		}
+	}
+
+	or := &et.Order{
+		OrderID:    a.GetIndex(),
+		Value:      &et.Order_MarketOrder{MarketOrder: payload},
+		Ty:         et.TyMarketOrderAction,
+		Balance:    payload.GetAmount(),
+		Status:     et.Ordered,
+		Addr:       a.fromaddr,
+		UpdateTime: a.blocktime,
+		Index:      a.GetIndex(),
+	}
+	re := &et.ReceiptExchange{
+		Order: or,
+		Index: a.GetIndex(),
+	}
+	//用最差成交价格作为撮合的限价
+	limit := &et.LimitOrder{LeftAsset: leftAsset, RightAsset: rightAsset, Price: payload.GetWorstPrice(), Amount: payload.GetAmount(), Op: payload.GetOp()}
+	logs, kvs, err := a.matchOrder(leftAssetDB, rightAssetDB, limit, or, re)
+	if err != nil {
+		return nil, err
+	}
+	if or.Executed == 0 {
+		return nil, et.ErrNoMatch
+	}
+	//市价单撮合结束后即完成，balance为未成交的数量
+	or.Status = et.Completed
+	kvs = append(kvs, a.GetKVSet(or)...)
+	re.Order = or
+	receiptlog := &types.ReceiptLog{Ty: et.TyMarketOrderLog, Log: types.Encode(re)}
+	logs = append(logs, receiptlog)
+	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+	return receipts, nil
+}
+
+//按照价格遍历对手方挂单进行撮合，payload.Price为撮合的限价，市价单的限价为0时表示不限制
+func (a *Action) matchOrder(leftAccountDB, rightAccountDB *account.DB, payload *et.LimitOrder, or *et.Order, re *et.ReceiptExchange) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	var orderKey string
+	var priceKey string
+	var count int
+	market := or.GetMarketOrder() != nil
 
 	//单笔交易最多撮合100笔历史订单,最大可撮合得深度，系统得自我防护
 	//迭代已有挂单价格
@@ -284,7 +531,7 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 				break
 			}
 			// 卖单价大于买单价
-			if payload.Op == et.OpBuy && marketDepth.Price > payload.GetPrice() {
+			if payload.Op == et.OpBuy && payload.GetPrice() > 0 && marketDepth.Price > payload.GetPrice() {
 				continue
 			}
 			// 买单价小于卖单价
@@ -307,23 +554,52 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 					if count >= et.MaxMatchCount {
 						break
 					}
+					//过期的挂单自动撤销，解冻剩余资金
+					if isExpired(matchorder, a.height) {
+						accountDB := leftAccountDB
+						if matchorder.GetLimitOrder().GetOp() == et.OpBuy {
+							accountDB = rightAccountDB
+						}
+						log, kv, err := a.revokeOrder(accountDB, matchorder)
+						if err != nil {
+							return nil, nil, err
+						}
+						logs = append(logs, log...)
+						kvs = append(kvs, kv...)
+						re.RevokedOrders = append(re.RevokedOrders, matchorder)
+						count = count + 1
+						continue
+					}
 					//同地址不能交易
 					if matchorder.Addr == a.fromaddr {
 						continue
 					}
+					matched := calcMatched(or, matchorder)
+					matchPayload := payload
+					if market {
+						//市价单按照挂单价格成交
+						matchPayload = &et.LimitOrder{LeftAsset: payload.LeftAsset, RightAsset: payload.RightAsset,
+							Price: matchorder.GetLimitOrder().GetPrice(), Amount: payload.Amount, Op: payload.Op}
+						//市价买单的成交数量受可用余额限制，余额不足时结束撮合
+						if payload.Op == et.OpBuy {
+							if affordable := a.calcAffordable(rightAccountDB, matchPayload.Price); affordable < matched {
+								matched = affordable
+							}
+							if matched <= 0 {
+								return logs, kvs, nil
+							}
+						}
+					}
 					//撮合,指针传递
-					log, kv, err := a.matchModel(leftAccountDB, rightAccountDB, payload, matchorder, or, re) // payload, or redundant
+					log, kv, err := a.matchModel(leftAccountDB, rightAccountDB, matchPayload, matchorder, or, re, matched) // payload, or redundant
 					if err != nil {
-						return nil, err
+						return nil, nil, err
 					}
 					logs = append(logs, log...)
 					kvs = append(kvs, kv...)
 					//订单完成,直接返回，如果没有完成，则继续撮合，直到count等于
 					if or.Status == et.Completed {
-						receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
-						logs = append(logs, receiptlog)
-						receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
-						return receipts, nil
+						return logs, kvs, nil
 					}
 					//TODO 这里得逻辑是否需要调整?当匹配的单数过多，会导致receipt日志数量激增，理论上存在日志存储攻击，需要加下最大匹配深度，防止这种攻击发生
 					//撮合深度计数
@@ -343,62 +619,47 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 		}
 		priceKey = marketDepthList.PrimaryKey
 	}
+	return logs, kvs, nil
+}
 
-	//未完成的订单需要冻结剩余未成交的资金
-	if payload.Op == et.OpBuy {
-		amount := CalcActualCost(et.OpBuy, or.Balance, payload.Price)
-		receipt, err := rightAccountDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
-		if err != nil {
-			elog.Error("LimitOrder.ExecFrozen", "addr", a.fromaddr, "amount", amount, "err", err.Error())
-			return nil, err
-		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
-	}
-	if payload.Op == et.OpSell {
-		amount := CalcActualCost(et.OpSell, or.Balance, payload.Price)
-		receipt, err := leftAccountDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
-		if err != nil {
-			elog.Error("LimitOrder.ExecFrozen", "addr", a.fromaddr, "amount", amount, "err", err.Error())
-			return nil, err
-		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
+//本次撮合的数量，取双方剩余数量的较小值
+func calcMatched(or, matchorder *et.Order) int64 {
+	if matchorder.GetBalance() >= or.GetBalance() {
+		return or.GetBalance()
 	}
-	//更新order状态
-	kvs = append(kvs, a.GetKVSet(or)...)
-	re.Order = or
-	receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
-	logs = append(logs, receiptlog)
-	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
-	return receipts, nil
+	return matchorder.GetBalance()
+}
+
+//按照可用余额计算在指定价格下最多可以买入的数量
+func (a *Action) calcAffordable(rightAccountDB *account.DB, price int64) int64 {
+	balance := rightAccountDB.LoadExecAccount(a.fromaddr, a.execaddr).Balance
+	res := big.NewInt(0).Mul(big.NewInt(balance), big.NewInt(types.Coin))
+	res = big.NewInt(0).Div(res, big.NewInt(price))
+	return res.Int64()
 }
 
 //交易撮合模型
-func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *et.LimitOrder, matchorder *et.Order, or *et.Order, re *et.ReceiptExchange) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *et.LimitOrder, matchorder *et.Order, or *et.Order, re *et.ReceiptExchange, matched int64) ([]*types.ReceiptLog, []*types.KeyValue, error) {
 	var logs []*types.ReceiptLog
 	var kvs []*types.KeyValue
-	var matched int64
-
-	if matchorder.GetBalance() >= or.GetBalance() {
-		matched = or.GetBalance()
-	} else {
-		matched = matchorder.GetBalance()
-	}
 
 	elog.Info("try match", "activeId", or.OrderID, "passiveId", matchorder.OrderID, "activeAddr", or.Addr, "passiveAddr",
 		matchorder.Addr, "amount", matched, "price", payload.Price)
 
+	cfg := a.api.GetConfig()
+	fork := cfg.IsDappFork(a.height, et.ExchangeX, et.ForkFee)
+	makerRate, takerRate := a.getFeeRate(payload.GetLeftAsset(), payload.GetRightAsset())
+	var takerFee, makerFee int64
 	if payload.Op == et.OpSell {
-		//转移冻结资产
+		//转移冻结资产，taker收到的rightAsset中扣除吃单手续费
 		amount := CalcActualCost(matchorder.GetLimitOrder().Op, matched, payload.Price)
-		receipt, err := rightAccountDB.ExecTransferFrozen(matchorder.Addr, a.fromaddr, a.execaddr, amount)
+		takerFee = calcFee(amount, takerRate)
+		log, kv, err := a.settle(rightAccountDB, matchorder.Addr, a.fromaddr, amount, takerFee, true)
 		if err != nil {
-			elog.Error("matchModel.ExecTransferFrozen", "from", matchorder.Addr, "to", a.fromaddr, "amount", amount, "err", err)
 			return nil, nil, err
 		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
 		//解冻多余资金
 		if payload.Price < matchorder.GetLimitOrder().Price {
 			amount := CalcActualCost(matchorder.GetLimitOrder().Op, matched, matchorder.GetLimitOrder().Price-payload.Price)
@@ -410,43 +671,51 @@ func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *
 			logs = append(logs, receipt.Logs...)
 			kvs = append(kvs, receipt.KV...)
 		}
-		//将达成交易的相应资产结算
+		//将达成交易的相应资产结算，maker收到的leftAsset中扣除挂单手续费
 		amount = CalcActualCost(payload.Op, matched, payload.Price)
-		receipt, err = leftAccountDB.ExecTransfer(a.fromaddr, matchorder.Addr, a.execaddr, amount)
+		makerFee = calcFee(amount, makerRate)
+		log, kv, err = a.settle(leftAccountDB, a.fromaddr, matchorder.Addr, amount, makerFee, false)
 		if err != nil {
-			elog.Error("matchModel.ExecTransfer", "from", a.fromaddr, "to", matchorder.Addr, "amount", amount, "err", err.Error())
 			return nil, nil, err
 		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
 
-		//卖单成交得平均价格始终与自身挂单价格相同
-		or.AVGPrice = payload.Price
+		//卖单成交得平均价格始终与自身挂单价格相同，市价单按挂单价格成交，fork之后按多次成交的数量加权平均
+		if cfg.IsDappFork(a.height, et.ExchangeX, et.ForkSellAVGPrice) {
+			or.AVGPrice = caclAVGPrice(or, payload.Price, matched)
+		} else {
+			or.AVGPrice = payload.Price
+		}
 		//计算matchOrder平均成交价格
 		matchorder.AVGPrice = caclAVGPrice(matchorder, payload.Price, matched) //TODO
 	}
 	if payload.Op == et.OpBuy {
-		//转移冻结资产
+		//转移冻结资产，taker收到的leftAsset中扣除吃单手续费
 		amount := CalcActualCost(matchorder.GetLimitOrder().Op, matched, matchorder.GetLimitOrder().Price)
-		receipt, err := leftAccountDB.ExecTransferFrozen(matchorder.Addr, a.fromaddr, a.execaddr, amount)
+		takerFee = calcFee(amount, takerRate)
+		log, kv, err := a.settle(leftAccountDB, matchorder.Addr, a.fromaddr, amount, takerFee, true)
 		if err != nil {
-			elog.Error("matchModel.ExecTransferFrozen2", "from", matchorder.Addr, "to", a.fromaddr, "amount", amount, "err", err.Error())
 			return nil, nil, err
 		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
-		//将达成交易的相应资产结算
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
+		//将达成交易的相应资产结算，maker收到的rightAsset中扣除挂单手续费
 		amount = CalcActualCost(payload.Op, matched, matchorder.GetLimitOrder().Price)
-		receipt, err = rightAccountDB.ExecTransfer(a.fromaddr, matchorder.Addr, a.execaddr, amount)
+		makerFee = calcFee(amount, makerRate)
+		log, kv, err = a.settle(rightAccountDB, a.fromaddr, matchorder.Addr, amount, makerFee, false)
 		if err != nil {
-			elog.Error("matchModel.ExecTransfer2", "from", a.fromaddr, "to", matchorder.Addr, "amount", amount, "err", err.Error())
 			return nil, nil, err
 		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
 
-		//买单得话，价格选取卖单的价格
-		or.AVGPrice = matchorder.GetLimitOrder().Price
+		//买单得话，价格选取卖单的价格，fork之后按多次成交的数量加权平均
+		if cfg.IsDappFork(a.height, et.ExchangeX, et.ForkAVGPrice) {
+			or.AVGPrice = caclAVGPrice(or, matchorder.GetLimitOrder().Price, matched)
+		} else {
+			or.AVGPrice = matchorder.GetLimitOrder().Price
+		}
 		//计算matchOrder平均成交价格
 		matchorder.AVGPrice = caclAVGPrice(matchorder, matchorder.GetLimitOrder().Price, matched) //TODO
 	}
@@ -463,6 +732,15 @@ func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *
 		or.Status = et.Ordered
 	}
 
+	//记录累计手续费
+	if fork {
+		matchorder.Fee += makerFee
+		or.Fee += takerFee
+		re.FeeAddr = subCfg.FeeAddr
+		re.TakerFee += takerFee
+		re.MakerFees = append(re.MakerFees, makerFee)
+	}
+
 	if matched == or.GetBalance() {
 		matchorder.Balance -= matched
 		matchorder.Executed = matched
@@ -475,11 +753,11 @@ func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *
 		or.Balance -= matched
 		or.Executed += matched
 
+		//市价买单受余额限制时，双方都只成交了一部分
 		matchorder.Executed = matched
-		matchorder.Balance = 0
+		matchorder.Balance -= matched
 		kvs = append(kvs, a.GetKVSet(matchorder)...) //matchorder complete
 	}
-
 	re.Order = or
 	re.MatchOrders = append(re.MatchOrders, matchorder)
 	return logs, kvs, nil
@@ -529,7 +807,7 @@ func findOrderIDListByPrice(localdb dbm.KV, left, right *et.Asset, price int64,
 	for _, row := range rows {
 		order := row.Data.(*et.Order)
 		//替换已经成交得量
-		order.Executed = order.GetLimitOrder().Amount - order.Balance
+		order.Executed = getOrderAmount(order) - order.Balance
 		orderList.List = append(orderList.List, order)
 	}
 	//设置主键索引
@@ -608,7 +886,7 @@ HERE:
 			continue
 		}
 		//替换已经成交得量
-		order.Executed = order.GetLimitOrder().Amount - order.Balance
+		order.Executed = getOrderAmount(order) - order.Balance
 		orderList.List = append(orderList.List, order)
 		if len(orderList.List) == int(count) {
 			//设置主键索引
@@ -651,7 +929,7 @@ func QueryOrderList(localdb dbm.KV, addr string, status, count, direction int32,
 	for _, row := range rows {
 		order := row.Data.(*et.Order)
 		//替换已经成交得量
-		order.Executed = order.GetLimitOrder().Amount - order.Balance
+		order.Executed = getOrderAmount(order) - order.Balance
 		orderList.List = append(orderList.List, order)
 	}
 	//设置主键索引
@@ -661,6 +939,40 @@ func QueryOrderList(localdb dbm.KV, addr string, status, count, direction int32,
 	return &orderList, nil
 }
 
+//QueryExpiredOrderList 按过期高度升序查询到height时已经过期的挂单
+func QueryExpiredOrderList(localdb dbm.KV, height int64, primaryKey string, count int32) (*et.OrderList, error) {
+	table := NewMarketOrderTable(localdb)
+	if count == 0 {
+		count = et.Count
+	}
+	var primary []byte
+	if primaryKey != "" {
+		primary = []byte(primaryKey)
+	}
+	rows, err := table.ListIndex("expire", []byte("1:"), primary, count, et.ListASC)
+	if err != nil {
+		elog.Error("QueryExpiredOrderList.", "height", height, "err", err.Error())
+		return nil, err
+	}
+	var orderList et.OrderList
+	for _, row := range rows {
+		order := row.Data.(*et.Order)
+		//索引按过期高度升序，遇到未过期的订单后面的都不需要了
+		if !isExpired(order, height) {
+			break
+		}
+		order.Executed = getOrderAmount(order) - order.Balance
+		orderList.List = append(orderList.List, order)
+	}
+	if len(orderList.List) == 0 {
+		return nil, types.ErrNotFound
+	}
+	if len(orderList.List) == int(count) {
+		orderList.PrimaryKey = string(rows[len(rows)-1].Primary)
+	}
+	return &orderList, nil
+}
+
 func queryMarketDepth(localdb dbm.KV, left, right *et.Asset, op int32, price int64) (*et.MarketDepth, error) {
 	table := NewMarketDepthTable(localdb)
 	primaryKey := []byte(fmt.Sprintf("%s:%s:%d:%016d", left.GetSymbol(), right.GetSymbol(), op, price))
@@ -671,6 +983,105 @@ func queryMarketDepth(localdb dbm.KV, left, right *et.Asset, op int32, price int
 	return row.Data.(*et.MarketDepth), nil
 }
 
+func queryCandle(localdb dbm.KV, left, right *et.Asset, period, time int64) (*et.Candle, error) {
+	table := NewCandleTable(localdb)
+	primaryKey := []byte(fmt.Sprintf("%s%016d", candlePrefix(left, right, period), time))
+	row, err := table.GetData(primaryKey)
+	if err != nil {
+		return nil, err
+	}
+	return row.Data.(*et.Candle), nil
+}
+
+//QueryTradeList 按时间范围查询交易对的成交记录，按时间倒序返回
+func QueryTradeList(localdb dbm.KV, left, right *et.Asset, startTime, endTime int64, primaryKey string, count int32) (*et.TradeList, error) {
+	table := NewTradeTable(localdb)
+	rows, next, err := listByTime(table, tradePrefix(left, right), startTime, endTime, primaryKey, count, func(data types.Message) int64 {
+		return data.(*et.Trade).BlockTime
+	})
+	if err != nil {
+		elog.Error("QueryTradeList.", "left", left, "right", right, "err", err.Error())
+		return nil, err
+	}
+	var tradeList et.TradeList
+	for _, row := range rows {
+		tradeList.List = append(tradeList.List, row.Data.(*et.Trade))
+	}
+	tradeList.PrimaryKey = next
+	return &tradeList, nil
+}
+
+//QueryCandleList 按时间范围查询交易对指定周期的K线，按时间倒序返回
+func QueryCandleList(localdb dbm.KV, left, right *et.Asset, period, startTime, endTime int64, primaryKey string, count int32) (*et.CandleList, error) {
+	table := NewCandleTable(localdb)
+	rows, next, err := listByTime(table, candlePrefix(left, right, period), startTime, endTime, primaryKey, count, func(data types.Message) int64 {
+		return data.(*et.Candle).Time
+	})
+	if err != nil {
+		elog.Error("QueryCandleList.", "left", left, "right", right, "period", period, "err", err.Error())
+		return nil, err
+	}
+	var candleList et.CandleList
+	for _, row := range rows {
+		candleList.List = append(candleList.List, row.Data.(*et.Candle))
+	}
+	candleList.PrimaryKey = next
+	return &candleList, nil
+}
+
+//按时间倒序遍历主键为{prefix}{time}格式的表，返回时间范围[startTime, endTime]内的数据和下一页的主键索引
+func listByTime(table *tab.Table, prefix string, startTime, endTime int64, primaryKey string, count int32, getTime func(types.Message) int64) ([]*tab.Row, string, error) {
+	if count == 0 {
+		count = et.Count
+	}
+	var start []byte
+	if primaryKey != "" {
+		start = []byte(primaryKey)
+	} else if endTime > 0 {
+		start = []byte(fmt.Sprintf("%s%016d", prefix, endTime+1))
+	}
+	//table在同时指定prefix和primaryKey时会重复拼接前缀，所以有起始位置时不指定prefix，遍历到其他交易对的数据时停止
+	var rows []*tab.Row
+	var err error
+	if start != nil {
+		rows, err = table.ListIndex("primary", nil, start, count, et.ListDESC)
+	}
+	//起始位置之后不存在任何数据时List返回空，这时从最新的数据开始遍历，并过滤掉结束时间之后的数据
+	if start == nil || (err == types.ErrNotFound && primaryKey == "") {
+		rows, err = table.ListIndex("primary", []byte(prefix), nil, count, et.ListDESC)
+	}
+	if err == types.ErrNotFound {
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	var list []*tab.Row
+	for _, row := range rows {
+		if !strings.HasPrefix(string(row.Primary), prefix) || getTime(row.Data) < startTime {
+			return list, "", nil
+		}
+		if endTime > 0 && getTime(row.Data) > endTime {
+			continue
+		}
+		list = append(list, row)
+	}
+	//设置主键索引
+	var next string
+	if len(rows) == int(count) {
+		next = string(rows[len(rows)-1].Primary)
+	}
+	return list, next, nil
+}
+
+//撮合成交价格，限价卖单按照自身价格成交，其他情况按照挂单价格成交
+func matchPrice(order, matchorder *et.Order) int64 {
+	if limitOrder := order.GetLimitOrder(); limitOrder != nil && limitOrder.GetOp() == et.OpSell {
+		return limitOrder.GetPrice()
+	}
+	return matchorder.GetLimitOrder().GetPrice()
+}
+
 //math库中的安全大数乘法，防溢出
 func SafeMul(x, y int64) int64 {
 	res := big.NewInt(0).Mul(big.NewInt(x), big.NewInt(y))
@@ -680,10 +1091,27 @@ func SafeMul(x, y int64) int64 {
 
 //计算平均成交价格
 func caclAVGPrice(order *et.Order, price int64, amount int64) int64 {
-	x := big.NewInt(0).Mul(big.NewInt(order.AVGPrice), big.NewInt(order.GetLimitOrder().Amount-order.GetBalance()))
+	x := big.NewInt(0).Mul(big.NewInt(order.AVGPrice), big.NewInt(getOrderAmount(order)-order.GetBalance()))
 	y := big.NewInt(0).Mul(big.NewInt(price), big.NewInt(amount))
 	total := big.NewInt(0).Add(x, y)
-	div := big.NewInt(0).Add(big.NewInt(order.GetLimitOrder().Amount-order.GetBalance()), big.NewInt(amount))
+	div := big.NewInt(0).Add(big.NewInt(getOrderAmount(order)-order.GetBalance()), big.NewInt(amount))
 	avg := big.NewInt(0).Div(total, div)
 	return avg.Int64()
 }
+
+//获取订单的交易资产和买卖方向，兼容限价单和市价单
+func getOrderAssets(order *et.Order) (left, right *et.Asset, op int32) {
+	if market := order.GetMarketOrder(); market != nil {
+		return market.GetLeftAsset(), market.GetRightAsset(), market.GetOp()
+	}
+	limit := order.GetLimitOrder()
+	return limit.GetLeftAsset(), limit.GetRightAsset(), limit.GetOp()
+}
+
+//获取订单总量，兼容限价单和市价单
+func getOrderAmount(order *et.Order) int64 {
+	if market := order.GetMarketOrder(); market != nil {
+		return market.GetAmount()
+	}
+	return order.GetLimitOrder().GetAmount()
+}
@@ -16,8 +16,11 @@ func (e *exchange) Exec_LimitOrder(payload *exchangetypes.LimitOrder, tx *types.
 }
 
 func (e *exchange) Exec_MarketOrder(payload *exchangetypes.MarketOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
-	//TODO marketOrder
-	return nil, types.ErrActionNotSupport
+	if !e.GetAPI().GetConfig().IsDappFork(e.GetHeight(), exchangetypes.ExchangeX, exchangetypes.ForkMarketOrder) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := NewAction(e, tx, index)
+	return action.MarketOrder(payload)
 }
 
 func (e *exchange) Exec_RevokeOrder(payload *exchangetypes.RevokeOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
@@ -76,13 +76,20 @@ func (e *exchange) updateIndex(receipt *ety.ReceiptExchange) (kvs []*types.KeyVa
 	historyTable := NewHistoryOrderTable(e.GetLocalDB())
 	marketTable := NewMarketDepthTable(e.GetLocalDB())
 	orderTable := NewMarketOrderTable(e.GetLocalDB())
+	tradeTable := NewTradeTable(e.GetLocalDB())
+	candleTable := NewCandleTable(e.GetLocalDB())
+	//成交记录和K线
+	err := e.updateTrades(tradeTable, candleTable, receipt)
+	if err != nil {
+		return nil
+	}
 	switch receipt.Order.Status {
 	case ety.Ordered:
 		err := e.updateOrder(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetIndex())
 		if err != nil {
 			return nil
 		}
-		err = e.updateMatchOrders(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetMatchOrders(), receipt.GetIndex())
+		err = e.updateMatchOrders(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetMatchOrders(), receipt.GetRevokedOrders(), receipt.GetIndex())
 		if err != nil {
 			return nil
 		}
@@ -91,7 +98,7 @@ func (e *exchange) updateIndex(receipt *ety.ReceiptExchange) (kvs []*types.KeyVa
 		if err != nil {
 			return nil
 		}
-		err = e.updateMatchOrders(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetMatchOrders(), receipt.GetIndex())
+		err = e.updateMatchOrders(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetMatchOrders(), receipt.GetRevokedOrders(), receipt.GetIndex())
 		if err != nil {
 			return nil
 		}
@@ -121,6 +128,18 @@ func (e *exchange) updateIndex(receipt *ety.ReceiptExchange) (kvs []*types.KeyVa
 		return nil
 	}
 	kvs = append(kvs, kv...)
+	kv, err = tradeTable.Save()
+	if err != nil {
+		elog.Error("updateIndex", "tradeTable.Save", err.Error())
+		return nil
+	}
+	kvs = append(kvs, kv...)
+	kv, err = candleTable.Save()
+	if err != nil {
+		elog.Error("updateIndex", "candleTable.Save", err.Error())
+		return nil
+	}
+	kvs = append(kvs, kv...)
 
 	return
 }
@@ -208,11 +227,9 @@ func (e *exchange) updateOrder(marketTable, orderTable, historyTable *table.Tabl
 	}
 	return nil
 }
-func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *table.Table, order *ety.Order, matchOrders []*ety.Order, index int64) error {
-	left := order.GetLimitOrder().GetLeftAsset()
-	right := order.GetLimitOrder().GetRightAsset()
-	op := order.GetLimitOrder().GetOp()
-	if len(matchOrders) > 0 {
+func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *table.Table, order *ety.Order, matchOrders, revokedOrders []*ety.Order, index int64) error {
+	left, right, op := getOrderAssets(order)
+	if len(matchOrders) > 0 || len(revokedOrders) > 0 {
 		//撮合交易更新
 		cache := make(map[int64]int64)
 		for i, matchOrder := range matchOrders {
@@ -245,6 +262,24 @@ func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *tabl
 			executed = executed + matchOrder.Executed
 			cache[matchOrder.GetLimitOrder().Price] = executed
 		}
+		//撮合过程中自动撤销的过期挂单
+		for i, revokedOrder := range revokedOrders {
+			// 删除原有状态orderID
+			revokedOrder.Status = ety.Ordered
+			err := orderTable.DelRow(revokedOrder)
+			if err != nil {
+				elog.Error("updateIndex", "orderTable.DelRow", err.Error())
+				return err
+			}
+			revokedOrder.Status = ety.Revoked
+			revokedOrder.Index = index + int64(len(matchOrders)+i+1)
+			err = historyTable.Replace(revokedOrder)
+			if err != nil {
+				elog.Error("updateIndex", "historyTable.Replace", err.Error())
+				return err
+			}
+			cache[revokedOrder.GetLimitOrder().Price] += revokedOrder.Balance
+		}
 
 		//更改匹配市场深度
 		for pr, executed := range cache {
@@ -277,6 +312,68 @@ func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *tabl
 	}
 	return nil
 }
+
+//记录撮合产生的成交记录，并更新各周期的K线，一笔交易中的成交时间相同，每个周期只需要更新一根K线
+func (e *exchange) updateTrades(tradeTable, candleTable *table.Table, receipt *ety.ReceiptExchange) error {
+	order := receipt.GetOrder()
+	matchOrders := receipt.GetMatchOrders()
+	if len(matchOrders) == 0 {
+		return nil
+	}
+	left, right, op := getOrderAssets(order)
+	var trades []*ety.Trade
+	for i, matchOrder := range matchOrders {
+		trade := &ety.Trade{
+			LeftAsset:    left,
+			RightAsset:   right,
+			Price:        matchPrice(order, matchOrder),
+			Amount:       matchOrder.Executed,
+			Op:           op,
+			TakerOrderID: order.OrderID,
+			MakerOrderID: matchOrder.OrderID,
+			Height:       e.GetHeight(),
+			BlockTime:    e.GetBlockTime(),
+			Index:        receipt.GetIndex() + int64(i+1),
+		}
+		err := tradeTable.Add(trade)
+		if err != nil {
+			elog.Error("updateIndex", "tradeTable.Add", err.Error())
+			return err
+		}
+		trades = append(trades, trade)
+	}
+
+	for _, period := range ety.CandlePeriods {
+		start := e.GetBlockTime() - e.GetBlockTime()%period
+		candle, err := queryCandle(e.GetLocalDB(), left, right, period, start)
+		if err == types.ErrNotFound {
+			price := trades[0].Price
+			candle = &ety.Candle{LeftAsset: left, RightAsset: right, Period: period, Time: start, Open: price, High: price, Low: price}
+		} else if err != nil {
+			elog.Error("updateIndex", "queryCandle", err.Error())
+			return err
+		}
+		for _, trade := range trades {
+			if trade.Price > candle.High {
+				candle.High = trade.Price
+			}
+			if trade.Price < candle.Low {
+				candle.Low = trade.Price
+			}
+			candle.Close = trade.Price
+			candle.Volume += trade.Amount
+			candle.Turnover += SafeMul(trade.Amount, trade.Price)
+			candle.Count++
+		}
+		err = candleTable.Replace(candle)
+		if err != nil {
+			elog.Error("updateIndex", "candleTable.Replace", err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
 func OpSwap(op int32) int32 {
 	if op == ety.OpBuy {
 		return ety.OpSell
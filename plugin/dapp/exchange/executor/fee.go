@@ -0,0 +1,81 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/types"
+	et "github.com/33cn/plugin/plugin/dapp/exchange/types"
+)
+
+/*
+ * 撮合手续费
+ * 手续费从成交后收到的资产中扣除，转入配置的手续费地址在exchange合约下的账户
+ * 费率以1e8为基数，与价格精度保持一致，例如费率1e5表示千分之一
+ */
+
+//费率必须小于100%
+func checkFeeRate(rate int64) bool {
+	return rate >= 0 && rate < types.Coin
+}
+
+func checkFeeConfig(cfg *subConfig) error {
+	if !checkFeeRate(cfg.MakerRate) || !checkFeeRate(cfg.TakerRate) {
+		return et.ErrFeeRate
+	}
+	for _, pair := range cfg.Pairs {
+		if pair.Left == "" || pair.Right == "" || !checkFeeRate(pair.MakerRate) || !checkFeeRate(pair.TakerRate) {
+			return et.ErrFeeRate
+		}
+	}
+	return nil
+}
+
+//getFeeRate 获取交易对的挂单和吃单费率，未单独配置的交易对使用默认费率
+func getFeeRate(left, right *et.Asset) (makerRate, takerRate int64) {
+	for _, pair := range subCfg.Pairs {
+		if pair.Left == left.GetSymbol() && pair.Right == right.GetSymbol() {
+			return pair.MakerRate, pair.TakerRate
+		}
+	}
+	return subCfg.MakerRate, subCfg.TakerRate
+}
+
+//按费率计算手续费
+func calcFee(amount, rate int64) int64 {
+	return SafeMul(amount, rate)
+}
+
+//获取当前高度下交易对的费率，fork之前或者没有配置手续费地址时不收取手续费
+func (a *Action) getFeeRate(left, right *et.Asset) (makerRate, takerRate int64) {
+	if !a.api.GetConfig().IsDappFork(a.height, et.ExchangeX, et.ForkFee) || subCfg.FeeAddr == "" {
+		return 0, 0
+	}
+	return getFeeRate(left, right)
+}
+
+//成交资产结算，收款方实际收到扣除手续费后的资产，手续费由付款方直接转入手续费地址
+//frozen为true时从付款方的冻结资产中转出，付款方就是手续费地址时手续费直接留在付款方账户
+func (a *Action) settle(accountDB *account.DB, from, to string, amount, fee int64, frozen bool) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	transfer := accountDB.ExecTransfer
+	if frozen {
+		transfer = accountDB.ExecTransferFrozen
+	}
+	receipt, err := transfer(from, to, a.execaddr, amount-fee)
+	if err != nil {
+		elog.Error("settle.ExecTransfer", "from", from, "to", to, "amount", amount-fee, "frozen", frozen, "err", err)
+		return nil, nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kvs = append(kvs, receipt.KV...)
+	if fee > 0 && from != subCfg.FeeAddr {
+		receipt, err = transfer(from, subCfg.FeeAddr, a.execaddr, fee)
+		if err != nil {
+			elog.Error("settle.ExecTransferFee", "from", from, "to", subCfg.FeeAddr, "fee", fee, "frozen", frozen, "err", err)
+			return nil, nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+	}
+	return logs, kvs, nil
+}
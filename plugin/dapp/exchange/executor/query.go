@@ -61,3 +61,40 @@ func (s *exchange) Query_QueryOrderList(in *et.QueryOrderList) (types.Message, e
 	}
 	return QueryOrderList(s.GetLocalDB(), in.Address, in.Status, in.Count, in.Direction, in.PrimaryKey)
 }
+
+//按时间范围查询成交记录
+func (s *exchange) Query_QueryTradeList(in *et.QueryTradeList) (types.Message, error) {
+	if !CheckExchangeAsset(in.LeftAsset, in.RightAsset) {
+		return nil, et.ErrAsset
+	}
+	if in.Count < 0 || in.Count > et.MaxQueryCount {
+		return nil, et.ErrCount
+	}
+	return QueryTradeList(s.GetLocalDB(), in.LeftAsset, in.RightAsset, in.StartTime, in.EndTime, in.PrimaryKey, in.Count)
+}
+
+//按时间范围查询K线
+func (s *exchange) Query_QueryCandleList(in *et.QueryCandleList) (types.Message, error) {
+	if !CheckExchangeAsset(in.LeftAsset, in.RightAsset) {
+		return nil, et.ErrAsset
+	}
+	if !CheckPeriod(in.Period) {
+		return nil, et.ErrPeriod
+	}
+	if in.Count < 0 || in.Count > et.MaxQueryCount {
+		return nil, et.ErrCount
+	}
+	return QueryCandleList(s.GetLocalDB(), in.LeftAsset, in.RightAsset, in.Period, in.StartTime, in.EndTime, in.PrimaryKey, in.Count)
+}
+
+//查询已经过期但仍在挂单中的订单，任意地址都可以用revokeOrder撤销这些订单
+func (s *exchange) Query_QueryExpiredOrderList(in *et.QueryExpiredOrderList) (types.Message, error) {
+	if !CheckCount(in.Count) {
+		return nil, et.ErrCount
+	}
+	height := in.Height
+	if height == 0 {
+		height = s.GetHeight()
+	}
+	return QueryExpiredOrderList(s.GetLocalDB(), height, in.PrimaryKey, in.Count)
+}
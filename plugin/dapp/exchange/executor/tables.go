@@ -40,7 +40,7 @@ var opt_exchange_order = &table.Option{
 	Prefix:  KeyPrefixLocalDB,
 	Name:    "order",
 	Primary: "orderID",
-	Index:   []string{"market_order", "addr_status"},
+	Index:   []string{"market_order", "addr_status", "expire"},
 }
 
 var opt_exchange_history = &table.Option{
@@ -50,6 +50,22 @@ var opt_exchange_history = &table.Option{
 	Index:   []string{"name", "addr_status"},
 }
 
+//成交记录，主键由{leftAsset}:{rightAsset}:{blockTime}:{index}构成，可以按时间范围查询
+var opt_exchange_trade = &table.Option{
+	Prefix:  KeyPrefixLocalDB,
+	Name:    "trade",
+	Primary: "trade",
+	Index:   nil,
+}
+
+//K线，主键由{leftAsset}:{rightAsset}:{period}:{time}构成
+var opt_exchange_candle = &table.Option{
+	Prefix:  KeyPrefixLocalDB,
+	Name:    "candle",
+	Primary: "candle",
+	Index:   nil,
+}
+
 //NewTable 新建表
 func NewMarketDepthTable(kvdb db.KV) *table.Table {
 	rowmeta := NewMarketDepthRow()
@@ -110,6 +126,13 @@ func (r *OrderRow) Get(key string) ([]byte, error) {
 		return []byte(fmt.Sprintf("%s:%s:%d:%016d", r.GetLimitOrder().LeftAsset.GetSymbol(), r.GetLimitOrder().RightAsset.GetSymbol(), r.GetLimitOrder().Op, r.GetLimitOrder().Price)), nil
 	} else if key == "addr_status" {
 		return []byte(fmt.Sprintf("%s:%d", r.Addr, r.Status)), nil
+	} else if key == "expire" {
+		//设置了过期高度的挂单为1:{expireHeight}，其他为0
+		expireHeight := r.GetLimitOrder().GetExpireHeight()
+		if expireHeight == 0 {
+			return []byte("0"), nil
+		}
+		return []byte(fmt.Sprintf("1:%018d", expireHeight)), nil
 	}
 	return nil, types.ErrNotFound
 }
@@ -141,7 +164,8 @@ func (m *HistoryOrderRow) Get(key string) ([]byte, error) {
 	if key == "index" {
 		return []byte(fmt.Sprintf("%022d", m.Index)), nil
 	} else if key == "name" {
-		return []byte(fmt.Sprintf("%s:%s", m.GetLimitOrder().LeftAsset.GetSymbol(), m.GetLimitOrder().RightAsset.GetSymbol())), nil
+		left, right, _ := getOrderAssets(m.Order)
+		return []byte(fmt.Sprintf("%s:%s", left.GetSymbol(), right.GetSymbol())), nil
 	} else if key == "addr_status" {
 		return []byte(fmt.Sprintf("%s:%d", m.Addr, m.Status)), nil
 	}
@@ -179,3 +203,93 @@ func (m *MarketDepthRow) Get(key string) ([]byte, error) {
 	}
 	return nil, types.ErrNotFound
 }
+
+func NewTradeTable(kvdb db.KV) *table.Table {
+	rowmeta := NewTradeRow()
+	table, err := table.NewTable(rowmeta, kvdb, opt_exchange_trade)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+func NewCandleTable(kvdb db.KV) *table.Table {
+	rowmeta := NewCandleRow()
+	table, err := table.NewTable(rowmeta, kvdb, opt_exchange_candle)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+func tradePrefix(left, right *ety.Asset) string {
+	return fmt.Sprintf("%s:%s:", left.GetSymbol(), right.GetSymbol())
+}
+
+func candlePrefix(left, right *ety.Asset, period int64) string {
+	return fmt.Sprintf("%s:%s:%08d:", left.GetSymbol(), right.GetSymbol(), period)
+}
+
+//TradeRow table meta 结构
+type TradeRow struct {
+	*ety.Trade
+}
+
+//NewTradeRow 新建一个meta 结构
+func NewTradeRow() *TradeRow {
+	return &TradeRow{Trade: &ety.Trade{}}
+}
+
+//CreateRow 新建数据行
+func (m *TradeRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ety.Trade{}}
+}
+
+//SetPayload 设置数据
+func (m *TradeRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*ety.Trade); ok {
+		m.Trade = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (m *TradeRow) Get(key string) ([]byte, error) {
+	if key == "trade" {
+		return []byte(fmt.Sprintf("%s%016d:%022d", tradePrefix(m.LeftAsset, m.RightAsset), m.BlockTime, m.Index)), nil
+	}
+	return nil, types.ErrNotFound
+}
+
+//CandleRow table meta 结构
+type CandleRow struct {
+	*ety.Candle
+}
+
+//NewCandleRow 新建一个meta 结构
+func NewCandleRow() *CandleRow {
+	return &CandleRow{Candle: &ety.Candle{}}
+}
+
+//CreateRow 新建数据行
+func (m *CandleRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ety.Candle{}}
+}
+
+//SetPayload 设置数据
+func (m *CandleRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*ety.Candle); ok {
+		m.Candle = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (m *CandleRow) Get(key string) ([]byte, error) {
+	if key == "candle" {
+		return []byte(fmt.Sprintf("%s%016d", candlePrefix(m.LeftAsset, m.RightAsset, m.Period), m.Time)), nil
+	}
+	return nil, types.ErrNotFound
+}
@@ -15,4 +15,11 @@ var (
 	ErrDirection    = fmt.Errorf("%s", "The direction only 0 or 1!")
 	ErrStatus       = fmt.Errorf("%s", "The status only in  0 , 1, 2!")
 	ErrOrderID      = fmt.Errorf("%s", "Wrong OrderID!")
+	ErrFeeRate      = fmt.Errorf("%s", "The fee rate is not valid!")
+	ErrNoMatch      = fmt.Errorf("%s", "No order can be matched!")
+	ErrTimeInForce  = fmt.Errorf("%s", "The time in force is not valid!")
+	ErrExpireHeight = fmt.Errorf("%s", "The expire height is not valid!")
+	ErrNotFilled    = fmt.Errorf("%s", "The fill-or-kill order can't be filled completely!")
+	ErrPostOnly     = fmt.Errorf("%s", "The post-only order would be matched immediately!")
+	ErrPeriod       = fmt.Errorf("%s", "The candle period is not supported!")
 )
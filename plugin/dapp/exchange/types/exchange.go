@@ -27,6 +27,9 @@ const (
 	FuncNameQueryHistoryOrderList = "QueryHistoryOrderList"
 	FuncNameQueryOrder            = "QueryOrder"
 	FuncNameQueryOrderList        = "QueryOrderList"
+	FuncNameQueryTradeList        = "QueryTradeList"
+	FuncNameQueryCandleList       = "QueryCandleList"
+	FuncNameQueryExpiredOrderList = "QueryExpiredOrderList"
 )
 
 // log类型id值
@@ -50,6 +53,18 @@ const (
 	Revoked
 )
 
+//time in force
+const (
+	//GTC 一直有效直到成交或者撤单
+	GTC = iota
+	//IOC 立即成交，剩余未成交的部分撤销
+	IOC
+	//FOK 立即全部成交，否则交易失败
+	FOK
+	//PostOnly 只能作为挂单方，会立即成交时交易失败
+	PostOnly
+)
+
 //const
 const (
 	ListDESC = int32(0)
@@ -62,6 +77,27 @@ const (
 	Count = int32(10)
 	//系统最大撮合深度
 	MaxMatchCount = 100
+	//成交记录和K线单次最多返回条数
+	MaxQueryCount = int32(100)
+)
+
+//fork
+const (
+	//ForkFee 撮合成交时按交易对费率收取手续费
+	ForkFee = "ForkFee"
+	//ForkAVGPrice 买单多次成交时按成交数量加权计算成交均价
+	ForkAVGPrice = "ForkAVGPrice"
+	//ForkMarketOrder 支持市价单
+	ForkMarketOrder = "ForkMarketOrder"
+	//ForkSellAVGPrice 卖单多次成交时按成交数量加权计算成交均价
+	ForkSellAVGPrice = "ForkSellAVGPrice"
+	//ForkTimeInForce 限价单支持IOC、FOK、post-only和过期高度
+	ForkTimeInForce = "ForkTimeInForce"
+)
+
+var (
+	//CandlePeriods 支持的K线周期，单位秒，分别为1分钟、5分钟、1小时、1天
+	CandlePeriods = []int64{60, 300, 3600, 86400}
 )
 
 var (
@@ -93,6 +129,11 @@ func init() {
 // InitFork defines register fork
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ExchangeX, "Enable", 0)
+	cfg.RegisterDappFork(ExchangeX, ForkFee, 4600000)
+	cfg.RegisterDappFork(ExchangeX, ForkAVGPrice, 4600000)
+	cfg.RegisterDappFork(ExchangeX, ForkMarketOrder, 0)
+	cfg.RegisterDappFork(ExchangeX, ForkSellAVGPrice, 4600000)
+	cfg.RegisterDappFork(ExchangeX, ForkTimeInForce, 0)
 }
 
 // InitExecutor defines register executor
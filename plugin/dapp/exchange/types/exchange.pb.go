@@ -168,7 +168,12 @@ type LimitOrder struct {
 	//总量
 	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	//操作， 1为买，2为卖
-	Op                   int32    `protobuf:"varint,5,opt,name=op,proto3" json:"op,omitempty"`
+	Op int32 `protobuf:"varint,5,opt,name=op,proto3" json:"op,omitempty"`
+	//有效方式，0 GTC 一直有效直到成交或者撤单，1 IOC 立即成交剩余部分撤销，2 FOK 全部成交否则失败，3 post-only 只能作为挂单方
+	TimeInForce int32 `protobuf:"varint,6,opt,name=timeInForce,proto3" json:"timeInForce,omitempty"`
+	//过期高度，到达该高度后订单不再参与撮合，0表示不过期。过期挂单不会在该高度自动撤销，
+	//而是在之后的撮合中被撤销并解冻资金，或者由任意地址发起revokeOrder撤销，QueryExpiredOrderList可以查询已经过期的挂单
+	ExpireHeight         int64    `protobuf:"varint,7,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -234,6 +239,20 @@ func (m *LimitOrder) GetOp() int32 {
 	return 0
 }
 
+func (m *LimitOrder) GetTimeInForce() int32 {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
 //市价委托
 type MarketOrder struct {
 	//资产1
@@ -243,7 +262,9 @@ type MarketOrder struct {
 	//总量
 	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	//操作， 1为买，2为卖
-	Op                   int32    `protobuf:"varint,4,opt,name=op,proto3" json:"op,omitempty"`
+	Op int32 `protobuf:"varint,4,opt,name=op,proto3" json:"op,omitempty"`
+	//最差成交价格，买单为可接受的最高价，卖单为可接受的最低价，0表示不限制
+	WorstPrice           int64    `protobuf:"varint,5,opt,name=worstPrice,proto3" json:"worstPrice,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -302,6 +323,13 @@ func (m *MarketOrder) GetOp() int32 {
 	return 0
 }
 
+func (m *MarketOrder) GetWorstPrice() int64 {
+	if m != nil {
+		return m.WorstPrice
+	}
+	return 0
+}
+
 //撤回订单
 type RevokeOrder struct {
 	//订单号
@@ -413,7 +441,9 @@ type Order struct {
 	//更新时间
 	UpdateTime int64 `protobuf:"varint,10,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
 	//索引
-	Index                int64    `protobuf:"varint,11,opt,name=index,proto3" json:"index,omitempty"`
+	Index int64 `protobuf:"varint,11,opt,name=index,proto3" json:"index,omitempty"`
+	//累计支付的手续费，以成交后收到的资产计价，买单为leftAsset，卖单为rightAsset
+	Fee                  int64    `protobuf:"varint,12,opt,name=fee,proto3" json:"fee,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -544,6 +574,13 @@ func (m *Order) GetIndex() int64 {
 	return 0
 }
 
+func (m *Order) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Order) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -998,9 +1035,17 @@ func (m *OrderList) GetPrimaryKey() string {
 
 // exchange执行票据日志
 type ReceiptExchange struct {
-	Order                *Order   `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	MatchOrders          []*Order `protobuf:"bytes,2,rep,name=matchOrders,proto3" json:"matchOrders,omitempty"`
-	Index                int64    `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Order       *Order   `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	MatchOrders []*Order `protobuf:"bytes,2,rep,name=matchOrders,proto3" json:"matchOrders,omitempty"`
+	Index       int64    `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	//手续费收取地址
+	FeeAddr string `protobuf:"bytes,4,opt,name=feeAddr,proto3" json:"feeAddr,omitempty"`
+	//主动成交方(taker)本次支付的手续费
+	TakerFee int64 `protobuf:"varint,5,opt,name=takerFee,proto3" json:"takerFee,omitempty"`
+	//被动成交方(maker)本次支付的手续费，与matchOrders一一对应
+	MakerFees []int64 `protobuf:"varint,6,rep,packed,name=makerFees,proto3" json:"makerFees,omitempty"`
+	//撮合过程中自动撤销的过期挂单
+	RevokedOrders        []*Order `protobuf:"bytes,7,rep,name=revokedOrders,proto3" json:"revokedOrders,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1052,6 +1097,623 @@ func (m *ReceiptExchange) GetIndex() int64 {
 	return 0
 }
 
+func (m *ReceiptExchange) GetFeeAddr() string {
+	if m != nil {
+		return m.FeeAddr
+	}
+	return ""
+}
+
+func (m *ReceiptExchange) GetTakerFee() int64 {
+	if m != nil {
+		return m.TakerFee
+	}
+	return 0
+}
+
+func (m *ReceiptExchange) GetMakerFees() []int64 {
+	if m != nil {
+		return m.MakerFees
+	}
+	return nil
+}
+
+func (m *ReceiptExchange) GetRevokedOrders() []*Order {
+	if m != nil {
+		return m.RevokedOrders
+	}
+	return nil
+}
+
+// 成交记录
+type Trade struct {
+	//资产1
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//资产2
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//成交价格
+	Price int64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	//成交数量
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	//主动成交方(taker)的买卖方向， 1为买，2为卖
+	Op int32 `protobuf:"varint,5,opt,name=op,proto3" json:"op,omitempty"`
+	//主动成交方订单号
+	TakerOrderID int64 `protobuf:"varint,6,opt,name=takerOrderID,proto3" json:"takerOrderID,omitempty"`
+	//被动成交方订单号
+	MakerOrderID int64 `protobuf:"varint,7,opt,name=makerOrderID,proto3" json:"makerOrderID,omitempty"`
+	//区块高度
+	Height int64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	//成交时间
+	BlockTime int64 `protobuf:"varint,9,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	//索引
+	Index                int64    `protobuf:"varint,10,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{15}
+}
+
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Trade.Unmarshal(m, b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return xxx_messageInfo_Trade.Size(m)
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetLeftAsset() *Asset {
+	if m != nil {
+		return m.LeftAsset
+	}
+	return nil
+}
+
+func (m *Trade) GetRightAsset() *Asset {
+	if m != nil {
+		return m.RightAsset
+	}
+	return nil
+}
+
+func (m *Trade) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *Trade) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Trade) GetOp() int32 {
+	if m != nil {
+		return m.Op
+	}
+	return 0
+}
+
+func (m *Trade) GetTakerOrderID() int64 {
+	if m != nil {
+		return m.TakerOrderID
+	}
+	return 0
+}
+
+func (m *Trade) GetMakerOrderID() int64 {
+	if m != nil {
+		return m.MakerOrderID
+	}
+	return 0
+}
+
+func (m *Trade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Trade) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *Trade) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// K线
+type Candle struct {
+	//资产1
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//资产2
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//周期，单位秒
+	Period int64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	//周期开始时间
+	Time int64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	//开盘价
+	Open int64 `protobuf:"varint,5,opt,name=open,proto3" json:"open,omitempty"`
+	//最高价
+	High int64 `protobuf:"varint,6,opt,name=high,proto3" json:"high,omitempty"`
+	//最低价
+	Low int64 `protobuf:"varint,7,opt,name=low,proto3" json:"low,omitempty"`
+	//收盘价
+	Close int64 `protobuf:"varint,8,opt,name=close,proto3" json:"close,omitempty"`
+	//成交量，leftAsset的数量
+	Volume int64 `protobuf:"varint,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	//成交额，rightAsset的数量
+	Turnover int64 `protobuf:"varint,10,opt,name=turnover,proto3" json:"turnover,omitempty"`
+	//成交笔数
+	Count                int64    `protobuf:"varint,11,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{16}
+}
+
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Candle.Unmarshal(m, b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return xxx_messageInfo_Candle.Size(m)
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetLeftAsset() *Asset {
+	if m != nil {
+		return m.LeftAsset
+	}
+	return nil
+}
+
+func (m *Candle) GetRightAsset() *Asset {
+	if m != nil {
+		return m.RightAsset
+	}
+	return nil
+}
+
+func (m *Candle) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *Candle) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *Candle) GetOpen() int64 {
+	if m != nil {
+		return m.Open
+	}
+	return 0
+}
+
+func (m *Candle) GetHigh() int64 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
+func (m *Candle) GetLow() int64 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
+}
+
+func (m *Candle) GetClose() int64 {
+	if m != nil {
+		return m.Close
+	}
+	return 0
+}
+
+func (m *Candle) GetVolume() int64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *Candle) GetTurnover() int64 {
+	if m != nil {
+		return m.Turnover
+	}
+	return 0
+}
+
+func (m *Candle) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// 按时间范围查询成交记录，结果按时间倒序排列
+type QueryTradeList struct {
+	//资产1
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//资产2
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//开始时间，包含
+	StartTime int64 `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	//结束时间，包含，0表示不限制
+	EndTime int64 `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// 主键索引
+	PrimaryKey string `protobuf:"bytes,5,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	//单页返回多少条记录，默认返回10条,为了系统安全最多单次只能返回100条
+	Count                int32    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryTradeList) Reset()         { *m = QueryTradeList{} }
+func (m *QueryTradeList) String() string { return proto.CompactTextString(m) }
+func (*QueryTradeList) ProtoMessage()    {}
+func (*QueryTradeList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{17}
+}
+
+func (m *QueryTradeList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryTradeList.Unmarshal(m, b)
+}
+func (m *QueryTradeList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryTradeList.Marshal(b, m, deterministic)
+}
+func (m *QueryTradeList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradeList.Merge(m, src)
+}
+func (m *QueryTradeList) XXX_Size() int {
+	return xxx_messageInfo_QueryTradeList.Size(m)
+}
+func (m *QueryTradeList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradeList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradeList proto.InternalMessageInfo
+
+func (m *QueryTradeList) GetLeftAsset() *Asset {
+	if m != nil {
+		return m.LeftAsset
+	}
+	return nil
+}
+
+func (m *QueryTradeList) GetRightAsset() *Asset {
+	if m != nil {
+		return m.RightAsset
+	}
+	return nil
+}
+
+func (m *QueryTradeList) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryTradeList) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryTradeList) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *QueryTradeList) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// 成交记录列表
+type TradeList struct {
+	List                 []*Trade `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeList) Reset()         { *m = TradeList{} }
+func (m *TradeList) String() string { return proto.CompactTextString(m) }
+func (*TradeList) ProtoMessage()    {}
+func (*TradeList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{18}
+}
+
+func (m *TradeList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeList.Unmarshal(m, b)
+}
+func (m *TradeList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeList.Marshal(b, m, deterministic)
+}
+func (m *TradeList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeList.Merge(m, src)
+}
+func (m *TradeList) XXX_Size() int {
+	return xxx_messageInfo_TradeList.Size(m)
+}
+func (m *TradeList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeList proto.InternalMessageInfo
+
+func (m *TradeList) GetList() []*Trade {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+func (m *TradeList) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+// 按时间范围查询K线，结果按时间倒序排列
+type QueryCandleList struct {
+	//资产1
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//资产2
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//周期，单位秒，支持60、300、3600、86400
+	Period int64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	//开始时间，包含
+	StartTime int64 `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	//结束时间，包含，0表示不限制
+	EndTime int64 `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// 主键索引
+	PrimaryKey string `protobuf:"bytes,6,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	//单页返回多少条记录，默认返回10条,为了系统安全最多单次只能返回100条
+	Count                int32    `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryCandleList) Reset()         { *m = QueryCandleList{} }
+func (m *QueryCandleList) String() string { return proto.CompactTextString(m) }
+func (*QueryCandleList) ProtoMessage()    {}
+func (*QueryCandleList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{19}
+}
+
+func (m *QueryCandleList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryCandleList.Unmarshal(m, b)
+}
+func (m *QueryCandleList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryCandleList.Marshal(b, m, deterministic)
+}
+func (m *QueryCandleList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandleList.Merge(m, src)
+}
+func (m *QueryCandleList) XXX_Size() int {
+	return xxx_messageInfo_QueryCandleList.Size(m)
+}
+func (m *QueryCandleList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandleList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandleList proto.InternalMessageInfo
+
+func (m *QueryCandleList) GetLeftAsset() *Asset {
+	if m != nil {
+		return m.LeftAsset
+	}
+	return nil
+}
+
+func (m *QueryCandleList) GetRightAsset() *Asset {
+	if m != nil {
+		return m.RightAsset
+	}
+	return nil
+}
+
+func (m *QueryCandleList) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *QueryCandleList) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryCandleList) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryCandleList) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *QueryCandleList) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// K线列表
+type CandleList struct {
+	List                 []*Candle `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	PrimaryKey           string    `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CandleList) Reset()         { *m = CandleList{} }
+func (m *CandleList) String() string { return proto.CompactTextString(m) }
+func (*CandleList) ProtoMessage()    {}
+func (*CandleList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{20}
+}
+
+func (m *CandleList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandleList.Unmarshal(m, b)
+}
+func (m *CandleList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandleList.Marshal(b, m, deterministic)
+}
+func (m *CandleList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandleList.Merge(m, src)
+}
+func (m *CandleList) XXX_Size() int {
+	return xxx_messageInfo_CandleList.Size(m)
+}
+func (m *CandleList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandleList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandleList proto.InternalMessageInfo
+
+func (m *CandleList) GetList() []*Candle {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+func (m *CandleList) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+//查询到指定高度已经过期但仍在挂单中的订单，按过期高度升序排列
+type QueryExpiredOrderList struct {
+	//区块高度，0表示当前高度
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// 主键索引
+	PrimaryKey string `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	//单页返回多少条记录，默认返回10条,为了系统安全最多单次只能返回20条
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryExpiredOrderList) Reset()         { *m = QueryExpiredOrderList{} }
+func (m *QueryExpiredOrderList) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiredOrderList) ProtoMessage()    {}
+func (*QueryExpiredOrderList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{21}
+}
+
+func (m *QueryExpiredOrderList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryExpiredOrderList.Unmarshal(m, b)
+}
+func (m *QueryExpiredOrderList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryExpiredOrderList.Marshal(b, m, deterministic)
+}
+func (m *QueryExpiredOrderList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiredOrderList.Merge(m, src)
+}
+func (m *QueryExpiredOrderList) XXX_Size() int {
+	return xxx_messageInfo_QueryExpiredOrderList.Size(m)
+}
+func (m *QueryExpiredOrderList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiredOrderList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiredOrderList proto.InternalMessageInfo
+
+func (m *QueryExpiredOrderList) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryExpiredOrderList) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *QueryExpiredOrderList) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Exchange)(nil), "types.Exchange")
 	proto.RegisterType((*ExchangeAction)(nil), "types.ExchangeAction")
@@ -1068,6 +1730,13 @@ func init() {
 	proto.RegisterType((*QueryOrderList)(nil), "types.QueryOrderList")
 	proto.RegisterType((*OrderList)(nil), "types.OrderList")
 	proto.RegisterType((*ReceiptExchange)(nil), "types.ReceiptExchange")
+	proto.RegisterType((*Trade)(nil), "types.Trade")
+	proto.RegisterType((*Candle)(nil), "types.Candle")
+	proto.RegisterType((*QueryTradeList)(nil), "types.QueryTradeList")
+	proto.RegisterType((*TradeList)(nil), "types.TradeList")
+	proto.RegisterType((*QueryCandleList)(nil), "types.QueryCandleList")
+	proto.RegisterType((*CandleList)(nil), "types.CandleList")
+	proto.RegisterType((*QueryExpiredOrderList)(nil), "types.QueryExpiredOrderList")
 }
 
 func init() {
@@ -1075,49 +1744,72 @@ func init() {
 }
 
 var fileDescriptor_e0328a4f16f87ea1 = []byte{
-	// 667 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdd, 0x6e, 0xd3, 0x4a,
-	0x10, 0xae, 0xed, 0x38, 0xa9, 0x27, 0x47, 0xe9, 0x39, 0xab, 0x73, 0x8e, 0x2c, 0x40, 0xa8, 0xf2,
-	0x45, 0xa9, 0x10, 0xca, 0x45, 0x2b, 0xc1, 0x75, 0x50, 0x51, 0x8b, 0x68, 0x05, 0xac, 0x50, 0x25,
-	0xae, 0x90, 0x6b, 0x0f, 0xcd, 0xaa, 0x49, 0x6c, 0xad, 0x37, 0x55, 0x2d, 0x1e, 0x82, 0x3b, 0x9e,
-	0x00, 0x5e, 0x80, 0x77, 0xe0, 0x96, 0x27, 0xe0, 0x61, 0xd0, 0xce, 0xae, 0xe3, 0x4d, 0x5b, 0xd4,
-	0x0a, 0x94, 0x3b, 0x7f, 0xf3, 0xb3, 0xfe, 0x66, 0xe6, 0x9b, 0x5d, 0x18, 0xe0, 0x45, 0x36, 0x4e,
-	0x67, 0xa7, 0x38, 0x2c, 0x65, 0xa1, 0x0a, 0x16, 0xaa, 0xba, 0xc4, 0x2a, 0x01, 0x58, 0x7f, 0x66,
-	0x1d, 0xc9, 0x77, 0x0f, 0x06, 0x0d, 0x18, 0x65, 0x4a, 0x14, 0x33, 0xb6, 0x0b, 0x30, 0x11, 0x53,
-	0xa1, 0x5e, 0xca, 0x1c, 0x65, 0xec, 0x6d, 0x7a, 0xdb, 0xfd, 0x9d, 0x7f, 0x86, 0x94, 0x3a, 0x3c,
-	0x5c, 0x38, 0x0e, 0xd6, 0xb8, 0x13, 0xc6, 0x1e, 0x43, 0x7f, 0x9a, 0xca, 0x33, 0xb4, 0x59, 0x3e,
-	0x65, 0x31, 0x9b, 0x75, 0xd4, 0x7a, 0x0e, 0xd6, 0xb8, 0x1b, 0xa8, 0xf3, 0x24, 0x9e, 0x17, 0x67,
-	0x68, 0xf2, 0x82, 0xa5, 0x3c, 0xde, 0x7a, 0x74, 0x9e, 0x13, 0xc8, 0x06, 0xe0, 0xab, 0x3a, 0xee,
-	0x6e, 0x7a, 0xdb, 0x21, 0xf7, 0x55, 0xfd, 0xb4, 0x07, 0xe1, 0x79, 0x3a, 0x99, 0x63, 0xf2, 0xd9,
-	0x03, 0x68, 0x59, 0xb2, 0x87, 0x10, 0x4d, 0xf0, 0xbd, 0x1a, 0x55, 0x15, 0x2a, 0x5b, 0xcb, 0x5f,
-	0xf6, 0xf4, 0x54, 0xdb, 0x78, 0xeb, 0x66, 0x8f, 0x00, 0xa4, 0x38, 0x1d, 0xdb, 0x60, 0xff, 0x9a,
-	0x60, 0xc7, 0xcf, 0xfe, 0x85, 0xb0, 0x94, 0x22, 0x43, 0xe2, 0x1c, 0x70, 0x03, 0xd8, 0xff, 0xd0,
-	0x4d, 0xa7, 0xc5, 0x7c, 0xa6, 0xe2, 0x0e, 0x99, 0x2d, 0xd2, 0x7c, 0x8b, 0x32, 0x0e, 0x0d, 0xdf,
-	0xa2, 0x4c, 0x3e, 0x7a, 0xd0, 0x77, 0xda, 0xb2, 0x42, 0x9e, 0x2d, 0xa3, 0xe0, 0x1a, 0x46, 0x9d,
-	0x05, 0xa3, 0x07, 0xd0, 0x77, 0xfa, 0xcd, 0x62, 0xe8, 0x15, 0xfa, 0xe3, 0xf9, 0x1e, 0xd1, 0x09,
-	0x78, 0x03, 0x93, 0x27, 0x10, 0xa6, 0xcd, 0xc9, 0x78, 0x81, 0x99, 0x15, 0x49, 0xc4, 0x2d, 0xd2,
-	0xf6, 0xaa, 0x9e, 0x9e, 0x14, 0x13, 0xe2, 0x16, 0x71, 0x8b, 0x92, 0x1f, 0x3e, 0x84, 0x37, 0x1c,
-	0x7e, 0x49, 0x7c, 0xfe, 0x6f, 0x89, 0x2f, 0xb8, 0xad, 0xf8, 0x8c, 0x88, 0x3a, 0x8d, 0x88, 0xd8,
-	0x1d, 0x58, 0xd7, 0x25, 0xcc, 0x15, 0xe6, 0x34, 0xaa, 0x80, 0x2f, 0x30, 0xbb, 0x0b, 0xd1, 0xe8,
-	0x78, 0xff, 0x9d, 0x19, 0x79, 0xd7, 0x38, 0x47, 0xc7, 0xfb, 0xaf, 0x68, 0xea, 0x31, 0xf4, 0x4e,
-	0xd2, 0x49, 0x3a, 0xcb, 0x30, 0xee, 0x99, 0x7a, 0x2c, 0xa4, 0x5e, 0xa8, 0x54, 0xcd, 0xab, 0x78,
-	0x9d, 0x7e, 0x63, 0x11, 0x63, 0xd0, 0x49, 0xf3, 0x5c, 0xc6, 0x11, 0x75, 0x88, 0xbe, 0xd9, 0x7d,
-	0x80, 0x79, 0x99, 0xa7, 0x0a, 0xdf, 0x88, 0x29, 0xc6, 0x40, 0x07, 0x39, 0x16, 0xad, 0x38, 0x31,
-	0xcb, 0xf1, 0x22, 0xee, 0x1b, 0xc5, 0x11, 0x68, 0x95, 0xff, 0xd5, 0x83, 0xbf, 0x5f, 0xcf, 0x51,
-	0xd6, 0xa6, 0xe2, 0x3d, 0x2c, 0xd5, 0x78, 0x85, 0xba, 0x32, 0xfa, 0x09, 0x1a, 0xfd, 0x68, 0xf6,
-	0xa5, 0x14, 0xd3, 0x54, 0xd6, 0x2f, 0xd0, 0x34, 0x35, 0xe2, 0x8e, 0x45, 0xb3, 0xcf, 0x48, 0x86,
-	0x66, 0x09, 0x0c, 0x48, 0xbe, 0x2c, 0xf6, 0x60, 0xd5, 0x7c, 0xff, 0x6c, 0x5f, 0xdf, 0xc2, 0x86,
-	0x43, 0xf3, 0x50, 0x54, 0x8a, 0x6d, 0x41, 0x67, 0x22, 0x2a, 0xcd, 0x32, 0xb8, 0x22, 0x37, 0x8a,
-	0xe2, 0xe4, 0xbf, 0xd4, 0x18, 0xff, 0x72, 0x63, 0x92, 0x6f, 0x1e, 0xfc, 0x47, 0x73, 0x3b, 0x10,
-	0x95, 0x2a, 0x64, 0x4d, 0xda, 0xa4, 0x3f, 0xac, 0xae, 0x19, 0xcb, 0x9c, 0x82, 0x5f, 0x0f, 0xab,
-	0xe3, 0x0c, 0x8b, 0xdd, 0x83, 0x28, 0x17, 0x12, 0xe9, 0x99, 0xb0, 0xbd, 0x69, 0x0d, 0xc9, 0x16,
-	0x00, 0x95, 0x71, 0xd3, 0xfd, 0xf1, 0xc9, 0x83, 0x41, 0x1b, 0x48, 0x85, 0xb6, 0x5b, 0xe2, 0x2d,
-	0x6d, 0x49, 0x0c, 0x3d, 0xbd, 0x19, 0x58, 0x55, 0xb6, 0x6f, 0x0d, 0x5c, 0x49, 0x01, 0x47, 0x10,
-	0xb5, 0x94, 0x36, 0x97, 0xa6, 0xdb, 0x74, 0x92, 0xfc, 0xb7, 0x9c, 0xeb, 0x07, 0xd8, 0xe0, 0x98,
-	0xa1, 0x28, 0x55, 0xf3, 0xc0, 0xb2, 0x04, 0xc2, 0xc2, 0x79, 0x55, 0x97, 0x4f, 0x35, 0x2e, 0x36,
-	0xd4, 0x97, 0x99, 0xca, 0xc6, 0x64, 0xd4, 0x75, 0x5f, 0xfd, 0xbf, 0x1b, 0xd0, 0xde, 0x0a, 0x81,
-	0x73, 0x2b, 0xec, 0x80, 0xbe, 0xca, 0xcc, 0x5f, 0x4f, 0xba, 0xf4, 0xfa, 0xef, 0xfe, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xf2, 0x3c, 0xac, 0xed, 0x0f, 0x08, 0x00, 0x00,
+	// 1030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6e, 0xe4, 0x44,
+	0x10, 0x5e, 0xdb, 0xe3, 0x99, 0xb8, 0x26, 0x9b, 0x2c, 0x2d, 0x40, 0x16, 0xac, 0xd0, 0xe0, 0xc3,
+	0x12, 0x21, 0x94, 0x43, 0x56, 0x82, 0xf3, 0xc0, 0xfe, 0x64, 0xc5, 0x46, 0x01, 0x2b, 0x5a, 0x89,
+	0x13, 0x72, 0xec, 0x4a, 0xc6, 0x8a, 0xc7, 0x6d, 0xb5, 0x7b, 0xb2, 0x99, 0x33, 0xef, 0xc0, 0x1b,
+	0x20, 0x71, 0x43, 0x82, 0x67, 0xe0, 0xca, 0x99, 0x67, 0xe0, 0x80, 0xc4, 0x1b, 0xa0, 0xae, 0x6e,
+	0x4f, 0xb7, 0xb3, 0xf9, 0x13, 0x68, 0xa4, 0xbd, 0x75, 0xfd, 0xb4, 0xbb, 0xea, 0xeb, 0xaf, 0xaa,
+	0xda, 0xb0, 0x85, 0x17, 0xf9, 0x2c, 0xab, 0x4f, 0x71, 0xb7, 0x11, 0x5c, 0x72, 0x16, 0xca, 0x65,
+	0x83, 0x6d, 0x02, 0xb0, 0xf1, 0xd4, 0x18, 0x92, 0x3f, 0x3c, 0xd8, 0xea, 0x84, 0x69, 0x2e, 0x4b,
+	0x5e, 0xb3, 0xc7, 0x00, 0x55, 0x39, 0x2f, 0xe5, 0xa1, 0x28, 0x50, 0xc4, 0xde, 0xc4, 0xdb, 0x19,
+	0xef, 0xbd, 0xb3, 0x4b, 0x5b, 0x77, 0x5f, 0xae, 0x0c, 0xfb, 0xf7, 0x52, 0xc7, 0x8d, 0x7d, 0x0e,
+	0xe3, 0x79, 0x26, 0xce, 0xd0, 0xec, 0xf2, 0x69, 0x17, 0x33, 0xbb, 0x0e, 0xac, 0x65, 0xff, 0x5e,
+	0xea, 0x3a, 0xaa, 0x7d, 0x02, 0xcf, 0xf9, 0x19, 0xea, 0x7d, 0x41, 0x6f, 0x5f, 0x6a, 0x2d, 0x6a,
+	0x9f, 0xe3, 0xc8, 0xb6, 0xc0, 0x97, 0xcb, 0x78, 0x38, 0xf1, 0x76, 0xc2, 0xd4, 0x97, 0xcb, 0x2f,
+	0x47, 0x10, 0x9e, 0x67, 0xd5, 0x02, 0x93, 0xbf, 0x3c, 0x00, 0x1b, 0x25, 0xfb, 0x14, 0xa2, 0x0a,
+	0x4f, 0xe4, 0xb4, 0x6d, 0x51, 0x9a, 0x5c, 0x36, 0xcd, 0xd7, 0x33, 0xa5, 0x4b, 0xad, 0x99, 0x7d,
+	0x06, 0x20, 0xca, 0xd3, 0x99, 0x71, 0xf6, 0xaf, 0x70, 0x76, 0xec, 0xec, 0x5d, 0x08, 0x1b, 0x51,
+	0xe6, 0x48, 0x31, 0x07, 0xa9, 0x16, 0xd8, 0xfb, 0x30, 0xcc, 0xe6, 0x7c, 0x51, 0xcb, 0x78, 0x40,
+	0x6a, 0x23, 0xa9, 0x78, 0x79, 0x13, 0x87, 0x3a, 0x5e, 0xde, 0xb0, 0x09, 0x8c, 0x65, 0x39, 0xc7,
+	0x17, 0xf5, 0x33, 0x2e, 0x72, 0x34, 0x89, 0xb8, 0x2a, 0x96, 0xc0, 0x26, 0x5e, 0x34, 0xa5, 0xc0,
+	0x7d, 0x54, 0x87, 0xc6, 0x23, 0xfa, 0x5e, 0x4f, 0x97, 0xfc, 0xe2, 0xc1, 0xd8, 0x01, 0x77, 0x8d,
+	0xd9, 0xda, 0xbc, 0x82, 0x2b, 0xf2, 0x1a, 0xac, 0xf2, 0xfa, 0x08, 0xe0, 0x35, 0x17, 0xad, 0xfc,
+	0x86, 0xa0, 0x09, 0xc9, 0xd7, 0xd1, 0x24, 0x9f, 0xc0, 0xd8, 0xb9, 0x55, 0x16, 0xc3, 0x88, 0xab,
+	0xc5, 0x8b, 0x27, 0x14, 0x6e, 0x90, 0x76, 0x62, 0xf2, 0x05, 0x84, 0x59, 0x77, 0x32, 0x5e, 0x60,
+	0x6e, 0xa8, 0x18, 0xa5, 0x46, 0x52, 0xfa, 0x76, 0x39, 0x3f, 0xe6, 0x15, 0xc5, 0x1e, 0xa5, 0x46,
+	0x4a, 0xfe, 0xf6, 0x21, 0xbc, 0xe5, 0xe3, 0x97, 0x28, 0xee, 0xff, 0x27, 0x8a, 0x07, 0x77, 0xa5,
+	0xb8, 0xa6, 0xea, 0xa0, 0xa3, 0x2a, 0xfb, 0x00, 0x36, 0x54, 0x0a, 0x0b, 0x89, 0x85, 0x01, 0x68,
+	0x25, 0xb3, 0x0f, 0x21, 0x9a, 0xbe, 0x7a, 0xfe, 0xbd, 0x26, 0xd6, 0x50, 0x1b, 0xa7, 0xaf, 0x9e,
+	0x13, 0x76, 0x2a, 0x9f, 0xe3, 0xac, 0xca, 0xea, 0x1c, 0x0d, 0x19, 0x3a, 0x91, 0xb0, 0x90, 0x99,
+	0x5c, 0xb4, 0xf1, 0x06, 0x1d, 0x63, 0x24, 0xc6, 0x60, 0x90, 0x15, 0x85, 0x88, 0x23, 0x42, 0x88,
+	0xd6, 0xea, 0x86, 0x16, 0x4d, 0x91, 0x49, 0x3c, 0x2a, 0xe7, 0x18, 0x83, 0xbe, 0x21, 0xab, 0x51,
+	0xbc, 0x2e, 0xeb, 0x02, 0x2f, 0xe2, 0xb1, 0xe6, 0x35, 0x09, 0xec, 0x01, 0x04, 0x27, 0x88, 0xf1,
+	0x26, 0xe9, 0xd4, 0xd2, 0x56, 0xdc, 0xaf, 0x1e, 0x3c, 0xf8, 0x76, 0x81, 0x62, 0xa9, 0x31, 0x78,
+	0x82, 0x8d, 0x9c, 0xad, 0x91, 0x89, 0x9a, 0x71, 0x81, 0xcb, 0xb8, 0x46, 0x94, 0xf3, 0x4c, 0x2c,
+	0xbf, 0x46, 0x0d, 0x73, 0x94, 0x3a, 0x1a, 0x95, 0x4f, 0x4e, 0xc4, 0xd5, 0xc5, 0xa7, 0x85, 0xe4,
+	0xa7, 0x55, 0xe5, 0xac, 0x3b, 0xde, 0xff, 0xd5, 0x27, 0x92, 0xef, 0x60, 0xdb, 0x09, 0xf3, 0x65,
+	0xd9, 0x4a, 0xf6, 0x08, 0x06, 0x55, 0xd9, 0xaa, 0x28, 0x83, 0x37, 0x08, 0x48, 0x5e, 0x29, 0xd9,
+	0x2f, 0x01, 0xe3, 0x5f, 0x06, 0x26, 0xf9, 0xdd, 0x83, 0xf7, 0xe8, 0xde, 0xf6, 0xcb, 0x56, 0x72,
+	0xb1, 0x24, 0xb6, 0xd2, 0x09, 0xeb, 0x03, 0xa3, 0x1f, 0x53, 0x70, 0xfd, 0x65, 0x0d, 0x9c, 0xcb,
+	0x62, 0x0f, 0x21, 0x2a, 0x4a, 0x81, 0x34, 0x9e, 0x0c, 0x36, 0x56, 0x91, 0x3c, 0x02, 0xa0, 0x34,
+	0x6e, 0xeb, 0x28, 0x3f, 0x7a, 0xb0, 0x65, 0x1d, 0x29, 0x51, 0x5b, 0x37, 0x5e, 0xaf, 0x6e, 0x62,
+	0x18, 0xa9, 0x5a, 0xc1, 0xb6, 0x35, 0xb8, 0x75, 0xe2, 0x5a, 0x12, 0x38, 0x80, 0xc8, 0x86, 0x34,
+	0xe9, 0xdd, 0x6e, 0x87, 0x24, 0xd9, 0xef, 0x78, 0xaf, 0x3f, 0xf8, 0xb0, 0x9d, 0x62, 0x8e, 0x65,
+	0x23, 0xbb, 0xc9, 0xce, 0x12, 0x08, 0xb9, 0x33, 0xce, 0xfb, 0x9f, 0xd5, 0x26, 0xb6, 0xab, 0xfa,
+	0x9b, 0xcc, 0x67, 0xa4, 0x54, 0x89, 0xbf, 0x19, 0x80, 0xeb, 0x60, 0x1b, 0x45, 0xe0, 0x36, 0x8a,
+	0x18, 0x46, 0x27, 0x88, 0x53, 0xd5, 0x75, 0x74, 0x2d, 0x76, 0xa2, 0xea, 0x7b, 0x32, 0x3b, 0x43,
+	0xf1, 0x0c, 0xbb, 0xc1, 0xb0, 0x92, 0x15, 0x40, 0x73, 0xb3, 0x6e, 0xe3, 0xe1, 0x24, 0xd8, 0x09,
+	0x52, 0xab, 0x60, 0x7b, 0x70, 0x5f, 0xcf, 0xfe, 0xc2, 0xc4, 0x36, 0xba, 0x22, 0xb6, 0xbe, 0x4b,
+	0xf2, 0x9b, 0x0f, 0xe1, 0x91, 0xc8, 0x0a, 0x7c, 0x6b, 0x9f, 0x00, 0x09, 0x6c, 0x52, 0xfe, 0x87,
+	0x86, 0xae, 0xba, 0xdd, 0xf7, 0x74, 0xca, 0x67, 0xee, 0xfa, 0x98, 0x47, 0x80, 0xab, 0x53, 0xe7,
+	0xcd, 0xf4, 0x13, 0x61, 0x43, 0x9f, 0xa7, 0x25, 0x85, 0xe9, 0x71, 0xc5, 0xf3, 0x33, 0xea, 0xf3,
+	0x11, 0x99, 0xac, 0xc2, 0xde, 0x1e, 0x38, 0xb7, 0x97, 0xfc, 0xec, 0xc3, 0xf0, 0xab, 0xac, 0x2e,
+	0x2a, 0x5c, 0xef, 0x5b, 0xa2, 0x41, 0x51, 0xf2, 0xa2, 0x7b, 0x4b, 0x68, 0x49, 0x4d, 0x2b, 0xf5,
+	0x00, 0x32, 0xb0, 0xd1, 0x5a, 0xe9, 0x78, 0x83, 0xb5, 0x21, 0x0c, 0xad, 0x95, 0x6e, 0x56, 0x9e,
+	0xce, 0x0c, 0x60, 0xb4, 0x56, 0xf3, 0xa9, 0xe2, 0xaf, 0x0d, 0x3e, 0x6a, 0x49, 0x95, 0x58, 0xf1,
+	0x16, 0x0d, 0x2a, 0x5a, 0x50, 0x67, 0x9f, 0xf3, 0x6a, 0xb1, 0x42, 0xc4, 0x48, 0x44, 0xce, 0x85,
+	0xa8, 0xf9, 0x39, 0x0a, 0x83, 0xc8, 0x4a, 0xb6, 0x35, 0x6d, 0x26, 0x22, 0x09, 0xc9, 0x9f, 0x5d,
+	0x3b, 0x21, 0x96, 0xad, 0xb9, 0x6f, 0x3e, 0x84, 0xa8, 0x95, 0x99, 0x90, 0x74, 0x97, 0x1a, 0x35,
+	0xab, 0x50, 0x35, 0x87, 0x75, 0x71, 0x64, 0xb1, 0xeb, 0xc4, 0x4b, 0xbd, 0x22, 0xbc, 0xbe, 0x5d,
+	0x0d, 0xdd, 0xe1, 0x78, 0x00, 0x91, 0x4d, 0xea, 0xea, 0x86, 0x44, 0xf6, 0x3b, 0x36, 0xa4, 0x7f,
+	0x3c, 0xd8, 0x26, 0xa4, 0x34, 0xb3, 0xd6, 0x0c, 0xd5, 0x75, 0xec, 0xea, 0x41, 0x38, 0xb8, 0x01,
+	0xc2, 0xf0, 0x26, 0x08, 0x87, 0xd7, 0x43, 0x38, 0x72, 0x21, 0x3c, 0x04, 0x70, 0xb2, 0xfd, 0xb8,
+	0x87, 0xe1, 0x7d, 0x13, 0xbb, 0x76, 0xb8, 0x23, 0x88, 0x68, 0x86, 0xf5, 0x53, 0x7a, 0xff, 0x17,
+	0xbd, 0x19, 0x66, 0xca, 0xdf, 0xeb, 0x95, 0xff, 0x2d, 0x1f, 0xb4, 0x71, 0x07, 0x4e, 0xdc, 0x7b,
+	0xa0, 0x1e, 0xa7, 0x7a, 0x68, 0x1c, 0x0f, 0xe9, 0xaf, 0xf1, 0xf1, 0xbf, 0x03, 0x00, 0xe5, 0xa3,
+	0x2a, 0x96, 0x47, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
@@ -81,6 +82,8 @@ func MultiSigTxCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		CreateMultiSigConfirmTxCmd(),
+		CreateMultiSigQueuedTxOperateCmd(),
+		CreateMultiSigExecCallCmd(),
 		CreateMultiSigAccTransferInCmd(),
 		CreateMultiSigAccTransferOutCmd(),
 		GetMultiSigAccTxCountCmd(),
@@ -217,6 +220,7 @@ func createOwnerAddTransferFlags(cmd *cobra.Command) {
 	cmd.Flags().Uint64P("owner_weight", "w", 0, "weight of owner")
 	cmd.MarkFlagRequired("owner_weight")
 
+	addTxTimelockFlags(cmd)
 }
 
 func createOwnerAddTransfer(cmd *cobra.Command, args []string) {
@@ -225,11 +229,14 @@ func createOwnerAddTransfer(cmd *cobra.Command, args []string) {
 	ownerAddr, _ := cmd.Flags().GetString("owner_addr")
 	ownerWeight, _ := cmd.Flags().GetUint64("owner_weight")
 
+	expireHeight, execDelay := getTxTimelockFlags(cmd)
 	params := &mty.MultiSigOwnerOperate{
 		MultiSigAccAddr: multiSigAddr,
 		NewOwner:        ownerAddr,
 		NewWeight:       ownerWeight,
 		OperateFlag:     mty.OwnerAdd,
+		ExpireHeight:    expireHeight,
+		ExecDelay:       execDelay,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigOwnerOperateTx", params, &res)
@@ -254,6 +261,8 @@ func createOwnerDelTransferFlags(cmd *cobra.Command) {
 
 	cmd.Flags().StringP("owner_addr", "o", "", "address of owner")
 	cmd.MarkFlagRequired("owner_addr")
+
+	addTxTimelockFlags(cmd)
 }
 
 func createOwnerDelTransfer(cmd *cobra.Command, args []string) {
@@ -261,10 +270,13 @@ func createOwnerDelTransfer(cmd *cobra.Command, args []string) {
 	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
 	ownerAddr, _ := cmd.Flags().GetString("owner_addr")
 
+	expireHeight, execDelay := getTxTimelockFlags(cmd)
 	params := &mty.MultiSigOwnerOperate{
 		MultiSigAccAddr: multiSigAddr,
 		OldOwner:        ownerAddr,
 		OperateFlag:     mty.OwnerDel,
+		ExpireHeight:    expireHeight,
+		ExecDelay:       execDelay,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigOwnerOperateTx", params, &res)
@@ -290,6 +302,8 @@ func createOwnerModifyTransferFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("owner_addr")
 	cmd.Flags().Uint64P("owner_weight", "w", 0, "new weight of owner")
 	cmd.MarkFlagRequired("owner_weight")
+
+	addTxTimelockFlags(cmd)
 }
 
 func createOwnerModifyTransfer(cmd *cobra.Command, args []string) {
@@ -298,11 +312,14 @@ func createOwnerModifyTransfer(cmd *cobra.Command, args []string) {
 	ownerAddr, _ := cmd.Flags().GetString("owner_addr")
 	ownerWeight, _ := cmd.Flags().GetUint64("owner_weight")
 
+	expireHeight, execDelay := getTxTimelockFlags(cmd)
 	params := &mty.MultiSigOwnerOperate{
 		MultiSigAccAddr: multiSigAddr,
 		OldOwner:        ownerAddr,
 		NewWeight:       ownerWeight,
 		OperateFlag:     mty.OwnerModify,
+		ExpireHeight:    expireHeight,
+		ExecDelay:       execDelay,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigOwnerOperateTx", params, &res)
@@ -328,6 +345,8 @@ func createOwnerReplaceTransferFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("owner_addr")
 	cmd.Flags().StringP("new_owner", "n", "", "address of new owner")
 	cmd.MarkFlagRequired("new_owner")
+
+	addTxTimelockFlags(cmd)
 }
 
 func createOwnerReplaceTransfer(cmd *cobra.Command, args []string) {
@@ -336,11 +355,14 @@ func createOwnerReplaceTransfer(cmd *cobra.Command, args []string) {
 	ownerAddr, _ := cmd.Flags().GetString("owner_addr")
 	newOwner, _ := cmd.Flags().GetString("new_owner")
 
+	expireHeight, execDelay := getTxTimelockFlags(cmd)
 	params := &mty.MultiSigOwnerOperate{
 		MultiSigAccAddr: multiSigAddr,
 		OldOwner:        ownerAddr,
 		NewOwner:        newOwner,
 		OperateFlag:     mty.OwnerReplace,
+		ExpireHeight:    expireHeight,
+		ExecDelay:       execDelay,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigOwnerOperateTx", params, &res)
@@ -363,6 +385,8 @@ func createMultiSigAccWeightModifyTransferFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
 	cmd.MarkFlagRequired("multisig_addr")
 	cmd.Flags().Uint64P("weight", "w", 0, "new required weight of multisig account ")
+
+	addTxTimelockFlags(cmd)
 }
 
 func createMultiSigAccWeightModifyTransfer(cmd *cobra.Command, args []string) {
@@ -370,10 +394,13 @@ func createMultiSigAccWeightModifyTransfer(cmd *cobra.Command, args []string) {
 	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
 	weight, _ := cmd.Flags().GetUint64("weight")
 
+	expireHeight, execDelay := getTxTimelockFlags(cmd)
 	params := &mty.MultiSigAccOperate{
 		MultiSigAccAddr:   multiSigAddr,
 		NewRequiredWeight: weight,
 		OperateFlag:       mty.AccWeightOp,
+		ExpireHeight:      expireHeight,
+		ExecDelay:         execDelay,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccOperateTx", params, &res)
@@ -404,6 +431,8 @@ func createMultiSigAccDailyLimitModifyTransferFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Float64P("daily_limit", "d", 0, "daily_limit of assets ")
 	cmd.MarkFlagRequired("daily_limit")
+
+	addTxTimelockFlags(cmd)
 }
 
 func createMultiSigAccDailyLimitModifyTransfer(cmd *cobra.Command, args []string) {
@@ -423,10 +452,13 @@ func createMultiSigAccDailyLimitModifyTransfer(cmd *cobra.Command, args []string
 		Execer:     execer,
 		DailyLimit: uint64(math.Trunc((dailylimit+0.0000001)*1e4)) * 1e4,
 	}
+	expireHeight, execDelay := getTxTimelockFlags(cmd)
 	params := &mty.MultiSigAccOperate{
 		MultiSigAccAddr: multiSigAddr,
 		DailyLimit:      assetsDailyLimit,
 		OperateFlag:     mty.AccDailyLimitOp,
+		ExpireHeight:    expireHeight,
+		ExecDelay:       execDelay,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccOperateTx", params, &res)
@@ -478,6 +510,115 @@ func createMultiSigConfirmTransfer(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateMultiSigQueuedTxOperateCmd create raw MultiSigQueuedTxOperate transaction
+func CreateMultiSigQueuedTxOperateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued",
+		Short: "Create a execute or veto transaction of the queued tx",
+		Run:   createMultiSigQueuedTxOperate,
+	}
+	createMultiSigQueuedTxOperateFlags(cmd)
+	return cmd
+}
+
+func createMultiSigQueuedTxOperateFlags(cmd *cobra.Command) {
+
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().Uint64P("txid", "i", 0, "txid of  multisig transaction")
+	cmd.MarkFlagRequired("txid")
+
+	cmd.Flags().StringP("execute_or_veto", "c", "t", "whether execute or veto tx (0/f/false for No; 1/t/true for Yes)")
+
+}
+
+func createMultiSigQueuedTxOperate(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+
+	executeOrVeto, _ := cmd.Flags().GetString("execute_or_veto")
+	executeOrVetoBool, err := strconv.ParseBool(executeOrVeto)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	params := &mty.MultiSigQueuedTxOperate{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+		ExecuteOrVeto:   executeOrVetoBool,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigQueuedTxOperateTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateMultiSigExecCallCmd create raw MultiSigExecCall transaction
+func CreateMultiSigExecCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec_call",
+		Short: "Create a transaction calling other executor as the multisig account",
+		Run:   createMultiSigExecCall,
+	}
+	createMultiSigExecCallFlags(cmd)
+	return cmd
+}
+
+func createMultiSigExecCallFlags(cmd *cobra.Command) {
+
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().StringP("data", "d", "", "unsigned raw transaction of the called executor, only execer and payload are used")
+	cmd.MarkFlagRequired("data")
+
+	addTxTimelockFlags(cmd)
+}
+
+func createMultiSigExecCall(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	data, _ := cmd.Flags().GetString("data")
+
+	txBytes, err := common.FromHex(data)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var tx types.Transaction
+	err = types.Decode(txBytes, &tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	expireHeight, execDelay := getTxTimelockFlags(cmd)
+	params := &mty.MultiSigExecCall{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          string(tx.Execer),
+		Payload:         tx.Payload,
+		ExpireHeight:    expireHeight,
+		ExecDelay:       execDelay,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigExecCallTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+//提交交易时可以设置交易的过期高度以及权重满足之后延迟执行的区块数
+func addTxTimelockFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64("expire_height", 0, "block height after which the tx can not be confirmed, 0 means never expire")
+	cmd.Flags().Int64("exec_delay", 0, "number of blocks the tx waits in queue after reaching the required weight")
+}
+
+func getTxTimelockFlags(cmd *cobra.Command) (int64, int64) {
+	expireHeight, _ := cmd.Flags().GetInt64("expire_height")
+	execDelay, _ := cmd.Flags().GetInt64("exec_delay")
+	return expireHeight, execDelay
+}
+
 // CreateMultiSigAccTransferInCmd create raw MultiSigAccTransferInCmd transaction
 func CreateMultiSigAccTransferInCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -558,6 +699,8 @@ func createMultiSigAccTransferOutFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
 	cmd.MarkFlagRequired("amount")
+
+	addTxTimelockFlags(cmd)
 }
 
 func createMultiSigAccTransferOut(cmd *cobra.Command, args []string) {
@@ -573,13 +716,16 @@ func createMultiSigAccTransferOut(cmd *cobra.Command, args []string) {
 		fmt.Fprintln(os.Stderr, types.ErrAmount)
 		return
 	}
+	expireHeight, execDelay := getTxTimelockFlags(cmd)
 	params := &mty.MultiSigExecTransferFrom{
-		Symbol:   symbol,
-		Amount:   int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4,
-		Note:     note,
-		Execname: execer,
-		From:     from,
-		To:       to,
+		Symbol:       symbol,
+		Amount:       int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4,
+		Note:         note,
+		Execname:     execer,
+		From:         from,
+		To:           to,
+		ExpireHeight: expireHeight,
+		ExecDelay:    execDelay,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccTransferOutTx", params, &res)
@@ -783,6 +929,12 @@ func getMultiSigTxidsCmdFlags(cmd *cobra.Command) {
 
 	cmd.Flags().StringP("executed", "x", "t", "whether executed tx (0/f/false for No; 1/t/true for Yes)")
 
+	cmd.Flags().String("expired", "t", "whether expired tx (0/f/false for No; 1/t/true for Yes)")
+
+	cmd.Flags().String("queued", "t", "whether queued tx (0/f/false for No; 1/t/true for Yes)")
+
+	cmd.Flags().String("vetoed", "t", "whether vetoed tx (0/f/false for No; 1/t/true for Yes)")
+
 }
 
 func getMultiSigTxids(cmd *cobra.Command, args []string) {
@@ -810,12 +962,26 @@ func getMultiSigTxids(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	var status []bool
+	for _, name := range []string{"expired", "queued", "vetoed"} {
+		value, _ := cmd.Flags().GetString(name)
+		valueBool, err := strconv.ParseBool(value)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		status = append(status, valueBool)
+	}
+
 	req := mty.ReqMultiSigTxids{
 		MultiSigAddr: addr,
 		FromTxId:     start,
 		ToTxId:       end,
 		Pending:      pendingBool,
 		Executed:     executedBool,
+		Expired:      status[0],
+		Queued:       status[1],
+		Vetoed:       status[2],
 	}
 
 	var params rpctypes.Query4Jrpc
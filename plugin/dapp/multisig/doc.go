@@ -50,11 +50,22 @@ Available Commands:
   confirm          Create a confirm transaction
   confirmed_weight get the weight of the transaction confirmed.
   count            get multisig tx count
+  exec_call        Create a transaction calling other executor as the multisig account
   info             get multisig account tx info
+  queued           Create a execute or veto transaction of the queued tx
   transfer_in      Create a transfer to multisig account transaction
   transfer_out     Create a transfer from multisig account transaction
   txids            get multisig txids
 
+多重签名账户可以通过exec_call提交调用其他合约的交易，权重满足后由multisig合约以多重签名地址作为发起地址执行：
+	1. 被调用合约需要实现MultiSigCallee接口的ExecMultiSig，用传入的多重签名地址代替tx.From()，
+	   并通过IsFriend允许multisig交易修改自己的状态数据
+	2. 被调用合约的回执合并到multisig交易的回执中，执行失败时整个交易失败，提案保持未执行状态
+	3. localdb只允许写入本合约前缀的key，被调用合约的ExecLocal不会执行，所以ExecMultiSig只能支持不需要维护localdb索引的交易，
+	   需要维护索引的交易必须返回ErrActionNotSupport
+	token、exchange、autonomy等合约的交易都会更新各自的localdb索引，目前都没有实现MultiSigCallee，
+	多重签名账户仍然只能通过transfer_out转出资产后由其他地址操作这些合约
+
 
 测试步骤如下：
 cli seed save -p heyubin1234 -s "voice leisure mechanic tape cluster grunt receive joke nurse between monkey lunch save useful cruise"
@@ -195,6 +206,22 @@ cli send multisig tx confirm  -a "13q53Ga1kquDCqx7EWF8FU94tLUK18Zd47" -i 8 -k "1
 
 cli send multisig tx confirm  -a "13q53Ga1kquDCqx7EWF8FU94tLUK18Zd47" -i 8 -k "1C5xK2ytuoFqxmVGMcyz9XFKFWcDA8T3rK"
 
+第六步：测试交易的过期和延迟执行
+//提交交易时指定过期高度和延迟执行的区块数，过期之后交易不能再被确认，权重满足之后交易进入排队状态
+cli send multisig  tx transfer_out  -a 10 -e coins -s BTY -f "13q53Ga1kquDCqx7EWF8FU94tLUK18Zd47" -t 1LDGrokrZjo1HtSmSnw8ef3oy5Vm1nctbj -n test --expire_height 2000 --exec_delay 100 -k "166po3ghRbRu53hu8jBBQzddp7kUJ9Ynyf"
+
+//延迟期满之后任意owner都可以执行排队的交易
+cli send multisig tx queued  -a "13q53Ga1kquDCqx7EWF8FU94tLUK18Zd47" -i 9 -k "166po3ghRbRu53hu8jBBQzddp7kUJ9Ynyf"
+
+//执行之前任意owner都可以否决排队的交易
+cli send multisig tx queued  -a "13q53Ga1kquDCqx7EWF8FU94tLUK18Zd47" -i 9 -c f -k "1C5xK2ytuoFqxmVGMcyz9XFKFWcDA8T3rK"
+
+第七步：测试调用其他合约
+//data为被调用合约的未签名交易，被调用合约需要实现MultiSigCallee接口，由owner提交，权重满足后以多重签名地址执行
+cli send multisig tx exec_call -a "13q53Ga1kquDCqx7EWF8FU94tLUK18Zd47" -d "rawtx" -k "166po3ghRbRu53hu8jBBQzddp7kUJ9Ynyf"
+
+cli send multisig tx confirm  -a "13q53Ga1kquDCqx7EWF8FU94tLUK18Zd47" -i 10 -k "1C5xK2ytuoFqxmVGMcyz9XFKFWcDA8T3rK"
+
 // 获取owner拥有的所有多重签名地址，不指定地址时返回的是本钱包拥有的所有多重签名地址
 cli  multisig account owner -a 166po3ghRbRu53hu8jBBQzddp7kUJ9Ynyf
 */
@@ -28,13 +28,14 @@ type action struct {
 	index        int32
 	execaddr     string
 	api          client.QueueProtocolAPI
+	difficulty   uint64
 }
 
 func newAction(t *MultiSig, tx *types.Transaction, index int32) *action {
 	hash := tx.Hash()
 	fromaddr := tx.From()
 	return &action{t.GetCoinsAccount(), t.GetStateDB(), t.GetLocalDB(), hash, fromaddr,
-		t.GetBlockTime(), t.GetHeight(), index, dapp.ExecAddress(string(tx.Execer)), t.GetAPI()}
+		t.GetBlockTime(), t.GetHeight(), index, dapp.ExecAddress(string(tx.Execer)), t.GetAPI(), t.GetDifficulty()}
 }
 
 //MultiSigAccCreate 创建多重签名账户
@@ -155,6 +156,10 @@ func (a *action) MultiSigAccOperate(AccountOperate *mty.MultiSigAccOperate) (*ty
 	newMultiSigTx.Executed = false
 	newMultiSigTx.TxType = mty.AccountOperate
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	err = a.setTxTimelock(newMultiSigTx, AccountOperate.ExpireHeight, AccountOperate.ExecDelay)
+	if err != nil {
+		return nil, err
+	}
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
 
@@ -192,6 +197,10 @@ func (a *action) MultiSigOwnerOperate(AccOwnerOperate *mty.MultiSigOwnerOperate)
 	newMultiSigTx.Executed = false
 	newMultiSigTx.TxType = mty.OwnerOperate
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	err = a.setTxTimelock(newMultiSigTx, AccOwnerOperate.ExpireHeight, AccOwnerOperate.ExecDelay)
+	if err != nil {
+		return nil, err
+	}
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
 
@@ -238,6 +247,10 @@ func (a *action) MultiSigExecTransferFrom(multiSigAccTransfer *mty.MultiSigExecT
 	newMultiSigTx.Executed = false
 	newMultiSigTx.TxType = mty.TransferOperate
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	err = a.setTxTimelock(newMultiSigTx, multiSigAccTransfer.ExpireHeight, multiSigAccTransfer.ExecDelay)
+	if err != nil {
+		return nil, err
+	}
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
 
@@ -334,6 +347,18 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	if multiSigTx.Executed {
 		return nil, mty.ErrTxHasExecuted
 	}
+	//已经被否决的交易不可以再确认/撤销
+	if multiSigTx.Vetoed {
+		return nil, mty.ErrTxVetoed
+	}
+	//排队等待执行的交易只能执行或者否决
+	if multiSigTx.QueuedHeight != 0 {
+		return nil, mty.ErrTxQueued
+	}
+	//过期的交易不可以再确认
+	if ConfirmTx.ConfirmOrRevoke && isTxExpired(multiSigTx, a.height) {
+		return nil, mty.ErrTxExpired
+	}
 	//此owneraddr是否已经确认过此txid对应的交易
 	findindex, exist := isOwnerConfirmedTx(multiSigTx, owneraddr)
 
@@ -363,6 +388,63 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	if !isConfirm || !ConfirmTx.ConfirmOrRevoke {
 		return a.confirmTransaction(multiSigTx, multiSigTxOwner, ConfirmTx.ConfirmOrRevoke)
 	}
+	return a.executeMultiSigTx(multiSigAcc, multiSigTx, owner)
+}
+
+//MultiSigQueuedTxOperate 权重满足后排队等待执行的交易的执行和否决
+//延迟期满后任意owner都可以触发执行此交易，执行之前任意owner都可以否决此交易
+func (a *action) MultiSigQueuedTxOperate(operate *mty.MultiSigQueuedTxOperate) (*types.Receipt, error) {
+
+	//首先从statedb中获取MultiSigAccAddr的状态信息
+	multiSigAccAddr := operate.MultiSigAccAddr
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("MultiSigQueuedTxOperate:getMultiSigAccFromDb", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, err
+	}
+	//校验交易提交者是否是本账户的owner
+	owneraddr := a.fromaddr
+	ownerWeight, isowner := isOwner(multiSigAcc, owneraddr)
+	if !isowner {
+		return nil, mty.ErrIsNotOwner
+	}
+	//TxId的合法性校验
+	if operate.TxId > multiSigAcc.TxCount {
+		return nil, mty.ErrInvalidTxid
+	}
+	multiSigTx, err := getMultiSigAccTxFromDb(a.db, multiSigAccAddr, operate.TxId)
+	if err != nil {
+		multisiglog.Error("MultiSigQueuedTxOperate:getMultiSigAccTxFromDb", "multiSigAccAddr", multiSigAccAddr, "TxId", operate.TxId, "err", err)
+		return nil, mty.ErrTxidNotExist
+	}
+	if multiSigTx.Executed {
+		return nil, mty.ErrTxHasExecuted
+	}
+	if multiSigTx.Vetoed {
+		return nil, mty.ErrTxVetoed
+	}
+	if multiSigTx.QueuedHeight == 0 {
+		return nil, mty.ErrTxNotQueued
+	}
+
+	if !operate.ExecuteOrVeto {
+		owner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
+		return a.vetoTransaction(multiSigTx, owner)
+	}
+	//延迟期满之后才能执行
+	if a.height < multiSigTx.QueuedHeight+multiSigTx.ExecDelay {
+		return nil, mty.ErrTxTimelocked
+	}
+	//排队期间账户的请求权重可能被修改，执行之前需要再次确认权重
+	if !isConfirmed(multiSigAcc.RequiredWeight, multiSigTx) {
+		return nil, mty.ErrTotalWeightNotEnough
+	}
+	//延迟执行没有新增确认owner
+	return a.executeMultiSigTx(multiSigAcc, multiSigTx, nil)
+}
+
+//权重满足之后执行txid对应的交易，根据不同的交易类型调用各自的处理函数，区分 操作owner/account 和转账的交易
+func (a *action) executeMultiSigTx(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx, owner *mty.Owner) (*types.Receipt, error) {
 	//获取txhash对应交易详细信息
 	tx, err := getTxByHash(a.api, multiSigTx.TxHash)
 	if err != nil {
@@ -373,7 +455,6 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 		return nil, err
 	}
 
-	//根据不同的交易类型调用各自的处理函数，区分 操作owner/account 和转账的交易
 	if multiSigTx.TxType == mty.OwnerOperate && payload != nil {
 		transfer := payload.GetMultiSigOwnerOperate()
 		return a.executeOwnerOperateTx(multiSigAcc, multiSigTx, transfer, owner, false)
@@ -383,11 +464,41 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	} else if multiSigTx.TxType == mty.TransferOperate {
 		transfer := payload.GetMultiSigExecTransferFrom()
 		return a.executeTransferTx(multiSigAcc, multiSigTx, transfer, owner, mty.IsConfirm)
+	} else if multiSigTx.TxType == mty.ExecCallOperate {
+		call := payload.GetMultiSigExecCall()
+		return a.executeExecCallTx(multiSigAcc, multiSigTx, call, owner, mty.IsConfirm)
 	}
-	multisiglog.Error("MultiSigConfirmTx:GetMultiSigTx", "multiSigAccAddr", multiSigAccAddr, "Confirm TxId", ConfirmTx.TxId, "TxType unknown", multiSigTx.TxType)
+	multisiglog.Error("executeMultiSigTx:GetMultiSigTx", "multiSigAccAddr", multiSigTx.MultiSigAddr, "TxId", multiSigTx.Txid, "TxType unknown", multiSigTx.TxType)
 	return nil, mty.ErrTxTypeNoMatch
 }
 
+//设置交易的过期高度和权重满足后延迟执行的区块数，fork之前不支持
+func (a *action) setTxTimelock(multiSigTx *mty.MultiSigTx, expireHeight, execDelay int64) error {
+	if !a.api.GetConfig().IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimelockX) {
+		return nil
+	}
+	if expireHeight < 0 || execDelay < 0 {
+		return types.ErrInvalidParam
+	}
+	multiSigTx.ExpireHeight = expireHeight
+	multiSigTx.ExecDelay = execDelay
+	if isTxExpired(multiSigTx, a.height) {
+		return mty.ErrTxExpired
+	}
+	return nil
+}
+
+//权重满足时交易是否可以执行，设置了延迟执行的交易首先进入排队状态，等待延迟期满之后才能执行
+func (a *action) executable(multiSigTx *mty.MultiSigTx, confirmed bool) bool {
+	if !confirmed || multiSigTx.ExecDelay == 0 {
+		return confirmed
+	}
+	if multiSigTx.QueuedHeight == 0 {
+		multiSigTx.QueuedHeight = a.height
+	}
+	return a.height >= multiSigTx.QueuedHeight+multiSigTx.ExecDelay
+}
+
 //多重签名账户请求权重的修改,返回新的KeyValue对和ReceiptLog信息
 func (a *action) multiSigWeightModify(multiSigAccAddr string, newRequiredWeight uint64) (*types.KeyValue, *types.ReceiptLog, error) {
 
@@ -704,7 +815,7 @@ func (a *action) receiptTxCountUpdate(multiSigAccAddr string) (*types.KeyValue,
 }
 
 //组装MultiSigAccTx的receipt信息
-func (a *action) receiptMultiSigTx(multiSigTx *mty.MultiSigTx, owner *mty.Owner, prevExecutes, subOrConfirm bool) (*types.KeyValue, *types.ReceiptLog) {
+func (a *action) receiptMultiSigTx(multiSigTx *mty.MultiSigTx, owner *mty.Owner, prevExecutes bool, prevQueuedHeight int64, subOrConfirm bool) (*types.KeyValue, *types.ReceiptLog) {
 	receiptLog := &types.ReceiptLog{}
 
 	//组装receiptLog
@@ -715,9 +826,13 @@ func (a *action) receiptMultiSigTx(multiSigTx *mty.MultiSigTx, owner *mty.Owner,
 	receiptLogTx.PrevExecuted = prevExecutes
 	receiptLogTx.CurExecuted = multiSigTx.Executed
 	receiptLogTx.SubmitOrConfirm = subOrConfirm
+	receiptLogTx.PrevQueuedHeight = prevQueuedHeight
+	receiptLogTx.CurQueuedHeight = multiSigTx.QueuedHeight
 	if subOrConfirm {
 		receiptLogTx.TxHash = multiSigTx.TxHash
 		receiptLogTx.TxType = multiSigTx.TxType
+		receiptLogTx.ExpireHeight = multiSigTx.ExpireHeight
+		receiptLogTx.ExecDelay = multiSigTx.ExecDelay
 	}
 
 	receiptLog.Ty = mty.TyLogMultiSigTx
@@ -760,12 +875,14 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 	}
 
 	prevExecuted := newMultiSigTx.Executed
+	prevQueuedHeight := newMultiSigTx.QueuedHeight
 
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
 	//权重满足或者小于每日限额，允许执行此交易，如果转账交易执行失败，不应该直接返回，需要继续更新多重签名账户和tx列表的状态信息
-	if confirmed || underLimit {
+	//每日限额之内的转账不需要其他owner确认，不受延迟执行的限制
+	if !confirmed && underLimit || a.executable(newMultiSigTx, confirmed) {
 
 		//执行此交易，从多重签名账户转币到指定账户，在multiSig合约中转账
 		symbol := getRealSymbol(transfer.Symbol)
@@ -810,7 +927,7 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 		multisiglog.Error("executeTransaction:receiptDailyLimitUpdate", "error", err)
 	}
 	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
-	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
+	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, prevQueuedHeight, subOrConfirm)
 
 	logs = append(logs, receiptlog)
 	logs = append(logs, receiptlogtx)
@@ -833,6 +950,7 @@ func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *m
 	//确认权重是否已达到要求
 	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
 	prevExecuted := newMultiSigTx.Executed
+	prevQueuedHeight := newMultiSigTx.QueuedHeight
 
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
@@ -842,7 +960,7 @@ func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *m
 	var err error
 
 	//权重满足允许执行此交易，需要继续更新多重签名账户和tx列表的状态信息
-	if confirmed {
+	if a.executable(newMultiSigTx, confirmed) {
 		//修改账户RequiredWeight的操作
 		if accountOperate.OperateFlag {
 			accAttrkv, accAttrReceiptLog, err = a.multiSigWeightModify(multiSigAcc.MultiSigAddr, accountOperate.NewRequiredWeight)
@@ -873,7 +991,7 @@ func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *m
 		logs = append(logs, receiptlog)
 	}
 	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
-	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
+	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, prevQueuedHeight, subOrConfirm)
 	logs = append(logs, receiptlogtx)
 	kv = append(kv, keyvaluetx)
 	return &types.Receipt{
@@ -894,11 +1012,12 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 	//确认权重是否已达到要求
 	confirmed := isConfirmed(multiSigAccount.RequiredWeight, newMultiSigTx)
 	prevExecuted := newMultiSigTx.Executed
+	prevQueuedHeight := newMultiSigTx.QueuedHeight
 
 	flag := accountOperate.OperateFlag
 
 	//权重满足允许执行此交易，需要继续更新多重签名账户和tx列表的状态信息
-	if confirmed {
+	if a.executable(newMultiSigTx, confirmed) {
 		//add
 		if mty.OwnerAdd == flag {
 			multiSigkv, receiptLog, err = a.multiSigOwnerAdd(multiSigAccount.MultiSigAddr, accountOperate)
@@ -946,7 +1065,7 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 		logs = append(logs, receiptlog)
 	}
 	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
-	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
+	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, prevQueuedHeight, subOrConfirm)
 	logs = append(logs, receiptlogtx)
 	kv = append(kv, keyvaluetx)
 
@@ -960,6 +1079,126 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 	}, nil
 }
 
+//MultiSigExecCall 提交调用其他合约的交易，权重满足后以多重签名地址作为发起地址执行
+func (a *action) MultiSigExecCall(call *mty.MultiSigExecCall) (*types.Receipt, error) {
+	multiSigAccAddr := call.MultiSigAccAddr
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("MultiSigExecCall:getMultiSigAccFromDb", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, err
+	}
+	//校验交易提交者是否是本账户的owner
+	owneraddr := a.fromaddr
+	ownerWeight, isowner := isOwner(multiSigAcc, owneraddr)
+	if !isowner {
+		return nil, mty.ErrIsNotOwner
+	}
+	//提交时确认被调用合约支持多重签名账户的调用，避免确认之后才发现无法执行
+	if _, err := a.loadCallee(call.Execer); err != nil {
+		return nil, err
+	}
+
+	//生成新的txid,并将此交易信息添加到Txs列表中
+	newMultiSigTx := &mty.MultiSigTx{}
+	newMultiSigTx.Txid = multiSigAcc.TxCount
+	newMultiSigTx.TxHash = hex.EncodeToString(a.txhash)
+	newMultiSigTx.Executed = false
+	newMultiSigTx.TxType = mty.ExecCallOperate
+	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	err = a.setTxTimelock(newMultiSigTx, call.ExpireHeight, call.ExecDelay)
+	if err != nil {
+		return nil, err
+	}
+	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
+	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+
+	return a.executeExecCallTx(multiSigAcc, newMultiSigTx, call, confirmOwner, mty.IsSubmit)
+}
+
+//确认并执行调用其他合约的交易：区分submitTx和confirmtx阶段。
+//被调用合约的执行结果直接合并到本交易的回执中，执行失败时整个交易失败，提案保持未执行状态
+func (a *action) executeExecCallTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty.MultiSigTx, call *mty.MultiSigExecCall, confOwner *mty.Owner, subOrConfirm bool) (*types.Receipt, error) {
+	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
+	prevExecuted := newMultiSigTx.Executed
+	prevQueuedHeight := newMultiSigTx.QueuedHeight
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	if a.executable(newMultiSigTx, confirmed) {
+		receipt, err := a.execCall(multiSigAcc.MultiSigAddr, newMultiSigTx.Txid, call)
+		if err != nil {
+			multisiglog.Error("executeExecCallTx", "multiSigAddr", multiSigAcc.MultiSigAddr, "execer", call.Execer, "err", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+		//标识此交易已经被执行
+		newMultiSigTx.Executed = true
+	}
+
+	//更新multiSigAcc状态:txcount有增加在submit阶段
+	if subOrConfirm {
+		keyvalue, receiptlog, err := a.receiptTxCountUpdate(multiSigAcc.MultiSigAddr)
+		if err != nil {
+			multisiglog.Error("executeExecCallTx:receiptTxCountUpdate", "error", err)
+		}
+		kv = append(kv, keyvalue)
+		logs = append(logs, receiptlog)
+	}
+	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
+	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, prevQueuedHeight, subOrConfirm)
+	logs = append(logs, receiptlogtx)
+	kv = append(kv, keyvaluetx)
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   kv,
+		Logs: logs,
+	}, nil
+}
+
+//加载被调用的合约，合约需要实现MultiSigCallee接口，并且不能是multisig合约自身
+func (a *action) loadCallee(execer string) (dapp.Driver, error) {
+	if string(types.GetRealExecName([]byte(execer))) == mty.MultiSigX {
+		return nil, mty.ErrExecCallNotSupport
+	}
+	driver, err := dapp.LoadDriverWithClient(a.api, execer, a.height)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := driver.(mty.MultiSigCallee); !ok {
+		return nil, mty.ErrExecCallNotSupport
+	}
+	return driver, nil
+}
+
+//以多重签名地址作为发起地址执行被调用合约的交易，返回被调用合约的执行回执
+func (a *action) execCall(multiSigAddr string, txid uint64, call *mty.MultiSigExecCall) (*types.Receipt, error) {
+	driver, err := a.loadCallee(call.Execer)
+	if err != nil {
+		return nil, err
+	}
+	//内部交易没有签名，用提案的txid区分同一账户上内容相同的多个提案
+	tx := &types.Transaction{Execer: []byte(call.Execer), Payload: call.Payload, Nonce: int64(txid)}
+	driver.SetEnv(a.height, a.blocktime, a.difficulty)
+	if err := driver.Allow(tx, int(a.index)); err != nil {
+		return nil, err
+	}
+	driver.SetName(string(types.GetRealExecName(tx.Execer)))
+	driver.SetCurrentExecName(string(tx.Execer))
+	driver.SetCoinsAccount(a.coinsAccount)
+	driver.SetStateDB(a.db)
+	driver.SetLocalDB(a.localdb)
+	receipt, err := driver.(mty.MultiSigCallee).ExecMultiSig(multiSigAddr, tx, int(a.index))
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil || receipt.Ty != types.ExecOk {
+		return nil, mty.ErrExecCallFailed
+	}
+	return receipt, nil
+}
+
 //构造确认交易的receiptLog
 func (a *action) confirmTransaction(multiSigTx *mty.MultiSigTx, multiSigTxOwner *mty.MultiSigTxOwner, ConfirmOrRevoke bool) (*types.Receipt, error) {
 	receiptLog := &types.ReceiptLog{}
@@ -982,3 +1221,23 @@ func (a *action) confirmTransaction(multiSigTx *mty.MultiSigTx, multiSigTxOwner
 		Logs: []*types.ReceiptLog{receiptLog},
 	}, nil
 }
+
+//构造否决交易的receiptLog，否决之后的交易不能再确认和执行
+func (a *action) vetoTransaction(multiSigTx *mty.MultiSigTx, owner *mty.Owner) (*types.Receipt, error) {
+	multiSigTx.Vetoed = true
+	multiSigTxOwner := &mty.MultiSigTxOwner{MultiSigAddr: multiSigTx.MultiSigAddr, Txid: multiSigTx.Txid, ConfirmedOwner: owner}
+	receiptLog := &types.ReceiptLog{
+		Ty:  mty.TyLogMultiSigTxVeto,
+		Log: types.Encode(&mty.ReceiptTxVeto{MultiSigTxOwner: multiSigTxOwner}),
+	}
+
+	//更新MultiSigAccTx
+	key, value := setMultiSigAccTxToDb(a.db, multiSigTx)
+	kv := &types.KeyValue{Key: key, Value: value}
+
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{kv},
+		Logs: []*types.ReceiptLog{receiptLog},
+	}, nil
+}
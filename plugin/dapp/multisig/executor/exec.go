@@ -44,3 +44,21 @@ func (m *MultiSig) Exec_MultiSigExecTransferFrom(payload *mty.MultiSigExecTransf
 	action := newAction(m, tx, int32(index))
 	return action.MultiSigExecTransferFrom(payload)
 }
+
+//Exec_MultiSigQueuedTxOperate 多重签名账户上排队等待执行的交易的执行和否决
+func (m *MultiSig) Exec_MultiSigQueuedTxOperate(payload *mty.MultiSigQueuedTxOperate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !m.GetAPI().GetConfig().IsDappFork(m.GetHeight(), mty.MultiSigX, mty.ForkMultiSigTimelockX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigQueuedTxOperate(payload)
+}
+
+//Exec_MultiSigExecCall 提交多重签名账户调用其他合约的交易
+func (m *MultiSig) Exec_MultiSigExecCall(payload *mty.MultiSigExecCall, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !m.GetAPI().GetConfig().IsDappFork(m.GetHeight(), mty.MultiSigX, mty.ForkMultiSigExecCallX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigExecCall(payload)
+}
@@ -86,3 +86,29 @@ func (m *MultiSig) ExecDelLocal_MultiSigExecTransferFrom(payload *mty.MultiSigEx
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecDelLocal_MultiSigQueuedTxOperate 多重签名账户上排队等待执行的交易的执行和否决
+func (m *MultiSig) ExecDelLocal_MultiSigQueuedTxOperate(payload *mty.MultiSigQueuedTxOperate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecDelLocal_MultiSigExecCall 多重签名账户调用其他合约
+func (m *MultiSig) ExecDelLocal_MultiSigExecCall(payload *mty.MultiSigExecCall, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
@@ -91,3 +91,31 @@ func (m *MultiSig) ExecLocal_MultiSigExecTransferFrom(payload *mty.MultiSigExecT
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecLocal_MultiSigQueuedTxOperate 多重签名账户上排队等待执行的交易的执行和否决
+func (m *MultiSig) ExecLocal_MultiSigQueuedTxOperate(payload *mty.MultiSigQueuedTxOperate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigQueuedTxOperate", "err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecLocal_MultiSigExecCall 多重签名账户调用其他合约，被调用合约的localdb不在此更新
+func (m *MultiSig) ExecLocal_MultiSigExecCall(payload *mty.MultiSigExecCall, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigExecCall", "err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
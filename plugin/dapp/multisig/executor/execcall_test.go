@@ -0,0 +1,178 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	dbm "github.com/33cn/chain33/common/db"
+	dbmock "github.com/33cn/chain33/common/db/mocks"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	execCalleeX = "multisigcallee"
+	notCalleeX  = "multisignotcallee"
+)
+
+//execCallee 测试用的被调用合约，把payload作为key记录发起调用的多重签名地址
+type execCallee struct {
+	drivers.DriverBase
+}
+
+func newExecCallee() drivers.Driver {
+	c := &execCallee{}
+	c.SetChild(c)
+	return c
+}
+
+func (c *execCallee) GetDriverName() string {
+	return execCalleeX
+}
+
+func (c *execCallee) ExecMultiSig(multiSigAddr string, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if string(tx.Payload) == "fail" {
+		return &types.Receipt{Ty: types.ExecPack}, nil
+	}
+	kv := &types.KeyValue{Key: []byte("mavl-" + execCalleeX + "-" + string(tx.Payload)), Value: []byte(multiSigAddr)}
+	err := c.GetStateDB().Set(kv.Key, kv.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}}, nil
+}
+
+//notCallee 没有实现MultiSigCallee接口的合约
+type notCallee struct {
+	drivers.DriverBase
+}
+
+func newNotCallee() drivers.Driver {
+	c := &notCallee{}
+	c.SetChild(c)
+	return c
+}
+
+func (c *notCallee) GetDriverName() string {
+	return notCalleeX
+}
+
+func init() {
+	drivers.Register(chainTestCfg, execCalleeX, newExecCallee, 0)
+	drivers.Register(chainTestCfg, notCalleeX, newNotCallee, 0)
+}
+
+//多重签名账户调用其他合约，权重满足之后以多重签名地址执行
+func TestMultiSigExecCall(t *testing.T) {
+	env := execEnv{
+		1539918074,
+		chainTestCfg.GetDappFork(mty.MultiSigX, mty.ForkMultiSigExecCallX),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+
+	//owner:AddrC权重4，AddrD权重10，账户请求权重5
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+	params := &mty.MultiSigExecCall{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          execCalleeX,
+		Payload:         []byte("key"),
+	}
+
+	//不能调用multisig合约自身以及没有实现MultiSigCallee的合约
+	params.Execer = mty.MultiSigX
+	_, err = driver.Exec(submitExecCallTx(t, api, params, PrivKeyC), env.index)
+	assert.Equal(t, mty.ErrExecCallNotSupport, err)
+	params.Execer = notCalleeX
+	_, err = driver.Exec(submitExecCallTx(t, api, params, PrivKeyC), env.index)
+	assert.Equal(t, mty.ErrExecCallNotSupport, err)
+
+	//非owner不能提交
+	params.Execer = execCalleeX
+	_, err = driver.Exec(submitExecCallTx(t, api, params, PrivKeyA), env.index)
+	assert.Equal(t, mty.ErrIsNotOwner, err)
+
+	//AddrC提交之后权重不满足，没有执行
+	receipt, err := driver.Exec(submitExecCallTx(t, api, params, PrivKeyC), env.index)
+	assert.Nil(t, err)
+	receiptTx := decodeReceiptMultiSigTx(t, receipt.Logs[len(receipt.Logs)-1])
+	assert.Equal(t, false, receiptTx.CurExecuted)
+	txid := receiptTx.MultiSigTxOwner.Txid
+	_, err = stateDB.Get([]byte("mavl-" + execCalleeX + "-key"))
+	assert.Equal(t, types.ErrNotFound, err)
+
+	//AddrD确认之后权重满足，以多重签名地址执行被调用合约，回执中包含被调用合约的kv
+	receipt, err = testConfirmTx(driver, env, multiSigAddr, txid, true, PrivKeyD)
+	assert.Nil(t, err)
+	receiptTx = decodeReceiptMultiSigTx(t, receipt.Logs[len(receipt.Logs)-1])
+	assert.Equal(t, true, receiptTx.CurExecuted)
+	assert.Equal(t, []byte("mavl-"+execCalleeX+"-key"), receipt.KV[0].Key)
+	assert.Equal(t, []byte(multiSigAddr), receipt.KV[0].Value)
+
+	//被调用合约执行失败时整个交易失败
+	params.Payload = []byte("fail")
+	receipt, err = driver.Exec(submitExecCallTx(t, api, params, PrivKeyC), env.index)
+	assert.Nil(t, err)
+	for _, kv := range receipt.KV {
+		stateDB.Set(kv.Key, kv.Value)
+	}
+	txid = decodeReceiptMultiSigTx(t, receipt.Logs[len(receipt.Logs)-1]).MultiSigTxOwner.Txid
+	_, err = testConfirmTx(driver, env, multiSigAddr, txid, true, PrivKeyD)
+	assert.Equal(t, mty.ErrExecCallFailed, err)
+}
+
+//构造并签名调用其他合约的交易，并设置api返回此交易
+func submitExecCallTx(t *testing.T, api *apimock.QueueProtocolAPI, params *mty.MultiSigExecCall, privKey string) *types.Transaction {
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigExecCall,
+		Value: &mty.MultiSigAction_MultiSigExecCall{MultiSigExecCall: params},
+	}
+	tx, err := types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+	assert.Nil(t, err)
+	tx, err = signTx(tx, privKey)
+	assert.Nil(t, err)
+
+	txDetails := &types.TransactionDetails{}
+	txDetails.Txs = append(txDetails.Txs, &types.TransactionDetail{Tx: tx})
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+	return tx
+}
+
+func testConfirmTx(driver drivers.Driver, env execEnv, multiSigAddr string, txid uint64, confirmOrRevoke bool, privKey string) (*types.Receipt, error) {
+	param := &mty.MultiSigConfirmTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+		ConfirmOrRevoke: confirmOrRevoke,
+	}
+	tx, _ := multiSigConfirmTx(param)
+	tx, _ = signTx(tx, privKey)
+	return driver.Exec(tx, env.index)
+}
+
+func decodeReceiptMultiSigTx(t *testing.T, log *types.ReceiptLog) *mty.ReceiptMultiSigTx {
+	assert.Equal(t, int32(mty.TyLogMultiSigTx), log.Ty)
+	var receiptTx mty.ReceiptMultiSigTx
+	err := types.Decode(log.Log, &receiptTx)
+	assert.Nil(t, err)
+	return &receiptTx
+}
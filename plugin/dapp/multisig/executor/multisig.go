@@ -12,6 +12,7 @@ multiSig合约主要实现如下功能：
 //多重签名账户交易的确认和撤销
 //合约中外部账户转账到多重签名账户，Addr --->multiSigAddr
 //合约中多重签名账户转账到外部账户，multiSigAddr--->Addr
+//多重签名账户调用其他合约，权重满足后以多重签名地址作为发起地址执行
 */
 
 import (
@@ -104,6 +105,23 @@ func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
 		}
 		return nil
 	}
+	//MultiSigQueuedTxOperate  交易的检测
+	if ato, ok := payload.(*mty.MultiSigQueuedTxOperate); ok {
+		if err := address.CheckMultiSignAddress(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		return nil
+	}
+	//MultiSigExecCall  交易的检测
+	if ato, ok := payload.(*mty.MultiSigExecCall); ok {
+		if err := address.CheckMultiSignAddress(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		if len(ato.GetExecer()) == 0 || len(ato.GetPayload()) == 0 {
+			return types.ErrInvalidParam
+		}
+		return nil
+	}
 
 	//MultiSigExecTransferTo 交易的检测
 	if ato, ok := payload.(*mty.MultiSigExecTransferTo); ok {
@@ -363,6 +381,19 @@ func (m *MultiSig) execLocalMultiSigReceipt(receiptData *types.ReceiptData, tx *
 					set = append(set, kv2...)
 				}
 			}
+		case mty.TyLogMultiSigTxVeto: //排队等待执行的交易被否决
+			{
+				var receipt mty.ReceiptTxVeto
+				err := types.Decode(log.Log, &receipt)
+				if err != nil {
+					return nil, err
+				}
+				kv, err := m.saveMultiSigTxVeto(receipt, addOrRollback)
+				if err != nil {
+					return nil, err
+				}
+				set = append(set, kv...)
+			}
 		case mty.TyLogTxCountUpdate:
 			{
 				var receipt mty.ReceiptTxCountUpdate
@@ -416,12 +447,20 @@ func (m *MultiSig) saveMultiSigTransfer(tx *types.Transaction, SubmitOrConfirm,
 			return set, nil
 		}
 	} else {
-		if action.Ty != mty.ActionMultiSigConfirmTx || action.GetMultiSigConfirmTx() == nil {
+		//确认交易或者延迟期满之后的执行交易都可以触发txid对应交易的执行
+		var multiSigAccAddr string
+		var txid uint64
+		if action.Ty == mty.ActionMultiSigConfirmTx && action.GetMultiSigConfirmTx() != nil {
+			multiSigAccAddr = action.GetMultiSigConfirmTx().MultiSigAccAddr
+			txid = action.GetMultiSigConfirmTx().TxId
+		} else if action.Ty == mty.ActionMultiSigQueuedTxOperate && action.GetMultiSigQueuedTxOperate() != nil {
+			multiSigAccAddr = action.GetMultiSigQueuedTxOperate().MultiSigAccAddr
+			txid = action.GetMultiSigQueuedTxOperate().TxId
+		} else {
 			return nil, mty.ErrActionTyNoMatch
 		}
 		//通过需要确认的txid从数据库中获取对应的multiSigTx信息，然后根据txhash查询具体的交易详情
-		multiSigConfirmTx := action.GetMultiSigConfirmTx()
-		multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigConfirmTx.MultiSigAccAddr, multiSigConfirmTx.TxId)
+		multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAccAddr, txid)
 		if err != nil {
 			return set, err
 		}
@@ -739,6 +778,8 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 	temMultiSigTx.TxHash = execTx.TxHash
 	temMultiSigTx.TxType = execTx.TxType
 	temMultiSigTx.Executed = false
+	temMultiSigTx.ExpireHeight = execTx.ExpireHeight
+	temMultiSigTx.ExecDelay = execTx.ExecDelay
 	//获取多重签名交易信息从db中
 	multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAddr, txid)
 	if err != nil {
@@ -761,8 +802,16 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 		multiSigTx = temMultiSigTx
 	}
 
-	index, exist := isOwnerConfirmedTx(multiSigTx, owner.OwnerAddr)
-	if addOrRollback { //正常添加交易
+	if owner == nil { //延迟期满之后执行交易，没有新增确认owner，只需要更新Executed
+		if addOrRollback {
+			if prevExecuted != multiSigTx.Executed {
+				return nil, mty.ErrExecutedNoMatch
+			}
+			multiSigTx.Executed = curExecuted
+		} else {
+			multiSigTx.Executed = prevExecuted
+		}
+	} else if index, exist := isOwnerConfirmedTx(multiSigTx, owner.OwnerAddr); addOrRollback { //正常添加交易
 		if !exist { //add Confirmed Owner and modify Executed
 			multiSigTx.ConfirmedOwner = append(multiSigTx.ConfirmedOwner, owner)
 			if prevExecuted != multiSigTx.Executed {
@@ -782,6 +831,12 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 			return nil, mty.ErrOwnerNoMatch
 		}
 	}
+	//权重满足之后进入排队状态的高度
+	if addOrRollback {
+		multiSigTx.QueuedHeight = execTx.CurQueuedHeight
+	} else {
+		multiSigTx.QueuedHeight = execTx.PrevQueuedHeight
+	}
 	//submit交易的回滚需要将对应txid的值设置成nil
 	setNil := true
 	if !addOrRollback && submitOrConfirm {
@@ -799,6 +854,32 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 	return kvs, nil
 }
 
+//排队等待执行的交易被否决，回滚时恢复到排队状态
+func (m *MultiSig) saveMultiSigTxVeto(veto mty.ReceiptTxVeto, addOrRollback bool) ([]*types.KeyValue, error) {
+	multiSigAddr := veto.MultiSigTxOwner.MultiSigAddr
+	txid := veto.MultiSigTxOwner.Txid
+
+	multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAddr, txid)
+	if err != nil {
+		return nil, err
+	}
+	if multiSigTx == nil {
+		multisiglog.Error("saveMultiSigTxVeto", "addOrRollback", addOrRollback, "veto", veto)
+		return nil, mty.ErrTxidNotExist
+	}
+	multiSigTx.Vetoed = addOrRollback
+
+	err = setMultiSigTx(m.GetLocalDB(), multiSigTx, true)
+	if err != nil {
+		return nil, err
+	}
+	txkv := getMultiSigTxKV(multiSigTx, true)
+
+	var kvs []*types.KeyValue
+	kvs = append(kvs, txkv)
+	return kvs, nil
+}
+
 //多重签名账户交易被确认执行，更新对应资产的每日限额信息，以及txcount计数
 func (m *MultiSig) saveDailyLimitUpdate(execTransfer mty.ReceiptAccDailyLimitUpdate, addOrRollback bool) ([]*types.KeyValue, error) {
 	multiSigAddr := execTransfer.MultiSigAddr
@@ -929,6 +1010,11 @@ func isConfirmed(requiredWeight uint64, multiSigTx *mty.MultiSigTx) bool {
 	return totalweight >= requiredWeight
 }
 
+//确认某笔交易在当前高度是否已经过期，过期高度为0表示永不过期
+func isTxExpired(multiSigTx *mty.MultiSigTx, height int64) bool {
+	return multiSigTx.ExpireHeight != 0 && height > multiSigTx.ExpireHeight
+}
+
 //确认某笔交易的额度是否满足每日限额,返回是否满足，以及新的newLastDay时间
 func isUnderLimit(blocktime int64, amount uint64, dailyLimit *mty.DailyLimit) (bool, int64) {
 
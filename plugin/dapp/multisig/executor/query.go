@@ -116,7 +116,7 @@ func (m *MultiSig) Query_MultiSigAccTxCount(in *mty.ReqMultiSigAccInfo) (types.M
 	return &mty.Uint64{Data: multiSigAcc.TxCount}, nil
 }
 
-//Query_MultiSigTxids 获取txids通过设置的过滤条件和区间，pending, executed
+//Query_MultiSigTxids 获取txids通过设置的过滤条件和区间，pending, executed, expired, queued, vetoed
 //输入：
 //message ReqMultiSigTxids {
 //  string multisigaddr = 1;
@@ -124,6 +124,9 @@ func (m *MultiSig) Query_MultiSigAccTxCount(in *mty.ReqMultiSigAccInfo) (types.M
 //	uint64 totxid = 3;
 //	bool   pending = 4;
 //	bool   executed	= 5;
+//	bool   expired	= 6;
+//	bool   queued	= 7;
+//	bool   vetoed	= 8;
 // 返回:
 //message ReplyMultiSigTxids {
 //  string 			multisigaddr = 1;
@@ -156,8 +159,8 @@ func (m *MultiSig) Query_MultiSigTxids(in *mty.ReqMultiSigTxids) (types.Message,
 			continue
 		}
 		findTxid := txid
-		//查找Pending/Executed的交易txid
-		if in.Pending && !multiSigTx.Executed || in.Executed && multiSigTx.Executed {
+		//查找满足过滤条件的交易txid
+		if matchTxStatus(in, multiSigTx, m.GetHeight()) {
 			multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
 		}
 	}
@@ -391,3 +394,19 @@ func (m *MultiSig) Query_MultiSigAccAllAddress(in *mty.ReqMultiSigAccInfo) (type
 	}
 	return getMultiSigAccAllAddress(m.GetLocalDB(), in.MultiSigAccAddr)
 }
+
+//交易的状态是否满足过滤条件，交易只属于executed/vetoed/queued/expired/pending中的一种状态
+func matchTxStatus(in *mty.ReqMultiSigTxids, multiSigTx *mty.MultiSigTx, height int64) bool {
+	switch {
+	case multiSigTx.Executed:
+		return in.Executed
+	case multiSigTx.Vetoed:
+		return in.Vetoed
+	case multiSigTx.QueuedHeight != 0:
+		return in.Queued
+	case isTxExpired(multiSigTx, height):
+		return in.Expired
+	default:
+		return in.Pending
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	dbm "github.com/33cn/chain33/common/db"
+	dbmock "github.com/33cn/chain33/common/db/mocks"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+//交易的过期以及权重满足之后的延迟执行和否决
+func TestMultiSigTimelock(t *testing.T) {
+	env := execEnv{
+		1539918074,
+		chainTestCfg.GetDappFork(mty.MultiSigX, mty.ForkMultiSigTimelockX) + 100,
+		2,
+		1539918074,
+		"hash",
+	}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+
+	//owner:AddrC权重4，AddrD权重10，账户请求权重5
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+
+	//已经过期的交易不能提交
+	params := &mty.MultiSigAccOperate{
+		MultiSigAccAddr:   multiSigAddr,
+		NewRequiredWeight: AddrCWeight + AddrDWeight,
+		OperateFlag:       mty.AccWeightOp,
+		ExpireHeight:      env.blockHeight - 1,
+	}
+	tx := submitTimelockTx(t, api, params, PrivKeyC)
+	_, err = driver.Exec(tx, env.index)
+	assert.Equal(t, mty.ErrTxExpired, err)
+
+	//提交修改请求权重为14的交易，延迟5个区块执行
+	params.ExpireHeight = env.blockHeight + 10
+	params.ExecDelay = 5
+	txid := testSubmitTimelockTx(t, driver, env, api, params)
+
+	//AddrD确认之后权重满足，交易进入排队状态，没有被执行
+	setTimelockHeight(driver, env, env.blockHeight+1)
+	receipt, err := testConfirmTx(driver, env, multiSigAddr, txid, true, PrivKeyD)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(receipt.Logs))
+	receiptTx := decodeReceiptMultiSigTx(t, receipt.Logs[0])
+	assert.Equal(t, false, receiptTx.CurExecuted)
+	assert.Equal(t, int64(0), receiptTx.PrevQueuedHeight)
+	assert.Equal(t, env.blockHeight+1, receiptTx.CurQueuedHeight)
+
+	//排队的交易不能再确认或者撤销
+	_, err = testConfirmTx(driver, env, multiSigAddr, txid, false, PrivKeyD)
+	assert.Equal(t, mty.ErrTxQueued, err)
+
+	//延迟期满之前不能执行
+	setTimelockHeight(driver, env, env.blockHeight+5)
+	_, err = testQueuedTxOperate(driver, env, multiSigAddr, txid, true, PrivKeyC)
+	assert.Equal(t, mty.ErrTxTimelocked, err)
+
+	//非owner不能执行
+	setTimelockHeight(driver, env, env.blockHeight+6)
+	_, err = testQueuedTxOperate(driver, env, multiSigAddr, txid, true, PrivKeyA)
+	assert.Equal(t, mty.ErrIsNotOwner, err)
+
+	//延迟期满之后任意owner都可以执行，没有新增确认owner
+	receipt, err = testQueuedTxOperate(driver, env, multiSigAddr, txid, true, PrivKeyC)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(receipt.Logs))
+	assert.Equal(t, int32(mty.TyLogMultiSigAccWeightModify), receipt.Logs[0].Ty)
+	receiptTx = decodeReceiptMultiSigTx(t, receipt.Logs[1])
+	assert.Equal(t, true, receiptTx.CurExecuted)
+	assert.Nil(t, receiptTx.MultiSigTxOwner.ConfirmedOwner)
+
+	//已经执行的交易不能再执行或者否决
+	_, err = testQueuedTxOperate(driver, env, multiSigAddr, txid, false, PrivKeyC)
+	assert.Equal(t, mty.ErrTxHasExecuted, err)
+
+	//权重未满足的交易不能执行和否决
+	params.NewRequiredWeight = Requiredweight
+	params.ExpireHeight = env.blockHeight + 20
+	txid = testSubmitTimelockTx(t, driver, env, api, params)
+	_, err = testQueuedTxOperate(driver, env, multiSigAddr, txid, false, PrivKeyD)
+	assert.Equal(t, mty.ErrTxNotQueued, err)
+
+	//AddrD确认之后进入排队状态，然后AddrD否决此交易
+	_, err = testConfirmTx(driver, env, multiSigAddr, txid, true, PrivKeyD)
+	assert.Nil(t, err)
+	receipt, err = testQueuedTxOperate(driver, env, multiSigAddr, txid, false, PrivKeyD)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(mty.TyLogMultiSigTxVeto), receipt.Logs[0].Ty)
+	var veto mty.ReceiptTxVeto
+	err = types.Decode(receipt.Logs[0].Log, &veto)
+	assert.Nil(t, err)
+	assert.Equal(t, AddrD, veto.MultiSigTxOwner.ConfirmedOwner.OwnerAddr)
+
+	//否决之后的交易不能执行和确认
+	setTimelockHeight(driver, env, env.blockHeight+20)
+	_, err = testQueuedTxOperate(driver, env, multiSigAddr, txid, true, PrivKeyC)
+	assert.Equal(t, mty.ErrTxVetoed, err)
+	_, err = testConfirmTx(driver, env, multiSigAddr, txid, false, PrivKeyD)
+	assert.Equal(t, mty.ErrTxVetoed, err)
+
+	//过期之后的交易不能再确认，但可以撤销确认
+	params.ExpireHeight = env.blockHeight + 21
+	params.ExecDelay = 0
+	params.NewRequiredWeight = NewRequiredweight
+	txid = testSubmitTimelockTx(t, driver, env, api, params)
+	setTimelockHeight(driver, env, env.blockHeight+22)
+	_, err = testConfirmTx(driver, env, multiSigAddr, txid, true, PrivKeyD)
+	assert.Equal(t, mty.ErrTxExpired, err)
+	_, err = testConfirmTx(driver, env, multiSigAddr, txid, false, PrivKeyC)
+	assert.Nil(t, err)
+}
+
+func TestMatchTxStatus(t *testing.T) {
+	all := &mty.ReqMultiSigTxids{Pending: true, Executed: true, Expired: true, Queued: true, Vetoed: true}
+	pending := &mty.ReqMultiSigTxids{Pending: true}
+
+	tx := &mty.MultiSigTx{ExpireHeight: 10}
+	assert.True(t, matchTxStatus(pending, tx, 10))
+	assert.False(t, matchTxStatus(pending, tx, 11))
+	assert.True(t, matchTxStatus(&mty.ReqMultiSigTxids{Expired: true}, tx, 11))
+
+	tx.QueuedHeight = 5
+	assert.False(t, matchTxStatus(pending, tx, 6))
+	assert.True(t, matchTxStatus(&mty.ReqMultiSigTxids{Queued: true}, tx, 20))
+
+	tx.Vetoed = true
+	assert.True(t, matchTxStatus(&mty.ReqMultiSigTxids{Vetoed: true}, tx, 6))
+	assert.False(t, matchTxStatus(&mty.ReqMultiSigTxids{Queued: true}, tx, 6))
+
+	tx.Executed = true
+	assert.True(t, matchTxStatus(all, tx, 6))
+	assert.False(t, matchTxStatus(&mty.ReqMultiSigTxids{Vetoed: true}, tx, 6))
+}
+
+func setTimelockHeight(driver drivers.Driver, env execEnv, height int64) {
+	driver.SetEnv(height, env.blockTime, env.difficulty)
+}
+
+//构造并签名交易，并设置api返回此交易
+func submitTimelockTx(t *testing.T, api *apimock.QueueProtocolAPI, params *mty.MultiSigAccOperate, privKey string) *types.Transaction {
+	tx, err := multiSigAccOperate(params)
+	assert.Nil(t, err)
+	tx, err = signTx(tx, privKey)
+	assert.Nil(t, err)
+
+	txDetails := &types.TransactionDetails{}
+	txDetails.Txs = append(txDetails.Txs, &types.TransactionDetail{Tx: tx})
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+	return tx
+}
+
+func testSubmitTimelockTx(t *testing.T, driver drivers.Driver, env execEnv, api *apimock.QueueProtocolAPI, params *mty.MultiSigAccOperate) uint64 {
+	tx := submitTimelockTx(t, api, params, PrivKeyC)
+	receipt, err := driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	receiptTx := decodeReceiptMultiSigTx(t, receipt.Logs[len(receipt.Logs)-1])
+	assert.Equal(t, params.ExpireHeight, receiptTx.ExpireHeight)
+	assert.Equal(t, params.ExecDelay, receiptTx.ExecDelay)
+	return receiptTx.MultiSigTxOwner.Txid
+}
+
+func testQueuedTxOperate(driver drivers.Driver, env execEnv, multiSigAddr string, txid uint64, executeOrVeto bool, privKey string) (*types.Receipt, error) {
+	param := &mty.MultiSigQueuedTxOperate{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+		ExecuteOrVeto:   executeOrVeto,
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigQueuedTxOperate,
+		Value: &mty.MultiSigAction_MultiSigQueuedTxOperate{MultiSigQueuedTxOperate: param},
+	}
+	tx, _ := types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+	tx, _ = signTx(tx, privKey)
+	return driver.Exec(tx, env.index)
+}
@@ -67,6 +67,34 @@ func (c *Jrpc) MultiSigConfirmTx(param *mty.MultiSigConfirmTx, result *interface
 	return nil
 }
 
+// MultiSigQueuedTxOperateTx :构造执行或者否决排队交易的交易
+func (c *Jrpc) MultiSigQueuedTxOperateTx(param *mty.MultiSigQueuedTxOperate, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigQueuedTxOperate", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// MultiSigExecCallTx :构造多重签名账户调用其他合约的交易
+func (c *Jrpc) MultiSigExecCallTx(param *mty.MultiSigExecCall, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigExecCall", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
 // MultiSigAccTransferInTx :构造在多重签名合约中转账到多重签名账户的交易
 func (c *Jrpc) MultiSigAccTransferInTx(param *mty.MultiSigExecTransferTo, result *interface{}) error {
 	if param == nil {
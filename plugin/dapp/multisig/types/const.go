@@ -20,10 +20,11 @@ var (
 	//AccWeightOp 账户属性的操作
 	AccWeightOp     = true
 	AccDailyLimitOp = false
-	//OwnerOperate 多重签名交易类型：转账，owner操作，account操作
+	//OwnerOperate 多重签名交易类型：转账，owner操作，account操作，调用其他合约
 	OwnerOperate    uint64 = 1
 	AccountOperate  uint64 = 2
 	TransferOperate uint64 = 3
+	ExecCallOperate uint64 = 4
 	//IsSubmit ：
 	IsSubmit  = true
 	IsConfirm = false
@@ -35,6 +36,11 @@ var (
 	MaxOwnersCount       = 20 //一个多重签名的账户最多拥有20个owner
 
 	Multisiglog = log15.New("module", MultiSigX)
+
+	//ForkMultiSigExecCallX 多重签名账户支持调用其他合约
+	ForkMultiSigExecCallX = "ForkMultiSigExecCall"
+	//ForkMultiSigTimelockX 交易支持过期高度和权重满足后的延迟执行
+	ForkMultiSigTimelockX = "ForkMultiSigTimelock"
 )
 
 // MultiSig 交易的actionid
@@ -45,6 +51,8 @@ const (
 	ActionMultiSigConfirmTx        = 10003
 	ActionMultiSigExecTransferTo   = 10004
 	ActionMultiSigExecTransferFrom = 10005
+	ActionMultiSigExecCall         = 10006
+	ActionMultiSigQueuedTxOperate  = 10007
 )
 
 //多重签名账户执行输出的logid
@@ -66,6 +74,7 @@ const (
 	TyLogDailyLimitUpdate = 10010 //DailyLimit更新，DailyLimit在Submit和Confirm阶段都可能有变化
 	TyLogMultiSigTx       = 10011 //在Submit提交交易阶段才会有更新
 	TyLogTxCountUpdate    = 10012 //txcount只在在Submit阶段提交新的交易是才会增加计数
+	TyLogMultiSigTxVeto   = 10013 //排队等待执行的交易被owner否决
 
 )
 
@@ -41,4 +41,11 @@ var (
 	ErrInvalidExec          = errors.New("ErrInvalidExec")
 	ErrInvalidWeight        = errors.New("ErrInvalidWeight")
 	ErrInvalidDailyLimit    = errors.New("ErrInvalidDailyLimit")
+	ErrTxExpired            = errors.New("ErrTxExpired")
+	ErrTxVetoed             = errors.New("ErrTxVetoed")
+	ErrTxQueued             = errors.New("ErrTxQueued")
+	ErrTxNotQueued          = errors.New("ErrTxNotQueued")
+	ErrTxTimelocked         = errors.New("ErrTxTimelocked")
+	ErrExecCallNotSupport   = errors.New("ErrExecCallNotSupport")
+	ErrExecCallFailed       = errors.New("ErrExecCallFailed")
 )
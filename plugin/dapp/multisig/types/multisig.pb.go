@@ -153,6 +153,10 @@ func (m *ConfirmedOwner) GetConfirmedOwner() []*Owner {
 
 //记录提交的交易详情，在满足确认条件后执行data中的交易
 // txHash:用于存贮提交的确认交易。存贮在localdb中，通过txhash可以获取
+// expireHeight:交易的过期高度，超过此高度后不能再确认，0表示不过期
+// execDelay:权重满足后延迟执行的区块数，0表示权重满足后立即执行
+// queuedHeight:权重满足进入排队等待执行的高度，0表示还没有进入排队
+// vetoed:排队期间被owner否决，否决后的交易不能再确认和执行
 type MultiSigTx struct {
 	Txid                 uint64   `protobuf:"varint,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	TxHash               string   `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
@@ -160,6 +164,10 @@ type MultiSigTx struct {
 	TxType               uint64   `protobuf:"varint,4,opt,name=txType,proto3" json:"txType,omitempty"`
 	MultiSigAddr         string   `protobuf:"bytes,5,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	ConfirmedOwner       []*Owner `protobuf:"bytes,6,rep,name=confirmedOwner,proto3" json:"confirmedOwner,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,7,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExecDelay            int64    `protobuf:"varint,8,opt,name=execDelay,proto3" json:"execDelay,omitempty"`
+	QueuedHeight         int64    `protobuf:"varint,9,opt,name=queuedHeight,proto3" json:"queuedHeight,omitempty"`
+	Vetoed               bool     `protobuf:"varint,10,opt,name=vetoed,proto3" json:"vetoed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -232,6 +240,34 @@ func (m *MultiSigTx) GetConfirmedOwner() []*Owner {
 	return nil
 }
 
+func (m *MultiSigTx) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetExecDelay() int64 {
+	if m != nil {
+		return m.ExecDelay
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetQueuedHeight() int64 {
+	if m != nil {
+		return m.QueuedHeight
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetVetoed() bool {
+	if m != nil {
+		return m.Vetoed
+	}
+	return false
+}
+
 // owner 结构体：owner账户地址，以及权重
 type Owner struct {
 	OwnerAddr            string   `protobuf:"bytes,1,opt,name=ownerAddr,proto3" json:"ownerAddr,omitempty"`
@@ -418,6 +454,8 @@ type MultiSigAction struct {
 	//	*MultiSigAction_MultiSigConfirmTx
 	//	*MultiSigAction_MultiSigExecTransferTo
 	//	*MultiSigAction_MultiSigExecTransferFrom
+	//	*MultiSigAction_MultiSigExecCall
+	//	*MultiSigAction_MultiSigQueuedTxOperate
 	Value                isMultiSigAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                  `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -478,6 +516,14 @@ type MultiSigAction_MultiSigExecTransferFrom struct {
 	MultiSigExecTransferFrom *MultiSigExecTransferFrom `protobuf:"bytes,6,opt,name=multiSigExecTransferFrom,proto3,oneof"`
 }
 
+type MultiSigAction_MultiSigExecCall struct {
+	MultiSigExecCall *MultiSigExecCall `protobuf:"bytes,8,opt,name=multiSigExecCall,proto3,oneof"`
+}
+
+type MultiSigAction_MultiSigQueuedTxOperate struct {
+	MultiSigQueuedTxOperate *MultiSigQueuedTxOperate `protobuf:"bytes,9,opt,name=multiSigQueuedTxOperate,proto3,oneof"`
+}
+
 func (*MultiSigAction_MultiSigAccCreate) isMultiSigAction_Value() {}
 
 func (*MultiSigAction_MultiSigOwnerOperate) isMultiSigAction_Value() {}
@@ -490,6 +536,10 @@ func (*MultiSigAction_MultiSigExecTransferTo) isMultiSigAction_Value() {}
 
 func (*MultiSigAction_MultiSigExecTransferFrom) isMultiSigAction_Value() {}
 
+func (*MultiSigAction_MultiSigExecCall) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_MultiSigQueuedTxOperate) isMultiSigAction_Value() {}
+
 func (m *MultiSigAction) GetValue() isMultiSigAction_Value {
 	if m != nil {
 		return m.Value
@@ -539,6 +589,20 @@ func (m *MultiSigAction) GetMultiSigExecTransferFrom() *MultiSigExecTransferFrom
 	return nil
 }
 
+func (m *MultiSigAction) GetMultiSigExecCall() *MultiSigExecCall {
+	if x, ok := m.GetValue().(*MultiSigAction_MultiSigExecCall); ok {
+		return x.MultiSigExecCall
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetMultiSigQueuedTxOperate() *MultiSigQueuedTxOperate {
+	if x, ok := m.GetValue().(*MultiSigAction_MultiSigQueuedTxOperate); ok {
+		return x.MultiSigQueuedTxOperate
+	}
+	return nil
+}
+
 func (m *MultiSigAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -555,6 +619,8 @@ func (*MultiSigAction) XXX_OneofWrappers() []interface{} {
 		(*MultiSigAction_MultiSigConfirmTx)(nil),
 		(*MultiSigAction_MultiSigExecTransferTo)(nil),
 		(*MultiSigAction_MultiSigExecTransferFrom)(nil),
+		(*MultiSigAction_MultiSigExecCall)(nil),
+		(*MultiSigAction_MultiSigQueuedTxOperate)(nil),
 	}
 }
 
@@ -621,6 +687,8 @@ type MultiSigOwnerOperate struct {
 	NewOwner             string   `protobuf:"bytes,3,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
 	NewWeight            uint64   `protobuf:"varint,4,opt,name=newWeight,proto3" json:"newWeight,omitempty"`
 	OperateFlag          uint64   `protobuf:"varint,5,opt,name=operateFlag,proto3" json:"operateFlag,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,6,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExecDelay            int64    `protobuf:"varint,7,opt,name=execDelay,proto3" json:"execDelay,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -686,6 +754,20 @@ func (m *MultiSigOwnerOperate) GetOperateFlag() uint64 {
 	return 0
 }
 
+func (m *MultiSigOwnerOperate) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MultiSigOwnerOperate) GetExecDelay() int64 {
+	if m != nil {
+		return m.ExecDelay
+	}
+	return 0
+}
+
 //对MultiSigAccount账户的操作：modify/add:SymbolDailyLimit,requiredweight
 //修改或者添加每日限额，或者请求权重的值。
 type MultiSigAccOperate struct {
@@ -693,6 +775,8 @@ type MultiSigAccOperate struct {
 	DailyLimit           *SymbolDailyLimit `protobuf:"bytes,2,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
 	NewRequiredWeight    uint64            `protobuf:"varint,3,opt,name=newRequiredWeight,proto3" json:"newRequiredWeight,omitempty"`
 	OperateFlag          bool              `protobuf:"varint,4,opt,name=operateFlag,proto3" json:"operateFlag,omitempty"`
+	ExpireHeight         int64             `protobuf:"varint,5,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExecDelay            int64             `protobuf:"varint,6,opt,name=execDelay,proto3" json:"execDelay,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -751,6 +835,20 @@ func (m *MultiSigAccOperate) GetOperateFlag() bool {
 	return false
 }
 
+func (m *MultiSigAccOperate) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MultiSigAccOperate) GetExecDelay() int64 {
+	if m != nil {
+		return m.ExecDelay
+	}
+	return 0
+}
+
 //多重签名合约中账户之间转币操作:增加一个from的字段实现MultiSigAddr--->addr之间的转账
 //需要判断from地址是否是多重签名地址
 //将MultiSig合约中from地址上execname+symbol的资产转移到to地址
@@ -761,6 +859,8 @@ type MultiSigExecTransferFrom struct {
 	Execname             string   `protobuf:"bytes,4,opt,name=execname,proto3" json:"execname,omitempty"`
 	To                   string   `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
 	From                 string   `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,7,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExecDelay            int64    `protobuf:"varint,8,opt,name=execDelay,proto3" json:"execDelay,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -833,6 +933,20 @@ func (m *MultiSigExecTransferFrom) GetFrom() string {
 	return ""
 }
 
+func (m *MultiSigExecTransferFrom) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MultiSigExecTransferFrom) GetExecDelay() int64 {
+	if m != nil {
+		return m.ExecDelay
+	}
+	return 0
+}
+
 //多重签名合约中账户之间转币操作: addr --->MultiSigAddr之间的转账
 //需要判断to地址是否是多重签名地址
 //将MultiSig合约中签名地址上execname+symbol的资产转移到to地址
@@ -965,6 +1079,137 @@ func (m *MultiSigConfirmTx) GetConfirmOrRevoke() bool {
 	return false
 }
 
+// 排队等待执行的交易的执行或者否决
+// executeOrVeto:true表示延迟期满后执行此交易，false表示在执行之前否决此交易
+type MultiSigQueuedTxOperate struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	TxId                 uint64   `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	ExecuteOrVeto        bool     `protobuf:"varint,3,opt,name=executeOrVeto,proto3" json:"executeOrVeto,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigQueuedTxOperate) Reset()         { *m = MultiSigQueuedTxOperate{} }
+func (m *MultiSigQueuedTxOperate) String() string { return proto.CompactTextString(m) }
+func (*MultiSigQueuedTxOperate) ProtoMessage()    {}
+func (*MultiSigQueuedTxOperate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{13}
+}
+
+func (m *MultiSigQueuedTxOperate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigQueuedTxOperate.Unmarshal(m, b)
+}
+func (m *MultiSigQueuedTxOperate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigQueuedTxOperate.Marshal(b, m, deterministic)
+}
+func (m *MultiSigQueuedTxOperate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigQueuedTxOperate.Merge(m, src)
+}
+func (m *MultiSigQueuedTxOperate) XXX_Size() int {
+	return xxx_messageInfo_MultiSigQueuedTxOperate.Size(m)
+}
+func (m *MultiSigQueuedTxOperate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigQueuedTxOperate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigQueuedTxOperate proto.InternalMessageInfo
+
+func (m *MultiSigQueuedTxOperate) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigQueuedTxOperate) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *MultiSigQueuedTxOperate) GetExecuteOrVeto() bool {
+	if m != nil {
+		return m.ExecuteOrVeto
+	}
+	return false
+}
+
+//多重签名账户调用其他合约的交易，权重满足后以多重签名地址作为交易发起地址执行
+// execer:被调用的合约名，合约需要实现ExecMultiSig接口并通过IsFriend允许multisig交易修改其状态数据
+// payload:被调用合约的action编码
+type MultiSigExecCall struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,4,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExecDelay            int64    `protobuf:"varint,5,opt,name=execDelay,proto3" json:"execDelay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigExecCall) Reset()         { *m = MultiSigExecCall{} }
+func (m *MultiSigExecCall) String() string { return proto.CompactTextString(m) }
+func (*MultiSigExecCall) ProtoMessage()    {}
+func (*MultiSigExecCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{14}
+}
+
+func (m *MultiSigExecCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigExecCall.Unmarshal(m, b)
+}
+func (m *MultiSigExecCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigExecCall.Marshal(b, m, deterministic)
+}
+func (m *MultiSigExecCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigExecCall.Merge(m, src)
+}
+func (m *MultiSigExecCall) XXX_Size() int {
+	return xxx_messageInfo_MultiSigExecCall.Size(m)
+}
+func (m *MultiSigExecCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigExecCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigExecCall proto.InternalMessageInfo
+
+func (m *MultiSigExecCall) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigExecCall) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigExecCall) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *MultiSigExecCall) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MultiSigExecCall) GetExecDelay() int64 {
+	if m != nil {
+		return m.ExecDelay
+	}
+	return 0
+}
+
 //获取所有多重签名账号
 type ReqMultiSigAccs struct {
 	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
@@ -978,7 +1223,7 @@ func (m *ReqMultiSigAccs) Reset()         { *m = ReqMultiSigAccs{} }
 func (m *ReqMultiSigAccs) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccs) ProtoMessage()    {}
 func (*ReqMultiSigAccs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{13}
+	return fileDescriptor_62b8b91adf3febfa, []int{15}
 }
 
 func (m *ReqMultiSigAccs) XXX_Unmarshal(b []byte) error {
@@ -1024,7 +1269,7 @@ func (m *ReplyMultiSigAccs) Reset()         { *m = ReplyMultiSigAccs{} }
 func (m *ReplyMultiSigAccs) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigAccs) ProtoMessage()    {}
 func (*ReplyMultiSigAccs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{14}
+	return fileDescriptor_62b8b91adf3febfa, []int{16}
 }
 
 func (m *ReplyMultiSigAccs) XXX_Unmarshal(b []byte) error {
@@ -1064,7 +1309,7 @@ func (m *ReqMultiSigAccInfo) Reset()         { *m = ReqMultiSigAccInfo{} }
 func (m *ReqMultiSigAccInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccInfo) ProtoMessage()    {}
 func (*ReqMultiSigAccInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{15}
+	return fileDescriptor_62b8b91adf3febfa, []int{17}
 }
 
 func (m *ReqMultiSigAccInfo) XXX_Unmarshal(b []byte) error {
@@ -1109,7 +1354,7 @@ func (m *ReplyMultiSigAccInfo) Reset()         { *m = ReplyMultiSigAccInfo{} }
 func (m *ReplyMultiSigAccInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigAccInfo) ProtoMessage()    {}
 func (*ReplyMultiSigAccInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{16}
+	return fileDescriptor_62b8b91adf3febfa, []int{18}
 }
 
 func (m *ReplyMultiSigAccInfo) XXX_Unmarshal(b []byte) error {
@@ -1172,13 +1417,16 @@ func (m *ReplyMultiSigAccInfo) GetRequiredWeight() uint64 {
 	return 0
 }
 
-//获取txids设置过滤条件和区间，pending, executed
+// 获取txids设置过滤条件和区间，pending, executed, expired, queued, vetoed
 type ReqMultiSigTxids struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	FromTxId             uint64   `protobuf:"varint,2,opt,name=fromTxId,proto3" json:"fromTxId,omitempty"`
 	ToTxId               uint64   `protobuf:"varint,3,opt,name=toTxId,proto3" json:"toTxId,omitempty"`
 	Pending              bool     `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
 	Executed             bool     `protobuf:"varint,5,opt,name=executed,proto3" json:"executed,omitempty"`
+	Expired              bool     `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	Queued               bool     `protobuf:"varint,7,opt,name=queued,proto3" json:"queued,omitempty"`
+	Vetoed               bool     `protobuf:"varint,8,opt,name=vetoed,proto3" json:"vetoed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1188,7 +1436,7 @@ func (m *ReqMultiSigTxids) Reset()         { *m = ReqMultiSigTxids{} }
 func (m *ReqMultiSigTxids) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigTxids) ProtoMessage()    {}
 func (*ReqMultiSigTxids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{17}
+	return fileDescriptor_62b8b91adf3febfa, []int{19}
 }
 
 func (m *ReqMultiSigTxids) XXX_Unmarshal(b []byte) error {
@@ -1244,6 +1492,27 @@ func (m *ReqMultiSigTxids) GetExecuted() bool {
 	return false
 }
 
+func (m *ReqMultiSigTxids) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+func (m *ReqMultiSigTxids) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *ReqMultiSigTxids) GetVetoed() bool {
+	if m != nil {
+		return m.Vetoed
+	}
+	return false
+}
+
 type ReplyMultiSigTxids struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	Txids                []uint64 `protobuf:"varint,2,rep,packed,name=txids,proto3" json:"txids,omitempty"`
@@ -1256,7 +1525,7 @@ func (m *ReplyMultiSigTxids) Reset()         { *m = ReplyMultiSigTxids{} }
 func (m *ReplyMultiSigTxids) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigTxids) ProtoMessage()    {}
 func (*ReplyMultiSigTxids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{18}
+	return fileDescriptor_62b8b91adf3febfa, []int{20}
 }
 
 func (m *ReplyMultiSigTxids) XXX_Unmarshal(b []byte) error {
@@ -1304,7 +1573,7 @@ func (m *ReqMultiSigTxInfo) Reset()         { *m = ReqMultiSigTxInfo{} }
 func (m *ReqMultiSigTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigTxInfo) ProtoMessage()    {}
 func (*ReqMultiSigTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{19}
+	return fileDescriptor_62b8b91adf3febfa, []int{21}
 }
 
 func (m *ReqMultiSigTxInfo) XXX_Unmarshal(b []byte) error {
@@ -1350,7 +1619,7 @@ func (m *ReplyMultiSigTxInfo) Reset()         { *m = ReplyMultiSigTxInfo{} }
 func (m *ReplyMultiSigTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigTxInfo) ProtoMessage()    {}
 func (*ReplyMultiSigTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{20}
+	return fileDescriptor_62b8b91adf3febfa, []int{22}
 }
 
 func (m *ReplyMultiSigTxInfo) XXX_Unmarshal(b []byte) error {
@@ -1392,7 +1661,7 @@ func (m *ReqMultiSigAccUnSpentToday) Reset()         { *m = ReqMultiSigAccUnSpen
 func (m *ReqMultiSigAccUnSpentToday) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccUnSpentToday) ProtoMessage()    {}
 func (*ReqMultiSigAccUnSpentToday) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{21}
+	return fileDescriptor_62b8b91adf3febfa, []int{23}
 }
 
 func (m *ReqMultiSigAccUnSpentToday) XXX_Unmarshal(b []byte) error {
@@ -1445,7 +1714,7 @@ func (m *ReplyUnSpentAssets) Reset()         { *m = ReplyUnSpentAssets{} }
 func (m *ReplyUnSpentAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyUnSpentAssets) ProtoMessage()    {}
 func (*ReplyUnSpentAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{22}
+	return fileDescriptor_62b8b91adf3febfa, []int{24}
 }
 
 func (m *ReplyUnSpentAssets) XXX_Unmarshal(b []byte) error {
@@ -1485,7 +1754,7 @@ func (m *UnSpentAssets) Reset()         { *m = UnSpentAssets{} }
 func (m *UnSpentAssets) String() string { return proto.CompactTextString(m) }
 func (*UnSpentAssets) ProtoMessage()    {}
 func (*UnSpentAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{23}
+	return fileDescriptor_62b8b91adf3febfa, []int{25}
 }
 
 func (m *UnSpentAssets) XXX_Unmarshal(b []byte) error {
@@ -1532,7 +1801,7 @@ func (m *ReceiptMultiSig) Reset()         { *m = ReceiptMultiSig{} }
 func (m *ReceiptMultiSig) String() string { return proto.CompactTextString(m) }
 func (*ReceiptMultiSig) ProtoMessage()    {}
 func (*ReceiptMultiSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{24}
+	return fileDescriptor_62b8b91adf3febfa, []int{26}
 }
 
 func (m *ReceiptMultiSig) XXX_Unmarshal(b []byte) error {
@@ -1573,7 +1842,7 @@ func (m *ReceiptOwnerAddOrDel) Reset()         { *m = ReceiptOwnerAddOrDel{} }
 func (m *ReceiptOwnerAddOrDel) String() string { return proto.CompactTextString(m) }
 func (*ReceiptOwnerAddOrDel) ProtoMessage()    {}
 func (*ReceiptOwnerAddOrDel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{25}
+	return fileDescriptor_62b8b91adf3febfa, []int{27}
 }
 
 func (m *ReceiptOwnerAddOrDel) XXX_Unmarshal(b []byte) error {
@@ -1631,7 +1900,7 @@ func (m *ReceiptOwnerModOrRep) Reset()         { *m = ReceiptOwnerModOrRep{} }
 func (m *ReceiptOwnerModOrRep) String() string { return proto.CompactTextString(m) }
 func (*ReceiptOwnerModOrRep) ProtoMessage()    {}
 func (*ReceiptOwnerModOrRep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{26}
+	return fileDescriptor_62b8b91adf3febfa, []int{28}
 }
 
 func (m *ReceiptOwnerModOrRep) XXX_Unmarshal(b []byte) error {
@@ -1695,7 +1964,7 @@ func (m *ReceiptWeightModify) Reset()         { *m = ReceiptWeightModify{} }
 func (m *ReceiptWeightModify) String() string { return proto.CompactTextString(m) }
 func (*ReceiptWeightModify) ProtoMessage()    {}
 func (*ReceiptWeightModify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{27}
+	return fileDescriptor_62b8b91adf3febfa, []int{29}
 }
 
 func (m *ReceiptWeightModify) XXX_Unmarshal(b []byte) error {
@@ -1753,7 +2022,7 @@ func (m *ReceiptDailyLimitOperate) Reset()         { *m = ReceiptDailyLimitOpera
 func (m *ReceiptDailyLimitOperate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptDailyLimitOperate) ProtoMessage()    {}
 func (*ReceiptDailyLimitOperate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{28}
+	return fileDescriptor_62b8b91adf3febfa, []int{30}
 }
 
 func (m *ReceiptDailyLimitOperate) XXX_Unmarshal(b []byte) error {
@@ -1817,7 +2086,7 @@ func (m *ReceiptConfirmTx) Reset()         { *m = ReceiptConfirmTx{} }
 func (m *ReceiptConfirmTx) String() string { return proto.CompactTextString(m) }
 func (*ReceiptConfirmTx) ProtoMessage()    {}
 func (*ReceiptConfirmTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{29}
+	return fileDescriptor_62b8b91adf3febfa, []int{31}
 }
 
 func (m *ReceiptConfirmTx) XXX_Unmarshal(b []byte) error {
@@ -1866,7 +2135,7 @@ func (m *ReceiptAccDailyLimitUpdate) Reset()         { *m = ReceiptAccDailyLimit
 func (m *ReceiptAccDailyLimitUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptAccDailyLimitUpdate) ProtoMessage()    {}
 func (*ReceiptAccDailyLimitUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{30}
+	return fileDescriptor_62b8b91adf3febfa, []int{32}
 }
 
 func (m *ReceiptAccDailyLimitUpdate) XXX_Unmarshal(b []byte) error {
@@ -1909,6 +2178,7 @@ func (m *ReceiptAccDailyLimitUpdate) GetCurDailyLimit() *DailyLimit {
 }
 
 //执行MultiSigAcc相关的交易可能会修改tx的执行状态和增加确认owner
+// 延迟期满后执行交易时没有新增确认owner，multiSigTxOwner中的confirmedOwner为空
 type ReceiptMultiSigTx struct {
 	MultiSigTxOwner      *MultiSigTxOwner `protobuf:"bytes,1,opt,name=multiSigTxOwner,proto3" json:"multiSigTxOwner,omitempty"`
 	PrevExecuted         bool             `protobuf:"varint,2,opt,name=prevExecuted,proto3" json:"prevExecuted,omitempty"`
@@ -1916,6 +2186,10 @@ type ReceiptMultiSigTx struct {
 	SubmitOrConfirm      bool             `protobuf:"varint,4,opt,name=submitOrConfirm,proto3" json:"submitOrConfirm,omitempty"`
 	TxHash               string           `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
 	TxType               uint64           `protobuf:"varint,6,opt,name=txType,proto3" json:"txType,omitempty"`
+	ExpireHeight         int64            `protobuf:"varint,7,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExecDelay            int64            `protobuf:"varint,8,opt,name=execDelay,proto3" json:"execDelay,omitempty"`
+	PrevQueuedHeight     int64            `protobuf:"varint,9,opt,name=prevQueuedHeight,proto3" json:"prevQueuedHeight,omitempty"`
+	CurQueuedHeight      int64            `protobuf:"varint,10,opt,name=curQueuedHeight,proto3" json:"curQueuedHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1925,7 +2199,7 @@ func (m *ReceiptMultiSigTx) Reset()         { *m = ReceiptMultiSigTx{} }
 func (m *ReceiptMultiSigTx) String() string { return proto.CompactTextString(m) }
 func (*ReceiptMultiSigTx) ProtoMessage()    {}
 func (*ReceiptMultiSigTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{31}
+	return fileDescriptor_62b8b91adf3febfa, []int{33}
 }
 
 func (m *ReceiptMultiSigTx) XXX_Unmarshal(b []byte) error {
@@ -1988,6 +2262,74 @@ func (m *ReceiptMultiSigTx) GetTxType() uint64 {
 	return 0
 }
 
+func (m *ReceiptMultiSigTx) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *ReceiptMultiSigTx) GetExecDelay() int64 {
+	if m != nil {
+		return m.ExecDelay
+	}
+	return 0
+}
+
+func (m *ReceiptMultiSigTx) GetPrevQueuedHeight() int64 {
+	if m != nil {
+		return m.PrevQueuedHeight
+	}
+	return 0
+}
+
+func (m *ReceiptMultiSigTx) GetCurQueuedHeight() int64 {
+	if m != nil {
+		return m.CurQueuedHeight
+	}
+	return 0
+}
+
+// TyLogMultiSigTxVeto = 10013 //输出否决的交易id，以及否决的owner信息
+type ReceiptTxVeto struct {
+	MultiSigTxOwner      *MultiSigTxOwner `protobuf:"bytes,1,opt,name=multiSigTxOwner,proto3" json:"multiSigTxOwner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReceiptTxVeto) Reset()         { *m = ReceiptTxVeto{} }
+func (m *ReceiptTxVeto) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTxVeto) ProtoMessage()    {}
+func (*ReceiptTxVeto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{34}
+}
+
+func (m *ReceiptTxVeto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTxVeto.Unmarshal(m, b)
+}
+func (m *ReceiptTxVeto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTxVeto.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTxVeto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTxVeto.Merge(m, src)
+}
+func (m *ReceiptTxVeto) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTxVeto.Size(m)
+}
+func (m *ReceiptTxVeto) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTxVeto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTxVeto proto.InternalMessageInfo
+
+func (m *ReceiptTxVeto) GetMultiSigTxOwner() *MultiSigTxOwner {
+	if m != nil {
+		return m.MultiSigTxOwner
+	}
+	return nil
+}
+
 type ReceiptTxCountUpdate struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	CurTxCount           uint64   `protobuf:"varint,2,opt,name=curTxCount,proto3" json:"curTxCount,omitempty"`
@@ -2000,7 +2342,7 @@ func (m *ReceiptTxCountUpdate) Reset()         { *m = ReceiptTxCountUpdate{} }
 func (m *ReceiptTxCountUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTxCountUpdate) ProtoMessage()    {}
 func (*ReceiptTxCountUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{32}
+	return fileDescriptor_62b8b91adf3febfa, []int{35}
 }
 
 func (m *ReceiptTxCountUpdate) XXX_Unmarshal(b []byte) error {
@@ -2048,7 +2390,7 @@ func (m *MultiSigTxOwner) Reset()         { *m = MultiSigTxOwner{} }
 func (m *MultiSigTxOwner) String() string { return proto.CompactTextString(m) }
 func (*MultiSigTxOwner) ProtoMessage()    {}
 func (*MultiSigTxOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{33}
+	return fileDescriptor_62b8b91adf3febfa, []int{36}
 }
 
 func (m *MultiSigTxOwner) XXX_Unmarshal(b []byte) error {
@@ -2101,7 +2443,7 @@ func (m *Uint64) Reset()         { *m = Uint64{} }
 func (m *Uint64) String() string { return proto.CompactTextString(m) }
 func (*Uint64) ProtoMessage()    {}
 func (*Uint64) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{34}
+	return fileDescriptor_62b8b91adf3febfa, []int{37}
 }
 
 func (m *Uint64) XXX_Unmarshal(b []byte) error {
@@ -2143,7 +2485,7 @@ func (m *AccountAssets) Reset()         { *m = AccountAssets{} }
 func (m *AccountAssets) String() string { return proto.CompactTextString(m) }
 func (*AccountAssets) ProtoMessage()    {}
 func (*AccountAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{35}
+	return fileDescriptor_62b8b91adf3febfa, []int{38}
 }
 
 func (m *AccountAssets) XXX_Unmarshal(b []byte) error {
@@ -2199,7 +2541,7 @@ func (m *ReqAccAssets) Reset()         { *m = ReqAccAssets{} }
 func (m *ReqAccAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAccAssets) ProtoMessage()    {}
 func (*ReqAccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{36}
+	return fileDescriptor_62b8b91adf3febfa, []int{39}
 }
 
 func (m *ReqAccAssets) XXX_Unmarshal(b []byte) error {
@@ -2252,7 +2594,7 @@ func (m *ReplyAccAssets) Reset()         { *m = ReplyAccAssets{} }
 func (m *ReplyAccAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyAccAssets) ProtoMessage()    {}
 func (*ReplyAccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{37}
+	return fileDescriptor_62b8b91adf3febfa, []int{40}
 }
 
 func (m *ReplyAccAssets) XXX_Unmarshal(b []byte) error {
@@ -2294,7 +2636,7 @@ func (m *AccAssets) Reset()         { *m = AccAssets{} }
 func (m *AccAssets) String() string { return proto.CompactTextString(m) }
 func (*AccAssets) ProtoMessage()    {}
 func (*AccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{38}
+	return fileDescriptor_62b8b91adf3febfa, []int{41}
 }
 
 func (m *AccAssets) XXX_Unmarshal(b []byte) error {
@@ -2348,7 +2690,7 @@ func (m *Assets) Reset()         { *m = Assets{} }
 func (m *Assets) String() string { return proto.CompactTextString(m) }
 func (*Assets) ProtoMessage()    {}
 func (*Assets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{39}
+	return fileDescriptor_62b8b91adf3febfa, []int{42}
 }
 
 func (m *Assets) XXX_Unmarshal(b []byte) error {
@@ -2395,7 +2737,7 @@ func (m *AccAddress) Reset()         { *m = AccAddress{} }
 func (m *AccAddress) String() string { return proto.CompactTextString(m) }
 func (*AccAddress) ProtoMessage()    {}
 func (*AccAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{40}
+	return fileDescriptor_62b8b91adf3febfa, []int{43}
 }
 
 func (m *AccAddress) XXX_Unmarshal(b []byte) error {
@@ -2437,7 +2779,7 @@ func (m *OwnerAttr) Reset()         { *m = OwnerAttr{} }
 func (m *OwnerAttr) String() string { return proto.CompactTextString(m) }
 func (*OwnerAttr) ProtoMessage()    {}
 func (*OwnerAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{41}
+	return fileDescriptor_62b8b91adf3febfa, []int{44}
 }
 
 func (m *OwnerAttr) XXX_Unmarshal(b []byte) error {
@@ -2490,7 +2832,7 @@ func (m *OwnerAttrs) Reset()         { *m = OwnerAttrs{} }
 func (m *OwnerAttrs) String() string { return proto.CompactTextString(m) }
 func (*OwnerAttrs) ProtoMessage()    {}
 func (*OwnerAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{42}
+	return fileDescriptor_62b8b91adf3febfa, []int{45}
 }
 
 func (m *OwnerAttrs) XXX_Unmarshal(b []byte) error {
@@ -2532,6 +2874,8 @@ func init() {
 	proto.RegisterType((*MultiSigExecTransferFrom)(nil), "types.MultiSigExecTransferFrom")
 	proto.RegisterType((*MultiSigExecTransferTo)(nil), "types.MultiSigExecTransferTo")
 	proto.RegisterType((*MultiSigConfirmTx)(nil), "types.MultiSigConfirmTx")
+	proto.RegisterType((*MultiSigQueuedTxOperate)(nil), "types.MultiSigQueuedTxOperate")
+	proto.RegisterType((*MultiSigExecCall)(nil), "types.MultiSigExecCall")
 	proto.RegisterType((*ReqMultiSigAccs)(nil), "types.ReqMultiSigAccs")
 	proto.RegisterType((*ReplyMultiSigAccs)(nil), "types.ReplyMultiSigAccs")
 	proto.RegisterType((*ReqMultiSigAccInfo)(nil), "types.ReqMultiSigAccInfo")
@@ -2551,6 +2895,7 @@ func init() {
 	proto.RegisterType((*ReceiptConfirmTx)(nil), "types.ReceiptConfirmTx")
 	proto.RegisterType((*ReceiptAccDailyLimitUpdate)(nil), "types.ReceiptAccDailyLimitUpdate")
 	proto.RegisterType((*ReceiptMultiSigTx)(nil), "types.ReceiptMultiSigTx")
+	proto.RegisterType((*ReceiptTxVeto)(nil), "types.ReceiptTxVeto")
 	proto.RegisterType((*ReceiptTxCountUpdate)(nil), "types.ReceiptTxCountUpdate")
 	proto.RegisterType((*MultiSigTxOwner)(nil), "types.MultiSigTxOwner")
 	proto.RegisterType((*Uint64)(nil), "types.Uint64")
@@ -2569,104 +2914,119 @@ func init() {
 }
 
 var fileDescriptor_62b8b91adf3febfa = []byte{
-	// 1572 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x18, 0xdd, 0x6e, 0xdc, 0x44,
-	0x37, 0xf6, 0xfe, 0x24, 0x7b, 0x92, 0x6c, 0x93, 0xf9, 0xa2, 0x7c, 0x26, 0x94, 0x12, 0x8d, 0x4a,
-	0xb5, 0xaa, 0x20, 0x42, 0x69, 0xa1, 0x14, 0x09, 0xd4, 0xa5, 0x69, 0x95, 0xaa, 0xa4, 0x29, 0xd3,
-	0xad, 0x2a, 0x21, 0x71, 0xe1, 0xd8, 0x93, 0xd4, 0x62, 0xd7, 0xde, 0xda, 0xde, 0x64, 0x17, 0x90,
-	0xca, 0x25, 0x2f, 0x00, 0x17, 0x5c, 0x20, 0x1e, 0x82, 0x0b, 0x9e, 0x00, 0x5e, 0x80, 0xb7, 0xe0,
-	0x1a, 0x71, 0x8b, 0xe6, 0xcf, 0x9e, 0xb1, 0xbd, 0x91, 0x2b, 0x15, 0x84, 0xb8, 0xf3, 0x39, 0x73,
-	0xe6, 0xfc, 0xff, 0x79, 0xa0, 0x3b, 0x9a, 0x0c, 0xd3, 0x20, 0x09, 0x4e, 0x76, 0xc6, 0x71, 0x94,
-	0x46, 0xa8, 0x95, 0xce, 0xc6, 0x34, 0xd9, 0x5a, 0x75, 0x3d, 0x2f, 0x9a, 0x84, 0xa9, 0xc0, 0xe2,
-	0xdf, 0x2d, 0x58, 0x3a, 0x60, 0x84, 0x8f, 0x82, 0x13, 0x74, 0x09, 0xc0, 0x8b, 0xa9, 0x9b, 0xd2,
-	0xbe, 0xef, 0xc7, 0x8e, 0xb5, 0x6d, 0xf5, 0x3a, 0x44, 0xc3, 0x20, 0x0c, 0x2b, 0x23, 0x49, 0xcb,
-	0x29, 0x6c, 0x4e, 0x61, 0xe0, 0xd0, 0x65, 0x68, 0x47, 0x67, 0x21, 0x8d, 0x13, 0xa7, 0xb1, 0xdd,
-	0xe8, 0x2d, 0xef, 0xae, 0xec, 0x70, 0xb9, 0x3b, 0x87, 0x0c, 0x49, 0xe4, 0x19, 0xba, 0x06, 0xcb,
-	0xbe, 0x1b, 0x0c, 0x67, 0x1f, 0x07, 0xa3, 0x20, 0x4d, 0x9c, 0x26, 0x27, 0x5d, 0x97, 0xa4, 0x7b,
-	0xd9, 0x09, 0xd1, 0xa9, 0x90, 0x03, 0x8b, 0xe9, 0xf4, 0x36, 0x53, 0xde, 0x69, 0x6d, 0x5b, 0xbd,
-	0x26, 0x51, 0x20, 0xba, 0x02, 0xdd, 0x98, 0x3e, 0x9b, 0x04, 0x31, 0xf5, 0x9f, 0xd0, 0xe0, 0xe4,
-	0x69, 0xea, 0xb4, 0x39, 0x41, 0x01, 0x8b, 0xef, 0x42, 0xf7, 0x76, 0x14, 0x1e, 0x07, 0xf1, 0x88,
-	0xfa, 0x5c, 0x21, 0x74, 0x1d, 0xba, 0x9e, 0x81, 0x71, 0xac, 0x0a, 0xb5, 0x0b, 0x34, 0xf8, 0x17,
-	0x0b, 0x40, 0x79, 0x6d, 0x30, 0x45, 0x08, 0x9a, 0xe9, 0x34, 0xf0, 0xb9, 0xc7, 0x9a, 0x84, 0x7f,
-	0xa3, 0x4d, 0x68, 0xa7, 0xd3, 0x7d, 0x37, 0x79, 0x2a, 0xbd, 0x24, 0x21, 0xb4, 0x05, 0x4b, 0x74,
-	0x4a, 0xbd, 0x49, 0x4a, 0x7d, 0xa7, 0xb1, 0x6d, 0xf5, 0x96, 0x48, 0x06, 0x8b, 0x3b, 0x83, 0xd9,
-	0x98, 0x3a, 0x4d, 0xce, 0x49, 0x42, 0x25, 0xbf, 0xb7, 0x2a, 0xfc, 0x5e, 0x36, 0xa4, 0x5d, 0xc3,
-	0x90, 0x0f, 0xa0, 0x25, 0xfc, 0x70, 0x11, 0x3a, 0x3c, 0x34, 0x5a, 0xe4, 0x73, 0x04, 0x53, 0xec,
-	0x4c, 0xf8, 0xd5, 0x16, 0x8a, 0x09, 0x08, 0x7f, 0x67, 0x01, 0xe4, 0xd1, 0x62, 0x64, 0xc9, 0x6c,
-	0x74, 0x14, 0x0d, 0x25, 0x07, 0x09, 0x31, 0x3c, 0xb3, 0x91, 0xaa, 0x8c, 0x91, 0x10, 0xcb, 0xb7,
-	0x3c, 0xbe, 0xdc, 0x1b, 0x4d, 0xa2, 0x61, 0xd8, 0x79, 0x32, 0xa6, 0x61, 0x3a, 0x88, 0x7c, 0x77,
-	0x26, 0x7d, 0xa2, 0x61, 0x58, 0x42, 0x0c, 0xdd, 0x24, 0xdd, 0x73, 0x67, 0xdc, 0x25, 0x0d, 0xa2,
-	0x40, 0x7c, 0x04, 0x6b, 0x8f, 0xb8, 0xec, 0xbf, 0x4f, 0x3b, 0xfc, 0x6d, 0x13, 0xba, 0x2a, 0x09,
-	0xfa, 0x5e, 0x1a, 0x44, 0x21, 0xda, 0x87, 0xf5, 0x2c, 0x28, 0x9e, 0x77, 0x9b, 0x57, 0x0e, 0x97,
-	0xb6, 0xbc, 0xeb, 0xc8, 0x38, 0x1c, 0x14, 0xcf, 0xf7, 0x17, 0x48, 0xf9, 0x12, 0xfa, 0x04, 0x36,
-	0x14, 0x92, 0x07, 0xe8, 0x70, 0x4c, 0x63, 0xc6, 0xcc, 0xe6, 0xcc, 0x5e, 0x2d, 0x30, 0xd3, 0x49,
-	0xf6, 0x17, 0x48, 0xe5, 0x55, 0x74, 0x1f, 0x90, 0x26, 0x47, 0x31, 0x6c, 0x70, 0x86, 0xaf, 0x94,
-	0xb5, 0xcb, 0xd9, 0x55, 0x5c, 0xd3, 0x2d, 0x95, 0x15, 0x35, 0x98, 0xf2, 0x08, 0x95, 0x2d, 0xcd,
-	0xce, 0x75, 0x4b, 0x33, 0x24, 0x7a, 0x02, 0x9b, 0x0a, 0x79, 0x67, 0x4a, 0xbd, 0x41, 0xec, 0x86,
-	0xc9, 0x31, 0x8d, 0x07, 0x11, 0x8f, 0xe9, 0xf2, 0xee, 0x6b, 0x05, 0x76, 0x26, 0xd1, 0xfe, 0x02,
-	0x99, 0x73, 0x1d, 0x7d, 0x06, 0x4e, 0xd5, 0xc9, 0xdd, 0x38, 0x1a, 0xf1, 0xf6, 0xb0, 0xbc, 0xfb,
-	0xfa, 0x39, 0xac, 0x19, 0xd9, 0xfe, 0x02, 0x99, 0xcb, 0x02, 0x75, 0xc1, 0x1e, 0xcc, 0x9c, 0xc5,
-	0x6d, 0xab, 0xd7, 0x22, 0xf6, 0x60, 0xf6, 0xd1, 0x22, 0xb4, 0x4e, 0xdd, 0xe1, 0x84, 0xe2, 0xef,
-	0x2d, 0x58, 0x2f, 0x45, 0x59, 0xeb, 0x8b, 0xd6, 0x39, 0x7d, 0xb1, 0xdc, 0xc8, 0xec, 0xaa, 0x46,
-	0x86, 0x6e, 0x94, 0x72, 0x73, 0x79, 0xf7, 0xff, 0x92, 0x63, 0x31, 0xf1, 0x8d, 0xa4, 0xfd, 0xd9,
-	0x82, 0x8d, 0xaa, 0xac, 0x41, 0x3d, 0xb8, 0xa0, 0x85, 0x59, 0x6b, 0x03, 0x45, 0x34, 0xeb, 0x60,
-	0xd1, 0x50, 0xf6, 0x18, 0x51, 0x31, 0x19, 0xcc, 0xce, 0x42, 0x7a, 0x26, 0xce, 0x1a, 0xe2, 0x4c,
-	0xc1, 0xac, 0xc5, 0x84, 0xf4, 0x4c, 0x9a, 0x25, 0x8a, 0x39, 0x47, 0xa0, 0x6d, 0x58, 0x8e, 0x84,
-	0x2a, 0x77, 0x87, 0xee, 0x89, 0x6c, 0xf0, 0x3a, 0x0a, 0xff, 0x6a, 0x01, 0x2a, 0xe7, 0xe7, 0x0b,
-	0x28, 0x6e, 0x3a, 0xcd, 0xae, 0xed, 0x34, 0xf4, 0x26, 0xac, 0x87, 0xf4, 0x8c, 0x98, 0x81, 0x11,
-	0x0d, 0xa1, 0x7c, 0x50, 0xb4, 0xa4, 0xc9, 0x9b, 0xbc, 0x61, 0xc9, 0x0f, 0x16, 0x38, 0xf3, 0x72,
-	0xee, 0xbc, 0x36, 0xe5, 0x8e, 0xf8, 0xf0, 0xb3, 0x79, 0xaf, 0x93, 0x10, 0x1b, 0x3e, 0x61, 0x24,
-	0x0b, 0xb9, 0x43, 0xf8, 0xb7, 0x1a, 0x32, 0xa1, 0x3b, 0x12, 0xa3, 0xa4, 0x43, 0x32, 0x98, 0xe5,
-	0x6d, 0x1a, 0xc9, 0x11, 0x62, 0xa7, 0x11, 0xbb, 0x7f, 0xac, 0x4a, 0xa2, 0x43, 0xf8, 0x37, 0xfe,
-	0xc6, 0x82, 0xcd, 0xea, 0x7a, 0xfb, 0xa7, 0xd5, 0xc3, 0x5f, 0xe6, 0xc5, 0x94, 0xf7, 0x8c, 0xfa,
-	0x31, 0xe7, 0xa3, 0xf9, 0x9e, 0x2f, 0xcb, 0x88, 0x7f, 0xb3, 0xdb, 0x72, 0x0c, 0x1e, 0xc6, 0x84,
-	0x9e, 0x46, 0x9f, 0x53, 0x39, 0x89, 0x8b, 0x68, 0x7c, 0x13, 0x2e, 0x10, 0xfa, 0x4c, 0x4b, 0xba,
-	0x04, 0x6d, 0x40, 0x2b, 0x49, 0xdd, 0x38, 0xe5, 0x02, 0x1b, 0x44, 0x00, 0x68, 0x0d, 0x1a, 0x34,
-	0xf4, 0xa5, 0xe9, 0xec, 0x13, 0xbf, 0x05, 0xeb, 0x84, 0x8e, 0x87, 0x33, 0xe3, 0xb2, 0x03, 0x8b,
-	0xae, 0xef, 0xc7, 0x34, 0x11, 0x5d, 0xa0, 0x43, 0x14, 0x88, 0x3f, 0x04, 0x64, 0x4a, 0xba, 0x17,
-	0x1e, 0x47, 0xf5, 0xed, 0xc4, 0x7f, 0x5a, 0xb0, 0x51, 0x94, 0xc7, 0x59, 0xfc, 0xe7, 0x77, 0xba,
-	0x1f, 0x2d, 0x58, 0xd3, 0x5c, 0x37, 0x98, 0x06, 0x7e, 0x52, 0xb2, 0xca, 0xaa, 0xb0, 0x6a, 0x0b,
-	0x96, 0x58, 0xb2, 0x0f, 0xf2, 0xf4, 0xc8, 0x60, 0xbe, 0x89, 0x45, 0xfc, 0xa4, 0x21, 0x37, 0x31,
-	0x0e, 0x31, 0x75, 0xc7, 0x34, 0xf4, 0x83, 0x50, 0xd5, 0xb5, 0x02, 0x8d, 0xbd, 0xae, 0x65, 0xee,
-	0x75, 0xf8, 0x01, 0x0b, 0xae, 0x16, 0x9b, 0xfa, 0x3a, 0x6e, 0x40, 0x8b, 0x6d, 0x93, 0x89, 0x63,
-	0x6f, 0x37, 0x7a, 0x4d, 0x22, 0x00, 0x7c, 0x9f, 0xe5, 0x96, 0x66, 0x31, 0x0f, 0x74, 0x1d, 0x76,
-	0x15, 0xd5, 0x80, 0x1f, 0xc2, 0xff, 0x0a, 0xca, 0x71, 0x76, 0x37, 0xe5, 0x0f, 0x44, 0x86, 0x91,
-	0x7b, 0xcc, 0x7a, 0x61, 0x66, 0x0e, 0xa6, 0xa4, 0x40, 0x88, 0xc7, 0xb0, 0x65, 0xe6, 0xf2, 0xe3,
-	0xf0, 0x51, 0xbe, 0xb4, 0xd5, 0xd1, 0x73, 0xde, 0x4a, 0x96, 0x37, 0x9f, 0x86, 0xde, 0x7c, 0xf0,
-	0x43, 0xe9, 0x60, 0x29, 0xa8, 0x9f, 0x24, 0x34, 0x4d, 0xd0, 0xfb, 0xb0, 0x3a, 0xd1, 0x11, 0x32,
-	0x7b, 0x37, 0xa4, 0x05, 0x06, 0x31, 0x31, 0x49, 0xf1, 0x03, 0x58, 0x35, 0x99, 0xbd, 0x01, 0x6d,
-	0x57, 0x70, 0x11, 0x7e, 0x58, 0x95, 0x5c, 0xe4, 0x75, 0x79, 0x58, 0x68, 0x83, 0x4d, 0xd5, 0x06,
-	0xf1, 0x3b, 0xac, 0x93, 0x78, 0x34, 0x18, 0xa7, 0xd9, 0xdf, 0x56, 0x0d, 0x47, 0xe0, 0x2f, 0x58,
-	0x55, 0xf3, 0x6b, 0x87, 0x72, 0x19, 0x3f, 0x8c, 0xf7, 0xe8, 0xb0, 0x96, 0x13, 0x31, 0xb4, 0xa2,
-	0x6c, 0x48, 0x17, 0x8b, 0x56, 0x1c, 0xb1, 0xac, 0x75, 0x25, 0x4f, 0xf5, 0x37, 0xa2, 0x60, 0xfc,
-	0x93, 0x65, 0x0a, 0x3f, 0x88, 0x7c, 0xd6, 0x18, 0xc7, 0xb5, 0x84, 0x5f, 0x85, 0xce, 0x38, 0xa6,
-	0xa7, 0x87, 0x73, 0x15, 0xc8, 0x8f, 0xd1, 0xdb, 0xb0, 0xe2, 0x4d, 0xe2, 0x98, 0x86, 0x69, 0xbe,
-	0x38, 0x14, 0xc9, 0x0d, 0x0a, 0xa6, 0xf6, 0x48, 0x6a, 0x23, 0xeb, 0x30, 0x83, 0xf1, 0x73, 0x96,
-	0xcf, 0x5c, 0x6b, 0xd1, 0x20, 0x0e, 0x22, 0x3f, 0x38, 0xae, 0x97, 0x76, 0x97, 0x00, 0x98, 0x56,
-	0xc6, 0xe6, 0xa5, 0x61, 0xd0, 0x65, 0x58, 0x95, 0x6a, 0x18, 0x3b, 0x80, 0x89, 0xc4, 0xbf, 0x59,
-	0xe0, 0x48, 0x0d, 0xf2, 0xae, 0xa7, 0xb6, 0x95, 0x3a, 0x6a, 0xdc, 0x84, 0x2e, 0x13, 0xba, 0x57,
-	0xdc, 0x55, 0x2a, 0x7a, 0x69, 0x81, 0x10, 0xdd, 0xe0, 0x1a, 0xee, 0x15, 0x57, 0xc3, 0x8a, 0x9b,
-	0x26, 0x1d, 0x5b, 0x5a, 0x78, 0xe0, 0x85, 0xb7, 0xd4, 0xd2, 0xa2, 0xa1, 0xf0, 0xd7, 0xbc, 0xcf,
-	0x72, 0xb3, 0xf2, 0x41, 0x7c, 0x2b, 0x1f, 0x50, 0x83, 0xa9, 0xfa, 0x7f, 0x66, 0x12, 0x37, 0x4b,
-	0x6d, 0x42, 0xc4, 0xb1, 0x48, 0x8e, 0xae, 0xc2, 0x9a, 0xfa, 0x27, 0xcd, 0xa6, 0xb1, 0xcd, 0xa5,
-	0x97, 0xf0, 0x2c, 0x23, 0xb7, 0xa4, 0x0a, 0x7d, 0xcf, 0xcb, 0xb5, 0x7f, 0x3c, 0xf6, 0xff, 0xc5,
-	0xbe, 0xc5, 0x7f, 0x58, 0xac, 0x5f, 0x1b, 0xc5, 0xff, 0x52, 0x5c, 0x87, 0x61, 0x85, 0xa9, 0x78,
-	0x47, 0x8d, 0x1d, 0xe1, 0x36, 0x03, 0xc7, 0xe2, 0xea, 0x4d, 0xe2, 0x3b, 0xe6, 0x8b, 0x83, 0x8e,
-	0x62, 0x3b, 0x46, 0x32, 0x39, 0x62, 0x29, 0x1a, 0xcb, 0xb8, 0xca, 0xe8, 0x17, 0xd1, 0xda, 0x93,
-	0x46, 0xcb, 0x78, 0xd2, 0xc8, 0x9f, 0x2d, 0xda, 0xfa, 0xb3, 0x05, 0xfe, 0x34, 0xeb, 0x1f, 0x03,
-	0x31, 0xd3, 0x5f, 0x20, 0x4e, 0x6c, 0x6d, 0x99, 0xc4, 0xf2, 0x9e, 0x2a, 0xc5, 0x1c, 0x83, 0x9f,
-	0xc3, 0x85, 0x83, 0xb2, 0x3b, 0xea, 0x0d, 0xc0, 0x40, 0x1b, 0x80, 0x81, 0x5f, 0xf1, 0x72, 0x52,
-	0xd5, 0x80, 0x8a, 0x2f, 0x27, 0x17, 0xa1, 0xfd, 0x38, 0x08, 0xd3, 0x77, 0xaf, 0x33, 0x9e, 0xbe,
-	0x9b, 0xba, 0xea, 0xf5, 0x87, 0x7d, 0xe3, 0x18, 0x56, 0xfb, 0xe2, 0x9d, 0x4d, 0x8e, 0x8f, 0x3a,
-	0xca, 0xe5, 0x23, 0xc6, 0xae, 0x37, 0x62, 0x1a, 0xfa, 0xa6, 0x8d, 0x23, 0x58, 0x21, 0xf4, 0x19,
-	0x5b, 0x08, 0x5f, 0xba, 0xc8, 0x0d, 0x68, 0x05, 0x49, 0x7f, 0xa8, 0x66, 0x84, 0x00, 0xf0, 0x2d,
-	0xe8, 0xf2, 0xa9, 0x9b, 0x8b, 0xdc, 0x81, 0x8e, 0xab, 0x00, 0xf9, 0x9f, 0xbb, 0xa6, 0x38, 0x2a,
-	0x3c, 0xc9, 0x49, 0xf0, 0x57, 0xd0, 0xc9, 0x2f, 0xd7, 0x9c, 0xb0, 0x97, 0x00, 0x62, 0xea, 0x9d,
-	0xf6, 0xf5, 0x9f, 0x0d, 0x0d, 0x83, 0x7a, 0xb0, 0x28, 0x9f, 0x38, 0x65, 0x1c, 0xbb, 0xb9, 0x06,
-	0x0c, 0x4b, 0xd4, 0x31, 0x7e, 0x0f, 0xda, 0xfd, 0xcc, 0xa5, 0x72, 0xdf, 0xb0, 0xe6, 0xec, 0x1b,
-	0xb6, 0xb1, 0x6f, 0x5c, 0x01, 0x90, 0x8b, 0x37, 0x4d, 0xce, 0xdb, 0xea, 0x29, 0x74, 0xc4, 0xdc,
-	0x4e, 0xd3, 0x7a, 0xf9, 0x69, 0x3c, 0xc3, 0xd9, 0xf3, 0x9f, 0xe1, 0x1a, 0xc6, 0x33, 0xdc, 0x75,
-	0x80, 0x4c, 0x4c, 0x82, 0xae, 0x40, 0x2b, 0x48, 0xe9, 0xa8, 0x18, 0x80, 0x8c, 0x82, 0x88, 0xe3,
-	0xa3, 0x36, 0x7f, 0x01, 0xbe, 0xf6, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x1b, 0x43, 0x0b,
-	0x29, 0x16, 0x00, 0x00,
+	// 1816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6e, 0x24, 0x49,
+	0x11, 0x76, 0x55, 0x77, 0xf5, 0x4f, 0xd8, 0xee, 0xe9, 0xce, 0x6d, 0x79, 0x0a, 0x33, 0x18, 0x2b,
+	0xb5, 0xac, 0x5a, 0x23, 0xb0, 0x90, 0x77, 0x60, 0x19, 0x24, 0xd0, 0x36, 0xb6, 0x47, 0xbd, 0x5a,
+	0xbc, 0x5e, 0xe7, 0xf4, 0xb0, 0xd2, 0x4a, 0x1c, 0xca, 0x5d, 0x69, 0x4f, 0x89, 0xee, 0xaa, 0x76,
+	0x55, 0xb5, 0xdd, 0xcd, 0x8f, 0x86, 0x23, 0x1c, 0xb8, 0x72, 0xe0, 0x29, 0x38, 0x70, 0xe0, 0x01,
+	0x78, 0x04, 0x1e, 0x80, 0x33, 0x3c, 0x00, 0x12, 0x27, 0x94, 0x7f, 0xf5, 0x93, 0x55, 0x6d, 0xd7,
+	0xc8, 0x03, 0x42, 0xdc, 0x2a, 0x22, 0x23, 0x23, 0x23, 0x23, 0x23, 0xbf, 0x88, 0x8c, 0x82, 0xce,
+	0x6c, 0x31, 0x8d, 0xbd, 0xc8, 0xbb, 0x3a, 0x98, 0x87, 0x41, 0x1c, 0x20, 0x2b, 0x5e, 0xcd, 0x69,
+	0xb4, 0xbb, 0xed, 0x4c, 0x26, 0xc1, 0xc2, 0x8f, 0x05, 0x17, 0xff, 0xc3, 0x80, 0xd6, 0x29, 0x13,
+	0x7c, 0xe9, 0x5d, 0xa1, 0x3d, 0x80, 0x49, 0x48, 0x9d, 0x98, 0x0e, 0x5d, 0x37, 0xb4, 0x8d, 0x7d,
+	0x63, 0xd0, 0x26, 0x19, 0x0e, 0xc2, 0xb0, 0x35, 0x93, 0xb2, 0x5c, 0xc2, 0xe4, 0x12, 0x39, 0x1e,
+	0x7a, 0x1f, 0x1a, 0xc1, 0xad, 0x4f, 0xc3, 0xc8, 0xae, 0xed, 0xd7, 0x06, 0x9b, 0x87, 0x5b, 0x07,
+	0x7c, 0xdd, 0x83, 0x33, 0xc6, 0x24, 0x72, 0x0c, 0x7d, 0x08, 0x9b, 0xae, 0xe3, 0x4d, 0x57, 0x3f,
+	0xf6, 0x66, 0x5e, 0x1c, 0xd9, 0x75, 0x2e, 0xda, 0x93, 0xa2, 0xc7, 0xc9, 0x08, 0xc9, 0x4a, 0x21,
+	0x1b, 0x9a, 0xf1, 0xf2, 0x88, 0x19, 0x6f, 0x5b, 0xfb, 0xc6, 0xa0, 0x4e, 0x14, 0x89, 0x3e, 0x80,
+	0x4e, 0x48, 0xaf, 0x17, 0x5e, 0x48, 0xdd, 0x2f, 0xa8, 0x77, 0xf5, 0x3a, 0xb6, 0x1b, 0x5c, 0x40,
+	0xe3, 0xe2, 0x17, 0xd0, 0x39, 0x0a, 0xfc, 0x4b, 0x2f, 0x9c, 0x51, 0x97, 0x1b, 0x84, 0x9e, 0x41,
+	0x67, 0x92, 0xe3, 0xd8, 0x46, 0x89, 0xd9, 0x9a, 0x0c, 0xfe, 0x8b, 0x09, 0xa0, 0xbc, 0x36, 0x5e,
+	0x22, 0x04, 0xf5, 0x78, 0xe9, 0xb9, 0xdc, 0x63, 0x75, 0xc2, 0xbf, 0xd1, 0x0e, 0x34, 0xe2, 0xe5,
+	0xc8, 0x89, 0x5e, 0x4b, 0x2f, 0x49, 0x0a, 0xed, 0x42, 0x8b, 0x2e, 0xe9, 0x64, 0x11, 0x53, 0xd7,
+	0xae, 0xed, 0x1b, 0x83, 0x16, 0x49, 0x68, 0x31, 0x67, 0xbc, 0x9a, 0x53, 0xbb, 0xce, 0x35, 0x49,
+	0xaa, 0xe0, 0x77, 0xab, 0xc4, 0xef, 0xc5, 0x8d, 0x34, 0xee, 0xdf, 0x08, 0xd3, 0x4c, 0x97, 0x73,
+	0x2f, 0xa4, 0x23, 0xe1, 0xb6, 0xe6, 0xbe, 0x31, 0xa8, 0x91, 0x1c, 0x0f, 0x3d, 0x81, 0x36, 0xb3,
+	0xf0, 0x98, 0x4e, 0x9d, 0x95, 0xdd, 0xe2, 0x02, 0x29, 0x83, 0x69, 0xb8, 0x5e, 0xd0, 0x05, 0x75,
+	0xa5, 0x86, 0xb6, 0xd0, 0x90, 0xe5, 0xb1, 0x7d, 0xdd, 0xd0, 0x38, 0xa0, 0xae, 0x0d, 0x7c, 0xc7,
+	0x92, 0xc2, 0x3f, 0x00, 0x4b, 0x98, 0xf1, 0x04, 0xda, 0x3c, 0x30, 0x32, 0x71, 0x97, 0x32, 0xd8,
+	0xf4, 0x5b, 0xa1, 0xdc, 0x14, 0x6e, 0x11, 0x14, 0xfe, 0xbd, 0x01, 0x90, 0xc6, 0x0a, 0x13, 0x8b,
+	0x56, 0xb3, 0x8b, 0x60, 0x2a, 0x35, 0x48, 0x8a, 0xf1, 0x99, 0xb9, 0x54, 0xc5, 0xab, 0xa4, 0x58,
+	0xb4, 0xa7, 0xd1, 0xc5, 0xcf, 0xa2, 0x4e, 0x32, 0x1c, 0x36, 0x1e, 0xcd, 0xa9, 0x1f, 0x8f, 0x03,
+	0xd7, 0x59, 0xc9, 0x13, 0xc9, 0x70, 0x58, 0x38, 0x4e, 0x9d, 0x28, 0x3e, 0x76, 0x56, 0xfc, 0x40,
+	0x6a, 0x44, 0x91, 0xf8, 0x02, 0xba, 0x2f, 0xf9, 0xda, 0xff, 0x39, 0xeb, 0xf0, 0x9f, 0x2d, 0xe8,
+	0xa8, 0x10, 0x1c, 0x4e, 0x62, 0x2f, 0xf0, 0xd1, 0x08, 0x7a, 0x49, 0x48, 0x4c, 0x26, 0x47, 0xfc,
+	0xde, 0xf2, 0xd5, 0x36, 0x0f, 0x6d, 0x19, 0x05, 0xa7, 0xfa, 0xf8, 0x68, 0x83, 0x14, 0x27, 0xa1,
+	0x73, 0xe8, 0x2b, 0x26, 0x3f, 0xa0, 0xb3, 0x39, 0x0d, 0x99, 0x32, 0x93, 0x2b, 0xfb, 0xaa, 0xa6,
+	0x2c, 0x2b, 0x32, 0xda, 0x20, 0xa5, 0x53, 0xd1, 0xa7, 0x80, 0x32, 0xeb, 0x28, 0x85, 0x35, 0xae,
+	0xf0, 0x2b, 0x45, 0xeb, 0x52, 0x75, 0x25, 0xd3, 0xb2, 0x3b, 0x95, 0xf7, 0x79, 0xbc, 0xb4, 0xeb,
+	0xa5, 0x3b, 0x4d, 0xc6, 0xb3, 0x3b, 0x4d, 0x98, 0xe8, 0x0b, 0xd8, 0x51, 0xcc, 0x93, 0x25, 0x9d,
+	0x8c, 0x43, 0xc7, 0x8f, 0x2e, 0x69, 0x38, 0x0e, 0xf8, 0x99, 0x6e, 0x1e, 0x7e, 0x4d, 0x53, 0x97,
+	0x17, 0x1a, 0x6d, 0x90, 0x35, 0xd3, 0xd1, 0x4f, 0xc1, 0x2e, 0x1b, 0x79, 0x11, 0x06, 0x33, 0x0e,
+	0x4e, 0x9b, 0x87, 0x5f, 0xbf, 0x43, 0x35, 0x13, 0x1b, 0x6d, 0x90, 0xb5, 0x2a, 0xd0, 0x09, 0x74,
+	0xb3, 0x63, 0x47, 0xce, 0x74, 0xca, 0xef, 0xe6, 0xe6, 0xe1, 0xe3, 0x12, 0xb5, 0x6c, 0x78, 0xb4,
+	0x41, 0x0a, 0x53, 0xd0, 0x97, 0xf0, 0x58, 0xf1, 0xce, 0xf9, 0x8d, 0x1d, 0x2f, 0xd5, 0xd1, 0xb4,
+	0xb9, 0xb6, 0x3d, 0x4d, 0x9b, 0x26, 0x35, 0xda, 0x20, 0xeb, 0x14, 0xa0, 0x0e, 0x98, 0xe3, 0x15,
+	0x47, 0x14, 0x8b, 0x98, 0xe3, 0xd5, 0x8f, 0x9a, 0x60, 0xdd, 0x38, 0xd3, 0x05, 0xc5, 0x7f, 0x30,
+	0xa0, 0x57, 0x08, 0xc4, 0x4c, 0xe2, 0x30, 0xee, 0x48, 0x1c, 0x45, 0xa4, 0x37, 0xcb, 0x90, 0x1e,
+	0x7d, 0x54, 0xb8, 0x3e, 0xa9, 0x67, 0xf4, 0xbb, 0x99, 0xbb, 0x57, 0xff, 0x32, 0xa0, 0x5f, 0x16,
+	0xd8, 0x68, 0x00, 0x8f, 0x32, 0x91, 0x98, 0x41, 0x2a, 0x9d, 0xcd, 0x20, 0x3e, 0x98, 0x4a, 0x10,
+	0x16, 0x97, 0x3a, 0xa1, 0xd9, 0x98, 0x4f, 0x6f, 0xc5, 0x58, 0x4d, 0x8c, 0x29, 0x9a, 0xa1, 0xa0,
+	0x4f, 0x6f, 0xe5, 0xb6, 0x04, 0xde, 0xa4, 0x0c, 0xb4, 0x0f, 0x9b, 0x81, 0x30, 0xe5, 0xc5, 0xd4,
+	0xb9, 0x92, 0x19, 0x30, 0xcb, 0x2a, 0x80, 0x79, 0xe3, 0x3e, 0x30, 0x6f, 0x6a, 0x60, 0x8e, 0x7f,
+	0x6b, 0x02, 0x2a, 0x5e, 0xc2, 0xb7, 0xd8, 0x7a, 0xde, 0xed, 0x66, 0x65, 0xb7, 0xa3, 0x6f, 0x42,
+	0xcf, 0xa7, 0xb7, 0x24, 0x7f, 0xb4, 0x02, 0xf5, 0x8a, 0x03, 0xba, 0x2f, 0xea, 0x3c, 0xab, 0xdc,
+	0xe9, 0x0b, 0xeb, 0x3e, 0x5f, 0x34, 0x74, 0x5f, 0xfc, 0xcd, 0x00, 0x7b, 0xdd, 0xd5, 0xbc, 0x0b,
+	0xcd, 0x9d, 0x19, 0xaf, 0x50, 0x4c, 0xae, 0x4f, 0x52, 0xac, 0x42, 0xf0, 0x03, 0x89, 0x77, 0x6d,
+	0xc2, 0xbf, 0x55, 0x25, 0xe0, 0x3b, 0x33, 0x91, 0xef, 0xdb, 0x24, 0xa1, 0xd9, 0xdd, 0x89, 0x03,
+	0x99, 0xe7, 0xcd, 0x38, 0x60, 0xf3, 0x2f, 0x15, 0x72, 0xb4, 0x09, 0xff, 0x7e, 0x78, 0xee, 0xc6,
+	0xbf, 0x31, 0x60, 0xa7, 0x1c, 0xd8, 0xfe, 0xdb, 0x1b, 0xc4, 0xbf, 0x48, 0x21, 0x21, 0x05, 0xe7,
+	0xea, 0x71, 0xc7, 0x2b, 0xb0, 0x4f, 0x5c, 0x09, 0x06, 0xfc, 0x9b, 0xcd, 0x96, 0xd5, 0xce, 0x59,
+	0x48, 0xe8, 0x4d, 0xf0, 0x33, 0x2a, 0x0b, 0x2e, 0x9d, 0x8d, 0x7f, 0x05, 0x8f, 0xd7, 0xe0, 0xdb,
+	0x03, 0x4d, 0x78, 0x1f, 0xb6, 0x65, 0x71, 0x77, 0x16, 0xfe, 0x84, 0xc6, 0x81, 0x34, 0x20, 0xcf,
+	0xc4, 0x7f, 0x34, 0xa0, 0xab, 0xa3, 0xf5, 0x5b, 0x2c, 0xbc, 0xae, 0x82, 0xb0, 0xa1, 0x39, 0x77,
+	0x56, 0xd3, 0xc0, 0x11, 0x85, 0xe6, 0x16, 0x51, 0x64, 0x21, 0x72, 0xea, 0xf7, 0x45, 0x8e, 0xa5,
+	0x47, 0xce, 0x73, 0x78, 0x44, 0xe8, 0x75, 0x06, 0x2a, 0x22, 0xd4, 0x07, 0x2b, 0x8a, 0x9d, 0x30,
+	0xe6, 0x66, 0xd6, 0x88, 0x20, 0x50, 0x17, 0x6a, 0xd4, 0x77, 0x65, 0xb0, 0xb0, 0x4f, 0xfc, 0x2d,
+	0xe8, 0x11, 0x3a, 0x9f, 0xae, 0x72, 0x93, 0x6d, 0x68, 0x3a, 0xae, 0x1b, 0xd2, 0x48, 0xa0, 0x7f,
+	0x9b, 0x28, 0x12, 0xff, 0x10, 0x50, 0x7e, 0xa5, 0x4f, 0xfc, 0xcb, 0xa0, 0xba, 0x77, 0xf0, 0x3f,
+	0x0d, 0xe8, 0xeb, 0xeb, 0x71, 0x15, 0xff, 0xf7, 0x8f, 0x9d, 0xbf, 0x1b, 0xd0, 0xcd, 0xb8, 0x6e,
+	0xbc, 0xf4, 0xdc, 0xa8, 0xb0, 0x2b, 0xa3, 0x64, 0x57, 0xbb, 0xd0, 0x62, 0x00, 0x33, 0x4e, 0xa3,
+	0x39, 0xa1, 0xf9, 0x13, 0x25, 0xe0, 0x23, 0x35, 0xf9, 0x44, 0xe1, 0x14, 0x0f, 0x36, 0xea, 0xbb,
+	0x9e, 0xaf, 0xd0, 0x58, 0x91, 0xb9, 0x07, 0x8f, 0xa5, 0x3d, 0x78, 0x6c, 0x68, 0x8a, 0xa0, 0x73,
+	0xf9, 0x1e, 0x5a, 0x44, 0x91, 0x6c, 0x1d, 0xf1, 0x84, 0xe0, 0xb0, 0xd6, 0x22, 0x92, 0xca, 0x3c,
+	0x25, 0x5a, 0xb9, 0xa7, 0xc4, 0x67, 0x80, 0x72, 0xa7, 0x5c, 0x7d, 0xb7, 0x7d, 0xb0, 0xd8, 0x83,
+	0x2d, 0xb2, 0xcd, 0xfd, 0xda, 0xa0, 0x4e, 0x04, 0x81, 0x3f, 0x85, 0x5e, 0xce, 0x77, 0x3c, 0x64,
+	0xaa, 0xa8, 0x2b, 0x81, 0x01, 0xfc, 0x39, 0xbc, 0xa7, 0x19, 0xc7, 0xd5, 0x3d, 0x97, 0x6f, 0xf4,
+	0x84, 0x23, 0x8b, 0xf5, 0x9e, 0x56, 0x73, 0x8d, 0x97, 0x44, 0x13, 0xc4, 0x73, 0xd8, 0xcd, 0xdf,
+	0x8a, 0x57, 0xfe, 0xcb, 0xf4, 0x65, 0x52, 0xc5, 0xce, 0x75, 0xa8, 0x91, 0x02, 0x7f, 0x2d, 0x0b,
+	0xfc, 0xf8, 0x73, 0xe9, 0x60, 0xb9, 0xd0, 0x30, 0x8a, 0x68, 0x1c, 0xa1, 0xef, 0xc3, 0xf6, 0x22,
+	0xcb, 0x90, 0xf7, 0xa0, 0x2f, 0x77, 0x90, 0x13, 0x26, 0x79, 0x51, 0xfc, 0x19, 0x6c, 0xe7, 0x95,
+	0x7d, 0x03, 0x1a, 0x8e, 0xd0, 0x22, 0xfc, 0xb0, 0x2d, 0xb5, 0xc8, 0xe9, 0x72, 0x50, 0x4b, 0x41,
+	0x75, 0x95, 0x82, 0xf0, 0x77, 0x18, 0x26, 0x4d, 0xa8, 0x37, 0x8f, 0x93, 0x86, 0x46, 0x05, 0x47,
+	0xe0, 0x9f, 0x43, 0x5f, 0x4e, 0x3b, 0x93, 0x2f, 0xce, 0xb3, 0xf0, 0x98, 0x4e, 0x2b, 0x39, 0x11,
+	0x83, 0x15, 0x24, 0x65, 0x9e, 0x7e, 0xfd, 0xc5, 0x10, 0x8b, 0x7f, 0x47, 0xea, 0x54, 0x0f, 0x7e,
+	0x45, 0xe3, 0x3f, 0x19, 0xf9, 0xc5, 0x4f, 0x03, 0x97, 0x25, 0xa5, 0x79, 0xa5, 0xc5, 0x9f, 0x42,
+	0x7b, 0x1e, 0xd2, 0x9b, 0xb3, 0xb5, 0x06, 0xa4, 0xc3, 0xe8, 0xdb, 0xb0, 0x35, 0x59, 0x84, 0x21,
+	0xf5, 0xe3, 0xb4, 0xf4, 0xd4, 0xc5, 0x73, 0x12, 0xcc, 0xec, 0x99, 0xb4, 0x46, 0xde, 0xe8, 0x84,
+	0xc6, 0x6f, 0xe0, 0x3d, 0x69, 0xb5, 0x80, 0x9a, 0xd3, 0xc0, 0xf5, 0x2e, 0xab, 0x85, 0xdd, 0x1e,
+	0x00, 0xb3, 0x2a, 0x57, 0xbb, 0x67, 0x38, 0x2c, 0x63, 0x4a, 0x33, 0x72, 0x35, 0x60, 0x9e, 0x89,
+	0xff, 0x6a, 0x80, 0x2d, 0x2d, 0x48, 0xf1, 0x53, 0xa5, 0xec, 0x2a, 0x66, 0x3c, 0x87, 0x0e, 0x5b,
+	0xf4, 0x58, 0xaf, 0x55, 0x4b, 0x50, 0x59, 0x13, 0x44, 0x1f, 0x71, 0x0b, 0x8f, 0xf5, 0xc7, 0x45,
+	0xc9, 0xcc, 0xbc, 0x1c, 0x2b, 0x5a, 0xf9, 0xc1, 0x0b, 0x6f, 0xa9, 0xa2, 0x35, 0xc3, 0xc2, 0xbf,
+	0xe6, 0x88, 0xcd, 0xb7, 0x95, 0x16, 0x41, 0x1f, 0xa7, 0xa9, 0x6e, 0xbc, 0x54, 0x2d, 0x2a, 0xb6,
+	0xe2, 0x4e, 0x01, 0x26, 0xc4, 0x39, 0xea, 0xe2, 0xe8, 0x29, 0x74, 0x55, 0xdb, 0x27, 0xa9, 0x84,
+	0x4c, 0xbe, 0x7a, 0x81, 0xcf, 0x22, 0x72, 0x57, 0x9a, 0x30, 0x9c, 0x4c, 0x52, 0xeb, 0x5f, 0xcd,
+	0xdd, 0xff, 0x61, 0xdf, 0xe2, 0xdf, 0xd5, 0xa0, 0x27, 0xcd, 0x4e, 0xdd, 0xf1, 0x0e, 0x5c, 0x87,
+	0x61, 0x8b, 0x99, 0x78, 0xa2, 0x12, 0x98, 0x70, 0x5b, 0x8e, 0xc7, 0xce, 0x75, 0xb2, 0x08, 0x4f,
+	0xf2, 0x4d, 0xbd, 0x2c, 0x8b, 0x55, 0x2b, 0xd1, 0xe2, 0x82, 0x85, 0x68, 0x28, 0xcf, 0x55, 0x9e,
+	0xbe, 0xce, 0xce, 0x74, 0x0d, 0xad, 0x5c, 0xd7, 0x30, 0xed, 0x0c, 0x36, 0xf4, 0xce, 0xe0, 0x03,
+	0xfb, 0x77, 0x4f, 0xa1, 0xcb, 0x76, 0x73, 0x5e, 0xec, 0xe1, 0x15, 0xf8, 0xbc, 0xa2, 0x5e, 0x84,
+	0x39, 0x51, 0xe0, 0xa2, 0x3a, 0x1b, 0x9f, 0xc3, 0xb6, 0x3c, 0x8e, 0xf1, 0x92, 0xd5, 0xb8, 0x0f,
+	0x3f, 0x0a, 0xfc, 0x65, 0x02, 0x95, 0x63, 0x51, 0x08, 0xbd, 0x45, 0x48, 0xb2, 0x5a, 0x6f, 0x11,
+	0xca, 0x79, 0x0a, 0x75, 0x52, 0x0e, 0x7e, 0x03, 0x8f, 0x4e, 0x8b, 0x27, 0x5f, 0x2d, 0xd7, 0x7b,
+	0x99, 0x5c, 0xef, 0xb9, 0x25, 0x7d, 0xd8, 0x32, 0xac, 0xd5, 0x64, 0xf0, 0x13, 0x68, 0xbc, 0xf2,
+	0xfc, 0xf8, 0xbb, 0xcf, 0x98, 0x4e, 0xd7, 0x89, 0x1d, 0xd5, 0x4b, 0x66, 0xdf, 0x38, 0x84, 0xed,
+	0xa1, 0xe8, 0xda, 0xcb, 0x4c, 0x59, 0xc5, 0xb8, 0x34, 0x9b, 0x9a, 0xd5, 0xb2, 0x69, 0x2d, 0xfb,
+	0xa0, 0xc3, 0x01, 0x6c, 0x11, 0x7a, 0xcd, 0xaa, 0xe8, 0x77, 0xbe, 0x64, 0x1f, 0x2c, 0x2f, 0x1a,
+	0x4e, 0x55, 0x3a, 0x14, 0x04, 0xfe, 0x18, 0x3a, 0xbc, 0xc0, 0x48, 0x97, 0x3c, 0x80, 0xb6, 0xa3,
+	0x08, 0xd9, 0x14, 0xea, 0x2a, 0x8d, 0x8a, 0x4f, 0x52, 0x11, 0xfc, 0x4b, 0x68, 0xa7, 0x93, 0x2b,
+	0x16, 0x13, 0x7b, 0x00, 0x21, 0x9d, 0xdc, 0x0c, 0xb3, 0x6f, 0xda, 0x0c, 0x07, 0x0d, 0xa0, 0x29,
+	0x7f, 0x98, 0xc8, 0x73, 0xec, 0xa4, 0x16, 0x30, 0x2e, 0x51, 0xc3, 0xf8, 0x7b, 0xd0, 0x18, 0x26,
+	0x2e, 0x95, 0xa5, 0x95, 0xb1, 0xa6, 0xb4, 0x32, 0x73, 0xa5, 0xd5, 0x07, 0x00, 0xf2, 0xb5, 0x42,
+	0xa3, 0xbb, 0x9e, 0x42, 0x14, 0xda, 0xa2, 0x44, 0x89, 0xe3, 0x6a, 0xf1, 0x99, 0x6b, 0xab, 0x9b,
+	0xeb, 0xdb, 0xea, 0xb5, 0x5c, 0x5b, 0xfd, 0x19, 0x40, 0xb2, 0x0c, 0x6b, 0xb8, 0x59, 0x5e, 0x4c,
+	0x67, 0xfa, 0x01, 0x24, 0x12, 0x44, 0x0c, 0x5f, 0x34, 0xf8, 0xff, 0xa4, 0x0f, 0xff, 0x3d, 0x00,
+	0x0f, 0xb8, 0x88, 0x1d, 0x77, 0x1a, 0x00, 0x00,
 }
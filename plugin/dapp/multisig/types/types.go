@@ -18,6 +18,8 @@ func init() {
 
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(MultiSigX, "Enable", 0)
+	cfg.RegisterDappFork(MultiSigX, ForkMultiSigExecCallX, 4600000)
+	cfg.RegisterDappFork(MultiSigX, ForkMultiSigTimelockX, 4600000)
 }
 
 func InitExecutor(cfg *types.Chain33Config) {
@@ -56,6 +58,8 @@ func (m *MultiSigType) GetTypeMap() map[string]int32 {
 		"MultiSigConfirmTx":        ActionMultiSigConfirmTx,
 		"MultiSigExecTransferTo":   ActionMultiSigExecTransferTo,
 		"MultiSigExecTransferFrom": ActionMultiSigExecTransferFrom,
+		"MultiSigQueuedTxOperate":  ActionMultiSigQueuedTxOperate,
+		"MultiSigExecCall":         ActionMultiSigExecCall,
 	}
 }
 
@@ -79,6 +83,7 @@ func (m *MultiSigType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogDailyLimitUpdate: {Ty: reflect.TypeOf(ReceiptAccDailyLimitUpdate{}), Name: "LogAccDailyLimitUpdate"},
 		TyLogMultiSigTx:       {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigAccTx"},
 		TyLogTxCountUpdate:    {Ty: reflect.TypeOf(ReceiptTxCountUpdate{}), Name: "LogTxCountUpdate"},
+		TyLogMultiSigTxVeto:   {Ty: reflect.TypeOf(ReceiptTxVeto{}), Name: "LogMultiSigTxVeto"},
 	}
 }
 
@@ -111,6 +116,18 @@ func (m MultiSigType) ActionName(tx *types.Transaction) string {
 		return "MultiSigExecTransfer"
 	} else if g.Ty == ActionMultiSigExecTransferFrom && g.GetMultiSigExecTransferFrom() != nil {
 		return "MultiSigAccExecTransfer"
+	} else if g.Ty == ActionMultiSigQueuedTxOperate && g.GetMultiSigQueuedTxOperate() != nil {
+		return "MultiSigQueuedTxOperate"
+	} else if g.Ty == ActionMultiSigExecCall && g.GetMultiSigExecCall() != nil {
+		return "MultiSigExecCall"
 	}
 	return "unknown"
 }
+
+//MultiSigCallee 可以被多重签名账户调用的合约需要实现的接口，
+//合约还需要通过IsFriend允许multisig交易修改自己的状态数据。
+//multisig交易不能写入其他合约的localdb，被调用合约的ExecLocal不会执行，需要维护localdb索引的交易应返回ErrActionNotSupport
+type MultiSigCallee interface {
+	//ExecMultiSig 以多重签名地址multiSigAddr作为发起地址执行tx，tx中只有Execer和Payload来自提案
+	ExecMultiSig(multiSigAddr string, tx *types.Transaction, index int) (*types.Receipt, error)
+}
@@ -8,6 +8,7 @@ package commands
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -33,7 +34,14 @@ func OracleCmd() *cobra.Command {
 		OraclePrePublishResultRawTxCmd(),
 		OracleAbortPrePubResultRawTxCmd(),
 		OraclePublishResultRawTxCmd(),
+		OracleQuorumConfigRawTxCmd(),
+		OracleSubmitResultRawTxCmd(),
 		OracleQueryRawTxCmd(),
+		OracleQueryQuorumCmd(),
+		OraclePriceFeedRawTxCmd(),
+		OracleQueryPriceCmd(),
+		OracleQueryPriceHistoryCmd(),
+		OracleQueryPriceTWAPCmd(),
 	)
 
 	return cmd
@@ -367,6 +375,322 @@ func publishResult(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// OracleQuorumConfigRawTxCmd 配置事件类型的多数据源发布规则
+func OracleQuorumConfigRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quorum_config",
+		Short: "config publishers and threshold of an event type, results are decided by quorum",
+		Run:   quorumConfig,
+	}
+	addQuorumConfigFlags(cmd)
+	return cmd
+}
+
+func addQuorumConfigFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("type", "t", "", "event type, such as \"football\"")
+	err := cmd.MarkFlagRequired("type")
+	if err != nil {
+		fmt.Printf("addQuorumConfigFlags type Error: %v", err)
+		return
+	}
+
+	cmd.Flags().StringP("publishers", "p", "", "publisher addresses, use comma between many addresses, empty to cancel quorum of the type")
+	cmd.Flags().Int32P("threshold", "m", 0, "submissions needed to decide the result")
+	cmd.Flags().Int32P("mode", "o", 0, "0: threshold publishers submit the same result, 1: median of numeric results")
+	cmd.Flags().Int32P("deviation", "d", 0, "median mode, submissions deviate from the median more than this basis points are recorded as disputes, 0 to skip")
+}
+
+func quorumConfig(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, err := cmd.Flags().GetString("rpc_laddr")
+	if err != nil {
+		fmt.Printf("quorumConfig rpc_laddr Error: %v", err)
+		return
+	}
+	ty, err := cmd.Flags().GetString("type")
+	if err != nil {
+		fmt.Printf("quorumConfig type Error: %v", err)
+		return
+	}
+	publishers, err := cmd.Flags().GetString("publishers")
+	if err != nil {
+		fmt.Printf("quorumConfig publishers Error: %v", err)
+		return
+	}
+	threshold, _ := cmd.Flags().GetInt32("threshold")
+	mode, _ := cmd.Flags().GetInt32("mode")
+	deviation, _ := cmd.Flags().GetInt32("deviation")
+
+	config := &oraclety.QuorumConfig{
+		Type:         ty,
+		Threshold:    threshold,
+		Mode:         mode,
+		MaxDeviation: deviation,
+	}
+	if publishers != "" {
+		config.Publishers = strings.Split(publishers, ",")
+	}
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(oraclety.OracleX),
+		ActionName: oraclety.CreateQuorumConfigTx,
+		Payload:    types.MustPBToJSON(config),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// OracleSubmitResultRawTxCmd 多数据源事件的发布者提交结果
+func OracleSubmitResultRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit_result",
+		Short: "submit result of a quorum event as one of its publishers",
+		Run:   submitResult,
+	}
+	addPublishResultFlags(cmd)
+	return cmd
+}
+
+func submitResult(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, err := cmd.Flags().GetString("rpc_laddr")
+	if err != nil {
+		fmt.Printf("submitResult rpc_laddr Error: %v", err)
+		return
+	}
+	eventID, err := cmd.Flags().GetString("eventID")
+	if err != nil {
+		fmt.Printf("submitResult eventID Error: %v", err)
+		return
+	}
+	source, err := cmd.Flags().GetString("source")
+	if err != nil {
+		fmt.Printf("submitResult source Error: %v", err)
+		return
+	}
+	result, err := cmd.Flags().GetString("result")
+	if err != nil {
+		fmt.Printf("submitResult result Error: %v", err)
+		return
+	}
+
+	submit := &oraclety.ResultSubmit{
+		EventID: eventID,
+		Source:  source,
+		Result:  result,
+	}
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(oraclety.OracleX),
+		ActionName: oraclety.CreateResultSubmitTx,
+		Payload:    types.MustPBToJSON(submit),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// OracleQueryQuorumCmd 查询事件类型的多数据源发布配置
+func OracleQueryQuorumCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query_quorum",
+		Short: "query quorum config of an event type",
+		Run:   queryQuorum,
+	}
+	cmd.Flags().StringP("type", "t", "", "event type, such as \"football\"")
+	err := cmd.MarkFlagRequired("type")
+	if err != nil {
+		fmt.Printf("MarkFlagRequired type Error: %v", err)
+	}
+	return cmd
+}
+
+func queryQuorum(cmd *cobra.Command, args []string) {
+	rpcLaddr, err := cmd.Flags().GetString("rpc_laddr")
+	if err != nil {
+		fmt.Printf("queryQuorum rpc_laddr Error: %v", err)
+		return
+	}
+	ty, err := cmd.Flags().GetString("type")
+	if err != nil {
+		fmt.Printf("queryQuorum type Error: %v", err)
+		return
+	}
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = oraclety.OracleX
+	params.FuncName = oraclety.FuncNameQueryQuorumConfig
+	params.Payload = types.MustPBToJSON(&oraclety.QueryQuorumConfig{Type: ty})
+	var res oraclety.QuorumConfig
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// OraclePriceFeedRawTxCmd 交易对喂价
+func OraclePriceFeedRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price_feed",
+		Short: "feed price of an asset pair",
+		Run:   priceFeed,
+	}
+	addPriceFeedFlags(cmd)
+	return cmd
+}
+
+func addPriceFeedFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("pair", "p", "", "asset pair, such as \"BTC-USDT\"")
+	err := cmd.MarkFlagRequired("pair")
+	if err != nil {
+		fmt.Printf("addPriceFeedFlags pair Error: %v", err)
+		return
+	}
+
+	cmd.Flags().Float64P("price", "r", 0, "price")
+	err = cmd.MarkFlagRequired("price")
+	if err != nil {
+		fmt.Printf("addPriceFeedFlags price Error: %v", err)
+		return
+	}
+
+	cmd.Flags().Int64P("volume", "v", 0, "volume")
+	err = cmd.MarkFlagRequired("volume")
+	if err != nil {
+		fmt.Printf("addPriceFeedFlags volume Error: %v", err)
+		return
+	}
+}
+
+func priceFeed(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, err := cmd.Flags().GetString("rpc_laddr")
+	if err != nil {
+		fmt.Printf("priceFeed rpc_laddr Error: %v", err)
+		return
+	}
+	pair, err := cmd.Flags().GetString("pair")
+	if err != nil {
+		fmt.Printf("priceFeed pair Error: %v", err)
+		return
+	}
+	price, err := cmd.Flags().GetFloat64("price")
+	if err != nil {
+		fmt.Printf("priceFeed price Error: %v", err)
+		return
+	}
+	volume, err := cmd.Flags().GetInt64("volume")
+	if err != nil {
+		fmt.Printf("priceFeed volume Error: %v", err)
+		return
+	}
+
+	feed := &oraclety.PriceFeed{
+		Pair:   pair,
+		Price:  []int64{int64(math.Trunc(price * 1e4))},
+		Volume: []int64{volume},
+	}
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(oraclety.OracleX),
+		ActionName: oraclety.CreatePriceFeedTx,
+		Payload:    types.MustPBToJSON(feed),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// OracleQueryPriceCmd 查询交易对最新喂价
+func OracleQueryPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query_price",
+		Short: "query latest price of an asset pair",
+		Run:   queryPrice,
+	}
+	addPairFlag(cmd)
+	return cmd
+}
+
+func addPairFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("pair", "p", "", "asset pair, such as \"BTC-USDT\"")
+	err := cmd.MarkFlagRequired("pair")
+	if err != nil {
+		fmt.Printf("MarkFlagRequired pair Error: %v", err)
+	}
+}
+
+func queryPrice(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pair, _ := cmd.Flags().GetString("pair")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = oraclety.OracleX
+	params.FuncName = oraclety.FuncNameQueryLatestPrice
+	params.Payload = types.MustPBToJSON(&oraclety.QueryPrice{Pair: pair})
+	var res oraclety.PriceRecord
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// OracleQueryPriceHistoryCmd 分页查询交易对历史喂价
+func OracleQueryPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price_history",
+		Short: "query price history of an asset pair, latest first",
+		Run:   queryPriceHistory,
+	}
+	addPairFlag(cmd)
+	cmd.Flags().Int64P("last_seq", "s", 0, "seq of the last record in previous page, 0 to start from latest")
+	cmd.Flags().Int32P("count", "c", oraclety.DefaultCount, "record count")
+	return cmd
+}
+
+func queryPriceHistory(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pair, _ := cmd.Flags().GetString("pair")
+	lastSeq, _ := cmd.Flags().GetInt64("last_seq")
+	count, _ := cmd.Flags().GetInt32("count")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = oraclety.OracleX
+	params.FuncName = oraclety.FuncNameQueryPriceHistory
+	params.Payload = types.MustPBToJSON(&oraclety.QueryPriceHistory{Pair: pair, LastSeq: lastSeq, Count: count})
+	var res oraclety.ReplyPriceHistory
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// OracleQueryPriceTWAPCmd 查询交易对时间加权平均价
+func OracleQueryPriceTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price_twap",
+		Short: "query time weighted average price of an asset pair",
+		Run:   queryPriceTWAP,
+	}
+	addPairFlag(cmd)
+	cmd.Flags().Int64P("start", "s", 0, "start unix time, earlier than first feed means from first feed")
+	cmd.Flags().Int64P("end", "e", 0, "end unix time, 0 means time of latest feed")
+	return cmd
+}
+
+func queryPriceTWAP(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pair, _ := cmd.Flags().GetString("pair")
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = oraclety.OracleX
+	params.FuncName = oraclety.FuncNameQueryPriceTWAP
+	params.Payload = types.MustPBToJSON(&oraclety.QueryPriceTWAP{Pair: pair, StartTime: start, EndTime: end})
+	var res oraclety.ReplyPriceTWAP
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
 // OracleQueryRawTxCmd 查询事件
 func OracleQueryRawTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -393,7 +717,7 @@ func addOracleQueryFlags(cmd *cobra.Command) {
 		return
 	}
 
-	cmd.Flags().StringP("status", "s", "", "status, number 1-5")
+	cmd.Flags().StringP("status", "s", "", "status, number 1-6")
 	err = cmd.MarkFlagRequired("status")
 	if err != nil {
 		fmt.Printf("MarkFlagRequired status Error: %v", err)
@@ -472,8 +796,8 @@ func oracleQuery(cmd *cobra.Command, args []string) {
 		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 		ctx.Run()
 	} else if statusStr != "" {
-		if status < 0 || status > 5 {
-			fmt.Println("Error: status must be 1-5")
+		if status < 0 || status > 6 {
+			fmt.Println("Error: status must be 1-6")
 			cmd.Help()
 			return
 		} else if addr != "" {
@@ -35,3 +35,27 @@ func (o *oracle) Exec_ResultPublish(payload *oty.ResultPublish, tx *types.Transa
 	action := newOracleAction(o, tx, index)
 	return action.resultPublish(payload)
 }
+
+func (o *oracle) Exec_QuorumConfig(payload *oty.QuorumConfig, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newOracleAction(o, tx, index)
+	if !action.quorum {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.quorumConfig(payload)
+}
+
+func (o *oracle) Exec_ResultSubmit(payload *oty.ResultSubmit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newOracleAction(o, tx, index)
+	if !action.quorum {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.resultSubmit(payload)
+}
+
+func (o *oracle) Exec_PriceFeed(payload *oty.PriceFeed, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !o.GetAPI().GetConfig().IsDappFork(o.GetHeight(), oty.OracleX, oty.ForkOraclePriceFeedX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newOracleAction(o, tx, index)
+	return action.priceFeed(payload)
+}
@@ -15,6 +15,9 @@ func (o *oracle) execDelLocal(receipt *types.ReceiptData) (*types.LocalDBSet, er
 	set := &types.LocalDBSet{}
 	table := oty.NewTable(o.GetLocalDB())
 	for _, item := range receipt.Logs {
+		if !isStatusLog(item.Ty) {
+			continue
+		}
 		var oraclelog oty.ReceiptOracle
 		err := types.Decode(item.Log, &oraclelog)
 		if err != nil {
@@ -64,3 +67,15 @@ func (o *oracle) ExecDelLocal_ResultAbort(payload *oty.ResultAbort, tx *types.Tr
 func (o *oracle) ExecDelLocal_ResultPublish(payload *oty.ResultPublish, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return o.execDelLocal(receiptData)
 }
+
+func (o *oracle) ExecDelLocal_QuorumConfig(payload *oty.QuorumConfig, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
+
+func (o *oracle) ExecDelLocal_ResultSubmit(payload *oty.ResultSubmit, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
+
+func (o *oracle) ExecDelLocal_PriceFeed(payload *oty.PriceFeed, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
@@ -18,7 +18,7 @@ func (o *oracle) execLocal(receipt *types.ReceiptData) (*types.LocalDBSet, error
 	}
 	table := oty.NewTable(o.GetLocalDB())
 	for _, item := range receipt.Logs {
-		if item.Ty >= oty.TyLogEventPublish && item.Ty <= oty.TyLogResultPublish {
+		if isStatusLog(item.Ty) {
 			var oraclelog oty.ReceiptOracle
 			err := types.Decode(item.Log, &oraclelog)
 			if err != nil {
@@ -38,6 +38,11 @@ func (o *oracle) execLocal(receipt *types.ReceiptData) (*types.LocalDBSet, error
 	return set, nil
 }
 
+//只有记录事件状态变化的日志需要更新本地索引
+func isStatusLog(ty int32) bool {
+	return (ty >= oty.TyLogEventPublish && ty <= oty.TyLogResultPublish) || ty == oty.TyLogResultDispute
+}
+
 func (o *oracle) ExecLocal_EventPublish(payload *oty.EventPublish, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return o.execLocal(receiptData)
 }
@@ -57,3 +62,15 @@ func (o *oracle) ExecLocal_ResultAbort(payload *oty.ResultAbort, tx *types.Trans
 func (o *oracle) ExecLocal_ResultPublish(payload *oty.ResultPublish, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return o.execLocal(receiptData)
 }
+
+func (o *oracle) ExecLocal_QuorumConfig(payload *oty.QuorumConfig, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
+
+func (o *oracle) ExecLocal_ResultSubmit(payload *oty.ResultSubmit, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
+
+func (o *oracle) ExecLocal_PriceFeed(payload *oty.PriceFeed, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
@@ -2,6 +2,7 @@ package executor
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 	"time"
@@ -31,6 +32,9 @@ type execEnv struct {
 
 var (
 	PrivKeyA = "0x6da92a632ab7deb67d38c0f6560bcfed28167998f6496db64c258d5e8393a81b" // 1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4
+	PrivKeyB = "0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4" // 1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR
+	PrivKeyC = "0x7a80a1f75d7360c6123c32a78ecf978c1ac55636f87892df38d8b85a9aeff115" // 1NLHPEcbTWWxxU3dGUZBhayjrCHD3psX7k
+	PrivKeyD = "0xcacb1f5d51700aea07fca2246ab43b0917d70405c65edea9b5063d72eb5c6b71" // 1MCftFynyvG2F4ED5mdHYgziDxx6vDrScs
 
 	Nodes = [][]byte{
 		[]byte("1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"),
@@ -476,6 +480,288 @@ func TestOrace(t *testing.T) {
 
 }
 
+func TestOracleQuorum(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	InitExecType()
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 1000)
+	_, _, kvdb := util.CreateTestDB()
+
+	// set config key 授权
+	for _, key := range []string{"mavl-manage-oracle-publish-event", "mavl-manage-oracle-quorum-manager"} {
+		item := &types.ConfigItem{
+			Key: key,
+			Value: &types.ConfigItem_Arr{
+				Arr: &types.ArrayConfig{Value: []string{string(Nodes[0])}},
+			},
+		}
+		stateDB.Set([]byte(item.Key), types.Encode(item))
+	}
+
+	exec := newOracle()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	forkHeight := cfg.GetDappFork(oty.OracleX, oty.ForkOracleQuorumX)
+	exec.SetEnv(forkHeight-1, 1539918074, 1)
+
+	ety := types.LoadExecutorType(oty.OracleX)
+	execTx := func(action string, payload types.Message, privKey string) (*types.Receipt, error) {
+		tx, err := ety.Create(action, payload)
+		assert.Nil(t, err)
+		tx, err = types.FormatTx(cfg, oty.OracleX, tx)
+		assert.Nil(t, err)
+		tx, err = signTx(tx, privKey)
+		assert.Nil(t, err)
+		receipt, err := exec.Exec(tx, 1)
+		if err != nil {
+			return nil, err
+		}
+		for _, kv := range receipt.KV {
+			stateDB.Set(kv.Key, kv.Value)
+		}
+		set, err := exec.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+		assert.Nil(t, err)
+		for _, kv := range set.KV {
+			kvdb.Set(kv.Key, kv.Value)
+		}
+		return receipt, nil
+	}
+	publishEvent := func(ty string) string {
+		receipt, err := execTx(oty.CreateEventPublishTx, &oty.EventPublish{Type: ty, SubType: "BTC-USDT",
+			Time: time.Now().AddDate(0, 0, 1).Unix(), Content: fmt.Sprintf("price %d", r.Int())}, PrivKeyA)
+		assert.Nil(t, err)
+		var log oty.ReceiptOracle
+		assert.Nil(t, types.Decode(receipt.Logs[0].Log, &log))
+		return log.EventID
+	}
+	queryStatus := func(eventID string) *oty.OracleStatus {
+		msg, err := exec.Query(oty.FuncNameQueryOracleListByIDs, types.Encode(&oty.QueryOracleInfos{EventID: []string{eventID}}))
+		assert.Nil(t, err)
+		return msg.(*oty.ReplyOracleStatusList).Status[0]
+	}
+	publishers := []string{string(Nodes[1]), string(Nodes[2]), string(Nodes[3])}
+
+	//fork之前不支持多数据源配置
+	_, err := execTx(oty.CreateQuorumConfigTx, &oty.QuorumConfig{Type: "score", Publishers: publishers, Threshold: 2}, PrivKeyA)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+	exec.SetEnv(forkHeight, 1539918074, 1)
+
+	//只有管理地址能配置，阈值不能超过发布者数量
+	_, err = execTx(oty.CreateQuorumConfigTx, &oty.QuorumConfig{Type: "score", Publishers: publishers, Threshold: 2}, PrivKeyB)
+	assert.Equal(t, oty.ErrNoPrivilege, err)
+	_, err = execTx(oty.CreateQuorumConfigTx, &oty.QuorumConfig{Type: "score", Publishers: publishers, Threshold: 4}, PrivKeyA)
+	assert.Equal(t, oty.ErrQuorumConfigInvalid, err)
+	_, err = execTx(oty.CreateQuorumConfigTx, &oty.QuorumConfig{Type: "score", Publishers: publishers, Threshold: 2}, PrivKeyA)
+	assert.Nil(t, err)
+	msg, err := exec.Query(oty.FuncNameQueryQuorumConfig, types.Encode(&oty.QueryQuorumConfig{Type: "score"}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), msg.(*oty.QuorumConfig).Threshold)
+
+	//2-of-3 结果一致
+	eventID := publishEvent("score")
+	_, err = execTx(oty.CreateResultPublishTx, &oty.ResultPublish{EventID: eventID, Source: "sina", Result: "3:2"}, PrivKeyA)
+	assert.Equal(t, oty.ErrQuorumResultRequired, err)
+	_, err = execTx(oty.CreateResultSubmitTx, &oty.ResultSubmit{EventID: eventID, Source: "sina", Result: "3:2"}, PrivKeyA)
+	assert.Equal(t, oty.ErrNoPrivilege, err)
+	_, err = execTx(oty.CreateResultSubmitTx, &oty.ResultSubmit{EventID: eventID, Source: "sina", Result: "3:2"}, PrivKeyB)
+	assert.Nil(t, err)
+	_, err = execTx(oty.CreateResultSubmitTx, &oty.ResultSubmit{EventID: eventID, Source: "sina", Result: "3:2"}, PrivKeyB)
+	assert.Equal(t, oty.ErrResultSubmitted, err)
+	assert.Equal(t, int32(oty.EventPublished), queryStatus(eventID).Status.Status)
+	_, err = execTx(oty.CreateResultSubmitTx, &oty.ResultSubmit{EventID: eventID, Source: "espn", Result: "2:2"}, PrivKeyC)
+	assert.Nil(t, err)
+	receipt, err := execTx(oty.CreateResultSubmitTx, &oty.ResultSubmit{EventID: eventID, Source: "bbc", Result: "3:2"}, PrivKeyD)
+	assert.Nil(t, err)
+	status := queryStatus(eventID)
+	assert.Equal(t, int32(oty.ResultPublished), status.Status.Status)
+	assert.Equal(t, "3:2", status.Result)
+	assert.Equal(t, "sina", status.Source)
+	assert.Equal(t, 3, len(status.Submissions))
+	assert.Equal(t, 1, len(status.Disputes))
+	assert.Equal(t, string(Nodes[2]), status.Disputes[0].Addr)
+	msg, err = exec.Query(oty.FuncNameQueryEventIDByStatus, types.Encode(&oty.QueryEventID{Status: oty.ResultPublished}))
+	assert.Nil(t, err)
+	assert.Equal(t, eventID, msg.(*oty.ReplyEventIDs).EventID[0])
+
+	//回滚最终提交后恢复为已发布状态
+	tx, err := ety.Create(oty.CreateResultSubmitTx, &oty.ResultSubmit{EventID: eventID})
+	assert.Nil(t, err)
+	set, err := exec.ExecDelLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+	msg, err = exec.Query(oty.FuncNameQueryEventIDByStatus, types.Encode(&oty.QueryEventID{Status: oty.EventPublished}))
+	assert.Nil(t, err)
+	assert.Equal(t, eventID, msg.(*oty.ReplyEventIDs).EventID[0])
+
+	//全部提交仍无法达成一致时记为争议，可以取消事件
+	eventID = publishEvent("score")
+	for i, key := range []string{PrivKeyB, PrivKeyC, PrivKeyD} {
+		_, err = execTx(oty.CreateResultSubmitTx, &oty.ResultSubmit{EventID: eventID, Source: "sina", Result: fmt.Sprintf("%d:0", i)}, key)
+		assert.Nil(t, err)
+	}
+	status = queryStatus(eventID)
+	assert.Equal(t, int32(oty.ResultDisputed), status.Status.Status)
+	assert.Equal(t, 3, len(status.Disputes))
+	_, err = execTx(oty.CreateResultSubmitTx, &oty.ResultSubmit{EventID: eventID, Source: "sina", Result: "0:0"}, PrivKeyB)
+	assert.Equal(t, oty.ErrResultSubmitNotAllowed, err)
+	_, err = execTx(oty.CreateAbortEventPublishTx, &oty.EventAbort{EventID: eventID}, PrivKeyA)
+	assert.Nil(t, err)
+
+	//数值结果取中位数，偏离超过1%记为争议
+	_, err = execTx(oty.CreateQuorumConfigTx, &oty.QuorumConfig{Type: "price", Publishers: publishers, Threshold: 3,
+		Mode: oty.QuorumModeMedian, MaxDeviation: 100}, PrivKeyA)
+	assert.Nil(t, err)
+	eventID = publishEvent("price")
+	_, err = execTx(oty.CreateResultSubmitTx, &oty.ResultSubmit{EventID: eventID, Source: "a", Result: "high"}, PrivKeyB)
+	assert.Equal(t, oty.ErrResultNotNumeric, err)
+	for i, result := range []string{"101.5", "100", "90"} {
+		_, err = execTx(oty.CreateResultSubmitTx, &oty.ResultSubmit{EventID: eventID, Source: fmt.Sprint(i), Result: result},
+			[]string{PrivKeyB, PrivKeyC, PrivKeyD}[i])
+		assert.Nil(t, err)
+	}
+	status = queryStatus(eventID)
+	assert.Equal(t, int32(oty.ResultPublished), status.Status.Status)
+	assert.Equal(t, "100", status.Result)
+	assert.Equal(t, 2, len(status.Disputes))
+
+	//配置变更不影响已发布事件，取消配置后恢复单发布者流程
+	_, err = execTx(oty.CreateQuorumConfigTx, &oty.QuorumConfig{Type: "score"}, PrivKeyA)
+	assert.Nil(t, err)
+	_, err = exec.Query(oty.FuncNameQueryQuorumConfig, types.Encode(&oty.QueryQuorumConfig{Type: "score"}))
+	assert.Equal(t, types.ErrNotFound, err)
+	eventID = publishEvent("score")
+	assert.Nil(t, queryStatus(eventID).Quorum)
+	_, err = execTx(oty.CreatePrePublishResultTx, &oty.ResultPrePublish{EventID: eventID, Source: "sina", Result: "1:0"}, PrivKeyA)
+	assert.Nil(t, err)
+}
+
+func TestOraclePriceFeed(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	InitExecType()
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 1000)
+	_, _, kvdb := util.CreateTestDB()
+
+	// set config key 授权
+	item := &types.ConfigItem{
+		Key: "mavl-manage-oracle-price-feed",
+		Value: &types.ConfigItem_Arr{
+			Arr: &types.ArrayConfig{Value: []string{string(Nodes[0])}},
+		},
+	}
+	stateDB.Set([]byte(item.Key), types.Encode(item))
+
+	exec := newOracle()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+
+	//区块高度和区块时间同步增长，区块时间1000时处于fork高度
+	forkHeight := cfg.GetDappFork(oty.OracleX, oty.ForkOraclePriceFeedX)
+	ety := types.LoadExecutorType(oty.OracleX)
+	feed := func(blockTime int64, price, volume []int64, privKey string) error {
+		tx, err := ety.Create(oty.CreatePriceFeedTx, &oty.PriceFeed{Pair: "BTC-USDT", Price: price, Volume: volume})
+		assert.Nil(t, err)
+		tx, err = types.FormatTx(cfg, oty.OracleX, tx)
+		assert.Nil(t, err)
+		tx, err = signTx(tx, privKey)
+		assert.Nil(t, err)
+		exec.SetEnv(forkHeight+blockTime-1000, blockTime, 1)
+		receipt, err := exec.Exec(tx, 1)
+		if err != nil {
+			return err
+		}
+		for _, kv := range receipt.KV {
+			stateDB.Set(kv.Key, kv.Value)
+		}
+		_, err = exec.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+		assert.Nil(t, err)
+		return nil
+	}
+
+	assert.Equal(t, types.ErrActionNotSupport, feed(999, []int64{100e4}, []int64{1}, PrivKeyA))
+	assert.Equal(t, oty.ErrNoPrivilege, feed(1000, []int64{100e4}, []int64{1}, PrivKeyB))
+	assert.Equal(t, types.ErrInvalidParam, feed(1000, []int64{100e4}, nil, PrivKeyA))
+	//按成交量加权 (90*3+130*1)/4 = 100
+	assert.Nil(t, feed(1000, []int64{90e4, 130e4}, []int64{3, 1}, PrivKeyA))
+	assert.Equal(t, oty.ErrPriceFeedTooFrequent, feed(1000, []int64{100e4}, []int64{1}, PrivKeyA))
+	assert.Nil(t, feed(1010, []int64{200e4}, []int64{1}, PrivKeyA))
+	assert.Nil(t, feed(1040, []int64{50e4}, []int64{1}, PrivKeyA))
+
+	msg, err := exec.Query(oty.FuncNameQueryLatestPrice, types.Encode(&oty.QueryPrice{Pair: "BTC-USDT"}))
+	assert.Nil(t, err)
+	latest := msg.(*oty.PriceRecord)
+	assert.Equal(t, int64(50e4), latest.Price)
+	assert.Equal(t, int64(3), latest.Seq)
+	assert.Equal(t, "70000000", latest.Cumulative)
+
+	msg, err = exec.Query(oty.FuncNameQueryPriceHistory, types.Encode(&oty.QueryPriceHistory{Pair: "BTC-USDT", Count: 2}))
+	assert.Nil(t, err)
+	history := msg.(*oty.ReplyPriceHistory)
+	assert.Equal(t, 2, len(history.Records))
+	assert.Equal(t, int64(200e4), history.Records[1].Price)
+	msg, err = exec.Query(oty.FuncNameQueryPriceHistory, types.Encode(&oty.QueryPriceHistory{Pair: "BTC-USDT", LastSeq: 2}))
+	assert.Nil(t, err)
+	history = msg.(*oty.ReplyPriceHistory)
+	assert.Equal(t, 1, len(history.Records))
+	assert.Equal(t, int64(100e4), history.Records[0].Price)
+
+	//整个区间 (100*10+200*30)/40 = 175
+	msg, err = exec.Query(oty.FuncNameQueryPriceTWAP, types.Encode(&oty.QueryPriceTWAP{Pair: "BTC-USDT"}))
+	assert.Nil(t, err)
+	twap := msg.(*oty.ReplyPriceTWAP)
+	assert.Equal(t, int64(175e4), twap.Price)
+	assert.Equal(t, int64(1000), twap.StartTime)
+	assert.Equal(t, int64(1040), twap.EndTime)
+	//(100*5+200*10)/15
+	msg, err = exec.Query(oty.FuncNameQueryPriceTWAP, types.Encode(&oty.QueryPriceTWAP{Pair: "BTC-USDT", StartTime: 1005, EndTime: 1020}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(25000000/15), msg.(*oty.ReplyPriceTWAP).Price)
+	//最新喂价之后按最新价格延续
+	reply, err := GetPriceTWAP(stateDB, "BTC-USDT", 1040, 1060)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(50e4), reply.Price)
+	//区间为一个时刻时返回该时刻生效的价格
+	reply, err = GetPriceTWAP(stateDB, "BTC-USDT", 1040, 1040)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(50e4), reply.Price)
+	reply, err = GetPriceTWAP(stateDB, "BTC-USDT", 1015, 1015)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(200e4), reply.Price)
+	_, err = GetPriceTWAP(stateDB, "BTC-USDT", 1041, 1040)
+	assert.Equal(t, oty.ErrPriceTimeRangeInvalid, err)
+
+	//只有一次喂价时返回该价格
+	tx, err := ety.Create(oty.CreatePriceFeedTx, &oty.PriceFeed{Pair: "ETH-USDT", Price: []int64{3e4}, Volume: []int64{1}})
+	assert.Nil(t, err)
+	tx, err = types.FormatTx(cfg, oty.OracleX, tx)
+	assert.Nil(t, err)
+	tx, err = signTx(tx, PrivKeyA)
+	assert.Nil(t, err)
+	receipt, err := exec.Exec(tx, 1)
+	assert.Nil(t, err)
+	for _, kv := range receipt.KV {
+		stateDB.Set(kv.Key, kv.Value)
+	}
+	reply, err = GetPriceTWAP(stateDB, "ETH-USDT", 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3e4), reply.Price)
+
+	//加权计算的中间值超出int64时仍然可以得到正确价格，数量为0的喂价无效
+	price, err := pricePolicy(&oty.PriceFeed{Price: []int64{math.MaxInt64, math.MaxInt64}, Volume: []int64{math.MaxInt64, 1}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(math.MaxInt64), price)
+	_, err = pricePolicy(&oty.PriceFeed{Price: []int64{math.MaxInt64}, Volume: []int64{0}})
+	assert.Equal(t, oty.ErrPriceInvalid, err)
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.New(types.GetSignName(oty.OracleX, signType))
@@ -78,13 +78,15 @@ type oracleAction struct {
 	blocktime int64
 	height    int64
 	index     int
+	quorum    bool
 }
 
 func newOracleAction(o *oracle, tx *types.Transaction, index int) *oracleAction {
 	hash := tx.Hash()
 	fromaddr := tx.From()
+	quorum := o.GetAPI().GetConfig().IsDappFork(o.GetHeight(), oty.OracleX, oty.ForkOracleQuorumX)
 	return &oracleAction{o.GetStateDB(), hash, fromaddr,
-		o.GetBlockTime(), o.GetHeight(), index}
+		o.GetBlockTime(), o.GetHeight(), index, quorum}
 }
 
 func (action *oracleAction) eventPublish(event *oty.EventPublish) (*types.Receipt, error) {
@@ -110,6 +112,14 @@ func (action *oracleAction) eventPublish(event *oty.EventPublish) (*types.Receip
 	}
 
 	eventStatus := NewOracleDB(eventID, action.fromaddr, event.Type, event.SubType, event.Content, event.Introduction, event.Time, action.GetIndex())
+	//事件类型配置了多数据源发布时，快照当时的配置，之后配置变更不影响已发布的事件
+	if action.quorum {
+		quorum, err := findQuorumConfig(action.db, event.Type)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		eventStatus.Quorum = quorum
+	}
 	olog.Debug("eventPublish", "PublisherAddr", eventStatus.Addr, "EventID", eventStatus.EventID, "Event", eventStatus.Content)
 
 	if err := eventStatus.save(action.db); err != nil {
@@ -142,7 +152,7 @@ func (action *oracleAction) eventAbort(event *oty.EventAbort) (*types.Receipt, e
 
 	ora := &OracleDB{*oracleStatus}
 
-	if ora.Status.Status != oty.EventPublished && ora.Status.Status != oty.ResultAborted {
+	if ora.Status.Status != oty.EventPublished && ora.Status.Status != oty.ResultAborted && ora.Status.Status != oty.ResultDisputed {
 		olog.Error("EventAbort", "EventAbort can not abort for status", ora.Status.Status)
 		return nil, oty.ErrEventAbortNotAllowed
 	}
@@ -178,6 +188,9 @@ func (action *oracleAction) resultPrePublish(event *oty.ResultPrePublish) (*type
 	}
 
 	ora := &OracleDB{*oracleStatus}
+	if ora.Quorum != nil {
+		return nil, oty.ErrQuorumResultRequired
+	}
 
 	if ora.Status.Status != oty.EventPublished && ora.Status.Status != oty.ResultAborted {
 		olog.Error("ResultPrePublish", "ResultPrePublish can not pre-publish", ora.Status.Status)
@@ -256,6 +269,9 @@ func (action *oracleAction) resultPublish(event *oty.ResultPublish) (*types.Rece
 	}
 
 	ora := &OracleDB{*oracleStatus}
+	if ora.Quorum != nil {
+		return nil, oty.ErrQuorumResultRequired
+	}
 
 	if ora.Status.Status != oty.ResultPrePublished {
 		olog.Error("ResultPublish", "ResultPublish can not abort", ora.Status.Status)
@@ -309,20 +325,24 @@ func isEventPublisher(addr string, db dbm.KV, isSolo bool) bool {
 	if isSolo {
 		return true
 	}
-	value, err := getManageKey(publishEventKey, db)
+	return isManageAddr(publishEventKey, addr, db)
+}
+
+func isManageAddr(key, addr string, db dbm.KV) bool {
+	value, err := getManageKey(key, db)
 	if err != nil {
-		olog.Error("OracleEventPublish", "publishEventKey", publishEventKey)
+		olog.Error("OracleManageAddr", "manageKey", key)
 		return false
 	}
 	if value == nil {
-		olog.Error("OracleEventPublish found nil value")
+		olog.Error("OracleManageAddr found nil value", "manageKey", key)
 		return false
 	}
 
 	var item types.ConfigItem
 	err = types.Decode(value, &item)
 	if err != nil {
-		olog.Error("OracleEventPublish", "Decode", value)
+		olog.Error("OracleManageAddr", "Decode", value)
 		return false
 	}
 
@@ -377,7 +397,7 @@ func getOracleLisByIDs(db dbm.KV, infos *oty.QueryOracleInfos) (types.Message, e
 }
 
 func getEventIDListByStatus(db dbm.KVDB, status int32, eventID string) (types.Message, error) {
-	if status <= oty.NoEvent || status > oty.ResultPublished {
+	if status <= oty.NoEvent || status > oty.ResultDisputed {
 		return nil, oty.ErrParamStatusInvalid
 	}
 	data := &oty.ReceiptOracle{
@@ -388,7 +408,7 @@ func getEventIDListByStatus(db dbm.KVDB, status int32, eventID string) (types.Me
 }
 
 func getEventIDListByAddrAndStatus(db dbm.KVDB, addr string, status int32, eventID string) (types.Message, error) {
-	if status <= oty.NoEvent || status > oty.ResultPublished {
+	if status <= oty.NoEvent || status > oty.ResultDisputed {
 		return nil, oty.ErrParamStatusInvalid
 	}
 	if len(addr) == 0 {
@@ -404,7 +424,7 @@ func getEventIDListByAddrAndStatus(db dbm.KVDB, addr string, status int32, event
 }
 
 func getEventIDListByTypeAndStatus(db dbm.KVDB, ty string, status int32, eventID string) (types.Message, error) {
-	if status <= oty.NoEvent || status > oty.ResultPublished {
+	if status <= oty.NoEvent || status > oty.ResultDisputed {
 		return nil, oty.ErrParamStatusInvalid
 	}
 	if len(ty) == 0 {
@@ -0,0 +1,267 @@
+/*
+ * Copyright Fuzamei Corp. 2018 All Rights Reserved.
+ * Use of this source code is governed by a BSD-style
+ * license that can be found in the LICENSE file.
+ */
+
+package executor
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
+)
+
+/*
+ * 交易对喂价，每个交易对在statedb中保存最新记录和按序号保存的历史记录
+ * 每条记录带有此前价格对时间的累计值，任意时间段的时间加权平均价只需二分查找两端的记录
+ * 其他执行器可以通过自己的statedb调用GetLatestPrice、GetPriceTWAP读取
+ */
+
+// 喂价的管理地址
+const priceFeedKey = "oracle-price-feed"
+
+// PriceKey 交易对的最新喂价
+func PriceKey(pair string) (key []byte) {
+	key = append(key, []byte("mavl-"+oty.OracleX+"-price-")...)
+	key = append(key, []byte(pair)...)
+	return key
+}
+
+// PriceRecordKey 交易对按序号保存的历史喂价
+func PriceRecordKey(pair string, seq int64) (key []byte) {
+	key = append(key, PriceKey(pair)...)
+	key = append(key, []byte(fmt.Sprintf(":%020d", seq))...)
+	return key
+}
+
+func findPriceRecord(db dbm.KV, key []byte) (*oty.PriceRecord, error) {
+	data, err := db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	var record oty.PriceRecord
+	err = types.Decode(data, &record)
+	if err != nil {
+		olog.Debug("findPriceRecord", "decode", err)
+		return nil, err
+	}
+	return &record, nil
+}
+
+// GetLatestPrice 获取交易对的最新喂价
+func GetLatestPrice(db dbm.KV, pair string) (*oty.PriceRecord, error) {
+	return findPriceRecord(db, PriceKey(pair))
+}
+
+// GetPriceRecord 获取交易对指定序号的喂价
+func GetPriceRecord(db dbm.KV, pair string, seq int64) (*oty.PriceRecord, error) {
+	return findPriceRecord(db, PriceRecordKey(pair, seq))
+}
+
+// GetPriceHistory 从lastSeq之前按序号降序获取喂价记录，lastSeq为0时从最新记录开始
+func GetPriceHistory(db dbm.KV, pair string, lastSeq int64, count int32) (*oty.ReplyPriceHistory, error) {
+	latest, err := GetLatestPrice(db, pair)
+	if err != nil {
+		return nil, err
+	}
+	if count <= 0 {
+		count = oty.DefaultCount
+	}
+	if count > oty.MaxPriceHistoryCount {
+		count = oty.MaxPriceHistoryCount
+	}
+	seq := latest.Seq
+	if lastSeq > 0 && lastSeq-1 < seq {
+		seq = lastSeq - 1
+	}
+	reply := &oty.ReplyPriceHistory{}
+	for ; seq > 0 && len(reply.Records) < int(count); seq-- {
+		record, err := GetPriceRecord(db, pair, seq)
+		if err != nil {
+			return nil, err
+		}
+		reply.Records = append(reply.Records, record)
+	}
+	if len(reply.Records) == 0 {
+		return nil, types.ErrNotFound
+	}
+	return reply, nil
+}
+
+// GetPriceTWAP 计算交易对在[startTime, endTime]内的时间加权平均价
+// startTime早于首次喂价时从首次喂价开始，endTime为0时取最新喂价时间，晚于最新喂价时按最新价格延续，
+// startTime和endTime相同时(例如只有一次喂价)返回该时刻的价格
+func GetPriceTWAP(db dbm.KV, pair string, startTime, endTime int64) (*oty.ReplyPriceTWAP, error) {
+	latest, err := GetLatestPrice(db, pair)
+	if err != nil {
+		return nil, err
+	}
+	first, err := GetPriceRecord(db, pair, 1)
+	if err != nil {
+		return nil, err
+	}
+	if endTime == 0 {
+		endTime = latest.Time
+	}
+	if startTime < first.Time {
+		startTime = first.Time
+	}
+	if startTime > endTime {
+		return nil, oty.ErrPriceTimeRangeInvalid
+	}
+	if startTime == endTime {
+		record, err := recordAt(db, latest, startTime)
+		if err != nil {
+			return nil, err
+		}
+		return &oty.ReplyPriceTWAP{Pair: pair, Price: record.Price, StartTime: startTime, EndTime: endTime}, nil
+	}
+
+	startCum, err := cumulativeAt(db, latest, startTime)
+	if err != nil {
+		return nil, err
+	}
+	endCum, err := cumulativeAt(db, latest, endTime)
+	if err != nil {
+		return nil, err
+	}
+	twap := new(big.Int).Sub(endCum, startCum)
+	twap.Quo(twap, big.NewInt(endTime-startTime))
+	if !twap.IsInt64() {
+		olog.Error("GetPriceTWAP", "pair", pair, "twap", twap.String(), "err", oty.ErrPriceOverflow)
+		return nil, oty.ErrPriceOverflow
+	}
+	return &oty.ReplyPriceTWAP{Pair: pair, Price: twap.Int64(), StartTime: startTime, EndTime: endTime}, nil
+}
+
+//t时刻价格对时间的累计值，t不能早于首次喂价
+func cumulativeAt(db dbm.KV, latest *oty.PriceRecord, t int64) (*big.Int, error) {
+	record, err := recordAt(db, latest, t)
+	if err != nil {
+		return nil, err
+	}
+	return accumulate(record, t)
+}
+
+//t时刻生效的喂价记录，即最后一条时间不晚于t的记录
+func recordAt(db dbm.KV, latest *oty.PriceRecord, t int64) (*oty.PriceRecord, error) {
+	record := latest
+	if t < latest.Time {
+		//二分查找最后一条时间不晚于t的记录
+		lo, hi := int64(1), latest.Seq
+		for lo < hi {
+			mid := lo + (hi-lo+1)/2
+			r, err := GetPriceRecord(db, latest.Pair, mid)
+			if err != nil {
+				return nil, err
+			}
+			if r.Time <= t {
+				lo = mid
+			} else {
+				hi = mid - 1
+			}
+		}
+		r, err := GetPriceRecord(db, latest.Pair, lo)
+		if err != nil {
+			return nil, err
+		}
+		record = r
+	}
+	return record, nil
+}
+
+//record的累计值加上record价格持续到t的部分
+func accumulate(record *oty.PriceRecord, t int64) (*big.Int, error) {
+	cum, ok := new(big.Int).SetString(record.Cumulative, 10)
+	if !ok {
+		return nil, types.ErrDecode
+	}
+	delta := new(big.Int).Mul(big.NewInt(record.Price), big.NewInt(t-record.Time))
+	return cum.Add(cum, delta), nil
+}
+
+//按成交量加权计算价格
+func pricePolicy(feed *oty.PriceFeed) (int64, error) {
+	totalPrice := new(big.Int)
+	totalVolume := new(big.Int)
+	for i, price := range feed.Price {
+		if price <= 0 || feed.Volume[i] <= 0 {
+			return 0, oty.ErrPriceInvalid
+		}
+		totalPrice.Add(totalPrice, new(big.Int).Mul(big.NewInt(price), big.NewInt(feed.Volume[i])))
+		totalVolume.Add(totalVolume, big.NewInt(feed.Volume[i]))
+	}
+	totalPrice.Quo(totalPrice, totalVolume)
+	if !totalPrice.IsInt64() {
+		return 0, oty.ErrPriceOverflow
+	}
+	return totalPrice.Int64(), nil
+}
+
+func (action *oracleAction) priceFeed(feed *oty.PriceFeed) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	//交易对中不能包含历史记录key的分隔符
+	if len(feed.Pair) == 0 || strings.Contains(feed.Pair, ":") {
+		return nil, oty.ErrParamPairInvalid
+	}
+	if len(feed.Price) == 0 || len(feed.Price) != len(feed.Volume) {
+		olog.Error("PriceFeed", "pair", feed.Pair, "err", types.ErrInvalidParam)
+		return nil, types.ErrInvalidParam
+	}
+	if !isManageAddr(priceFeedKey, action.fromaddr, action.db) {
+		olog.Error("PriceFeed", "addr", action.fromaddr, "error", "Address has no permission to feed price")
+		return nil, oty.ErrNoPrivilege
+	}
+	price, err := pricePolicy(feed)
+	if err != nil {
+		olog.Error("PriceFeed", "pair", feed.Pair, "error", err)
+		return nil, err
+	}
+	if price <= 0 {
+		olog.Error("PriceFeed", "price", price, "error", oty.ErrPriceInvalid)
+		return nil, oty.ErrPriceInvalid
+	}
+
+	record := &oty.PriceRecord{
+		Pair:       feed.Pair,
+		Price:      price,
+		Time:       action.blocktime,
+		Height:     action.height,
+		Feeder:     action.fromaddr,
+		Seq:        1,
+		Cumulative: "0",
+	}
+	latest, err := GetLatestPrice(action.db, feed.Pair)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	if latest != nil {
+		//同一时间只保留一个价格，保证时间加权计算的区间不重叠
+		if action.blocktime <= latest.Time {
+			return nil, oty.ErrPriceFeedTooFrequent
+		}
+		cum, err := accumulate(latest, action.blocktime)
+		if err != nil {
+			return nil, err
+		}
+		record.Seq = latest.Seq + 1
+		record.Cumulative = cum.String()
+	}
+
+	value := types.Encode(record)
+	for _, key := range [][]byte{PriceKey(feed.Pair), PriceRecordKey(feed.Pair, record.Seq)} {
+		if err := action.db.Set(key, value); err != nil {
+			return nil, err
+		}
+		kv = append(kv, &types.KeyValue{Key: key, Value: value})
+	}
+	logs = append(logs, &types.ReceiptLog{Ty: oty.TyLogPriceFeed, Log: value})
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
@@ -44,3 +44,35 @@ func (o *oracle) Query_QueryEventIDsByTypeAndStatus(in *oty.QueryEventID) (types
 	}
 	return eventIds, nil
 }
+
+//查询事件类型的多数据源发布配置
+func (o *oracle) Query_QueryQuorumConfig(in *oty.QueryQuorumConfig) (types.Message, error) {
+	if len(in.Type) == 0 {
+		return nil, oty.ErrParamTypeMustNotEmpty
+	}
+	return findQuorumConfig(o.GetStateDB(), in.Type)
+}
+
+//查询交易对的最新喂价
+func (o *oracle) Query_QueryLatestPrice(in *oty.QueryPrice) (types.Message, error) {
+	if len(in.Pair) == 0 {
+		return nil, oty.ErrParamPairInvalid
+	}
+	return GetLatestPrice(o.GetStateDB(), in.Pair)
+}
+
+//分页查询交易对的历史喂价
+func (o *oracle) Query_QueryPriceHistory(in *oty.QueryPriceHistory) (types.Message, error) {
+	if len(in.Pair) == 0 {
+		return nil, oty.ErrParamPairInvalid
+	}
+	return GetPriceHistory(o.GetStateDB(), in.Pair, in.LastSeq, in.Count)
+}
+
+//查询交易对的时间加权平均价
+func (o *oracle) Query_QueryPriceTWAP(in *oty.QueryPriceTWAP) (types.Message, error) {
+	if len(in.Pair) == 0 {
+		return nil, oty.ErrParamPairInvalid
+	}
+	return GetPriceTWAP(o.GetStateDB(), in.Pair, in.StartTime, in.EndTime)
+}
@@ -0,0 +1,255 @@
+/*
+ * Copyright Fuzamei Corp. 2018 All Rights Reserved.
+ * Use of this source code is governed by a BSD-style
+ * license that can be found in the LICENSE file.
+ */
+
+package executor
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
+)
+
+// 配置多数据源发布规则的管理地址
+const quorumManageKey = "oracle-quorum-manager"
+
+// QuorumKey 事件类型对应的多数据源发布配置
+func QuorumKey(ty string) (key []byte) {
+	key = append(key, []byte("mavl-"+oty.OracleX+"-quorum-")...)
+	key = append(key, []byte(ty)...)
+	return key
+}
+
+func findQuorumConfig(db dbm.KV, ty string) (*oty.QuorumConfig, error) {
+	data, err := db.Get(QuorumKey(ty))
+	if err != nil {
+		return nil, err
+	}
+	var config oty.QuorumConfig
+	err = types.Decode(data, &config)
+	if err != nil {
+		olog.Debug("findQuorumConfig", "decode", err)
+		return nil, err
+	}
+	//发布者列表为空表示该类型已取消多数据源发布
+	if len(config.Publishers) == 0 {
+		return nil, types.ErrNotFound
+	}
+	return &config, nil
+}
+
+//发布者和阈值都为空时表示取消该类型的多数据源发布
+func checkQuorumConfig(config *oty.QuorumConfig) error {
+	if len(config.Type) == 0 {
+		return oty.ErrParamTypeMustNotEmpty
+	}
+	if len(config.Publishers) == 0 && config.Threshold == 0 {
+		return nil
+	}
+	if config.Mode != oty.QuorumModeAgree && config.Mode != oty.QuorumModeMedian {
+		return oty.ErrQuorumConfigInvalid
+	}
+	if config.Threshold <= 0 || int(config.Threshold) > len(config.Publishers) || config.MaxDeviation < 0 {
+		return oty.ErrQuorumConfigInvalid
+	}
+	publishers := make(map[string]bool)
+	for _, addr := range config.Publishers {
+		if err := address.CheckAddress(addr); err != nil {
+			return err
+		}
+		if publishers[addr] {
+			return oty.ErrQuorumConfigInvalid
+		}
+		publishers[addr] = true
+	}
+	return nil
+}
+
+func (action *oracleAction) quorumConfig(config *oty.QuorumConfig) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	if !isManageAddr(quorumManageKey, action.fromaddr, action.db) {
+		return nil, oty.ErrNoPrivilege
+	}
+	if err := checkQuorumConfig(config); err != nil {
+		olog.Error("QuorumConfig", "type", config.Type, "err", err)
+		return nil, err
+	}
+
+	value := types.Encode(config)
+	if err := action.db.Set(QuorumKey(config.Type), value); err != nil {
+		return nil, err
+	}
+	kv = append(kv, &types.KeyValue{Key: QuorumKey(config.Type), Value: value})
+	logs = append(logs, &types.ReceiptLog{Ty: oty.TyLogQuorumConfig, Log: value})
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (action *oracleAction) resultSubmit(event *oty.ResultSubmit) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	oracleStatus, err := findOracleStatus(action.db, event.EventID)
+	if err == types.ErrNotFound {
+		olog.Error("ResultSubmit", "ResultSubmit not found eventID", event.EventID)
+		return nil, oty.ErrEventIDNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	ora := &OracleDB{*oracleStatus}
+	if ora.Quorum == nil {
+		return nil, oty.ErrQuorumNotConfigured
+	}
+	if ora.Status.Status != oty.EventPublished {
+		olog.Error("ResultSubmit", "ResultSubmit can not submit for status", ora.Status.Status)
+		return nil, oty.ErrResultSubmitNotAllowed
+	}
+	//只有事件发布时快照下来的发布者能提交结果，且每人只能提交一次
+	if !isQuorumPublisher(ora.Quorum, action.fromaddr) {
+		return nil, oty.ErrNoPrivilege
+	}
+	for _, s := range ora.Submissions {
+		if s.Addr == action.fromaddr {
+			return nil, oty.ErrResultSubmitted
+		}
+	}
+	if ora.Quorum.Mode == oty.QuorumModeMedian {
+		if _, err := parseNumericResult(event.Result); err != nil {
+			return nil, err
+		}
+	}
+
+	submission := &oty.ResultSubmission{
+		Addr:   action.fromaddr,
+		Source: event.Source,
+		Result: event.Result,
+		Height: action.height,
+	}
+	ora.Submissions = append(ora.Submissions, submission)
+	logs = append(logs, &types.ReceiptLog{Ty: oty.TyLogResultSubmit, Log: types.Encode(submission)})
+
+	final, disputes, disputed := aggregateResult(ora.Quorum, ora.Submissions)
+	if final != nil {
+		updateStatus(ora, action.GetIndex(), action.fromaddr, oty.ResultPublished)
+		ora.Result = final.Result
+		ora.Source = final.Source
+		ora.Disputes = disputes
+		logs = append(logs, action.getOracleCommonRecipt(&ora.OracleStatus, oty.TyLogResultPublish))
+	} else if disputed {
+		updateStatus(ora, action.GetIndex(), action.fromaddr, oty.ResultDisputed)
+		ora.Disputes = disputes
+		logs = append(logs, action.getOracleCommonRecipt(&ora.OracleStatus, oty.TyLogResultDispute))
+	}
+
+	if err := ora.save(action.db); err != nil {
+		return nil, err
+	}
+	kv = append(kv, ora.GetKVSet()...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func isQuorumPublisher(quorum *oty.QuorumConfig, addr string) bool {
+	for _, publisher := range quorum.Publishers {
+		if publisher == addr {
+			return true
+		}
+	}
+	return false
+}
+
+//数值结果按float64解析后转为有理数比较，避免浮点运算在不同平台上结果不一致
+func parseNumericResult(result string) (*big.Rat, error) {
+	f, err := strconv.ParseFloat(result, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, oty.ErrResultNotNumeric
+	}
+	return new(big.Rat).SetFloat64(f), nil
+}
+
+// aggregateResult 汇总已提交的结果
+// 返回最终结果及与之不一致的提交；所有发布者都已提交仍无法确定结果时disputed为true，此时所有提交都记为争议
+func aggregateResult(quorum *oty.QuorumConfig, submissions []*oty.ResultSubmission) (final *oty.ResultSubmission, disputes []*oty.ResultSubmission, disputed bool) {
+	if quorum.Mode == oty.QuorumModeMedian {
+		final, disputes = medianResult(quorum, submissions)
+	} else {
+		final, disputes = agreedResult(quorum, submissions)
+	}
+	if final == nil && len(submissions) >= len(quorum.Publishers) {
+		return nil, submissions, true
+	}
+	return final, disputes, false
+}
+
+//每次提交后都会汇总，所以只有最新一笔提交的结果可能刚好达到阈值
+func agreedResult(quorum *oty.QuorumConfig, submissions []*oty.ResultSubmission) (*oty.ResultSubmission, []*oty.ResultSubmission) {
+	if len(submissions) == 0 {
+		return nil, nil
+	}
+	latest := submissions[len(submissions)-1]
+	var final *oty.ResultSubmission
+	var agreed int32
+	for _, s := range submissions {
+		if s.Result == latest.Result {
+			if final == nil {
+				final = s
+			}
+			agreed++
+		}
+	}
+	if agreed < quorum.Threshold {
+		return nil, nil
+	}
+	var disputes []*oty.ResultSubmission
+	for _, s := range submissions {
+		if s.Result != final.Result {
+			disputes = append(disputes, s)
+		}
+	}
+	return final, disputes
+}
+
+//取下中位数，保证最终结果是某个发布者实际提交的值
+func medianResult(quorum *oty.QuorumConfig, submissions []*oty.ResultSubmission) (*oty.ResultSubmission, []*oty.ResultSubmission) {
+	if len(submissions) < int(quorum.Threshold) {
+		return nil, nil
+	}
+	values := make([]*big.Rat, len(submissions))
+	sorted := make([]int, len(submissions))
+	for i, s := range submissions {
+		v, err := parseNumericResult(s.Result)
+		if err != nil {
+			return nil, nil
+		}
+		values[i] = v
+		sorted[i] = i
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return values[sorted[i]].Cmp(values[sorted[j]]) < 0
+	})
+	median := sorted[(len(sorted)-1)/2]
+
+	var disputes []*oty.ResultSubmission
+	if quorum.MaxDeviation > 0 {
+		//|v-m|*10000 > maxDeviation*|m| 即偏离超过maxDeviation万分比
+		m := values[median]
+		limit := new(big.Rat).Mul(new(big.Rat).Abs(m), big.NewRat(int64(quorum.MaxDeviation), 1))
+		for i, s := range submissions {
+			diff := new(big.Rat).Sub(values[i], m)
+			diff.Abs(diff).Mul(diff, big.NewRat(10000, 1))
+			if diff.Cmp(limit) > 0 {
+				disputes = append(disputes, s)
+			}
+		}
+	}
+	return submissions[median], disputes
+}
@@ -11,6 +11,10 @@ import "errors"
 var (
 	// OracleX oracle name
 	OracleX = "oracle"
+	// ForkOracleQuorumX 多数据源共同发布事件结果的分叉
+	ForkOracleQuorumX = "ForkOracleQuorum"
+	// ForkOraclePriceFeedX 交易对喂价的分叉
+	ForkOraclePriceFeedX = "ForkOraclePriceFeed"
 )
 
 // oracle action type
@@ -20,6 +24,9 @@ const (
 	ActionResultPublish
 	ActionEventAbort
 	ActionResultAbort
+	ActionQuorumConfig
+	ActionResultSubmit
+	ActionPriceFeed
 )
 
 // oracle status
@@ -30,6 +37,15 @@ const (
 	ResultPrePublished
 	ResultAborted
 	ResultPublished
+	ResultDisputed
+)
+
+// quorum mode
+const (
+	// QuorumModeAgree M个发布者提交相同结果
+	QuorumModeAgree = iota
+	// QuorumModeMedian 提交数达到M个后取数值中位数
+	QuorumModeMedian
 )
 
 // log type define
@@ -39,6 +55,10 @@ const (
 	TyLogResultPrePublish = 812
 	TyLogResultAbort      = 813
 	TyLogResultPublish    = 814
+	TyLogQuorumConfig     = 815
+	TyLogResultSubmit     = 816
+	TyLogResultDispute    = 817
+	TyLogPriceFeed        = 818
 )
 
 // executor action and function define
@@ -51,6 +71,14 @@ const (
 	FuncNameQueryEventIDByAddrAndStatus = "QueryEventIDsByAddrAndStatus"
 	// FuncNameQueryEventIDByTypeAndStatus 根据事件类型和状态查询eventID
 	FuncNameQueryEventIDByTypeAndStatus = "QueryEventIDsByTypeAndStatus"
+	// FuncNameQueryQuorumConfig 根据事件类型查询多数据源发布配置
+	FuncNameQueryQuorumConfig = "QueryQuorumConfig"
+	// FuncNameQueryLatestPrice 查询交易对的最新喂价
+	FuncNameQueryLatestPrice = "QueryLatestPrice"
+	// FuncNameQueryPriceHistory 分页查询交易对的历史喂价
+	FuncNameQueryPriceHistory = "QueryPriceHistory"
+	// FuncNameQueryPriceTWAP 查询交易对一段时间内的时间加权平均价
+	FuncNameQueryPriceTWAP = "QueryPriceTWAP"
 	// CreateEventPublishTx 创建发布事件交易
 	CreateEventPublishTx = "EventPublish"
 	// CreateAbortEventPublishTx 创建取消发布事件交易
@@ -61,6 +89,12 @@ const (
 	CreateAbortResultPrePublishTx = "ResultAbort"
 	// CreateResultPublishTx 创建预发布事件结果交易
 	CreateResultPublishTx = "ResultPublish"
+	// CreateQuorumConfigTx 创建配置事件类型多数据源发布规则交易
+	CreateQuorumConfigTx = "QuorumConfig"
+	// CreateResultSubmitTx 创建多数据源提交事件结果交易
+	CreateResultSubmitTx = "ResultSubmit"
+	// CreatePriceFeedTx 创建交易对喂价交易
+	CreatePriceFeedTx = "PriceFeed"
 )
 
 // query param define
@@ -69,6 +103,8 @@ const (
 	ListDESC = int32(0)
 	// DefaultCount 默认一次取多少条记录
 	DefaultCount = int32(20)
+	// MaxPriceHistoryCount 一次最多取多少条喂价记录
+	MaxPriceHistoryCount = int32(100)
 )
 
 // Errors for oracle
@@ -85,4 +121,15 @@ var (
 	ErrParamStatusInvalid         = errors.New("ErrParamStatusInvalid")
 	ErrParamAddressMustnotEmpty   = errors.New("ErrParamAddressMustnotEmpty")
 	ErrParamTypeMustNotEmpty      = errors.New("ErrParamTypeMustNotEmpty")
+	ErrQuorumConfigInvalid        = errors.New("ErrQuorumConfigInvalid")
+	ErrQuorumNotConfigured        = errors.New("ErrQuorumNotConfigured")
+	ErrQuorumResultRequired       = errors.New("ErrQuorumResultRequired")
+	ErrResultSubmitNotAllowed     = errors.New("ErrResultSubmitNotAllowed")
+	ErrResultSubmitted            = errors.New("ErrResultSubmitted")
+	ErrResultNotNumeric           = errors.New("ErrResultNotNumeric")
+	ErrParamPairInvalid           = errors.New("ErrParamPairInvalid")
+	ErrPriceInvalid               = errors.New("ErrPriceInvalid")
+	ErrPriceFeedTooFrequent       = errors.New("ErrPriceFeedTooFrequent")
+	ErrPriceTimeRangeInvalid      = errors.New("ErrPriceTimeRangeInvalid")
+	ErrPriceOverflow              = errors.New("ErrPriceOverflow")
 )
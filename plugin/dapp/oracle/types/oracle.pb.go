@@ -23,20 +23,23 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 //事件
 type OracleStatus struct {
-	EventID              string       `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
-	Addr                 string       `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Type                 string       `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	SubType              string       `protobuf:"bytes,4,opt,name=subType,proto3" json:"subType,omitempty"`
-	Time                 int64        `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
-	Content              string       `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	Introduction         string       `protobuf:"bytes,7,opt,name=introduction,proto3" json:"introduction,omitempty"`
-	Status               *EventStatus `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	Source               string       `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
-	Result               string       `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`
-	PreStatus            *EventStatus `protobuf:"bytes,11,opt,name=preStatus,proto3" json:"preStatus,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	EventID              string              `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Addr                 string              `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Type                 string              `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	SubType              string              `protobuf:"bytes,4,opt,name=subType,proto3" json:"subType,omitempty"`
+	Time                 int64               `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	Content              string              `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	Introduction         string              `protobuf:"bytes,7,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	Status               *EventStatus        `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Source               string              `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	Result               string              `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`
+	PreStatus            *EventStatus        `protobuf:"bytes,11,opt,name=preStatus,proto3" json:"preStatus,omitempty"`
+	Quorum               *QuorumConfig       `protobuf:"bytes,12,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	Submissions          []*ResultSubmission `protobuf:"bytes,13,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	Disputes             []*ResultSubmission `protobuf:"bytes,14,rep,name=disputes,proto3" json:"disputes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *OracleStatus) Reset()         { *m = OracleStatus{} }
@@ -141,6 +144,27 @@ func (m *OracleStatus) GetPreStatus() *EventStatus {
 	return nil
 }
 
+func (m *OracleStatus) GetQuorum() *QuorumConfig {
+	if m != nil {
+		return m.Quorum
+	}
+	return nil
+}
+
+func (m *OracleStatus) GetSubmissions() []*ResultSubmission {
+	if m != nil {
+		return m.Submissions
+	}
+	return nil
+}
+
+func (m *OracleStatus) GetDisputes() []*ResultSubmission {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
 // action
 type OracleAction struct {
 	// Types that are valid to be assigned to Value:
@@ -149,6 +173,9 @@ type OracleAction struct {
 	//	*OracleAction_ResultPrePublish
 	//	*OracleAction_ResultPublish
 	//	*OracleAction_ResultAbort
+	//	*OracleAction_QuorumConfig
+	//	*OracleAction_ResultSubmit
+	//	*OracleAction_PriceFeed
 	Value                isOracleAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -205,6 +232,18 @@ type OracleAction_ResultAbort struct {
 	ResultAbort *ResultAbort `protobuf:"bytes,5,opt,name=resultAbort,proto3,oneof"`
 }
 
+type OracleAction_QuorumConfig struct {
+	QuorumConfig *QuorumConfig `protobuf:"bytes,8,opt,name=quorumConfig,proto3,oneof"`
+}
+
+type OracleAction_ResultSubmit struct {
+	ResultSubmit *ResultSubmit `protobuf:"bytes,9,opt,name=resultSubmit,proto3,oneof"`
+}
+
+type OracleAction_PriceFeed struct {
+	PriceFeed *PriceFeed `protobuf:"bytes,10,opt,name=priceFeed,proto3,oneof"`
+}
+
 func (*OracleAction_EventPublish) isOracleAction_Value() {}
 
 func (*OracleAction_EventAbort) isOracleAction_Value() {}
@@ -215,6 +254,12 @@ func (*OracleAction_ResultPublish) isOracleAction_Value() {}
 
 func (*OracleAction_ResultAbort) isOracleAction_Value() {}
 
+func (*OracleAction_QuorumConfig) isOracleAction_Value() {}
+
+func (*OracleAction_ResultSubmit) isOracleAction_Value() {}
+
+func (*OracleAction_PriceFeed) isOracleAction_Value() {}
+
 func (m *OracleAction) GetValue() isOracleAction_Value {
 	if m != nil {
 		return m.Value
@@ -257,6 +302,27 @@ func (m *OracleAction) GetResultAbort() *ResultAbort {
 	return nil
 }
 
+func (m *OracleAction) GetQuorumConfig() *QuorumConfig {
+	if x, ok := m.GetValue().(*OracleAction_QuorumConfig); ok {
+		return x.QuorumConfig
+	}
+	return nil
+}
+
+func (m *OracleAction) GetResultSubmit() *ResultSubmit {
+	if x, ok := m.GetValue().(*OracleAction_ResultSubmit); ok {
+		return x.ResultSubmit
+	}
+	return nil
+}
+
+func (m *OracleAction) GetPriceFeed() *PriceFeed {
+	if x, ok := m.GetValue().(*OracleAction_PriceFeed); ok {
+		return x.PriceFeed
+	}
+	return nil
+}
+
 func (m *OracleAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -272,6 +338,9 @@ func (*OracleAction) XXX_OneofWrappers() []interface{} {
 		(*OracleAction_ResultPrePublish)(nil),
 		(*OracleAction_ResultPublish)(nil),
 		(*OracleAction_ResultAbort)(nil),
+		(*OracleAction_QuorumConfig)(nil),
+		(*OracleAction_ResultSubmit)(nil),
+		(*OracleAction_PriceFeed)(nil),
 	}
 }
 
@@ -581,6 +650,337 @@ func (m *ResultAbort) GetEventID() string {
 	return ""
 }
 
+type QuorumConfig struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Publishers           []string `protobuf:"bytes,2,rep,name=publishers,proto3" json:"publishers,omitempty"`
+	Threshold            int32    `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Mode                 int32    `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	MaxDeviation         int32    `protobuf:"varint,5,opt,name=maxDeviation,proto3" json:"maxDeviation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuorumConfig) Reset()         { *m = QuorumConfig{} }
+func (m *QuorumConfig) String() string { return proto.CompactTextString(m) }
+func (*QuorumConfig) ProtoMessage()    {}
+func (*QuorumConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{8}
+}
+
+func (m *QuorumConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuorumConfig.Unmarshal(m, b)
+}
+func (m *QuorumConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuorumConfig.Marshal(b, m, deterministic)
+}
+func (m *QuorumConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumConfig.Merge(m, src)
+}
+func (m *QuorumConfig) XXX_Size() int {
+	return xxx_messageInfo_QuorumConfig.Size(m)
+}
+func (m *QuorumConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumConfig proto.InternalMessageInfo
+
+func (m *QuorumConfig) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *QuorumConfig) GetPublishers() []string {
+	if m != nil {
+		return m.Publishers
+	}
+	return nil
+}
+
+func (m *QuorumConfig) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *QuorumConfig) GetMode() int32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *QuorumConfig) GetMaxDeviation() int32 {
+	if m != nil {
+		return m.MaxDeviation
+	}
+	return 0
+}
+
+type ResultSubmit struct {
+	EventID              string   `protobuf:"bytes,2,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Source               string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Result               string   `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResultSubmit) Reset()         { *m = ResultSubmit{} }
+func (m *ResultSubmit) String() string { return proto.CompactTextString(m) }
+func (*ResultSubmit) ProtoMessage()    {}
+func (*ResultSubmit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{9}
+}
+
+func (m *ResultSubmit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultSubmit.Unmarshal(m, b)
+}
+func (m *ResultSubmit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultSubmit.Marshal(b, m, deterministic)
+}
+func (m *ResultSubmit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultSubmit.Merge(m, src)
+}
+func (m *ResultSubmit) XXX_Size() int {
+	return xxx_messageInfo_ResultSubmit.Size(m)
+}
+func (m *ResultSubmit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultSubmit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultSubmit proto.InternalMessageInfo
+
+func (m *ResultSubmit) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
+func (m *ResultSubmit) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ResultSubmit) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+type ResultSubmission struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Result               string   `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResultSubmission) Reset()         { *m = ResultSubmission{} }
+func (m *ResultSubmission) String() string { return proto.CompactTextString(m) }
+func (*ResultSubmission) ProtoMessage()    {}
+func (*ResultSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{10}
+}
+
+func (m *ResultSubmission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultSubmission.Unmarshal(m, b)
+}
+func (m *ResultSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultSubmission.Marshal(b, m, deterministic)
+}
+func (m *ResultSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultSubmission.Merge(m, src)
+}
+func (m *ResultSubmission) XXX_Size() int {
+	return xxx_messageInfo_ResultSubmission.Size(m)
+}
+func (m *ResultSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultSubmission proto.InternalMessageInfo
+
+func (m *ResultSubmission) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ResultSubmission) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ResultSubmission) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *ResultSubmission) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type PriceFeed struct {
+	Pair                 string   `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Price                []int64  `protobuf:"varint,2,rep,name=price,proto3" json:"price,omitempty"`
+	Volume               []int64  `protobuf:"varint,3,rep,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceFeed) Reset()         { *m = PriceFeed{} }
+func (m *PriceFeed) String() string { return proto.CompactTextString(m) }
+func (*PriceFeed) ProtoMessage()    {}
+func (*PriceFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{11}
+}
+
+func (m *PriceFeed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceFeed.Unmarshal(m, b)
+}
+func (m *PriceFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceFeed.Marshal(b, m, deterministic)
+}
+func (m *PriceFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceFeed.Merge(m, src)
+}
+func (m *PriceFeed) XXX_Size() int {
+	return xxx_messageInfo_PriceFeed.Size(m)
+}
+func (m *PriceFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceFeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceFeed proto.InternalMessageInfo
+
+func (m *PriceFeed) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PriceFeed) GetPrice() []int64 {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *PriceFeed) GetVolume() []int64 {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type PriceRecord struct {
+	Pair                 string   `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Price                int64    `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Feeder               string   `protobuf:"bytes,5,opt,name=feeder,proto3" json:"feeder,omitempty"`
+	Seq                  int64    `protobuf:"varint,6,opt,name=seq,proto3" json:"seq,omitempty"`
+	Cumulative           string   `protobuf:"bytes,7,opt,name=cumulative,proto3" json:"cumulative,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceRecord) Reset()         { *m = PriceRecord{} }
+func (m *PriceRecord) String() string { return proto.CompactTextString(m) }
+func (*PriceRecord) ProtoMessage()    {}
+func (*PriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{12}
+}
+
+func (m *PriceRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceRecord.Unmarshal(m, b)
+}
+func (m *PriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceRecord.Marshal(b, m, deterministic)
+}
+func (m *PriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRecord.Merge(m, src)
+}
+func (m *PriceRecord) XXX_Size() int {
+	return xxx_messageInfo_PriceRecord.Size(m)
+}
+func (m *PriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRecord proto.InternalMessageInfo
+
+func (m *PriceRecord) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PriceRecord) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *PriceRecord) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *PriceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PriceRecord) GetFeeder() string {
+	if m != nil {
+		return m.Feeder
+	}
+	return ""
+}
+
+func (m *PriceRecord) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *PriceRecord) GetCumulative() string {
+	if m != nil {
+		return m.Cumulative
+	}
+	return ""
+}
+
 // localDB
 type EventRecord struct {
 	EventID              string   `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
@@ -593,7 +993,7 @@ func (m *EventRecord) Reset()         { *m = EventRecord{} }
 func (m *EventRecord) String() string { return proto.CompactTextString(m) }
 func (*EventRecord) ProtoMessage()    {}
 func (*EventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{8}
+	return fileDescriptor_b544994cdab50f02, []int{13}
 }
 
 func (m *EventRecord) XXX_Unmarshal(b []byte) error {
@@ -632,7 +1032,7 @@ func (m *QueryOracleInfos) Reset()         { *m = QueryOracleInfos{} }
 func (m *QueryOracleInfos) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleInfos) ProtoMessage()    {}
 func (*QueryOracleInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{9}
+	return fileDescriptor_b544994cdab50f02, []int{14}
 }
 
 func (m *QueryOracleInfos) XXX_Unmarshal(b []byte) error {
@@ -671,7 +1071,7 @@ func (m *ReplyEventIDs) Reset()         { *m = ReplyEventIDs{} }
 func (m *ReplyEventIDs) String() string { return proto.CompactTextString(m) }
 func (*ReplyEventIDs) ProtoMessage()    {}
 func (*ReplyEventIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{10}
+	return fileDescriptor_b544994cdab50f02, []int{15}
 }
 
 func (m *ReplyEventIDs) XXX_Unmarshal(b []byte) error {
@@ -713,7 +1113,7 @@ func (m *QueryEventID) Reset()         { *m = QueryEventID{} }
 func (m *QueryEventID) String() string { return proto.CompactTextString(m) }
 func (*QueryEventID) ProtoMessage()    {}
 func (*QueryEventID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{11}
+	return fileDescriptor_b544994cdab50f02, []int{16}
 }
 
 func (m *QueryEventID) XXX_Unmarshal(b []byte) error {
@@ -777,7 +1177,7 @@ func (m *ReceiptOracle) Reset()         { *m = ReceiptOracle{} }
 func (m *ReceiptOracle) String() string { return proto.CompactTextString(m) }
 func (*ReceiptOracle) ProtoMessage()    {}
 func (*ReceiptOracle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{12}
+	return fileDescriptor_b544994cdab50f02, []int{17}
 }
 
 func (m *ReceiptOracle) XXX_Unmarshal(b []byte) error {
@@ -833,6 +1233,296 @@ func (m *ReceiptOracle) GetPreStatus() int32 {
 	return 0
 }
 
+type QueryQuorumConfig struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryQuorumConfig) Reset()         { *m = QueryQuorumConfig{} }
+func (m *QueryQuorumConfig) String() string { return proto.CompactTextString(m) }
+func (*QueryQuorumConfig) ProtoMessage()    {}
+func (*QueryQuorumConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{18}
+}
+
+func (m *QueryQuorumConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryQuorumConfig.Unmarshal(m, b)
+}
+func (m *QueryQuorumConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryQuorumConfig.Marshal(b, m, deterministic)
+}
+func (m *QueryQuorumConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuorumConfig.Merge(m, src)
+}
+func (m *QueryQuorumConfig) XXX_Size() int {
+	return xxx_messageInfo_QueryQuorumConfig.Size(m)
+}
+func (m *QueryQuorumConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuorumConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuorumConfig proto.InternalMessageInfo
+
+func (m *QueryQuorumConfig) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type QueryPrice struct {
+	Pair                 string   `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryPrice) Reset()         { *m = QueryPrice{} }
+func (m *QueryPrice) String() string { return proto.CompactTextString(m) }
+func (*QueryPrice) ProtoMessage()    {}
+func (*QueryPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{19}
+}
+
+func (m *QueryPrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPrice.Unmarshal(m, b)
+}
+func (m *QueryPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPrice.Marshal(b, m, deterministic)
+}
+func (m *QueryPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrice.Merge(m, src)
+}
+func (m *QueryPrice) XXX_Size() int {
+	return xxx_messageInfo_QueryPrice.Size(m)
+}
+func (m *QueryPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrice proto.InternalMessageInfo
+
+func (m *QueryPrice) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+type QueryPriceHistory struct {
+	Pair                 string   `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	LastSeq              int64    `protobuf:"varint,2,opt,name=lastSeq,proto3" json:"lastSeq,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryPriceHistory) Reset()         { *m = QueryPriceHistory{} }
+func (m *QueryPriceHistory) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistory) ProtoMessage()    {}
+func (*QueryPriceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{20}
+}
+
+func (m *QueryPriceHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPriceHistory.Unmarshal(m, b)
+}
+func (m *QueryPriceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPriceHistory.Marshal(b, m, deterministic)
+}
+func (m *QueryPriceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistory.Merge(m, src)
+}
+func (m *QueryPriceHistory) XXX_Size() int {
+	return xxx_messageInfo_QueryPriceHistory.Size(m)
+}
+func (m *QueryPriceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHistory proto.InternalMessageInfo
+
+func (m *QueryPriceHistory) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *QueryPriceHistory) GetLastSeq() int64 {
+	if m != nil {
+		return m.LastSeq
+	}
+	return 0
+}
+
+func (m *QueryPriceHistory) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyPriceHistory struct {
+	Records              []*PriceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReplyPriceHistory) Reset()         { *m = ReplyPriceHistory{} }
+func (m *ReplyPriceHistory) String() string { return proto.CompactTextString(m) }
+func (*ReplyPriceHistory) ProtoMessage()    {}
+func (*ReplyPriceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{21}
+}
+
+func (m *ReplyPriceHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyPriceHistory.Unmarshal(m, b)
+}
+func (m *ReplyPriceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyPriceHistory.Marshal(b, m, deterministic)
+}
+func (m *ReplyPriceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyPriceHistory.Merge(m, src)
+}
+func (m *ReplyPriceHistory) XXX_Size() int {
+	return xxx_messageInfo_ReplyPriceHistory.Size(m)
+}
+func (m *ReplyPriceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyPriceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyPriceHistory proto.InternalMessageInfo
+
+func (m *ReplyPriceHistory) GetRecords() []*PriceRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type QueryPriceTWAP struct {
+	Pair                 string   `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartTime            int64    `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryPriceTWAP) Reset()         { *m = QueryPriceTWAP{} }
+func (m *QueryPriceTWAP) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceTWAP) ProtoMessage()    {}
+func (*QueryPriceTWAP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{22}
+}
+
+func (m *QueryPriceTWAP) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPriceTWAP.Unmarshal(m, b)
+}
+func (m *QueryPriceTWAP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPriceTWAP.Marshal(b, m, deterministic)
+}
+func (m *QueryPriceTWAP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceTWAP.Merge(m, src)
+}
+func (m *QueryPriceTWAP) XXX_Size() int {
+	return xxx_messageInfo_QueryPriceTWAP.Size(m)
+}
+func (m *QueryPriceTWAP) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceTWAP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceTWAP proto.InternalMessageInfo
+
+func (m *QueryPriceTWAP) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *QueryPriceTWAP) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryPriceTWAP) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+type ReplyPriceTWAP struct {
+	Pair                 string   `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Price                int64    `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	StartTime            int64    `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyPriceTWAP) Reset()         { *m = ReplyPriceTWAP{} }
+func (m *ReplyPriceTWAP) String() string { return proto.CompactTextString(m) }
+func (*ReplyPriceTWAP) ProtoMessage()    {}
+func (*ReplyPriceTWAP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{23}
+}
+
+func (m *ReplyPriceTWAP) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyPriceTWAP.Unmarshal(m, b)
+}
+func (m *ReplyPriceTWAP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyPriceTWAP.Marshal(b, m, deterministic)
+}
+func (m *ReplyPriceTWAP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyPriceTWAP.Merge(m, src)
+}
+func (m *ReplyPriceTWAP) XXX_Size() int {
+	return xxx_messageInfo_ReplyPriceTWAP.Size(m)
+}
+func (m *ReplyPriceTWAP) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyPriceTWAP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyPriceTWAP proto.InternalMessageInfo
+
+func (m *ReplyPriceTWAP) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *ReplyPriceTWAP) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ReplyPriceTWAP) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ReplyPriceTWAP) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
 type ReplyOracleStatusList struct {
 	Status               []*OracleStatus `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -844,7 +1534,7 @@ func (m *ReplyOracleStatusList) Reset()         { *m = ReplyOracleStatusList{} }
 func (m *ReplyOracleStatusList) String() string { return proto.CompactTextString(m) }
 func (*ReplyOracleStatusList) ProtoMessage()    {}
 func (*ReplyOracleStatusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{13}
+	return fileDescriptor_b544994cdab50f02, []int{24}
 }
 
 func (m *ReplyOracleStatusList) XXX_Unmarshal(b []byte) error {
@@ -881,11 +1571,22 @@ func init() {
 	proto.RegisterType((*ResultPrePublish)(nil), "types.ResultPrePublish")
 	proto.RegisterType((*ResultPublish)(nil), "types.ResultPublish")
 	proto.RegisterType((*ResultAbort)(nil), "types.ResultAbort")
+	proto.RegisterType((*QuorumConfig)(nil), "types.QuorumConfig")
+	proto.RegisterType((*ResultSubmit)(nil), "types.ResultSubmit")
+	proto.RegisterType((*ResultSubmission)(nil), "types.ResultSubmission")
+	proto.RegisterType((*PriceFeed)(nil), "types.PriceFeed")
+	proto.RegisterType((*PriceRecord)(nil), "types.PriceRecord")
 	proto.RegisterType((*EventRecord)(nil), "types.EventRecord")
 	proto.RegisterType((*QueryOracleInfos)(nil), "types.QueryOracleInfos")
 	proto.RegisterType((*ReplyEventIDs)(nil), "types.ReplyEventIDs")
 	proto.RegisterType((*QueryEventID)(nil), "types.QueryEventID")
 	proto.RegisterType((*ReceiptOracle)(nil), "types.ReceiptOracle")
+	proto.RegisterType((*QueryQuorumConfig)(nil), "types.QueryQuorumConfig")
+	proto.RegisterType((*QueryPrice)(nil), "types.QueryPrice")
+	proto.RegisterType((*QueryPriceHistory)(nil), "types.QueryPriceHistory")
+	proto.RegisterType((*ReplyPriceHistory)(nil), "types.ReplyPriceHistory")
+	proto.RegisterType((*QueryPriceTWAP)(nil), "types.QueryPriceTWAP")
+	proto.RegisterType((*ReplyPriceTWAP)(nil), "types.ReplyPriceTWAP")
 	proto.RegisterType((*ReplyOracleStatusList)(nil), "types.ReplyOracleStatusList")
 }
 
@@ -894,42 +1595,69 @@ func init() {
 }
 
 var fileDescriptor_b544994cdab50f02 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x8b, 0xdb, 0x3c,
-	0x10, 0x8e, 0x3f, 0xf7, 0xdd, 0xb1, 0xf7, 0x25, 0x55, 0xbf, 0x74, 0xe8, 0x21, 0xf8, 0xd0, 0x4d,
-	0x3f, 0x08, 0x25, 0x0b, 0x85, 0x42, 0x7b, 0x48, 0xd9, 0x40, 0x16, 0x0a, 0xdd, 0xaa, 0xb9, 0x14,
-	0x7a, 0x49, 0x1c, 0x95, 0x35, 0xa4, 0x96, 0x91, 0xe5, 0x85, 0xfc, 0x82, 0xde, 0xfa, 0xc3, 0x7a,
-	0xee, 0x0f, 0x2a, 0x1a, 0x29, 0x89, 0x9c, 0x4d, 0x02, 0x85, 0xde, 0x34, 0x33, 0x8f, 0x1e, 0xcf,
-	0xc7, 0xa3, 0x31, 0xa4, 0x42, 0xce, 0xf2, 0x25, 0x1f, 0x54, 0x52, 0x28, 0x41, 0x22, 0xb5, 0xaa,
-	0x78, 0x9d, 0xfd, 0xf2, 0x21, 0xfd, 0x88, 0xfe, 0xcf, 0x6a, 0xa6, 0x9a, 0x9a, 0x50, 0x38, 0xe1,
-	0xb7, 0xbc, 0x54, 0x57, 0x97, 0xd4, 0xeb, 0x79, 0xfd, 0x53, 0xb6, 0x36, 0x09, 0x81, 0x70, 0xb6,
-	0x58, 0x48, 0xea, 0xa3, 0x1b, 0xcf, 0xda, 0xa7, 0x79, 0x68, 0x60, 0x7c, 0xfa, 0xac, 0x19, 0xea,
-	0x66, 0x3e, 0xd5, 0xee, 0xd0, 0x30, 0x58, 0x13, 0xd1, 0xc5, 0x77, 0x4e, 0xa3, 0x9e, 0xd7, 0x0f,
-	0x18, 0x9e, 0x35, 0x3a, 0x17, 0xa5, 0xe2, 0xa5, 0xa2, 0xb1, 0x41, 0x5b, 0x93, 0x64, 0x90, 0x16,
-	0xa5, 0x92, 0x62, 0xd1, 0xe4, 0xaa, 0x10, 0x25, 0x3d, 0xc1, 0x70, 0xcb, 0x47, 0x9e, 0x43, 0x5c,
-	0x63, 0xde, 0xf4, 0xbf, 0x9e, 0xd7, 0x4f, 0x86, 0x64, 0x80, 0x65, 0x0d, 0xc6, 0x3a, 0x67, 0x53,
-	0x11, 0xb3, 0x08, 0xf2, 0x08, 0xe2, 0x5a, 0x34, 0x32, 0xe7, 0xf4, 0x14, 0x99, 0xac, 0xa5, 0xfd,
-	0x92, 0xd7, 0xcd, 0x52, 0x51, 0x30, 0x7e, 0x63, 0x91, 0x57, 0x70, 0x5a, 0x49, 0xdb, 0x16, 0x9a,
-	0x1c, 0xa4, 0xdf, 0x82, 0xb2, 0xdf, 0x9b, 0x66, 0x8e, 0x4c, 0x7a, 0x6f, 0x20, 0xc5, 0xee, 0x5d,
-	0x37, 0xf3, 0x65, 0x51, 0xdf, 0x60, 0x47, 0x93, 0xe1, 0x7d, 0x97, 0xc5, 0x86, 0x26, 0x1d, 0xd6,
-	0x82, 0x92, 0x0b, 0x00, 0xb4, 0x47, 0x73, 0x21, 0x15, 0xf6, 0x3c, 0x19, 0xde, 0x73, 0x2f, 0x62,
-	0x60, 0xd2, 0x61, 0x0e, 0x8c, 0x8c, 0xa1, 0x6b, 0x92, 0xbf, 0x96, 0x7c, 0xfd, 0xcd, 0x00, 0xaf,
-	0x3e, 0xb6, 0x57, 0xd9, 0x4e, 0x78, 0xd2, 0x61, 0x77, 0xae, 0x90, 0xb7, 0x70, 0x66, 0x7d, 0x96,
-	0x23, 0x44, 0x8e, 0x07, 0x6d, 0x8e, 0x0d, 0x41, 0x1b, 0x4c, 0x5e, 0x43, 0x62, 0x1c, 0x26, 0xf5,
-	0xa8, 0xd5, 0x39, 0xb6, 0x8d, 0x4c, 0x3a, 0xcc, 0x05, 0x92, 0xff, 0xc1, 0x9f, 0xae, 0x70, 0xca,
-	0x11, 0xf3, 0xa7, 0xab, 0xf7, 0x27, 0x10, 0xdd, 0xce, 0x96, 0x0d, 0xcf, 0xde, 0x41, 0xe2, 0x34,
-	0x5c, 0xcf, 0x4b, 0x54, 0x23, 0xad, 0x44, 0x23, 0x50, 0x6b, 0xe1, 0x7c, 0xcd, 0xb0, 0x7c, 0xe4,
-	0xb0, 0x56, 0xf6, 0xd3, 0x83, 0xd4, 0x6d, 0xf5, 0x46, 0xb4, 0xfe, 0x7e, 0xd1, 0x06, 0xfb, 0x45,
-	0x1b, 0xee, 0x17, 0x6d, 0x74, 0x5c, 0xb4, 0xf1, 0x5d, 0xd1, 0x66, 0x4f, 0x01, 0xb6, 0x13, 0x74,
-	0x1f, 0x9c, 0xdf, 0x7a, 0x70, 0xd9, 0x57, 0xe8, 0xee, 0x8e, 0xeb, 0x30, 0xda, 0x91, 0x77, 0x70,
-	0x40, 0xde, 0xa1, 0x2b, 0xef, 0xec, 0x0b, 0x9c, 0xb5, 0x06, 0xf9, 0x0f, 0xa9, 0xcf, 0x21, 0x71,
-	0xe6, 0x7c, 0xa4, 0xc2, 0x73, 0x3b, 0x59, 0xc6, 0x73, 0x21, 0x17, 0x87, 0x77, 0x4f, 0xf6, 0x12,
-	0xba, 0x9f, 0x1a, 0x2e, 0x57, 0xe6, 0x75, 0x5d, 0x95, 0xdf, 0xc4, 0xce, 0xa6, 0x0a, 0x5c, 0xf4,
-	0x33, 0x5d, 0x5a, 0xb5, 0x5c, 0x8d, 0x8d, 0x7d, 0x0c, 0x7a, 0x03, 0x29, 0x12, 0x8f, 0x9d, 0x52,
-	0x8d, 0x88, 0x3c, 0x57, 0x44, 0x7f, 0xb3, 0xfc, 0xd6, 0x5f, 0x0a, 0xdb, 0x25, 0xfc, 0xf0, 0x74,
-	0x56, 0x39, 0x2f, 0x2a, 0x65, 0xaa, 0x38, 0xb2, 0x6a, 0x0f, 0x48, 0x79, 0x93, 0x45, 0xb0, 0x27,
-	0x8b, 0xd0, 0xc9, 0xe2, 0x89, 0xbb, 0xba, 0x62, 0xa4, 0x70, 0xd6, 0xd4, 0x25, 0x3c, 0xc4, 0xf6,
-	0xb8, 0x7b, 0xff, 0x43, 0x51, 0x2b, 0xf2, 0xc2, 0x29, 0x3e, 0x70, 0x16, 0x95, 0x0b, 0x5c, 0xe7,
-	0x32, 0x8f, 0xf1, 0x3f, 0x72, 0xf1, 0x27, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xd5, 0xe6, 0xbc, 0x57,
-	0x06, 0x00, 0x00,
+	// 1018 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xc1, 0x6e, 0xe3, 0x36,
+	0x13, 0xb6, 0x2c, 0xcb, 0x8e, 0x47, 0x4e, 0xe0, 0xf0, 0xdf, 0x7f, 0xab, 0x43, 0x50, 0x18, 0x3a,
+	0x74, 0xdd, 0x76, 0x11, 0x14, 0x09, 0x50, 0x60, 0x81, 0xf6, 0xe0, 0x36, 0x29, 0xbc, 0x40, 0x8b,
+	0x7a, 0x19, 0x03, 0xdb, 0x02, 0x7b, 0x91, 0x6d, 0x66, 0x2d, 0xc0, 0x16, 0x1d, 0x92, 0x32, 0xea,
+	0x27, 0xe8, 0xad, 0xe7, 0xbe, 0x44, 0x9f, 0xab, 0x2f, 0xd1, 0x43, 0xc1, 0x21, 0x25, 0x51, 0x89,
+	0xed, 0x45, 0x81, 0xbd, 0x71, 0x86, 0x1f, 0x87, 0x43, 0xce, 0xf7, 0x0d, 0x09, 0x3d, 0x2e, 0x92,
+	0xf9, 0x8a, 0x5d, 0x6e, 0x04, 0x57, 0x9c, 0x04, 0x6a, 0xb7, 0x61, 0x32, 0xfe, 0xdb, 0x87, 0xde,
+	0xcf, 0xe8, 0xbf, 0x53, 0x89, 0xca, 0x25, 0x89, 0xa0, 0xc3, 0xb6, 0x2c, 0x53, 0xaf, 0x6f, 0x22,
+	0x6f, 0xe0, 0x0d, 0xbb, 0xb4, 0x30, 0x09, 0x81, 0x56, 0xb2, 0x58, 0x88, 0xa8, 0x89, 0x6e, 0x1c,
+	0x6b, 0x9f, 0x8e, 0x13, 0xf9, 0xc6, 0xa7, 0xc7, 0x3a, 0x82, 0xcc, 0x67, 0x53, 0xed, 0x6e, 0x99,
+	0x08, 0xd6, 0x44, 0x74, 0xba, 0x66, 0x51, 0x30, 0xf0, 0x86, 0x3e, 0xc5, 0xb1, 0x46, 0xcf, 0x79,
+	0xa6, 0x58, 0xa6, 0xa2, 0xb6, 0x41, 0x5b, 0x93, 0xc4, 0xd0, 0x4b, 0x33, 0x25, 0xf8, 0x22, 0x9f,
+	0xab, 0x94, 0x67, 0x51, 0x07, 0xa7, 0x6b, 0x3e, 0xf2, 0x05, 0xb4, 0x25, 0xe6, 0x1d, 0x9d, 0x0c,
+	0xbc, 0x61, 0x78, 0x45, 0x2e, 0xf1, 0x58, 0x97, 0xb7, 0x3a, 0x67, 0x73, 0x22, 0x6a, 0x11, 0xe4,
+	0x39, 0xb4, 0x25, 0xcf, 0xc5, 0x9c, 0x45, 0x5d, 0x8c, 0x64, 0x2d, 0xed, 0x17, 0x4c, 0xe6, 0x2b,
+	0x15, 0x81, 0xf1, 0x1b, 0x8b, 0x7c, 0x05, 0xdd, 0x8d, 0xb0, 0xd7, 0x12, 0x85, 0x07, 0xc3, 0x57,
+	0x20, 0xf2, 0x25, 0xb4, 0x1f, 0x72, 0x2e, 0xf2, 0x75, 0xd4, 0x43, 0xf8, 0xff, 0x2c, 0xfc, 0x0d,
+	0x3a, 0xbf, 0xe7, 0xd9, 0x7d, 0xfa, 0x9e, 0x5a, 0x08, 0x79, 0x05, 0xa1, 0xcc, 0x67, 0xeb, 0x54,
+	0xca, 0x94, 0x67, 0x32, 0x3a, 0x1d, 0xf8, 0xc3, 0xf0, 0xea, 0x13, 0xbb, 0x82, 0x62, 0x0a, 0x77,
+	0xe5, 0x3c, 0x75, 0xb1, 0xe4, 0x1a, 0x4e, 0x16, 0xa9, 0xdc, 0xe4, 0x8a, 0xc9, 0xe8, 0xec, 0xf8,
+	0xba, 0x12, 0x18, 0xff, 0x53, 0x56, 0x7a, 0x64, 0xee, 0xee, 0x15, 0xf4, 0xb0, 0xb4, 0x93, 0x7c,
+	0xb6, 0x4a, 0xe5, 0x32, 0xf2, 0x6a, 0x39, 0xdf, 0x3a, 0x53, 0xe3, 0x06, 0xad, 0x41, 0xc9, 0x35,
+	0x00, 0xda, 0xa3, 0x19, 0x17, 0x0a, 0x09, 0x11, 0x5e, 0x9d, 0xbb, 0x0b, 0x71, 0x62, 0xdc, 0xa0,
+	0x0e, 0x8c, 0xdc, 0x42, 0xdf, 0xdc, 0xec, 0x44, 0xb0, 0x62, 0x4f, 0x7f, 0xe0, 0x3d, 0xc9, 0xbe,
+	0x9a, 0x1e, 0x37, 0xe8, 0x93, 0x25, 0xe4, 0x1b, 0x38, 0xb5, 0x3e, 0x1b, 0xa3, 0x85, 0x31, 0x9e,
+	0xd5, 0x63, 0x94, 0x01, 0xea, 0x60, 0xf2, 0x35, 0x84, 0xc6, 0x61, 0x52, 0x0f, 0x6a, 0x65, 0xa5,
+	0xd5, 0xcc, 0xb8, 0x41, 0x5d, 0xa0, 0xbe, 0xac, 0x07, 0xa7, 0x8a, 0xd1, 0x49, 0xed, 0xb2, 0xdc,
+	0x02, 0xeb, 0xcb, 0x72, 0xa1, 0x7a, 0xa9, 0xa8, 0xca, 0xa2, 0xa2, 0x6e, 0x6d, 0xa9, 0x53, 0x31,
+	0xbd, 0x69, 0x0d, 0x6a, 0x28, 0x98, 0xce, 0xd9, 0x0f, 0x8c, 0x2d, 0x90, 0x9d, 0xe1, 0x55, 0xdf,
+	0xae, 0x9b, 0x14, 0xfe, 0x71, 0x83, 0x56, 0x20, 0x72, 0x06, 0xcd, 0xe9, 0x0e, 0xa5, 0x12, 0xd0,
+	0xe6, 0x74, 0xf7, 0x5d, 0x07, 0x82, 0x6d, 0xb2, 0xca, 0x59, 0xfc, 0x2d, 0x84, 0x0e, 0x6b, 0x35,
+	0xe9, 0xf9, 0x66, 0xa4, 0xe5, 0x6c, 0x54, 0x6e, 0x2d, 0xed, 0xb7, 0x82, 0x6a, 0x62, 0x0c, 0x6b,
+	0xc5, 0x7f, 0x78, 0xd0, 0x73, 0x29, 0x51, 0x2a, 0xbf, 0xb9, 0x5f, 0xf9, 0xfe, 0x7e, 0xe5, 0xb7,
+	0xf6, 0x2b, 0x3f, 0x38, 0xae, 0xfc, 0xf6, 0x53, 0xe5, 0xc7, 0x9f, 0x01, 0x54, 0x4c, 0x73, 0xbb,
+	0x56, 0xb3, 0xd6, 0xb5, 0xe2, 0x77, 0xd0, 0x7f, 0x4c, 0xab, 0xc3, 0x68, 0xa7, 0x47, 0xf8, 0x07,
+	0x7a, 0x44, 0xcb, 0xed, 0x11, 0xf1, 0xaf, 0x70, 0x5a, 0x23, 0xdc, 0x47, 0x0c, 0xfd, 0x02, 0x42,
+	0x87, 0x8f, 0x47, 0x4e, 0xf8, 0xa7, 0x07, 0x3d, 0x97, 0x80, 0x65, 0x69, 0x3c, 0xa7, 0x34, 0x9f,
+	0x02, 0x6c, 0x4c, 0x8a, 0x4c, 0xe8, 0xda, 0xfa, 0xc3, 0x2e, 0x75, 0x3c, 0xe4, 0x02, 0xba, 0x6a,
+	0x29, 0x98, 0x5c, 0xf2, 0xd5, 0x02, 0x13, 0x0c, 0x68, 0xe5, 0xd0, 0x11, 0xd7, 0x7c, 0x61, 0xca,
+	0x17, 0x50, 0x1c, 0xeb, 0x22, 0xad, 0x93, 0xdf, 0x6e, 0xd8, 0x36, 0x4d, 0xb0, 0x48, 0x01, 0xce,
+	0xd5, 0x7c, 0xf1, 0x2f, 0xd0, 0x73, 0xf9, 0xfd, 0x11, 0x6f, 0x27, 0x83, 0xbe, 0x13, 0x19, 0x7b,
+	0x5d, 0xf9, 0x40, 0x79, 0xce, 0x03, 0x55, 0xc5, 0x6d, 0x1e, 0x88, 0xeb, 0xd7, 0x9a, 0xfe, 0x73,
+	0x68, 0x2f, 0x59, 0xfa, 0x7e, 0xa9, 0x2c, 0x55, 0xad, 0x15, 0xff, 0x04, 0xdd, 0x52, 0x71, 0x7a,
+	0xa3, 0x4d, 0x92, 0x96, 0x1b, 0xe9, 0x31, 0x79, 0x06, 0x01, 0xaa, 0x10, 0xef, 0xd6, 0xa7, 0xc6,
+	0xd0, 0xe1, 0xb6, 0x7c, 0x95, 0xaf, 0xf5, 0xb1, 0xb4, 0xdb, 0x5a, 0xf1, 0x5f, 0x1e, 0x84, 0x18,
+	0x8f, 0xb2, 0x39, 0x17, 0x1f, 0x8c, 0xe8, 0x55, 0x11, 0x0b, 0x25, 0xf9, 0x8e, 0x92, 0x0e, 0x24,
+	0xad, 0xfd, 0xf7, 0x8c, 0x2d, 0x98, 0xb0, 0x02, 0xb3, 0x16, 0xe9, 0x83, 0x2f, 0xd9, 0x03, 0xca,
+	0xca, 0xa7, 0x7a, 0xa8, 0xe9, 0x31, 0xcf, 0xd7, 0xf9, 0x2a, 0x51, 0xe9, 0x96, 0xd9, 0x97, 0xd6,
+	0xf1, 0x68, 0x32, 0xa2, 0xda, 0x6c, 0xba, 0x07, 0x3f, 0x09, 0xf1, 0x4b, 0xe8, 0xbf, 0xc9, 0x99,
+	0xd8, 0x99, 0x97, 0xe6, 0x75, 0x76, 0xcf, 0x1f, 0x7d, 0x29, 0x7c, 0x17, 0xfd, 0xb9, 0x96, 0xcf,
+	0x66, 0xb5, 0xbb, 0x35, 0xf6, 0x31, 0xe8, 0x52, 0x93, 0x9c, 0x89, 0x02, 0xea, 0x34, 0x2a, 0xcf,
+	0x6d, 0x54, 0xff, 0xe5, 0x97, 0x52, 0xec, 0xd4, 0xaa, 0x1f, 0xe1, 0x77, 0x4f, 0x67, 0x35, 0x67,
+	0xe9, 0x46, 0x99, 0x53, 0x1c, 0xf9, 0x13, 0x1d, 0x68, 0x97, 0x65, 0x16, 0xfe, 0x9e, 0x2c, 0x5a,
+	0x4e, 0x16, 0x17, 0xee, 0x1f, 0xa3, 0x6d, 0x64, 0x57, 0x3a, 0xe2, 0x17, 0x70, 0x8e, 0x67, 0xfe,
+	0x90, 0xba, 0xe3, 0x01, 0x00, 0x02, 0x27, 0x05, 0x45, 0x1e, 0x93, 0x29, 0x7e, 0x0b, 0xe7, 0x15,
+	0x62, 0x9c, 0x4a, 0xc5, 0xc5, 0x6e, 0x2f, 0xeb, 0x22, 0xe8, 0xac, 0x12, 0xa9, 0xee, 0xd8, 0x83,
+	0xe5, 0x5d, 0x61, 0x6a, 0x3e, 0xce, 0x79, 0x9e, 0x29, 0xdb, 0x1e, 0x8c, 0x11, 0x8f, 0xe0, 0x1c,
+	0x4b, 0x58, 0x0b, 0xfc, 0x12, 0x3a, 0x02, 0x99, 0x22, 0xb1, 0x8c, 0xd5, 0x0b, 0xeb, 0x70, 0x9e,
+	0x16, 0x90, 0xf8, 0x1d, 0x9c, 0x55, 0xb9, 0x4d, 0xdf, 0x8e, 0x26, 0x7b, 0x13, 0xbb, 0x80, 0xae,
+	0x54, 0x89, 0x50, 0x53, 0xcd, 0x7e, 0x93, 0x5a, 0xe5, 0xc0, 0x12, 0x65, 0x8b, 0x69, 0xa5, 0x8c,
+	0xc2, 0x8c, 0x05, 0x9c, 0x55, 0x09, 0x1e, 0x8c, 0xbe, 0x5f, 0x6c, 0xb5, 0x3d, 0xfd, 0x23, 0x7b,
+	0xb6, 0xea, 0x7b, 0xde, 0xc0, 0xff, 0x71, 0x4f, 0xf7, 0x67, 0xfd, 0x63, 0x2a, 0x95, 0xfe, 0x21,
+	0x96, 0xac, 0xf5, 0x9d, 0x5f, 0x80, 0x0b, 0x2c, 0x48, 0x34, 0x6b, 0xe3, 0x4f, 0xfd, 0xfa, 0xdf,
+	0x01, 0x00, 0xcc, 0x33, 0x02, 0x02, 0xb9, 0x0b, 0x00, 0x00,
 }
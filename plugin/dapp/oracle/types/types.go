@@ -21,6 +21,8 @@ func init() {
 
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(OracleX, "Enable", 0)
+	cfg.RegisterDappFork(OracleX, ForkOracleQuorumX, 4600000)
+	cfg.RegisterDappFork(OracleX, ForkOraclePriceFeedX, 4600000)
 }
 
 func InitExecutor(cfg *types.Chain33Config) {
@@ -58,6 +60,9 @@ func (o *OracleType) GetTypeMap() map[string]int32 {
 		"ResultPrePublish": ActionResultPrePublish,
 		"ResultAbort":      ActionResultAbort,
 		"ResultPublish":    ActionResultPublish,
+		"QuorumConfig":     ActionQuorumConfig,
+		"ResultSubmit":     ActionResultSubmit,
+		"PriceFeed":        ActionPriceFeed,
 	}
 }
 
@@ -69,5 +74,9 @@ func (o *OracleType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogResultPrePublish: {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultPrePublish"},
 		TyLogResultAbort:      {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultAbort"},
 		TyLogResultPublish:    {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultPublish"},
+		TyLogQuorumConfig:     {Ty: reflect.TypeOf(QuorumConfig{}), Name: "LogQuorumConfig"},
+		TyLogResultSubmit:     {Ty: reflect.TypeOf(ResultSubmission{}), Name: "LogResultSubmit"},
+		TyLogResultDispute:    {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultDispute"},
+		TyLogPriceFeed:        {Ty: reflect.TypeOf(PriceRecord{}), Name: "LogPriceFeed"},
 	}
 }
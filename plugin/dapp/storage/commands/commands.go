@@ -2,6 +2,20 @@
 package commands
 
 import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	scrypto "github.com/33cn/plugin/plugin/dapp/storage/crypto"
+	storagetypes "github.com/33cn/plugin/plugin/dapp/storage/types"
 	"github.com/spf13/cobra"
 )
 
@@ -17,7 +31,344 @@ func Cmd() *cobra.Command {
 		Args:  cobra.MinimumNArgs(1),
 	}
 	cmd.AddCommand(
-	//add sub command
+		//add sub command
+		createEncryptShareCmd(),
+		createShareCmd(),
+		decryptShareCmd(),
+		listStorageCmd(),
+		verifyContentHashCmd(),
 	)
 	return cmd
 }
+
+func createEncryptShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypt",
+		Short: "create encrypt share storage tx, content key is sealed to every recipient",
+		Run:   createEncryptShare,
+	}
+	cmd.Flags().StringP("content", "c", "", "content to be encrypted")
+	cmd.Flags().StringP("pubkeys", "p", "", "recipient public keys in hex, separated by ',', include your own to share it later")
+	cmd.Flags().Int32P("keytype", "t", scrypto.KeyTypeSecp256k1, "recipient public key type, 1:secp256k1 3:sm2")
+	cmd.Flags().StringP("cipher", "a", scrypto.CipherAESGCM, "symmetric cipher, aes-gcm or sm4-gcm")
+	cmd.Flags().StringP("key", "k", "", "storage key, default is tx hash")
+	cmd.Flags().StringP("value", "v", "", "storage value")
+	cmd.MarkFlagRequired("content")
+	cmd.MarkFlagRequired("pubkeys")
+	return cmd
+}
+
+func createEncryptShare(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	content, _ := cmd.Flags().GetString("content")
+	pubKeys, _ := cmd.Flags().GetString("pubkeys")
+	keyType, _ := cmd.Flags().GetInt32("keytype")
+	cipherName, _ := cmd.Flags().GetString("cipher")
+	key, _ := cmd.Flags().GetString("key")
+	value, _ := cmd.Flags().GetString("value")
+
+	keySize := scrypto.CipherKeySize(cipherName)
+	if keySize == 0 {
+		fmt.Fprintln(os.Stderr, scrypto.ErrCipher)
+		return
+	}
+	contentKey := make([]byte, keySize)
+	nonce := make([]byte, scrypto.GCMNonceSize)
+	if _, err := rand.Read(contentKey); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	c, _ := scrypto.NewCipher(cipherName, contentKey, nonce)
+	encryptContent, err := c.Encrypt([]byte(content))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	envelopes, err := sealEnvelopes(keyType, pubKeys, contentKey)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	req := storagetypes.EncryptShareNotaryStorage{
+		ContentHash:    common.Sha256([]byte(content)),
+		EncryptContent: encryptContent,
+		Key:            key,
+		Value:          value,
+		Cipher:         cipherName,
+		Nonce:          nonce,
+		Envelopes:      envelopes,
+		Op:             storagetypes.OpCreate,
+	}
+	chain33Req := rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(storagetypes.StorageX),
+		ActionName: storagetypes.NameEncryptShareStorageAction,
+		Payload:    types.MustPBToJSON(&req),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", chain33Req, &res)
+	ctx.RunWithoutMarshal()
+}
+
+func createShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share",
+		Short: "create tx to add recipients to encrypt share storage, only the creator can do it",
+		Run:   createShare,
+	}
+	addPrivKeyFlags(cmd)
+	cmd.Flags().StringP("pubkeys", "p", "", "new recipient public keys in hex, separated by ','")
+	cmd.Flags().Int32P("keytype", "t", scrypto.KeyTypeSecp256k1, "new recipient public key type, 1:secp256k1 3:sm2")
+	cmd.MarkFlagRequired("pubkeys")
+	return cmd
+}
+
+func createShare(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pubKeys, _ := cmd.Flags().GetString("pubkeys")
+	keyType, _ := cmd.Flags().GetInt32("keytype")
+
+	share, contentKey, err := openShareStorage(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	envelopes, err := sealEnvelopes(keyType, pubKeys, contentKey)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	req := storagetypes.EncryptShareNotaryStorage{
+		Key:       share.Key,
+		Envelopes: envelopes,
+		Op:        storagetypes.OpAdd,
+	}
+	chain33Req := rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(storagetypes.StorageX),
+		ActionName: storagetypes.NameEncryptShareStorageAction,
+		Payload:    types.MustPBToJSON(&req),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", chain33Req, &res)
+	ctx.RunWithoutMarshal()
+}
+
+func decryptShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrypt",
+		Short: "decrypt encrypt share storage content with recipient private key",
+		Run:   decryptShare,
+	}
+	addPrivKeyFlags(cmd)
+	return cmd
+}
+
+func decryptShare(cmd *cobra.Command, args []string) {
+	share, contentKey, err := openShareStorage(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	c, err := scrypto.NewCipher(share.Cipher, contentKey, share.Nonce)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	content, err := c.Decrypt(share.EncryptContent)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if len(share.ContentHash) != 0 && !bytes.Equal(common.Sha256(content), share.ContentHash) {
+		fmt.Fprintln(os.Stderr, "content hash mismatch")
+		return
+	}
+	fmt.Println(string(content))
+}
+
+//私钥不通过命令行参数传入，避免出现在shell历史和进程列表中
+func addPrivKeyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("key", "k", "", "storage key")
+	cmd.Flags().StringP("addr", "a", "", "recipient address in the wallet, private key is read from the unlocked wallet")
+	cmd.Flags().StringP("keyfile", "f", "", "file containing the recipient private key in hex")
+	cmd.Flags().Int32P("privtype", "y", scrypto.KeyTypeSecp256k1, "recipient private key type, 1:secp256k1 3:sm2")
+	cmd.MarkFlagRequired("key")
+}
+
+//从钱包或者私钥文件中读取接收者私钥
+func readPrivKey(cmd *cobra.Command, rpc *jsonclient.JSONClient) ([]byte, error) {
+	addr, _ := cmd.Flags().GetString("addr")
+	keyFile, _ := cmd.Flags().GetString("keyfile")
+	var privKey string
+	switch {
+	case addr != "" && keyFile != "":
+		return nil, fmt.Errorf("only one of addr and keyfile can be set")
+	case addr != "":
+		var res types.ReplyString
+		err := rpc.Call("Chain33.DumpPrivkey", types.ReqString{Data: addr}, &res)
+		if err != nil {
+			return nil, err
+		}
+		privKey = res.Data
+	case keyFile != "":
+		data, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		privKey = strings.TrimSpace(string(data))
+	default:
+		return nil, fmt.Errorf("addr or keyfile is required")
+	}
+	return common.FromHex(privKey)
+}
+
+//用逗号分隔的接收者公钥分别加密内容密钥
+func sealEnvelopes(keyType int32, pubKeys string, contentKey []byte) ([]*storagetypes.KeyEnvelope, error) {
+	var envelopes []*storagetypes.KeyEnvelope
+	for _, pubKey := range strings.Split(pubKeys, ",") {
+		pub, err := common.FromHex(strings.TrimSpace(pubKey))
+		if err != nil {
+			return nil, err
+		}
+		sealed, err := scrypto.SealKey(keyType, pub, contentKey)
+		if err != nil {
+			return nil, err
+		}
+		envelopes = append(envelopes, &storagetypes.KeyEnvelope{PubKey: pub, KeyType: keyType, EncryptedKey: sealed})
+	}
+	return envelopes, nil
+}
+
+//查询隐私分享存证，并用私钥解开对应公钥的信封得到内容密钥
+func openShareStorage(cmd *cobra.Command) (*storagetypes.EncryptShareNotaryStorage, []byte, error) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	key, _ := cmd.Flags().GetString("key")
+	keyType, _ := cmd.Flags().GetInt32("privtype")
+
+	driver, err := crypto.New(crypto.GetName(int(keyType)))
+	if err != nil {
+		return nil, nil, err
+	}
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	privBytes, err := readPrivKey(cmd, rpc)
+	if err != nil {
+		return nil, nil, err
+	}
+	priv, err := driver.PrivKeyFromBytes(privBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey := priv.PubKey().Bytes()
+
+	params := rpctypes.Query4Jrpc{
+		Execer:   cfg.ExecName(storagetypes.StorageX),
+		FuncName: storagetypes.FuncNameQueryStorage,
+		Payload:  types.MustPBToJSON(&storagetypes.QueryStorage{TxHash: key}),
+	}
+	var res storagetypes.Storage
+	err = rpc.Call("Chain33.Query", params, &res)
+	if err != nil {
+		return nil, nil, err
+	}
+	share := res.GetEncryptShareStorage()
+	if share == nil {
+		return nil, nil, storagetypes.ErrStorageType
+	}
+	for _, envelope := range share.Envelopes {
+		if envelope.KeyType == keyType && bytes.Equal(envelope.PubKey, pubKey) {
+			contentKey, err := scrypto.OpenKey(keyType, privBytes, envelope.EncryptedKey)
+			return share, contentKey, err
+		}
+	}
+	return nil, nil, fmt.Errorf("no key envelope for public key %s", common.ToHex(pubKey))
+}
+
+func listStorageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list storage records by key, content hash or sender address",
+		Run:   listStorage,
+	}
+	cmd.Flags().StringP("key", "k", "", "storage key")
+	cmd.Flags().StringP("hash", "c", "", "content hash in hex")
+	cmd.Flags().StringP("sender", "a", "", "sender address")
+	cmd.Flags().StringP("primary", "p", "", "primary of the last record in previous page")
+	cmd.Flags().Int32P("count", "n", 10, "record count, max 100")
+	cmd.Flags().Int32P("direction", "d", 0, "query direction, 0:desc 1:asc")
+	return cmd
+}
+
+func listStorage(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	key, _ := cmd.Flags().GetString("key")
+	hash, _ := cmd.Flags().GetString("hash")
+	sender, _ := cmd.Flags().GetString("sender")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+
+	req := &storagetypes.ReqListStorage{Key: key, ContentHash: hash, Sender: sender, Primary: primary, Count: count, Direction: direction}
+	var funcName string
+	switch {
+	case key != "":
+		funcName = storagetypes.FuncNameListStorageByKey
+	case hash != "":
+		funcName = storagetypes.FuncNameListStorageByContentHash
+	case sender != "":
+		funcName = storagetypes.FuncNameListStorageBySender
+	default:
+		fmt.Fprintln(os.Stderr, "one of key, hash or sender is required")
+		return
+	}
+	params := rpctypes.Query4Jrpc{
+		Execer:   cfg.ExecName(storagetypes.StorageX),
+		FuncName: funcName,
+		Payload:  types.MustPBToJSON(req),
+	}
+	var res storagetypes.ReplyListStorage
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+func verifyContentHashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "get the first storage record of the content hash with block height and tx proof",
+		Run:   verifyContentHash,
+	}
+	cmd.Flags().StringP("hash", "c", "", "content hash in hex")
+	cmd.MarkFlagRequired("hash")
+	return cmd
+}
+
+func verifyContentHash(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, _ := cmd.Flags().GetString("hash")
+
+	params := rpctypes.Query4Jrpc{
+		Execer:   cfg.ExecName(storagetypes.StorageX),
+		FuncName: storagetypes.FuncNameVerifyContentHash,
+		Payload:  types.MustPBToJSON(&storagetypes.ReqVerifyContentHash{ContentHash: hash}),
+	}
+	var res storagetypes.ReplyVerifyContentHash
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
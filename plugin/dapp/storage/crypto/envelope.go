@@ -0,0 +1,110 @@
+package crypto
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/tjfoc/gmsm/sm2"
+)
+
+/*
+ * 内容密钥信封
+ * 存证内容用随机生成的内容密钥对称加密，内容密钥再分别用每个接收者的公钥加密，
+ * 接收者用自己的私钥解开信封得到内容密钥后解密存证内容
+ * secp256k1公钥使用ECIES(ECDH + AES-256-CBC + HMAC-SHA256)加密，sm2公钥使用sm2标准公钥加密
+ */
+
+//公钥类型，与chain33签名类型的id保持一致
+const (
+	KeyTypeSecp256k1 = int32(1)
+	KeyTypeSM2       = int32(3)
+)
+
+//ErrKeyType 不支持的公钥类型
+var ErrKeyType = errors.New("ErrKeyType")
+
+//ValidKeyType 是否支持的公钥类型
+func ValidKeyType(keyType int32) bool {
+	return keyType == KeyTypeSecp256k1 || keyType == KeyTypeSM2
+}
+
+//SealKey 用接收者公钥加密内容密钥，公钥支持压缩和非压缩格式
+func SealKey(keyType int32, pubKey, key []byte) ([]byte, error) {
+	switch keyType {
+	case KeyTypeSecp256k1:
+		pub, err := btcec.ParsePubKey(pubKey, btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+		return btcec.Encrypt(pub, key)
+	case KeyTypeSM2:
+		pub, err := parseSM2PubKey(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		return sm2.Encrypt(pub, key)
+	default:
+		return nil, ErrKeyType
+	}
+}
+
+//OpenKey 用接收者私钥解开信封得到内容密钥
+func OpenKey(keyType int32, privKey, sealed []byte) ([]byte, error) {
+	switch keyType {
+	case KeyTypeSecp256k1:
+		priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), privKey)
+		return btcec.Decrypt(priv, sealed)
+	case KeyTypeSM2:
+		//sm2密文由1字节前缀、64字节临时公钥、32字节哈希以及密文构成
+		if len(sealed) <= 97 {
+			return nil, errors.New("invalid sm2 ciphertext")
+		}
+		curve := sm2.P256Sm2()
+		priv := &sm2.PrivateKey{D: new(big.Int).SetBytes(privKey)}
+		priv.Curve = curve
+		priv.X, priv.Y = curve.ScalarBaseMult(privKey)
+		return sm2.Decrypt(priv, sealed)
+	default:
+		return nil, ErrKeyType
+	}
+}
+
+func parseSM2PubKey(pubKey []byte) (*sm2.PublicKey, error) {
+	switch len(pubKey) {
+	case 33:
+		//压缩公钥通过曲线方程 y^2 = x^3 - 3x + b 恢复y坐标，前缀2表示y为偶数，3表示y为奇数
+		if pubKey[0] != 0x02 && pubKey[0] != 0x03 {
+			return nil, errors.New("invalid sm2 public key")
+		}
+		params := sm2.P256Sm2().Params()
+		x := new(big.Int).SetBytes(pubKey[1:])
+		y2 := new(big.Int).Exp(x, big.NewInt(3), params.P)
+		y2.Sub(y2, new(big.Int).Mul(x, big.NewInt(3)))
+		y2.Add(y2, params.B)
+		y2.Mod(y2, params.P)
+		y := new(big.Int).ModSqrt(y2, params.P)
+		if y == nil {
+			return nil, errors.New("invalid sm2 public key")
+		}
+		if y.Bit(0) != uint(pubKey[0]-2) {
+			y.Sub(params.P, y)
+		}
+		return newSM2PubKey(x, y)
+	case 65:
+		if pubKey[0] != 0x04 {
+			return nil, errors.New("invalid sm2 public key")
+		}
+		return newSM2PubKey(new(big.Int).SetBytes(pubKey[1:33]), new(big.Int).SetBytes(pubKey[33:]))
+	default:
+		return nil, errors.New("invalid sm2 public key")
+	}
+}
+
+func newSM2PubKey(x, y *big.Int) (*sm2.PublicKey, error) {
+	curve := sm2.P256Sm2()
+	if !curve.IsOnCurve(x, y) {
+		return nil, errors.New("invalid sm2 public key")
+	}
+	return &sm2.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
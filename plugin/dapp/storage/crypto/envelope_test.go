@@ -0,0 +1,54 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"github.com/tjfoc/gmsm/sm2"
+)
+
+//内容密钥信封测试，支持压缩和非压缩公钥
+func TestEnvelope(t *testing.T) {
+	contentKey := keys[2]
+
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+	for _, pub := range [][]byte{priv.PubKey().SerializeCompressed(), priv.PubKey().SerializeUncompressed()} {
+		sealed, err := SealKey(KeyTypeSecp256k1, pub, contentKey)
+		assert.Nil(t, err)
+		key, err := OpenKey(KeyTypeSecp256k1, priv.Serialize(), sealed)
+		assert.Nil(t, err)
+		assert.Equal(t, contentKey, key)
+	}
+
+	sm2Priv, err := sm2.GenerateKey()
+	assert.Nil(t, err)
+	compressed := sm2.Compress(&sm2Priv.PublicKey)
+	uncompressed := append([]byte{0x04}, append(paddedBytes(sm2Priv.X.Bytes()), paddedBytes(sm2Priv.Y.Bytes())...)...)
+	for _, pub := range [][]byte{compressed, uncompressed} {
+		sealed, err := SealKey(KeyTypeSM2, pub, contentKey)
+		assert.Nil(t, err)
+		key, err := OpenKey(KeyTypeSM2, paddedBytes(sm2Priv.D.Bytes()), sealed)
+		assert.Nil(t, err)
+		assert.Equal(t, contentKey, key)
+	}
+
+	//错误的私钥不能解开信封
+	sealed, err := SealKey(KeyTypeSecp256k1, priv.PubKey().SerializeCompressed(), contentKey)
+	assert.Nil(t, err)
+	other, _ := btcec.NewPrivateKey(btcec.S256())
+	_, err = OpenKey(KeyTypeSecp256k1, other.Serialize(), sealed)
+	assert.NotNil(t, err)
+
+	_, err = SealKey(2, compressed, contentKey)
+	assert.Equal(t, ErrKeyType, err)
+	_, err = SealKey(KeyTypeSM2, compressed[1:], contentKey)
+	assert.NotNil(t, err)
+}
+
+func paddedBytes(b []byte) []byte {
+	padded := make([]byte, 32)
+	copy(padded[32-len(b):], b)
+	return padded
+}
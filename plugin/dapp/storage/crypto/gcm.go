@@ -0,0 +1,93 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+
+	"github.com/tjfoc/gmsm/sm4"
+)
+
+//对称加密算法名称，保存在存证中用于解密时选择算法
+const (
+	CipherAESGCM = "aes-gcm"
+	CipherSM4GCM = "sm4-gcm"
+)
+
+//GCMNonceSize GCM模式的nonce长度固定12字节
+const GCMNonceSize = 12
+
+//ErrCipher 不支持的加密算法
+var ErrCipher = errors.New("ErrCipher")
+
+//GCM 带认证的对称加密，密文末尾附带16字节的认证标签，内容被篡改时解密失败
+type GCM struct {
+	newCipher func(key []byte) (cipher.Block, error)
+	key       []byte
+	nonce     []byte
+}
+
+//NewAESGCM AES 密钥长度为 16,24,32 字节，三种
+func NewAESGCM(key, nonce []byte) *GCM {
+	return &GCM{newCipher: aes.NewCipher, key: key, nonce: nonce}
+}
+
+//NewSM4GCM SM4 密钥长度固定16字节
+func NewSM4GCM(key, nonce []byte) *GCM {
+	return &GCM{newCipher: sm4.NewCipher, key: key, nonce: nonce}
+}
+
+//NewCipher 根据算法名称创建对称加密实例
+func NewCipher(name string, key, nonce []byte) (Crypto, error) {
+	switch name {
+	case CipherAESGCM:
+		return NewAESGCM(key, nonce), nil
+	case CipherSM4GCM:
+		return NewSM4GCM(key, nonce), nil
+	default:
+		return nil, ErrCipher
+	}
+}
+
+//CipherKeySize 算法对应的内容密钥长度
+func CipherKeySize(name string) int {
+	switch name {
+	case CipherAESGCM:
+		return 32
+	case CipherSM4GCM:
+		return 16
+	default:
+		return 0
+	}
+}
+
+func (g *GCM) aead() (cipher.AEAD, error) {
+	block, err := g.newCipher(g.key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(g.nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid nonce size")
+	}
+	return aead, nil
+}
+
+func (g *GCM) Encrypt(origData []byte) ([]byte, error) {
+	aead, err := g.aead()
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nil, g.nonce, origData, nil), nil
+}
+
+func (g *GCM) Decrypt(crypted []byte) ([]byte, error) {
+	aead, err := g.aead()
+	if err != nil {
+		return nil, err
+	}
+	return aead.Open(nil, g.nonce, crypted, nil)
+}
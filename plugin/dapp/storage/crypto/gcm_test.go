@@ -0,0 +1,30 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//GCM 加解密测试，篡改密文之后解密失败
+func TestGCM(t *testing.T) {
+	nonce := ivs[0][:GCMNonceSize]
+	for _, name := range []string{CipherAESGCM, CipherSM4GCM} {
+		c, err := NewCipher(name, keys[2][:CipherKeySize(name)], nonce)
+		assert.Nil(t, err)
+		result, err := c.Encrypt(contents[1])
+		assert.Nil(t, err)
+		origData, err := c.Decrypt(result)
+		assert.Nil(t, err)
+		assert.Equal(t, contents[1], origData)
+
+		result[0] ^= 0xff
+		_, err = c.Decrypt(result)
+		assert.NotNil(t, err)
+	}
+
+	_, err := NewCipher("aes-cbc", keys[2], nonce)
+	assert.Equal(t, ErrCipher, err)
+	_, err = NewAESGCM(keys[2], ivs[0]).Encrypt(contents[1])
+	assert.NotNil(t, err)
+}
@@ -35,3 +35,27 @@ func (s *storage) Exec_EncryptShareStorage(payload *storagetypes.EncryptShareNot
 	action := newStorageAction(s, tx, index)
 	return action.EncryptShareStorage(payload)
 }
+
+func (s *storage) Exec_ChunkStorage(payload *storagetypes.ChunkNotaryStorage, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !s.GetAPI().GetConfig().IsDappFork(s.GetHeight(), storagetypes.StorageX, storagetypes.ForkStorageChunk) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newStorageAction(s, tx, index)
+	return action.ChunkStorage(payload)
+}
+
+func (s *storage) Exec_ChunkDataStorage(payload *storagetypes.ChunkDataStorage, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !s.GetAPI().GetConfig().IsDappFork(s.GetHeight(), storagetypes.StorageX, storagetypes.ForkStorageChunk) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newStorageAction(s, tx, index)
+	return action.ChunkDataStorage(payload)
+}
+
+func (s *storage) Exec_ChunkResetStorage(payload *storagetypes.ChunkResetStorage, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !s.GetAPI().GetConfig().IsDappFork(s.GetHeight(), storagetypes.StorageX, storagetypes.ForkStorageChunk) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newStorageAction(s, tx, index)
+	return action.ChunkResetStorage(payload)
+}
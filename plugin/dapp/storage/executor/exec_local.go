@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
 	ety "github.com/33cn/plugin/plugin/dapp/storage/types"
 )
@@ -24,6 +25,11 @@ func (s *storage) ExecLocal_ContentStorage(payload *ety.ContentOnlyNotaryStorage
 					}
 					kv := &types.KeyValue{Key: getLocalDBKey(storage.GetContentStorage().Key), Value: types.Encode(storage)}
 					dbSet.KV = append(dbSet.KV, kv)
+					kvs, err := s.indexStorage(tx, index, storage.GetContentStorage().Key, common.Sha256(payload.Content), storage.Ty)
+					if err != nil {
+						return nil, err
+					}
+					dbSet.KV = append(dbSet.KV, kvs...)
 				}
 			}
 		}
@@ -45,6 +51,11 @@ func (s *storage) ExecLocal_HashStorage(payload *ety.HashOnlyNotaryStorage, tx *
 					}
 					kv := &types.KeyValue{Key: getLocalDBKey(storage.GetHashStorage().Key), Value: types.Encode(storage)}
 					dbSet.KV = append(dbSet.KV, kv)
+					kvs, err := s.indexStorage(tx, index, storage.GetHashStorage().Key, storage.GetHashStorage().Hash, storage.Ty)
+					if err != nil {
+						return nil, err
+					}
+					dbSet.KV = append(dbSet.KV, kvs...)
 				}
 			}
 		}
@@ -66,6 +77,11 @@ func (s *storage) ExecLocal_LinkStorage(payload *ety.LinkNotaryStorage, tx *type
 					}
 					kv := &types.KeyValue{Key: getLocalDBKey(storage.GetLinkStorage().Key), Value: types.Encode(storage)}
 					dbSet.KV = append(dbSet.KV, kv)
+					kvs, err := s.indexStorage(tx, index, storage.GetLinkStorage().Key, storage.GetLinkStorage().Hash, storage.Ty)
+					if err != nil {
+						return nil, err
+					}
+					dbSet.KV = append(dbSet.KV, kvs...)
 				}
 			}
 		}
@@ -87,6 +103,11 @@ func (s *storage) ExecLocal_EncryptStorage(payload *ety.EncryptNotaryStorage, tx
 					}
 					kv := &types.KeyValue{Key: getLocalDBKey(storage.GetEncryptStorage().Key), Value: types.Encode(storage)}
 					dbSet.KV = append(dbSet.KV, kv)
+					kvs, err := s.indexStorage(tx, index, storage.GetEncryptStorage().Key, storage.GetEncryptStorage().ContentHash, storage.Ty)
+					if err != nil {
+						return nil, err
+					}
+					dbSet.KV = append(dbSet.KV, kvs...)
 				}
 			}
 		}
@@ -108,7 +129,57 @@ func (s *storage) ExecLocal_EncryptShareStorage(payload *ety.EncryptShareNotaryS
 					}
 					kv := &types.KeyValue{Key: getLocalDBKey(storage.GetEncryptShareStorage().Key), Value: types.Encode(storage)}
 					dbSet.KV = append(dbSet.KV, kv)
+					kvs, err := s.indexStorage(tx, index, storage.GetEncryptShareStorage().Key, payload.ContentHash, storage.Ty)
+					if err != nil {
+						return nil, err
+					}
+					dbSet.KV = append(dbSet.KV, kvs...)
+				}
+			}
+		}
+	}
+	return s.addAutoRollBack(tx, dbSet.KV), nil
+}
+
+func (s *storage) ExecLocal_ChunkStorage(payload *ety.ChunkNotaryStorage, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocalChunk(tx, receiptData, index, true)
+}
+
+func (s *storage) ExecLocal_ChunkDataStorage(payload *ety.ChunkDataStorage, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocalChunk(tx, receiptData, index, false)
+}
+
+func (s *storage) ExecLocal_ChunkResetStorage(payload *ety.ChunkResetStorage, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocalChunk(tx, receiptData, index, false)
+}
+
+//分片存证的头信息和分片内容分别保存，上传分片或者重新声明总长度时更新头信息，只有创建分片存证时建立索引
+func (s *storage) execLocalChunk(tx *types.Transaction, receiptData *types.ReceiptData, index int, create bool) (*types.LocalDBSet, error) {
+	dbSet := &types.LocalDBSet{}
+	if receiptData.Ty == types.ExecOk {
+		for _, log := range receiptData.Logs {
+			switch log.Ty {
+			case ety.TyChunkStorageLog:
+				storage := &ety.Storage{}
+				if err := types.Decode(log.Log, storage); err != nil {
+					return nil, err
+				}
+				kv := &types.KeyValue{Key: getLocalDBKey(storage.GetChunkStorage().Key), Value: types.Encode(storage)}
+				dbSet.KV = append(dbSet.KV, kv)
+				if create {
+					kvs, err := s.indexStorage(tx, index, storage.GetChunkStorage().Key, storage.GetChunkStorage().MerkleRoot, storage.Ty)
+					if err != nil {
+						return nil, err
+					}
+					dbSet.KV = append(dbSet.KV, kvs...)
+				}
+			case ety.TyChunkDataStorageLog:
+				chunk := &ety.ChunkDataStorage{}
+				if err := types.Decode(log.Log, chunk); err != nil {
+					return nil, err
 				}
+				kv := &types.KeyValue{Key: getChunkLocalDBKey(chunk.Key, chunk.Index), Value: log.Log}
+				dbSet.KV = append(dbSet.KV, kv)
 			}
 		}
 	}
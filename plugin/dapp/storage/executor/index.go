@@ -0,0 +1,99 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	ety "github.com/33cn/plugin/plugin/dapp/storage/types"
+)
+
+/*
+ * 存证索引表，每笔存证交易一条记录，主键为交易所在的高度和序号
+ * 分别按key、内容哈希和发送者地址建立索引，用于分页查询
+ */
+
+var opt_storage_index = &table.Option{
+	Prefix:  "LODB-storage",
+	Name:    "index",
+	Primary: "heightindex",
+	Index:   []string{"key", "contentHash", "sender"},
+}
+
+// IndexRow table meta 结构
+type IndexRow struct {
+	*ety.StorageIndex
+}
+
+// NewIndexRow 新建一个meta 结构
+func NewIndexRow() *IndexRow {
+	return &IndexRow{StorageIndex: &ety.StorageIndex{}}
+}
+
+// CreateRow 新建数据行
+func (r *IndexRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ety.StorageIndex{}}
+}
+
+// SetPayload 设置数据
+func (r *IndexRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*ety.StorageIndex); ok {
+		r.StorageIndex = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+// Get 按照indexName 查询 indexValue
+func (r *IndexRow) Get(key string) ([]byte, error) {
+	switch key {
+	case "heightindex":
+		return []byte(dapp.HeightIndexStr(r.Height, r.Index)), nil
+	case "key":
+		return calcIndexPrefix(r.Key), nil
+	case "contentHash":
+		return calcIndexPrefix(common.ToHex(r.ContentHash)), nil
+	case "sender":
+		return calcIndexPrefix(r.Sender), nil
+	default:
+		return nil, types.ErrNotFound
+	}
+}
+
+//索引以分隔符结尾，避免按前缀查询时匹配到以该值开头的其他记录
+func calcIndexPrefix(value string) []byte {
+	return []byte(value + ":")
+}
+
+// NewIndexTable 新建存证索引表
+func NewIndexTable(kvdb dbm.KV) *table.Table {
+	rowmeta := NewIndexRow()
+	t, err := table.NewTable(rowmeta, kvdb, opt_storage_index)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+//按key、内容哈希和发送者给存证交易建立索引，追加接收者信封这类不带内容哈希的交易只能按key和发送者查到
+func (s *storage) indexStorage(tx *types.Transaction, index int, key string, contentHash []byte, ty int32) ([]*types.KeyValue, error) {
+	if !s.GetAPI().GetConfig().IsDappFork(s.GetHeight(), ety.StorageX, ety.ForkStorageIndex) {
+		return nil, nil
+	}
+	record := &ety.StorageIndex{
+		Key:         key,
+		TxHash:      common.ToHex(tx.Hash()),
+		ContentHash: contentHash,
+		Sender:      tx.From(),
+		Ty:          ty,
+		Height:      s.GetHeight(),
+		Index:       int64(index),
+		BlockTime:   s.GetBlockTime(),
+	}
+	t := NewIndexTable(s.GetLocalDB())
+	if err := t.Add(record); err != nil {
+		return nil, err
+	}
+	return t.Save()
+}
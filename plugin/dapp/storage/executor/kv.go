@@ -1,5 +1,7 @@
 package executor
 
+import "fmt"
+
 /*
  * 用户合约存取kv数据时，key值前缀需要满足一定规范
  * 即key = keyPrefix + userKey
@@ -25,3 +27,8 @@ func getLocalDBKey(txHash string) (key []byte) {
 	key = append(key, []byte(txHash)...)
 	return key
 }
+
+//分片内容的key，分片序号补齐长度保证按顺序排列
+func getChunkLocalDBKey(key string, index int32) []byte {
+	return []byte(fmt.Sprintf("%schunk-%s-%08d", KeyPrefixLocalDB, key, index))
+}
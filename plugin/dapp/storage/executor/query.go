@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
 	storagetypes "github.com/33cn/plugin/plugin/dapp/storage/types"
 )
@@ -14,3 +15,45 @@ func (s *storage) Query_QueryStorage(in *storagetypes.QueryStorage) (types.Messa
 func (s *storage) Query_BatchQueryStorage(in *storagetypes.BatchQueryStorage) (types.Message, error) {
 	return BatchQueryStorage(s.GetStateDB(), s.GetLocalDB(), in)
 }
+
+//根据key查询分片存证，拼接所有分片的内容
+func (s *storage) Query_QueryChunkStorage(in *storagetypes.QueryChunkStorage) (types.Message, error) {
+	return QueryChunkStorage(s.GetLocalDB(), in)
+}
+
+//根据key和分片序号查询分片内容及merkle证明
+func (s *storage) Query_QueryChunkProof(in *storagetypes.QueryChunkProof) (types.Message, error) {
+	return QueryChunkProof(s.GetLocalDB(), in)
+}
+
+//根据自定义的key查询存证
+func (s *storage) Query_QueryStorageByKey(in *storagetypes.QueryStorageByKey) (types.Message, error) {
+	if in.Key == "" {
+		return nil, types.ErrInvalidParam
+	}
+	return QueryStorageFromLocalDB(s.GetLocalDB(), in.Key)
+}
+
+//根据key分页查询存证记录，同一个key的内容存证追加时每次追加都是一条记录
+func (s *storage) Query_ListStorageByKey(in *storagetypes.ReqListStorage) (types.Message, error) {
+	return ListStorageIndex(s.GetLocalDB(), "key", in.Key, in)
+}
+
+//根据内容哈希分页查询存证记录
+func (s *storage) Query_ListStorageByContentHash(in *storagetypes.ReqListStorage) (types.Message, error) {
+	hash, err := common.FromHex(in.ContentHash)
+	if err != nil {
+		return nil, types.ErrInvalidParam
+	}
+	return ListStorageIndex(s.GetLocalDB(), "contentHash", common.ToHex(hash), in)
+}
+
+//根据发送者地址分页查询存证记录
+func (s *storage) Query_ListStorageBySender(in *storagetypes.ReqListStorage) (types.Message, error) {
+	return ListStorageIndex(s.GetLocalDB(), "sender", in.Sender, in)
+}
+
+//根据内容哈希查询存证所在的区块高度及交易证明
+func (s *storage) Query_VerifyContentHash(in *storagetypes.ReqVerifyContentHash) (types.Message, error) {
+	return VerifyContentHash(s.GetAPI(), s.GetLocalDB(), in)
+}
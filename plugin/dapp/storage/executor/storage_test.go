@@ -31,6 +31,7 @@ type execEnv struct {
 
 var (
 	PrivKeyA = "0x6da92a632ab7deb67d38c0f6560bcfed28167998f6496db64c258d5e8393a81b" // 1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4
+	PrivKeyB = "0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4" // 1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR
 
 	Nodes = [][]byte{
 		[]byte("1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"),
@@ -62,10 +63,11 @@ var (
 
 func init() {
 	r = rand.New(rand.NewSource(types.Now().UnixNano()))
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	Init(oty.StorageX, cfg, nil)
 }
 func TestStorage(t *testing.T) {
 	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
-	Init(oty.StorageX, cfg, nil)
 	cfg.RegisterDappFork(oty.StorageX, oty.ForkStorageLocalDB, 0)
 	total := 100 * types.Coin
 	accountA := types.Account{
@@ -167,6 +169,325 @@ func TestStorage(t *testing.T) {
 	assert.Equal(t, ivs[0], reply.GetEncryptStorage().Nonce)
 }
 
+func TestChunkStorage(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 1000)
+	_, _, kvdb := util.CreateTestDB()
+	env := &execEnv{
+		10,
+		cfg.GetDappFork(oty.StorageX, oty.ForkStorageChunk) - 1,
+		1539918074,
+	}
+
+	var content []byte
+	for _, c := range contents {
+		content = append(content, c...)
+	}
+	chunks := oty.SplitChunks(content, 30)
+	assert.Equal(t, 5, len(chunks))
+	root := oty.ChunkMerkleRoot(chunks)
+
+	//fork之前不支持分片存证
+	tx, err := CreateTx(oty.NameChunkStorageAction, &oty.ChunkNotaryStorage{MerkleRoot: root, ChunkCount: 5, TotalSize: int64(len(content))}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	env.blockHeight--
+	assert.Equal(t, types.ErrActionNotSupport, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	//声明的总长度小于分片数量
+	tx, err = CreateTx(oty.NameChunkStorageAction, &oty.ChunkNotaryStorage{MerkleRoot: root, ChunkCount: 5, TotalSize: 4}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, oty.ErrChunkParam, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	tx, err = CreateTx(oty.NameChunkStorageAction, &oty.ChunkNotaryStorage{MerkleRoot: root, ChunkCount: int32(len(chunks)), TotalSize: int64(len(content)), Key: "chunk"}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	Exec_Block(t, stateDB, kvdb, env, tx)
+	reply, err := QueryStorageByKey(stateDB, kvdb, "chunk", cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, root, reply.GetChunkStorage().MerkleRoot)
+	assert.Equal(t, string(Nodes[0]), reply.GetChunkStorage().Owner)
+
+	//非创建者不能上传分片
+	proof := oty.ChunkMerkleProof(chunks, 0)
+	tx, err = CreateTx(oty.NameChunkDataStorageAction, &oty.ChunkDataStorage{Key: "chunk", Index: 0, Content: chunks[0], Proof: proof}, PrivKeyB, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, oty.ErrChunkOwner, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	//分片内容和证明路径不匹配
+	tx, err = CreateTx(oty.NameChunkDataStorageAction, &oty.ChunkDataStorage{Key: "chunk", Index: 1, Content: chunks[0], Proof: proof}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, oty.ErrChunkProof, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	//乱序上传除最后一个以外的分片，同一个区块中可以上传多个分片
+	var txs []*types.Transaction
+	for _, index := range []int32{3, 0, 2, 1} {
+		tx, err = CreateTx(oty.NameChunkDataStorageAction, &oty.ChunkDataStorage{Key: "chunk", Index: index, Content: chunks[index], Proof: oty.ChunkMerkleProof(chunks, index)}, PrivKeyA, cfg)
+		assert.Nil(t, err)
+		txs = append(txs, tx)
+	}
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, txs...))
+
+	//重复上传
+	assert.Equal(t, oty.ErrChunkExisted, Exec_Block(t, stateDB, kvdb, env, txs[0]))
+
+	//分片没有全部上传时不能拼接，但可以查询单个分片的证明
+	_, err = queryChunk(stateDB, kvdb, oty.FuncNameQueryChunkStorage, &oty.QueryChunkStorage{Key: "chunk"}, cfg)
+	assert.Equal(t, oty.ErrChunkIncomplete, err)
+	msg, err := queryChunk(stateDB, kvdb, oty.FuncNameQueryChunkProof, &oty.QueryChunkProof{Key: "chunk", Index: 2}, cfg)
+	assert.Nil(t, err)
+	chunkProof := msg.(*oty.ReplyChunkProof)
+	assert.Equal(t, chunks[2], chunkProof.Chunk.Content)
+	assert.True(t, oty.VerifyChunkProof(chunkProof.MerkleRoot, chunkProof.Chunk.Content, 2, chunkProof.ChunkCount, chunkProof.Chunk.Proof))
+	assert.False(t, oty.VerifyChunkProof(chunkProof.MerkleRoot, chunkProof.Chunk.Content, 3, chunkProof.ChunkCount, chunkProof.Chunk.Proof))
+
+	tx, err = CreateTx(oty.NameChunkDataStorageAction, &oty.ChunkDataStorage{Key: "chunk", Index: 4, Content: chunks[4], Proof: oty.ChunkMerkleProof(chunks, 4)}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+	msg, err = queryChunk(stateDB, kvdb, oty.FuncNameQueryChunkStorage, &oty.QueryChunkStorage{Key: "chunk"}, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, content, msg.(*oty.ReplyChunkStorage).Content)
+	assert.Equal(t, int32(len(chunks)), msg.(*oty.ReplyChunkStorage).ChunkStorage.UploadedCount)
+	assert.Equal(t, int32(len(chunks)), msg.(*oty.ReplyChunkStorage).NextIndex)
+
+	//按分片序号分页查询
+	msg, err = queryChunk(stateDB, kvdb, oty.FuncNameQueryChunkStorage, &oty.QueryChunkStorage{Key: "chunk", StartIndex: 1, Count: 2}, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, append(append([]byte{}, chunks[1]...), chunks[2]...), msg.(*oty.ReplyChunkStorage).Content)
+	assert.Equal(t, int32(3), msg.(*oty.ReplyChunkStorage).NextIndex)
+	_, err = queryChunk(stateDB, kvdb, oty.FuncNameQueryChunkStorage, &oty.QueryChunkStorage{Key: "chunk", StartIndex: int32(len(chunks))}, cfg)
+	assert.Equal(t, oty.ErrChunkParam, err)
+
+	//上传完成之后不能再修改总长度
+	tx, err = CreateTx(oty.NameChunkResetStorageAction, &oty.ChunkResetStorage{Key: "chunk", TotalSize: int64(len(content)) + 1}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, oty.ErrChunkCompleted, Exec_Block(t, stateDB, kvdb, env, tx))
+}
+
+func TestChunkReset(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 1000)
+	_, _, kvdb := util.CreateTestDB()
+	env := &execEnv{
+		10,
+		cfg.GetDappFork(oty.StorageX, oty.ForkStorageChunk) - 1,
+		1539918074,
+	}
+
+	var content []byte
+	for _, c := range contents {
+		content = append(content, c...)
+	}
+	chunks := oty.SplitChunks(content, 30)
+	root := oty.ChunkMerkleRoot(chunks)
+	uploadChunk := func(key string, index int32) error {
+		tx, err := CreateTx(oty.NameChunkDataStorageAction, &oty.ChunkDataStorage{Key: key, Index: index, Content: chunks[index], Proof: oty.ChunkMerkleProof(chunks, index)}, PrivKeyA, cfg)
+		assert.Nil(t, err)
+		return Exec_Block(t, stateDB, kvdb, env, tx)
+	}
+
+	//声明的总长度过小，上传第一个分片时就发现剩余分片放不下
+	tx, err := CreateTx(oty.NameChunkStorageAction, &oty.ChunkNotaryStorage{MerkleRoot: root, ChunkCount: int32(len(chunks)), TotalSize: 10, Key: "small"}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+	assert.Equal(t, oty.ErrChunkSize, uploadChunk("small", 0))
+
+	//声明的总长度过大，最后一个分片无法上传
+	tx, err = CreateTx(oty.NameChunkStorageAction, &oty.ChunkNotaryStorage{MerkleRoot: root, ChunkCount: int32(len(chunks)), TotalSize: int64(len(content)) + 10, Key: "large"}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+	var uploaded int64
+	for index := int32(0); index < int32(len(chunks))-1; index++ {
+		assert.Nil(t, uploadChunk("large", index))
+		uploaded += int64(len(chunks[index]))
+	}
+	last := int32(len(chunks)) - 1
+	assert.Equal(t, oty.ErrChunkSize, uploadChunk("large", last))
+
+	//只有创建者可以修改，新的总长度需要能容纳剩余的分片
+	tx, err = CreateTx(oty.NameChunkResetStorageAction, &oty.ChunkResetStorage{Key: "large", TotalSize: int64(len(content))}, PrivKeyB, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, oty.ErrChunkOwner, Exec_Block(t, stateDB, kvdb, env, tx))
+	tx, err = CreateTx(oty.NameChunkResetStorageAction, &oty.ChunkResetStorage{Key: "large", TotalSize: uploaded}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, oty.ErrChunkSize, Exec_Block(t, stateDB, kvdb, env, tx))
+	tx, err = CreateTx(oty.NameChunkResetStorageAction, &oty.ChunkResetStorage{Key: "large", TotalSize: int64(len(content))}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	//纠正之后可以完成上传
+	assert.Nil(t, uploadChunk("large", last))
+	msg, err := queryChunk(stateDB, kvdb, oty.FuncNameQueryChunkStorage, &oty.QueryChunkStorage{Key: "large"}, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, content, msg.(*oty.ReplyChunkStorage).Content)
+	assert.Equal(t, int64(len(content)), msg.(*oty.ReplyChunkStorage).ChunkStorage.TotalSize)
+}
+
+func TestChunkMerkleProof(t *testing.T) {
+	for count := 1; count <= 9; count++ {
+		chunks := make([][]byte, count)
+		for i := range chunks {
+			chunks[i] = []byte{byte(i)}
+		}
+		root := oty.ChunkMerkleRoot(chunks)
+		for i := range chunks {
+			proof := oty.ChunkMerkleProof(chunks, int32(i))
+			assert.True(t, oty.VerifyChunkProof(root, chunks[i], int32(i), int32(count), proof))
+			assert.False(t, oty.VerifyChunkProof(root, []byte("x"), int32(i), int32(count), proof))
+		}
+		if count > 1 {
+			//用中间节点的原像冒充分片
+			assert.False(t, oty.VerifyChunkProof(root, common.Sha256(chunks[0]), 0, int32(count), nil))
+		}
+	}
+}
+
+//带内容密钥信封的隐私分享存证，创建者追加接收者之后接收者可以解密
+func TestEncryptShareEnvelope(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 1000)
+	_, _, kvdb := util.CreateTestDB()
+	env := &execEnv{
+		10,
+		cfg.GetDappFork(oty.StorageX, oty.ForkStorageEnvelope) - 2,
+		1539918074,
+	}
+
+	contentKey := keys[2]
+	nonce := ivs[0][:des.GCMNonceSize]
+	crypted, err := des.NewAESGCM(contentKey, nonce).Encrypt(contents[0])
+	assert.Nil(t, err)
+	envelopeA := testKeyEnvelope(t, PrivKeyA, contentKey)
+	envelopeB := testKeyEnvelope(t, PrivKeyB, contentKey)
+	share := &oty.EncryptShareNotaryStorage{ContentHash: common.Sha256(contents[0]), EncryptContent: crypted, Key: "share",
+		Cipher: des.CipherAESGCM, Nonce: nonce, Envelopes: []*oty.KeyEnvelope{envelopeA}}
+
+	//fork之前不检查信封, 按原来的隐私分享存证处理
+	share.Nonce = ivs[0]
+	share.Key = "legacy"
+	tx, err := CreateTx(oty.NameEncryptShareStorageAction, share, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	//nonce长度不符合GCM要求
+	share.Key = "share"
+	tx, err = CreateTx(oty.NameEncryptShareStorageAction, share, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, oty.ErrEnvelopeParam, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	share.Nonce = nonce
+	tx, err = CreateTx(oty.NameEncryptShareStorageAction, share, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+	reply, err := QueryStorageByKey(stateDB, kvdb, "share", cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[0]), reply.GetEncryptShareStorage().Owner)
+
+	//非创建者不能追加接收者
+	add := &oty.EncryptShareNotaryStorage{Key: "share", Envelopes: []*oty.KeyEnvelope{envelopeB}, Op: oty.OpAdd}
+	tx, err = CreateTx(oty.NameEncryptShareStorageAction, add, PrivKeyB, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, oty.ErrEnvelopeOwner, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	tx, err = CreateTx(oty.NameEncryptShareStorageAction, add, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	//重复追加同一个接收者
+	tx, err = CreateTx(oty.NameEncryptShareStorageAction, add, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, oty.ErrEnvelopeExisted, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	//接收者B用自己的私钥解开信封并解密内容
+	reply, err = QueryStorageByKey(stateDB, kvdb, "share", cfg)
+	assert.Nil(t, err)
+	stored := reply.GetEncryptShareStorage()
+	assert.Equal(t, 2, len(stored.Envelopes))
+	privB, _ := common.FromHex(PrivKeyB)
+	key, err := des.OpenKey(des.KeyTypeSecp256k1, privB, stored.Envelopes[1].EncryptedKey)
+	assert.Nil(t, err)
+	c, err := des.NewCipher(stored.Cipher, key, stored.Nonce)
+	assert.Nil(t, err)
+	origData, err := c.Decrypt(stored.EncryptContent)
+	assert.Nil(t, err)
+	assert.Equal(t, contents[0], origData)
+}
+
+//按key、内容哈希和发送者分页查询存证记录
+func TestStorageIndex(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 1000)
+	_, _, kvdb := util.CreateTestDB()
+	env := &execEnv{
+		10,
+		cfg.GetDappFork(oty.StorageX, oty.ForkStorageIndex),
+		1539918074,
+	}
+
+	hash := common.Sha256(contents[0])
+	tx1, err := CreateTx(oty.NameHashStorageAction, &oty.HashOnlyNotaryStorage{Hash: hash, Key: "hash"}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	tx2, err := CreateTx(oty.NameLinkStorageAction, &oty.LinkNotaryStorage{Hash: hash, Link: contents[0], Key: "link"}, PrivKeyB, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx1, tx2))
+	tx3, err := CreateTx(oty.NameContentStorageAction, &oty.ContentOnlyNotaryStorage{Content: contents[1], Key: "content"}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx3))
+	tx4, err := CreateTx(oty.NameContentStorageAction, &oty.ContentOnlyNotaryStorage{Content: contents[2], Key: "content", Op: oty.OpAdd}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx4))
+
+	msg, err := queryChunk(stateDB, kvdb, oty.FuncNameQueryStorageByKey, &oty.QueryStorageByKey{Key: "link"}, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, contents[0], msg.(*oty.Storage).GetLinkStorage().Link)
+
+	//同一个内容哈希的两条存证，按时间正序排列
+	msg, err = queryChunk(stateDB, kvdb, oty.FuncNameListStorageByContentHash, &oty.ReqListStorage{ContentHash: common.ToHex(hash), Direction: dbm.ListASC}, cfg)
+	assert.Nil(t, err)
+	reply := msg.(*oty.ReplyListStorage)
+	assert.Equal(t, 2, len(reply.Indexes))
+	assert.Equal(t, "hash", reply.Indexes[0].Key)
+	assert.Equal(t, string(Nodes[1]), reply.Indexes[1].Sender)
+	assert.Equal(t, reply.Indexes[0].Height, reply.Indexes[1].Height)
+	assert.Equal(t, int64(1), reply.Indexes[1].Index)
+
+	//内容存证每次追加都是一条记录
+	msg, err = queryChunk(stateDB, kvdb, oty.FuncNameListStorageByKey, &oty.ReqListStorage{Key: "content"}, cfg)
+	assert.Nil(t, err)
+	reply = msg.(*oty.ReplyListStorage)
+	assert.Equal(t, 2, len(reply.Indexes))
+	assert.Equal(t, common.ToHex(tx4.Hash()), reply.Indexes[0].TxHash)
+	assert.Equal(t, common.Sha256(contents[2]), reply.Indexes[0].ContentHash)
+
+	//按发送者分页查询
+	msg, err = queryChunk(stateDB, kvdb, oty.FuncNameListStorageBySender, &oty.ReqListStorage{Sender: string(Nodes[0]), Count: 2}, cfg)
+	assert.Nil(t, err)
+	reply = msg.(*oty.ReplyListStorage)
+	assert.Equal(t, 2, len(reply.Indexes))
+	assert.Equal(t, "content", reply.Indexes[1].Key)
+	msg, err = queryChunk(stateDB, kvdb, oty.FuncNameListStorageBySender, &oty.ReqListStorage{Sender: string(Nodes[0]), Count: 2, Primary: reply.Primary}, cfg)
+	assert.Nil(t, err)
+	reply = msg.(*oty.ReplyListStorage)
+	assert.Equal(t, 1, len(reply.Indexes))
+	assert.Equal(t, "hash", reply.Indexes[0].Key)
+
+	//地址前缀不能匹配到其他地址
+	_, err = queryChunk(stateDB, kvdb, oty.FuncNameListStorageBySender, &oty.ReqListStorage{Sender: string(Nodes[0][:10])}, cfg)
+	assert.Equal(t, types.ErrNotFound, err)
+	_, err = queryChunk(stateDB, kvdb, oty.FuncNameVerifyContentHash, &oty.ReqVerifyContentHash{ContentHash: "0x"}, cfg)
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func testKeyEnvelope(t *testing.T, hexPrivKey string, contentKey []byte) *oty.KeyEnvelope {
+	c, err := crypto.New(types.GetSignName(oty.StorageX, types.SECP256K1))
+	assert.Nil(t, err)
+	bytes, _ := common.FromHex(hexPrivKey)
+	privKey, err := c.PrivKeyFromBytes(bytes)
+	assert.Nil(t, err)
+	pubKey := privKey.PubKey().Bytes()
+	sealed, err := des.SealKey(des.KeyTypeSecp256k1, pubKey, contentKey)
+	assert.Nil(t, err)
+	return &oty.KeyEnvelope{PubKey: pubKey, KeyType: des.KeyTypeSecp256k1, EncryptedKey: sealed}
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.New(types.GetSignName(oty.StorageX, signType))
@@ -234,9 +555,8 @@ func CreateTx(action string, message types.Message, priv string, cfg *types.Chai
 
 //模拟区块中交易得执行过程
 func Exec_Block(t *testing.T, stateDB dbm.DB, kvdb dbm.KVDB, env *execEnv, txs ...*types.Transaction) error {
-	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
 	cfg.RegisterDappFork(oty.StorageX, oty.ForkStorageLocalDB, 0)
-	cfg.SetTitleOnlyForTest("chain33")
 	exec := newStorage()
 	e := exec.(*storage)
 	for index, tx := range txs {
@@ -280,3 +600,14 @@ func Exec_Block(t *testing.T, stateDB dbm.DB, kvdb dbm.KVDB, env *execEnv, txs .
 	}
 	return nil
 }
+
+func queryChunk(stateDB dbm.KV, kvdb dbm.KVDB, funcName string, para proto.Message, cfg *types.Chain33Config) (types.Message, error) {
+	exec := newStorage()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	return exec.Query(funcName, types.Encode(para))
+}
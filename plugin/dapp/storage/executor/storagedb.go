@@ -7,6 +7,7 @@ import (
 	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/storage/crypto"
 	ety "github.com/33cn/plugin/plugin/dapp/storage/types"
 	"github.com/golang/protobuf/proto"
 )
@@ -156,6 +157,10 @@ func (s *StorageAction) EncryptShareStorage(payload *ety.EncryptShareNotaryStora
 	var logs []*types.ReceiptLog
 	var kvs []*types.KeyValue
 	cfg := s.api.GetConfig()
+	//携带内容密钥信封或者追加接收者的隐私分享存证
+	if cfg.IsDappFork(s.height, ety.StorageX, ety.ForkStorageEnvelope) && (len(payload.Envelopes) > 0 || payload.Op != ety.OpCreate) {
+		return s.encryptShareEnvelope(payload)
+	}
 	if cfg.IsDappFork(s.height, ety.StorageX, ety.ForkStorageLocalDB) {
 		key := payload.Key
 		if key == "" {
@@ -177,6 +182,158 @@ func (s *StorageAction) EncryptShareStorage(payload *ety.EncryptShareNotaryStora
 	receipt := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
 	return receipt, nil
 }
+
+//创建带内容密钥信封的隐私分享存证，或者由创建者给已有的存证追加接收者信封
+func (s *StorageAction) encryptShareEnvelope(payload *ety.EncryptShareNotaryStorage) (*types.Receipt, error) {
+	if len(payload.Envelopes) == 0 {
+		return nil, ety.ErrEnvelopeParam
+	}
+	pubKeys := make(map[string]bool)
+	for _, envelope := range payload.Envelopes {
+		if !crypto.ValidKeyType(envelope.KeyType) || len(envelope.PubKey) == 0 || len(envelope.EncryptedKey) == 0 ||
+			pubKeys[string(envelope.PubKey)] {
+			return nil, ety.ErrEnvelopeParam
+		}
+		pubKeys[string(envelope.PubKey)] = true
+	}
+
+	var stg *ety.Storage
+	switch payload.Op {
+	case ety.OpCreate:
+		if crypto.CipherKeySize(payload.Cipher) == 0 || len(payload.Nonce) != crypto.GCMNonceSize || len(payload.EncryptContent) == 0 {
+			return nil, ety.ErrEnvelopeParam
+		}
+		key := payload.Key
+		if key == "" {
+			key = common.ToHex(s.txhash)
+		}
+		_, err := QueryStorageFromLocalDB(s.localdb, key)
+		if err != types.ErrNotFound {
+			return nil, ety.ErrKeyExisted
+		}
+		payload.Key = key
+		payload.Owner = s.fromaddr
+		stg = &ety.Storage{Value: &ety.Storage_EncryptShareStorage{EncryptShareStorage: payload}, Ty: ety.TyEncryptShareStorageAction}
+	case ety.OpAdd:
+		//追加接收者时只能携带信封
+		if len(payload.ContentHash) != 0 || len(payload.EncryptContent) != 0 || len(payload.PubKey) != 0 || len(payload.Nonce) != 0 ||
+			payload.Cipher != "" || payload.Value != "" {
+			return nil, ety.ErrEnvelopeParam
+		}
+		storage, err := QueryStorageFromLocalDB(s.localdb, payload.Key)
+		if err != nil {
+			return nil, err
+		}
+		share := storage.GetEncryptShareStorage()
+		if share == nil {
+			return nil, ety.ErrStorageType
+		}
+		if share.Owner == "" || share.Owner != s.fromaddr {
+			return nil, ety.ErrEnvelopeOwner
+		}
+		for _, envelope := range share.Envelopes {
+			if pubKeys[string(envelope.PubKey)] {
+				return nil, ety.ErrEnvelopeExisted
+			}
+		}
+		share.Envelopes = append(share.Envelopes, payload.Envelopes...)
+		stg = storage
+	default:
+		return nil, ety.ErrEnvelopeParam
+	}
+	log := &types.ReceiptLog{Ty: ety.TyEncryptShareStorageLog, Log: types.Encode(stg)}
+	return &types.Receipt{Ty: types.ExecOk, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+// ChunkStorage 创建分片存证，记录merkle树根和分片数量，之后由创建者逐个上传分片
+func (s *StorageAction) ChunkStorage(payload *ety.ChunkNotaryStorage) (*types.Receipt, error) {
+	if len(payload.MerkleRoot) != 32 || payload.ChunkCount <= 0 || payload.ChunkCount > ety.MaxChunkCount ||
+		payload.TotalSize < int64(payload.ChunkCount) {
+		return nil, ety.ErrChunkParam
+	}
+	key := payload.Key
+	if key == "" {
+		key = common.ToHex(s.txhash)
+	}
+	_, err := QueryStorageFromLocalDB(s.localdb, key)
+	if err != types.ErrNotFound {
+		return nil, ety.ErrKeyExisted
+	}
+	payload.Key = key
+	payload.Owner = s.fromaddr
+	payload.UploadedCount = 0
+	payload.UploadedSize = 0
+	stg := &ety.Storage{Value: &ety.Storage_ChunkStorage{ChunkStorage: payload}, Ty: ety.TyChunkStorageAction}
+	log := &types.ReceiptLog{Ty: ety.TyChunkStorageLog, Log: types.Encode(stg)}
+	return &types.Receipt{Ty: types.ExecOk, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+//ChunkDataStorage 上传一个分片，分片需要通过merkle证明校验，全部分片上传完成后总长度需要和声明的一致
+func (s *StorageAction) ChunkDataStorage(payload *ety.ChunkDataStorage) (*types.Receipt, error) {
+	if len(payload.Content) == 0 {
+		return nil, ety.ErrChunkParam
+	}
+	storage, err := QueryStorageFromLocalDB(s.localdb, payload.Key)
+	if err != nil {
+		return nil, err
+	}
+	head := storage.GetChunkStorage()
+	if storage.Ty != ety.TyChunkStorageAction || head == nil {
+		return nil, ety.ErrStorageType
+	}
+	if head.Owner != s.fromaddr {
+		return nil, ety.ErrChunkOwner
+	}
+	if payload.Index < 0 || payload.Index >= head.ChunkCount {
+		return nil, ety.ErrChunkParam
+	}
+	_, err = QueryChunkFromLocalDB(s.localdb, payload.Key, payload.Index)
+	if err != types.ErrNotFound {
+		return nil, ety.ErrChunkExisted
+	}
+	if !ety.VerifyChunkProof(head.MerkleRoot, payload.Content, payload.Index, head.ChunkCount, payload.Proof) {
+		return nil, ety.ErrChunkProof
+	}
+	head.UploadedCount++
+	head.UploadedSize += int64(len(payload.Content))
+	//剩余的每个分片至少1个字节，上传每个分片时检查，尽早发现声明的总长度有误
+	if head.UploadedSize+int64(head.ChunkCount-head.UploadedCount) > head.TotalSize ||
+		head.UploadedCount == head.ChunkCount && head.UploadedSize != head.TotalSize {
+		return nil, ety.ErrChunkSize
+	}
+	var logs []*types.ReceiptLog
+	logs = append(logs, &types.ReceiptLog{Ty: ety.TyChunkDataStorageLog, Log: types.Encode(payload)})
+	logs = append(logs, &types.ReceiptLog{Ty: ety.TyChunkStorageLog, Log: types.Encode(storage)})
+	return &types.Receipt{Ty: types.ExecOk, Logs: logs}, nil
+}
+
+//ChunkResetStorage 重新声明分片存证的总长度，创建时填错总长度会导致分片无法全部上传，由创建者在上传完成之前纠正
+func (s *StorageAction) ChunkResetStorage(payload *ety.ChunkResetStorage) (*types.Receipt, error) {
+	storage, err := QueryStorageFromLocalDB(s.localdb, payload.Key)
+	if err != nil {
+		return nil, err
+	}
+	head := storage.GetChunkStorage()
+	if storage.Ty != ety.TyChunkStorageAction || head == nil {
+		return nil, ety.ErrStorageType
+	}
+	if head.Owner != s.fromaddr {
+		return nil, ety.ErrChunkOwner
+	}
+	if head.UploadedCount == head.ChunkCount {
+		return nil, ety.ErrChunkCompleted
+	}
+	if payload.TotalSize == head.TotalSize {
+		return nil, ety.ErrChunkParam
+	}
+	if payload.TotalSize < head.UploadedSize+int64(head.ChunkCount-head.UploadedCount) {
+		return nil, ety.ErrChunkSize
+	}
+	head.TotalSize = payload.TotalSize
+	log := &types.ReceiptLog{Ty: ety.TyChunkStorageLog, Log: types.Encode(storage)}
+	return &types.Receipt{Ty: types.ExecOk, Logs: []*types.ReceiptLog{log}}, nil
+}
+
 func QueryStorageByTxHash(db dbm.KV, txhash string) (*ety.Storage, error) {
 	data, err := db.Get(Key(txhash))
 	if err != nil {
@@ -231,3 +388,124 @@ func QueryStorageFromLocalDB(localdb dbm.KV, key string) (*ety.Storage, error) {
 	}
 	return &storage, nil
 }
+
+//QueryChunkFromLocalDB 查询分片存证中指定序号的分片
+func QueryChunkFromLocalDB(localdb dbm.KV, key string, index int32) (*ety.ChunkDataStorage, error) {
+	data, err := localdb.Get(getChunkLocalDBKey(key, index))
+	if err != nil {
+		return nil, err
+	}
+	var chunk ety.ChunkDataStorage
+	err = types.Decode(data, &chunk)
+	if err != nil {
+		return nil, err
+	}
+	return &chunk, nil
+}
+
+func queryChunkHead(localdb dbm.KV, key string) (*ety.ChunkNotaryStorage, error) {
+	storage, err := QueryStorageFromLocalDB(localdb, key)
+	if err != nil {
+		return nil, err
+	}
+	if storage.Ty != ety.TyChunkStorageAction || storage.GetChunkStorage() == nil {
+		return nil, ety.ErrStorageType
+	}
+	return storage.GetChunkStorage(), nil
+}
+
+//QueryChunkStorage 从startIndex开始按分片序号分页拼接分片存证的内容，分片没有全部上传时返回错误
+func QueryChunkStorage(localdb dbm.KV, in *ety.QueryChunkStorage) (types.Message, error) {
+	head, err := queryChunkHead(localdb, in.Key)
+	if err != nil {
+		return nil, err
+	}
+	if head.UploadedCount != head.ChunkCount {
+		return nil, ety.ErrChunkIncomplete
+	}
+	if in.StartIndex < 0 || in.StartIndex >= head.ChunkCount {
+		return nil, ety.ErrChunkParam
+	}
+	count := in.Count
+	if count <= 0 || count > ety.MaxChunkQueryCount {
+		count = ety.MaxChunkQueryCount
+	}
+	end := in.StartIndex + count
+	if end > head.ChunkCount {
+		end = head.ChunkCount
+	}
+	var content []byte
+	for index := in.StartIndex; index < end; index++ {
+		chunk, err := QueryChunkFromLocalDB(localdb, in.Key, index)
+		if err != nil {
+			elog.Error("QueryChunkStorage", "key", in.Key, "index", index, "err", err)
+			return nil, err
+		}
+		content = append(content, chunk.Content...)
+	}
+	return &ety.ReplyChunkStorage{ChunkStorage: head, Content: content, NextIndex: end}, nil
+}
+
+//QueryChunkProof 查询指定分片的内容以及merkle证明，用于单独校验某个分片
+func QueryChunkProof(localdb dbm.KV, in *ety.QueryChunkProof) (types.Message, error) {
+	head, err := queryChunkHead(localdb, in.Key)
+	if err != nil {
+		return nil, err
+	}
+	chunk, err := QueryChunkFromLocalDB(localdb, in.Key, in.Index)
+	if err != nil {
+		return nil, err
+	}
+	return &ety.ReplyChunkProof{MerkleRoot: head.MerkleRoot, ChunkCount: head.ChunkCount, Chunk: chunk}, nil
+}
+
+//ListStorageIndex 按指定的索引分页查询存证记录，primary为上一页最后一条记录的主键
+func ListStorageIndex(localdb dbm.KVDB, indexName, value string, in *ety.ReqListStorage) (types.Message, error) {
+	if value == "" || in.Count < 0 || in.Count > ety.MaxListCount {
+		return nil, types.ErrInvalidParam
+	}
+	count := in.Count
+	if count == 0 {
+		count = ety.MaxListCount
+	}
+	var primary []byte
+	if in.Primary != "" {
+		primary = []byte(in.Primary)
+	}
+	query := NewIndexTable(localdb).GetQuery(localdb)
+	rows, err := query.ListIndex(indexName, calcIndexPrefix(value), primary, count, in.Direction)
+	if err != nil {
+		elog.Debug("ListStorageIndex", "index", indexName, "value", value, "err", err)
+		return nil, err
+	}
+	reply := &ety.ReplyListStorage{}
+	for _, row := range rows {
+		reply.Indexes = append(reply.Indexes, row.Data.(*ety.StorageIndex))
+	}
+	reply.Primary = string(rows[len(rows)-1].Primary)
+	return reply, nil
+}
+
+//VerifyContentHash 查询内容哈希最早的存证记录，并返回交易所在区块的高度和交易的merkle证明
+func VerifyContentHash(api client.QueueProtocolAPI, localdb dbm.KVDB, in *ety.ReqVerifyContentHash) (types.Message, error) {
+	hash, err := common.FromHex(in.ContentHash)
+	if err != nil || len(hash) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	query := NewIndexTable(localdb).GetQuery(localdb)
+	rows, err := query.ListIndex("contentHash", calcIndexPrefix(common.ToHex(hash)), nil, 1, dbm.ListASC)
+	if err != nil {
+		return nil, err
+	}
+	index := rows[0].Data.(*ety.StorageIndex)
+	txHash, err := common.FromHex(index.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	detail, err := api.QueryTx(&types.ReqHash{Hash: txHash})
+	if err != nil {
+		elog.Error("VerifyContentHash", "txHash", index.TxHash, "err", err)
+		return nil, err
+	}
+	return &ety.ReplyVerifyContentHash{Index: index, Proofs: detail.Proofs}, nil
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/merkle"
+)
+
+/*
+ * 分片存证的merkle树计算
+ * 叶子节点为分片内容的sha256哈希，按分片序号排列，非叶子节点的计算与区块交易的merkle树一致
+ * 客户端使用SplitChunks拆分文件，ChunkMerkleRoot计算树根，ChunkMerkleProof生成每个分片的证明路径
+ */
+
+//SplitChunks 按指定长度拆分文件内容
+func SplitChunks(content []byte, chunkSize int) [][]byte {
+	if chunkSize <= 0 {
+		return nil
+	}
+	var chunks [][]byte
+	for start := 0; start < len(content); start += chunkSize {
+		end := start + chunkSize
+		if end > len(content) {
+			end = len(content)
+		}
+		chunks = append(chunks, content[start:end])
+	}
+	return chunks
+}
+
+func chunkLeaves(chunks [][]byte) [][]byte {
+	leaves := make([][]byte, len(chunks))
+	for i, chunk := range chunks {
+		leaves[i] = common.Sha256(chunk)
+	}
+	return leaves
+}
+
+//ChunkMerkleRoot 计算所有分片构成的merkle树根
+func ChunkMerkleRoot(chunks [][]byte) []byte {
+	if len(chunks) == 0 {
+		return nil
+	}
+	root, _, _ := merkle.Computation(chunkLeaves(chunks), 1, 0)
+	return root
+}
+
+//ChunkMerkleProof 生成指定分片的merkle证明路径
+func ChunkMerkleProof(chunks [][]byte, index int32) [][]byte {
+	if index < 0 || int(index) >= len(chunks) {
+		return nil
+	}
+	return merkle.GetMerkleBranch(chunkLeaves(chunks), uint32(index))
+}
+
+//证明路径的长度等于merkle树的高度，避免用中间节点冒充分片
+func chunkProofDepth(chunkCount int32) int {
+	depth := 0
+	for n := int32(1); n < chunkCount; n <<= 1 {
+		depth++
+	}
+	return depth
+}
+
+//VerifyChunkProof 校验分片内容是否属于merkleRoot对应的文件
+func VerifyChunkProof(merkleRoot, content []byte, index, chunkCount int32, proof [][]byte) bool {
+	if index < 0 || index >= chunkCount || len(proof) != chunkProofDepth(chunkCount) {
+		return false
+	}
+	for _, branch := range proof {
+		if len(branch) != 32 {
+			return false
+		}
+	}
+	root := merkle.GetMerkleRootFromBranch(proof, common.Sha256(content), uint32(index))
+	return bytes.Equal(root, merkleRoot)
+}
@@ -6,4 +6,16 @@ import "fmt"
 var (
 	ErrKeyExisted  = fmt.Errorf("%s", "The key has already existed!")
 	ErrStorageType = fmt.Errorf("%s", "The key has used storage another type!")
+	//分片存证相关错误
+	ErrChunkParam      = fmt.Errorf("%s", "The chunk storage param is invalid!")
+	ErrChunkOwner      = fmt.Errorf("%s", "Only the creator can upload chunks!")
+	ErrChunkExisted    = fmt.Errorf("%s", "The chunk has already uploaded!")
+	ErrChunkProof      = fmt.Errorf("%s", "The chunk merkle proof is invalid!")
+	ErrChunkSize       = fmt.Errorf("%s", "The chunk size does not match the declared total size!")
+	ErrChunkIncomplete = fmt.Errorf("%s", "The chunk storage has not been fully uploaded!")
+	ErrChunkCompleted  = fmt.Errorf("%s", "The chunk storage has already been fully uploaded!")
+	//隐私分享存证相关错误
+	ErrEnvelopeParam   = fmt.Errorf("%s", "The key envelope param is invalid!")
+	ErrEnvelopeOwner   = fmt.Errorf("%s", "Only the creator can add recipients!")
+	ErrEnvelopeExisted = fmt.Errorf("%s", "The recipient has already existed!")
 )
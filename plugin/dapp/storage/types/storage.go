@@ -20,15 +20,29 @@ const (
 	TyLinkStorageAction
 	TyEncryptStorageAction
 	TyEncryptShareStorageAction
+	TyChunkStorageAction
+	TyChunkDataStorageAction
+	TyChunkResetStorageAction
 
 	NameContentStorageAction      = "ContentStorage"
 	NameHashStorageAction         = "HashStorage"
 	NameLinkStorageAction         = "LinkStorage"
 	NameEncryptStorageAction      = "EncryptStorage"
 	NameEncryptShareStorageAction = "EncryptShareStorage"
+	NameChunkStorageAction        = "ChunkStorage"
+	NameChunkDataStorageAction    = "ChunkDataStorage"
+	NameChunkResetStorageAction   = "ChunkResetStorage"
 
 	FuncNameQueryStorage      = "QueryStorage"
 	FuncNameBatchQueryStorage = "BatchQueryStorage"
+	FuncNameQueryChunkStorage = "QueryChunkStorage"
+	FuncNameQueryChunkProof   = "QueryChunkProof"
+
+	FuncNameQueryStorageByKey        = "QueryStorageByKey"
+	FuncNameListStorageByKey         = "ListStorageByKey"
+	FuncNameListStorageByContentHash = "ListStorageByContentHash"
+	FuncNameListStorageBySender      = "ListStorageBySender"
+	FuncNameVerifyContentHash        = "VerifyContentHash"
 )
 
 // log类型id值
@@ -39,6 +53,8 @@ const (
 	TyLinkStorageLog
 	TyEncryptStorageLog
 	TyEncryptShareStorageLog
+	TyChunkStorageLog
+	TyChunkDataStorageLog
 )
 
 const (
@@ -46,8 +62,20 @@ const (
 	OpAdd
 )
 
+//MaxChunkCount 分片存证最多支持的分片数量
+const MaxChunkCount = 10000
+
+//MaxChunkQueryCount 分页查询分片存证内容时每页最多返回的分片数量
+const MaxChunkQueryCount = 100
+
+//MaxListCount 分页查询存证索引时每页最多返回的记录数
+const MaxListCount = 100
+
 var (
-	ForkStorageLocalDB = "ForkStorageLocalDB"
+	ForkStorageLocalDB  = "ForkStorageLocalDB"
+	ForkStorageChunk    = "ForkStorageChunk"
+	ForkStorageEnvelope = "ForkStorageEnvelope"
+	ForkStorageIndex    = "ForkStorageIndex"
 )
 var (
 	//StorageX 执行器名称定义
@@ -59,6 +87,9 @@ var (
 		NameLinkStorageAction:         TyLinkStorageAction,
 		NameEncryptStorageAction:      TyEncryptStorageAction,
 		NameEncryptShareStorageAction: TyEncryptShareStorageAction,
+		NameChunkStorageAction:        TyChunkStorageAction,
+		NameChunkDataStorageAction:    TyChunkDataStorageAction,
+		NameChunkResetStorageAction:   TyChunkResetStorageAction,
 	}
 	//定义log的id和具体log类型及名称，填入具体自定义log类型
 	logMap = map[int64]*types.LogInfo{
@@ -67,6 +98,8 @@ var (
 		TyLinkStorageLog:         {Ty: reflect.TypeOf(Storage{}), Name: "LogLinkStorage"},
 		TyEncryptStorageLog:      {Ty: reflect.TypeOf(Storage{}), Name: "LogEncryptStorage"},
 		TyEncryptShareStorageLog: {Ty: reflect.TypeOf(Storage{}), Name: "LogEncryptShareStorage"},
+		TyChunkStorageLog:        {Ty: reflect.TypeOf(Storage{}), Name: "LogChunkStorage"},
+		TyChunkDataStorageLog:    {Ty: reflect.TypeOf(ChunkDataStorage{}), Name: "LogChunkDataStorage"},
 	}
 )
 
@@ -82,6 +115,9 @@ func init() {
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(StorageX, "Enable", 0)
 	cfg.RegisterDappFork(StorageX, ForkStorageLocalDB, 0)
+	cfg.RegisterDappFork(StorageX, ForkStorageChunk, 4600000)
+	cfg.RegisterDappFork(StorageX, ForkStorageEnvelope, 4600000)
+	cfg.RegisterDappFork(StorageX, ForkStorageIndex, 0)
 }
 
 // InitExecutor defines register executor
@@ -31,6 +31,7 @@ type Storage struct {
 	//	*Storage_LinkStorage
 	//	*Storage_EncryptStorage
 	//	*Storage_EncryptShareStorage
+	//	*Storage_ChunkStorage
 	Value                isStorage_Value `protobuf_oneof:"value"`
 	Ty                   int32           `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -87,6 +88,10 @@ type Storage_EncryptShareStorage struct {
 	EncryptShareStorage *EncryptShareNotaryStorage `protobuf:"bytes,5,opt,name=encryptShareStorage,proto3,oneof"`
 }
 
+type Storage_ChunkStorage struct {
+	ChunkStorage *ChunkNotaryStorage `protobuf:"bytes,7,opt,name=chunkStorage,proto3,oneof"`
+}
+
 func (*Storage_ContentStorage) isStorage_Value() {}
 
 func (*Storage_HashStorage) isStorage_Value() {}
@@ -97,6 +102,8 @@ func (*Storage_EncryptStorage) isStorage_Value() {}
 
 func (*Storage_EncryptShareStorage) isStorage_Value() {}
 
+func (*Storage_ChunkStorage) isStorage_Value() {}
+
 func (m *Storage) GetValue() isStorage_Value {
 	if m != nil {
 		return m.Value
@@ -139,6 +146,13 @@ func (m *Storage) GetEncryptShareStorage() *EncryptShareNotaryStorage {
 	return nil
 }
 
+func (m *Storage) GetChunkStorage() *ChunkNotaryStorage {
+	if x, ok := m.GetValue().(*Storage_ChunkStorage); ok {
+		return x.ChunkStorage
+	}
+	return nil
+}
+
 func (m *Storage) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -154,6 +168,7 @@ func (*Storage) XXX_OneofWrappers() []interface{} {
 		(*Storage_LinkStorage)(nil),
 		(*Storage_EncryptStorage)(nil),
 		(*Storage_EncryptShareStorage)(nil),
+		(*Storage_ChunkStorage)(nil),
 	}
 }
 
@@ -164,6 +179,9 @@ type StorageAction struct {
 	//	*StorageAction_LinkStorage
 	//	*StorageAction_EncryptStorage
 	//	*StorageAction_EncryptShareStorage
+	//	*StorageAction_ChunkStorage
+	//	*StorageAction_ChunkDataStorage
+	//	*StorageAction_ChunkResetStorage
 	Value                isStorageAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                 `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -220,6 +238,18 @@ type StorageAction_EncryptShareStorage struct {
 	EncryptShareStorage *EncryptShareNotaryStorage `protobuf:"bytes,5,opt,name=encryptShareStorage,proto3,oneof"`
 }
 
+type StorageAction_ChunkStorage struct {
+	ChunkStorage *ChunkNotaryStorage `protobuf:"bytes,7,opt,name=chunkStorage,proto3,oneof"`
+}
+
+type StorageAction_ChunkDataStorage struct {
+	ChunkDataStorage *ChunkDataStorage `protobuf:"bytes,8,opt,name=chunkDataStorage,proto3,oneof"`
+}
+
+type StorageAction_ChunkResetStorage struct {
+	ChunkResetStorage *ChunkResetStorage `protobuf:"bytes,9,opt,name=chunkResetStorage,proto3,oneof"`
+}
+
 func (*StorageAction_ContentStorage) isStorageAction_Value() {}
 
 func (*StorageAction_HashStorage) isStorageAction_Value() {}
@@ -230,6 +260,12 @@ func (*StorageAction_EncryptStorage) isStorageAction_Value() {}
 
 func (*StorageAction_EncryptShareStorage) isStorageAction_Value() {}
 
+func (*StorageAction_ChunkStorage) isStorageAction_Value() {}
+
+func (*StorageAction_ChunkDataStorage) isStorageAction_Value() {}
+
+func (*StorageAction_ChunkResetStorage) isStorageAction_Value() {}
+
 func (m *StorageAction) GetValue() isStorageAction_Value {
 	if m != nil {
 		return m.Value
@@ -272,6 +308,27 @@ func (m *StorageAction) GetEncryptShareStorage() *EncryptShareNotaryStorage {
 	return nil
 }
 
+func (m *StorageAction) GetChunkStorage() *ChunkNotaryStorage {
+	if x, ok := m.GetValue().(*StorageAction_ChunkStorage); ok {
+		return x.ChunkStorage
+	}
+	return nil
+}
+
+func (m *StorageAction) GetChunkDataStorage() *ChunkDataStorage {
+	if x, ok := m.GetValue().(*StorageAction_ChunkDataStorage); ok {
+		return x.ChunkDataStorage
+	}
+	return nil
+}
+
+func (m *StorageAction) GetChunkResetStorage() *ChunkResetStorage {
+	if x, ok := m.GetValue().(*StorageAction_ChunkResetStorage); ok {
+		return x.ChunkResetStorage
+	}
+	return nil
+}
+
 func (m *StorageAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -287,6 +344,9 @@ func (*StorageAction) XXX_OneofWrappers() []interface{} {
 		(*StorageAction_LinkStorage)(nil),
 		(*StorageAction_EncryptStorage)(nil),
 		(*StorageAction_EncryptShareStorage)(nil),
+		(*StorageAction_ChunkStorage)(nil),
+		(*StorageAction_ChunkDataStorage)(nil),
+		(*StorageAction_ChunkResetStorage)(nil),
 	}
 }
 
@@ -573,7 +633,17 @@ type EncryptShareNotaryStorage struct {
 	//自定义的主键，可以为空，如果没传，则用txhash为key
 	Key string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
 	//字符串值
-	Value                string   `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	//对称加密算法，aes-gcm或者sm4-gcm，为空时表示直接用pubKey加密
+	Cipher string `protobuf:"bytes,6,opt,name=cipher,proto3" json:"cipher,omitempty"`
+	//对称加密的nonce，GCM模式固定12字节
+	Nonce []byte `protobuf:"bytes,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	//内容密钥分别用每个接收者公钥加密后的信封
+	Envelopes []*KeyEnvelope `protobuf:"bytes,8,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
+	// Op 0表示创建 1表示追加接收者信封
+	Op int32 `protobuf:"varint,9,opt,name=op,proto3" json:"op,omitempty"`
+	//创建者地址，只有创建者可以追加接收者，由合约填写
+	Owner                string   `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -639,6 +709,322 @@ func (m *EncryptShareNotaryStorage) GetValue() string {
 	return ""
 }
 
+func (m *EncryptShareNotaryStorage) GetCipher() string {
+	if m != nil {
+		return m.Cipher
+	}
+	return ""
+}
+
+func (m *EncryptShareNotaryStorage) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *EncryptShareNotaryStorage) GetEnvelopes() []*KeyEnvelope {
+	if m != nil {
+		return m.Envelopes
+	}
+	return nil
+}
+
+func (m *EncryptShareNotaryStorage) GetOp() int32 {
+	if m != nil {
+		return m.Op
+	}
+	return 0
+}
+
+func (m *EncryptShareNotaryStorage) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// 内容密钥信封，接收者用自己的私钥解开信封得到内容密钥
+type KeyEnvelope struct {
+	//接收者公钥
+	PubKey []byte `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	//公钥类型，与签名类型一致，1:secp256k1 3:sm2
+	KeyType int32 `protobuf:"varint,2,opt,name=keyType,proto3" json:"keyType,omitempty"`
+	//用接收者公钥加密后的内容密钥
+	EncryptedKey         []byte   `protobuf:"bytes,3,opt,name=encryptedKey,proto3" json:"encryptedKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyEnvelope) Reset()         { *m = KeyEnvelope{} }
+func (m *KeyEnvelope) String() string { return proto.CompactTextString(m) }
+func (*KeyEnvelope) ProtoMessage()    {}
+func (*KeyEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{7}
+}
+
+func (m *KeyEnvelope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyEnvelope.Unmarshal(m, b)
+}
+func (m *KeyEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyEnvelope.Marshal(b, m, deterministic)
+}
+func (m *KeyEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyEnvelope.Merge(m, src)
+}
+func (m *KeyEnvelope) XXX_Size() int {
+	return xxx_messageInfo_KeyEnvelope.Size(m)
+}
+func (m *KeyEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyEnvelope proto.InternalMessageInfo
+
+func (m *KeyEnvelope) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *KeyEnvelope) GetKeyType() int32 {
+	if m != nil {
+		return m.KeyType
+	}
+	return 0
+}
+
+func (m *KeyEnvelope) GetEncryptedKey() []byte {
+	if m != nil {
+		return m.EncryptedKey
+	}
+	return nil
+}
+
+// 分片存证模型，大文件拆分成多个分片分别上链，所有分片的sha256哈希按顺序构成merkle树
+type ChunkNotaryStorage struct {
+	//自定义的主键，可以为空，如果没传，则用txhash为key
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	//所有分片哈希构成的merkle树根，长度固定为32字节
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkleRoot,proto3" json:"merkleRoot,omitempty"`
+	//分片数量
+	ChunkCount int32 `protobuf:"varint,3,opt,name=chunkCount,proto3" json:"chunkCount,omitempty"`
+	//文件总长度
+	TotalSize int64 `protobuf:"varint,4,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
+	//字符串值
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	//创建者地址，只有创建者可以上传分片，由合约填写
+	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	//已经上传的分片数量，由合约更新
+	UploadedCount int32 `protobuf:"varint,7,opt,name=uploadedCount,proto3" json:"uploadedCount,omitempty"`
+	//已经上传的分片总长度，由合约更新
+	UploadedSize         int64    `protobuf:"varint,8,opt,name=uploadedSize,proto3" json:"uploadedSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChunkNotaryStorage) Reset()         { *m = ChunkNotaryStorage{} }
+func (m *ChunkNotaryStorage) String() string { return proto.CompactTextString(m) }
+func (*ChunkNotaryStorage) ProtoMessage()    {}
+func (*ChunkNotaryStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{8}
+}
+
+func (m *ChunkNotaryStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChunkNotaryStorage.Unmarshal(m, b)
+}
+func (m *ChunkNotaryStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChunkNotaryStorage.Marshal(b, m, deterministic)
+}
+func (m *ChunkNotaryStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkNotaryStorage.Merge(m, src)
+}
+func (m *ChunkNotaryStorage) XXX_Size() int {
+	return xxx_messageInfo_ChunkNotaryStorage.Size(m)
+}
+func (m *ChunkNotaryStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkNotaryStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkNotaryStorage proto.InternalMessageInfo
+
+func (m *ChunkNotaryStorage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ChunkNotaryStorage) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *ChunkNotaryStorage) GetChunkCount() int32 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *ChunkNotaryStorage) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ChunkNotaryStorage) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ChunkNotaryStorage) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ChunkNotaryStorage) GetUploadedCount() int32 {
+	if m != nil {
+		return m.UploadedCount
+	}
+	return 0
+}
+
+func (m *ChunkNotaryStorage) GetUploadedSize() int64 {
+	if m != nil {
+		return m.UploadedSize
+	}
+	return 0
+}
+
+// 分片内容，每个分片需要附带merkle证明路径
+type ChunkDataStorage struct {
+	//分片存证的主键
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	//分片序号，从0开始
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	//分片内容
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	//分片哈希到merkle树根的证明路径
+	Proof                [][]byte `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChunkDataStorage) Reset()         { *m = ChunkDataStorage{} }
+func (m *ChunkDataStorage) String() string { return proto.CompactTextString(m) }
+func (*ChunkDataStorage) ProtoMessage()    {}
+func (*ChunkDataStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{9}
+}
+
+func (m *ChunkDataStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChunkDataStorage.Unmarshal(m, b)
+}
+func (m *ChunkDataStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChunkDataStorage.Marshal(b, m, deterministic)
+}
+func (m *ChunkDataStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkDataStorage.Merge(m, src)
+}
+func (m *ChunkDataStorage) XXX_Size() int {
+	return xxx_messageInfo_ChunkDataStorage.Size(m)
+}
+func (m *ChunkDataStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkDataStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkDataStorage proto.InternalMessageInfo
+
+func (m *ChunkDataStorage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ChunkDataStorage) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ChunkDataStorage) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *ChunkDataStorage) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// 重新声明分片存证的文件总长度，只能由创建者在分片全部上传之前修改，用于纠正创建时填错的总长度
+type ChunkResetStorage struct {
+	//分片存证的主键
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	//新的文件总长度
+	TotalSize            int64    `protobuf:"varint,2,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChunkResetStorage) Reset()         { *m = ChunkResetStorage{} }
+func (m *ChunkResetStorage) String() string { return proto.CompactTextString(m) }
+func (*ChunkResetStorage) ProtoMessage()    {}
+func (*ChunkResetStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{10}
+}
+
+func (m *ChunkResetStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChunkResetStorage.Unmarshal(m, b)
+}
+func (m *ChunkResetStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChunkResetStorage.Marshal(b, m, deterministic)
+}
+func (m *ChunkResetStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkResetStorage.Merge(m, src)
+}
+func (m *ChunkResetStorage) XXX_Size() int {
+	return xxx_messageInfo_ChunkResetStorage.Size(m)
+}
+func (m *ChunkResetStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkResetStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkResetStorage proto.InternalMessageInfo
+
+func (m *ChunkResetStorage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ChunkResetStorage) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
 //根据txhash去状态数据库中查询存储内容
 type QueryStorage struct {
 	TxHash               string   `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
@@ -651,7 +1037,7 @@ func (m *QueryStorage) Reset()         { *m = QueryStorage{} }
 func (m *QueryStorage) String() string { return proto.CompactTextString(m) }
 func (*QueryStorage) ProtoMessage()    {}
 func (*QueryStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{7}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{11}
 }
 
 func (m *QueryStorage) XXX_Unmarshal(b []byte) error {
@@ -691,7 +1077,7 @@ func (m *BatchQueryStorage) Reset()         { *m = BatchQueryStorage{} }
 func (m *BatchQueryStorage) String() string { return proto.CompactTextString(m) }
 func (*BatchQueryStorage) ProtoMessage()    {}
 func (*BatchQueryStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{8}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{12}
 }
 
 func (m *BatchQueryStorage) XXX_Unmarshal(b []byte) error {
@@ -730,7 +1116,7 @@ func (m *BatchReplyStorage) Reset()         { *m = BatchReplyStorage{} }
 func (m *BatchReplyStorage) String() string { return proto.CompactTextString(m) }
 func (*BatchReplyStorage) ProtoMessage()    {}
 func (*BatchReplyStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{9}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{13}
 }
 
 func (m *BatchReplyStorage) XXX_Unmarshal(b []byte) error {
@@ -768,7 +1154,7 @@ func (m *ReceiptStorage) Reset()         { *m = ReceiptStorage{} }
 func (m *ReceiptStorage) String() string { return proto.CompactTextString(m) }
 func (*ReceiptStorage) ProtoMessage()    {}
 func (*ReceiptStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{10}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{14}
 }
 
 func (m *ReceiptStorage) XXX_Unmarshal(b []byte) error {
@@ -789,6 +1175,572 @@ func (m *ReceiptStorage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReceiptStorage proto.InternalMessageInfo
 
+// 根据key查询分片存证并拼接所有分片的内容
+type QueryChunkStorage struct {
+	Key        string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	StartIndex int32  `protobuf:"varint,2,opt,name=startIndex,proto3" json:"startIndex,omitempty"`
+	//为0或者超过上限时使用上限MaxChunkQueryCount
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryChunkStorage) Reset()         { *m = QueryChunkStorage{} }
+func (m *QueryChunkStorage) String() string { return proto.CompactTextString(m) }
+func (*QueryChunkStorage) ProtoMessage()    {}
+func (*QueryChunkStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{15}
+}
+
+func (m *QueryChunkStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryChunkStorage.Unmarshal(m, b)
+}
+func (m *QueryChunkStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryChunkStorage.Marshal(b, m, deterministic)
+}
+func (m *QueryChunkStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChunkStorage.Merge(m, src)
+}
+func (m *QueryChunkStorage) XXX_Size() int {
+	return xxx_messageInfo_QueryChunkStorage.Size(m)
+}
+func (m *QueryChunkStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChunkStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChunkStorage proto.InternalMessageInfo
+
+func (m *QueryChunkStorage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *QueryChunkStorage) GetStartIndex() int32 {
+	if m != nil {
+		return m.StartIndex
+	}
+	return 0
+}
+
+func (m *QueryChunkStorage) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyChunkStorage struct {
+	ChunkStorage *ChunkNotaryStorage `protobuf:"bytes,1,opt,name=chunkStorage,proto3" json:"chunkStorage,omitempty"`
+	Content      []byte              `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	//下一页的起始分片序号，等于分片数量时表示已经读取完毕
+	NextIndex            int32    `protobuf:"varint,3,opt,name=nextIndex,proto3" json:"nextIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyChunkStorage) Reset()         { *m = ReplyChunkStorage{} }
+func (m *ReplyChunkStorage) String() string { return proto.CompactTextString(m) }
+func (*ReplyChunkStorage) ProtoMessage()    {}
+func (*ReplyChunkStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{16}
+}
+
+func (m *ReplyChunkStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyChunkStorage.Unmarshal(m, b)
+}
+func (m *ReplyChunkStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyChunkStorage.Marshal(b, m, deterministic)
+}
+func (m *ReplyChunkStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyChunkStorage.Merge(m, src)
+}
+func (m *ReplyChunkStorage) XXX_Size() int {
+	return xxx_messageInfo_ReplyChunkStorage.Size(m)
+}
+func (m *ReplyChunkStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyChunkStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyChunkStorage proto.InternalMessageInfo
+
+func (m *ReplyChunkStorage) GetChunkStorage() *ChunkNotaryStorage {
+	if m != nil {
+		return m.ChunkStorage
+	}
+	return nil
+}
+
+func (m *ReplyChunkStorage) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *ReplyChunkStorage) GetNextIndex() int32 {
+	if m != nil {
+		return m.NextIndex
+	}
+	return 0
+}
+
+// 根据key和分片序号查询分片内容及merkle证明
+type QueryChunkProof struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Index                int32    `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryChunkProof) Reset()         { *m = QueryChunkProof{} }
+func (m *QueryChunkProof) String() string { return proto.CompactTextString(m) }
+func (*QueryChunkProof) ProtoMessage()    {}
+func (*QueryChunkProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{17}
+}
+
+func (m *QueryChunkProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryChunkProof.Unmarshal(m, b)
+}
+func (m *QueryChunkProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryChunkProof.Marshal(b, m, deterministic)
+}
+func (m *QueryChunkProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChunkProof.Merge(m, src)
+}
+func (m *QueryChunkProof) XXX_Size() int {
+	return xxx_messageInfo_QueryChunkProof.Size(m)
+}
+func (m *QueryChunkProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChunkProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChunkProof proto.InternalMessageInfo
+
+func (m *QueryChunkProof) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *QueryChunkProof) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ReplyChunkProof struct {
+	MerkleRoot           []byte            `protobuf:"bytes,1,opt,name=merkleRoot,proto3" json:"merkleRoot,omitempty"`
+	ChunkCount           int32             `protobuf:"varint,2,opt,name=chunkCount,proto3" json:"chunkCount,omitempty"`
+	Chunk                *ChunkDataStorage `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReplyChunkProof) Reset()         { *m = ReplyChunkProof{} }
+func (m *ReplyChunkProof) String() string { return proto.CompactTextString(m) }
+func (*ReplyChunkProof) ProtoMessage()    {}
+func (*ReplyChunkProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{18}
+}
+
+func (m *ReplyChunkProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyChunkProof.Unmarshal(m, b)
+}
+func (m *ReplyChunkProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyChunkProof.Marshal(b, m, deterministic)
+}
+func (m *ReplyChunkProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyChunkProof.Merge(m, src)
+}
+func (m *ReplyChunkProof) XXX_Size() int {
+	return xxx_messageInfo_ReplyChunkProof.Size(m)
+}
+func (m *ReplyChunkProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyChunkProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyChunkProof proto.InternalMessageInfo
+
+func (m *ReplyChunkProof) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *ReplyChunkProof) GetChunkCount() int32 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *ReplyChunkProof) GetChunk() *ChunkDataStorage {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+//根据自定义的key查询存证
+type QueryStorageByKey struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryStorageByKey) Reset()         { *m = QueryStorageByKey{} }
+func (m *QueryStorageByKey) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageByKey) ProtoMessage()    {}
+func (*QueryStorageByKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{19}
+}
+
+func (m *QueryStorageByKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryStorageByKey.Unmarshal(m, b)
+}
+func (m *QueryStorageByKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryStorageByKey.Marshal(b, m, deterministic)
+}
+func (m *QueryStorageByKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageByKey.Merge(m, src)
+}
+func (m *QueryStorageByKey) XXX_Size() int {
+	return xxx_messageInfo_QueryStorageByKey.Size(m)
+}
+func (m *QueryStorageByKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageByKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageByKey proto.InternalMessageInfo
+
+func (m *QueryStorageByKey) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// 存证索引，按key、内容哈希和发送者分别建立索引，每笔存证交易一条记录
+type StorageIndex struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TxHash               string   `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	ContentHash          []byte   `protobuf:"bytes,3,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	Sender               string   `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	Ty                   int32    `protobuf:"varint,5,opt,name=ty,proto3" json:"ty,omitempty"`
+	Height               int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
+	BlockTime            int64    `protobuf:"varint,8,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageIndex) Reset()         { *m = StorageIndex{} }
+func (m *StorageIndex) String() string { return proto.CompactTextString(m) }
+func (*StorageIndex) ProtoMessage()    {}
+func (*StorageIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{20}
+}
+
+func (m *StorageIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageIndex.Unmarshal(m, b)
+}
+func (m *StorageIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageIndex.Marshal(b, m, deterministic)
+}
+func (m *StorageIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageIndex.Merge(m, src)
+}
+func (m *StorageIndex) XXX_Size() int {
+	return xxx_messageInfo_StorageIndex.Size(m)
+}
+func (m *StorageIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageIndex proto.InternalMessageInfo
+
+func (m *StorageIndex) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StorageIndex) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *StorageIndex) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+func (m *StorageIndex) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *StorageIndex) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *StorageIndex) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StorageIndex) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *StorageIndex) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+//分页查询存证索引，按查询函数分别使用key、contentHash(十六进制)或者sender，primary为上一页最后一条记录的主键
+type ReqListStorage struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ContentHash          string   `protobuf:"bytes,2,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	Sender               string   `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Primary              string   `protobuf:"bytes,4,opt,name=primary,proto3" json:"primary,omitempty"`
+	Count                int32    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqListStorage) Reset()         { *m = ReqListStorage{} }
+func (m *ReqListStorage) String() string { return proto.CompactTextString(m) }
+func (*ReqListStorage) ProtoMessage()    {}
+func (*ReqListStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{21}
+}
+
+func (m *ReqListStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqListStorage.Unmarshal(m, b)
+}
+func (m *ReqListStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqListStorage.Marshal(b, m, deterministic)
+}
+func (m *ReqListStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqListStorage.Merge(m, src)
+}
+func (m *ReqListStorage) XXX_Size() int {
+	return xxx_messageInfo_ReqListStorage.Size(m)
+}
+func (m *ReqListStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqListStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqListStorage proto.InternalMessageInfo
+
+func (m *ReqListStorage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ReqListStorage) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *ReqListStorage) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ReqListStorage) GetPrimary() string {
+	if m != nil {
+		return m.Primary
+	}
+	return ""
+}
+
+func (m *ReqListStorage) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqListStorage) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyListStorage struct {
+	Indexes              []*StorageIndex `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	Primary              string          `protobuf:"bytes,2,opt,name=primary,proto3" json:"primary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyListStorage) Reset()         { *m = ReplyListStorage{} }
+func (m *ReplyListStorage) String() string { return proto.CompactTextString(m) }
+func (*ReplyListStorage) ProtoMessage()    {}
+func (*ReplyListStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{22}
+}
+
+func (m *ReplyListStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyListStorage.Unmarshal(m, b)
+}
+func (m *ReplyListStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyListStorage.Marshal(b, m, deterministic)
+}
+func (m *ReplyListStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyListStorage.Merge(m, src)
+}
+func (m *ReplyListStorage) XXX_Size() int {
+	return xxx_messageInfo_ReplyListStorage.Size(m)
+}
+func (m *ReplyListStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyListStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyListStorage proto.InternalMessageInfo
+
+func (m *ReplyListStorage) GetIndexes() []*StorageIndex {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *ReplyListStorage) GetPrimary() string {
+	if m != nil {
+		return m.Primary
+	}
+	return ""
+}
+
+//根据内容哈希查询最早的存证记录及交易在区块中的merkle证明
+type ReqVerifyContentHash struct {
+	ContentHash          string   `protobuf:"bytes,1,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqVerifyContentHash) Reset()         { *m = ReqVerifyContentHash{} }
+func (m *ReqVerifyContentHash) String() string { return proto.CompactTextString(m) }
+func (*ReqVerifyContentHash) ProtoMessage()    {}
+func (*ReqVerifyContentHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{23}
+}
+
+func (m *ReqVerifyContentHash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqVerifyContentHash.Unmarshal(m, b)
+}
+func (m *ReqVerifyContentHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqVerifyContentHash.Marshal(b, m, deterministic)
+}
+func (m *ReqVerifyContentHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqVerifyContentHash.Merge(m, src)
+}
+func (m *ReqVerifyContentHash) XXX_Size() int {
+	return xxx_messageInfo_ReqVerifyContentHash.Size(m)
+}
+func (m *ReqVerifyContentHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqVerifyContentHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqVerifyContentHash proto.InternalMessageInfo
+
+func (m *ReqVerifyContentHash) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+type ReplyVerifyContentHash struct {
+	Index                *StorageIndex `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Proofs               [][]byte      `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReplyVerifyContentHash) Reset()         { *m = ReplyVerifyContentHash{} }
+func (m *ReplyVerifyContentHash) String() string { return proto.CompactTextString(m) }
+func (*ReplyVerifyContentHash) ProtoMessage()    {}
+func (*ReplyVerifyContentHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{24}
+}
+
+func (m *ReplyVerifyContentHash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyVerifyContentHash.Unmarshal(m, b)
+}
+func (m *ReplyVerifyContentHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyVerifyContentHash.Marshal(b, m, deterministic)
+}
+func (m *ReplyVerifyContentHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyVerifyContentHash.Merge(m, src)
+}
+func (m *ReplyVerifyContentHash) XXX_Size() int {
+	return xxx_messageInfo_ReplyVerifyContentHash.Size(m)
+}
+func (m *ReplyVerifyContentHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyVerifyContentHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyVerifyContentHash proto.InternalMessageInfo
+
+func (m *ReplyVerifyContentHash) GetIndex() *StorageIndex {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
+func (m *ReplyVerifyContentHash) GetProofs() [][]byte {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Storage)(nil), "types.Storage")
 	proto.RegisterType((*StorageAction)(nil), "types.StorageAction")
@@ -797,10 +1749,24 @@ func init() {
 	proto.RegisterType((*LinkNotaryStorage)(nil), "types.LinkNotaryStorage")
 	proto.RegisterType((*EncryptNotaryStorage)(nil), "types.EncryptNotaryStorage")
 	proto.RegisterType((*EncryptShareNotaryStorage)(nil), "types.EncryptShareNotaryStorage")
+	proto.RegisterType((*KeyEnvelope)(nil), "types.KeyEnvelope")
+	proto.RegisterType((*ChunkNotaryStorage)(nil), "types.ChunkNotaryStorage")
+	proto.RegisterType((*ChunkDataStorage)(nil), "types.ChunkDataStorage")
+	proto.RegisterType((*ChunkResetStorage)(nil), "types.ChunkResetStorage")
 	proto.RegisterType((*QueryStorage)(nil), "types.QueryStorage")
 	proto.RegisterType((*BatchQueryStorage)(nil), "types.BatchQueryStorage")
 	proto.RegisterType((*BatchReplyStorage)(nil), "types.BatchReplyStorage")
 	proto.RegisterType((*ReceiptStorage)(nil), "types.ReceiptStorage")
+	proto.RegisterType((*QueryChunkStorage)(nil), "types.QueryChunkStorage")
+	proto.RegisterType((*ReplyChunkStorage)(nil), "types.ReplyChunkStorage")
+	proto.RegisterType((*QueryChunkProof)(nil), "types.QueryChunkProof")
+	proto.RegisterType((*ReplyChunkProof)(nil), "types.ReplyChunkProof")
+	proto.RegisterType((*QueryStorageByKey)(nil), "types.QueryStorageByKey")
+	proto.RegisterType((*StorageIndex)(nil), "types.StorageIndex")
+	proto.RegisterType((*ReqListStorage)(nil), "types.ReqListStorage")
+	proto.RegisterType((*ReplyListStorage)(nil), "types.ReplyListStorage")
+	proto.RegisterType((*ReqVerifyContentHash)(nil), "types.ReqVerifyContentHash")
+	proto.RegisterType((*ReplyVerifyContentHash)(nil), "types.ReplyVerifyContentHash")
 }
 
 func init() {
@@ -808,38 +1774,76 @@ func init() {
 }
 
 var fileDescriptor_0d2c4ccf1453ffdb = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xc1, 0x8a, 0xd4, 0x40,
-	0x10, 0x35, 0xc9, 0x64, 0x62, 0x2a, 0xb3, 0x61, 0xb7, 0x5d, 0x97, 0x88, 0x82, 0x21, 0x87, 0x65,
-	0x10, 0x9c, 0xc3, 0x78, 0x15, 0xd4, 0x95, 0x85, 0x11, 0x45, 0xb1, 0xd7, 0x1f, 0xc8, 0x86, 0xc6,
-	0x0c, 0x13, 0xba, 0x43, 0xd2, 0x23, 0xe6, 0x17, 0x3c, 0xf9, 0x05, 0xde, 0xfc, 0x4f, 0x49, 0xa7,
-	0xba, 0x37, 0x99, 0x64, 0xc1, 0x83, 0xe0, 0x65, 0x6f, 0x5d, 0x5d, 0xaf, 0xde, 0x7b, 0x55, 0x95,
-	0xd0, 0x70, 0x54, 0x4b, 0x51, 0xa5, 0x5f, 0xd9, 0xaa, 0xac, 0x84, 0x14, 0xc4, 0x95, 0x4d, 0xc9,
-	0xea, 0xe4, 0x87, 0x03, 0xde, 0x55, 0x97, 0x20, 0xef, 0x20, 0xcc, 0x04, 0x97, 0x8c, 0x4b, 0xbc,
-	0x89, 0xac, 0xd8, 0x5a, 0x06, 0xeb, 0xa7, 0x2b, 0x85, 0x5d, 0xbd, 0xed, 0x92, 0x9f, 0x78, 0xd1,
-	0x7c, 0x14, 0x32, 0xad, 0x1a, 0x84, 0x6d, 0xee, 0xd1, 0x83, 0x42, 0xf2, 0x1a, 0x82, 0x3c, 0xad,
-	0x73, 0xcd, 0x63, 0x2b, 0x9e, 0x27, 0xc8, 0xb3, 0x49, 0xeb, 0x7c, 0x8a, 0xa4, 0x5f, 0x42, 0x5e,
-	0x42, 0x50, 0x6c, 0xf9, 0x4e, 0x33, 0x38, 0x8a, 0x21, 0x42, 0x86, 0x0f, 0x5b, 0xbe, 0x1b, 0x55,
-	0xf7, 0xe0, 0xe4, 0x12, 0x42, 0xc6, 0xb3, 0xaa, 0x29, 0x4d, 0x2b, 0x33, 0x45, 0xf0, 0x18, 0x09,
-	0x2e, 0xbb, 0xe4, 0xa8, 0x8d, 0x61, 0x11, 0xf9, 0x02, 0x0f, 0xf4, 0x4d, 0x9e, 0x56, 0x4c, 0x73,
-	0xb9, 0x8a, 0x2b, 0x1e, 0x72, 0x29, 0xc4, 0x21, 0xe1, 0x54, 0x39, 0x09, 0xc1, 0x96, 0x4d, 0x34,
-	0x8f, 0xad, 0xa5, 0x4b, 0x6d, 0xd9, 0x5c, 0x78, 0xe0, 0x7e, 0x4b, 0x8b, 0x3d, 0x4b, 0x7e, 0x3a,
-	0x70, 0x84, 0xa0, 0x37, 0x99, 0xdc, 0x0a, 0x7e, 0xb7, 0x92, 0xff, 0xbd, 0x92, 0x02, 0xa2, 0xdb,
-	0x66, 0x4c, 0x22, 0xf0, 0x70, 0xc6, 0x6a, 0x2b, 0x0b, 0xaa, 0x43, 0x72, 0x0c, 0xce, 0x8e, 0x35,
-	0x6a, 0xc6, 0x3e, 0x6d, 0x8f, 0xad, 0x80, 0x28, 0xd5, 0xc8, 0x5c, 0x6a, 0x8b, 0x92, 0x9c, 0xa2,
-	0x80, 0x1a, 0x82, 0x4f, 0x51, 0xed, 0x0a, 0x1e, 0x4e, 0x6e, 0x82, 0x10, 0x98, 0xb5, 0x9b, 0x40,
-	0x1d, 0x75, 0x9e, 0x10, 0x31, 0xa4, 0x4e, 0x9f, 0x34, 0x83, 0x93, 0xd1, 0x72, 0x5a, 0xc2, 0x76,
-	0x39, 0x9a, 0xb0, 0x3d, 0x1b, 0x11, 0x7b, 0x2c, 0xe2, 0x4c, 0x88, 0x0c, 0x9c, 0xff, 0xb2, 0xe0,
-	0x74, 0x6a, 0x83, 0x24, 0x86, 0x00, 0xa7, 0xb2, 0xb9, 0x69, 0xa0, 0x7f, 0x45, 0xce, 0xcd, 0x87,
-	0x81, 0x93, 0x46, 0x03, 0x07, 0xb7, 0xad, 0x30, 0x17, 0x3c, 0xeb, 0xba, 0x5b, 0xd0, 0x2e, 0xd0,
-	0x06, 0x67, 0x13, 0x06, 0xdd, 0xbe, 0xc1, 0xdf, 0x16, 0x3c, 0xba, 0xf5, 0xb3, 0xf8, 0x87, 0x2e,
-	0xcf, 0x60, 0x5e, 0xee, 0xaf, 0xdf, 0xe3, 0xcc, 0x16, 0x14, 0xa3, 0xbf, 0xf6, 0x79, 0x0e, 0x8b,
-	0xcf, 0x7b, 0x76, 0xe3, 0xec, 0x0c, 0xe6, 0xf2, 0xbb, 0x31, 0xe5, 0x53, 0x8c, 0x92, 0xe7, 0x70,
-	0x72, 0x91, 0xca, 0x2c, 0x1f, 0x80, 0x23, 0xf0, 0xba, 0x74, 0x1d, 0x59, 0xb1, 0xb3, 0xf4, 0xa9,
-	0x0e, 0x93, 0x57, 0x08, 0xa7, 0xac, 0x2c, 0x0c, 0xfc, 0x19, 0xdc, 0xc7, 0x47, 0xa1, 0xc3, 0x07,
-	0xeb, 0x10, 0x7f, 0x20, 0x44, 0x50, 0x93, 0x4f, 0x8e, 0x21, 0xa4, 0x2c, 0x63, 0x5b, 0xf3, 0x27,
-	0xae, 0x7d, 0xf0, 0x30, 0x7b, 0x3d, 0x57, 0x6f, 0xca, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x38, 0xc7, 0xa7, 0x39, 0x64, 0x06, 0x00, 0x00,
+	// 1089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcb, 0x6e, 0xe4, 0x44,
+	0x17, 0x8e, 0xed, 0xb8, 0x1d, 0x9f, 0xee, 0x64, 0xba, 0x6b, 0xfa, 0xcf, 0xef, 0x11, 0x11, 0xb4,
+	0x4a, 0x30, 0x0a, 0x48, 0x89, 0x50, 0xd8, 0x80, 0x04, 0x1a, 0x48, 0x13, 0xa9, 0x47, 0x33, 0xe2,
+	0x52, 0x89, 0xd8, 0x64, 0xe5, 0xb8, 0x6b, 0xa6, 0x4d, 0x3b, 0xb6, 0xc7, 0x76, 0x0f, 0x31, 0x2b,
+	0xd6, 0x3c, 0x04, 0x0f, 0x00, 0x8f, 0x82, 0xc4, 0x43, 0xf0, 0x18, 0xac, 0x50, 0xdd, 0xec, 0xf2,
+	0xa5, 0x67, 0x66, 0xc1, 0x06, 0x89, 0x5d, 0x9f, 0x53, 0xe7, 0x7c, 0xe7, 0xf6, 0xd5, 0x29, 0x37,
+	0xec, 0xe7, 0x45, 0x92, 0xf9, 0xcf, 0xe9, 0x69, 0x9a, 0x25, 0x45, 0x82, 0xec, 0xa2, 0x4c, 0x69,
+	0x8e, 0x7f, 0xb7, 0xc0, 0xb9, 0x14, 0x07, 0xe8, 0x31, 0x1c, 0x04, 0x49, 0x5c, 0xd0, 0xb8, 0x90,
+	0x1a, 0xcf, 0x98, 0x19, 0xc7, 0xc3, 0xb3, 0x77, 0x4e, 0xb9, 0xed, 0xe9, 0x5c, 0x1c, 0x7e, 0x1d,
+	0x47, 0xe5, 0x57, 0x49, 0xe1, 0x67, 0xa5, 0x34, 0x5b, 0xec, 0x90, 0x96, 0x23, 0xfa, 0x1c, 0x86,
+	0x2b, 0x3f, 0x5f, 0x29, 0x1c, 0x93, 0xe3, 0x1c, 0x49, 0x9c, 0x85, 0x9f, 0xaf, 0xfa, 0x40, 0x74,
+	0x17, 0xf4, 0x29, 0x0c, 0xa3, 0x30, 0x5e, 0x2b, 0x04, 0x8b, 0x23, 0x78, 0x12, 0xe1, 0x69, 0x18,
+	0xaf, 0x3b, 0xde, 0x9a, 0x39, 0xba, 0x80, 0x03, 0x1a, 0x07, 0x59, 0x99, 0x56, 0xa5, 0xec, 0x72,
+	0x80, 0xb7, 0x24, 0xc0, 0x85, 0x38, 0xec, 0x94, 0xd1, 0x74, 0x42, 0x57, 0x70, 0x5f, 0x69, 0x56,
+	0x7e, 0x46, 0x15, 0x96, 0xcd, 0xb1, 0x66, 0x4d, 0x2c, 0x6e, 0xd1, 0x06, 0xec, 0x73, 0x47, 0x8f,
+	0x60, 0x14, 0xac, 0x36, 0x75, 0x6d, 0x0e, 0x87, 0x7b, 0xa0, 0xba, 0xcc, 0x8e, 0xda, 0x38, 0x0d,
+	0x07, 0x74, 0x00, 0x66, 0x51, 0x7a, 0x83, 0x99, 0x71, 0x6c, 0x13, 0xb3, 0x28, 0xcf, 0x1d, 0xb0,
+	0x5f, 0xfa, 0xd1, 0x86, 0xe2, 0x3f, 0x77, 0x61, 0x5f, 0x1a, 0x7d, 0x11, 0x14, 0x61, 0x12, 0xff,
+	0x37, 0xd3, 0x7f, 0xcb, 0x4c, 0x2f, 0x60, 0xcc, 0xe5, 0x2f, 0xfd, 0xc2, 0x57, 0x20, 0x7b, 0x1c,
+	0xe4, 0xff, 0x3a, 0x88, 0x76, 0xbc, 0xd8, 0x21, 0x1d, 0x17, 0xb4, 0x80, 0x09, 0xd7, 0x11, 0x9a,
+	0xd3, 0xaa, 0x4f, 0x6e, 0xa3, 0xd1, 0xf3, 0xf6, 0xf9, 0x62, 0x87, 0x74, 0x9d, 0xb6, 0x93, 0x2c,
+	0x02, 0x6f, 0x1b, 0x6b, 0x90, 0x07, 0x8e, 0x64, 0x0d, 0xe7, 0xd9, 0x88, 0x28, 0x11, 0x8d, 0xc1,
+	0x5a, 0xd3, 0x92, 0xb3, 0xc6, 0x25, 0xec, 0x27, 0x0b, 0x90, 0xa4, 0x9c, 0x04, 0x36, 0x31, 0x93,
+	0x14, 0x4d, 0x65, 0x00, 0x3e, 0x56, 0x97, 0xc8, 0x68, 0x97, 0xf0, 0xbf, 0x5e, 0x6e, 0x21, 0x04,
+	0xbb, 0x8c, 0x5b, 0x32, 0x0e, 0xff, 0xdd, 0x13, 0xa4, 0x02, 0xb5, 0x74, 0xd0, 0x00, 0x26, 0x1d,
+	0xba, 0x31, 0x40, 0x46, 0x37, 0x05, 0xc8, 0x7e, 0x57, 0x41, 0xcc, 0x6e, 0x10, 0xab, 0x27, 0x48,
+	0x23, 0xf3, 0x5f, 0x0c, 0x98, 0xf6, 0x71, 0x12, 0xcd, 0x60, 0x28, 0xbb, 0xb2, 0xa8, 0x0b, 0xd0,
+	0x55, 0xe8, 0x61, 0x45, 0x75, 0xd9, 0x69, 0x99, 0x40, 0x4b, 0xcb, 0x02, 0xc7, 0x49, 0x1c, 0x88,
+	0xea, 0x46, 0x44, 0x08, 0x2a, 0xc1, 0xdd, 0x9e, 0x04, 0x6d, 0x3d, 0xc1, 0xdf, 0x4c, 0x78, 0xb0,
+	0x95, 0xe8, 0xff, 0x60, 0x96, 0x87, 0x30, 0x48, 0x37, 0x37, 0x4f, 0x64, 0xcf, 0x46, 0x44, 0x4a,
+	0x6f, 0x9a, 0x27, 0xf3, 0x0f, 0xc2, 0x74, 0x45, 0x33, 0xce, 0x46, 0x97, 0x48, 0xa9, 0xae, 0xde,
+	0xd1, 0xab, 0xff, 0x10, 0x5c, 0x1a, 0xbf, 0xa4, 0x51, 0x92, 0xd2, 0xdc, 0xdb, 0x9b, 0x59, 0xc7,
+	0xc3, 0x33, 0x24, 0x99, 0xff, 0x84, 0x96, 0x17, 0xf2, 0x88, 0xd4, 0x46, 0x92, 0x88, 0xae, 0x4e,
+	0xc4, 0xe4, 0x87, 0x98, 0x66, 0x1e, 0x88, 0x2c, 0xb8, 0x80, 0x03, 0x18, 0x6a, 0xfe, 0x5a, 0x51,
+	0x46, 0xa3, 0x28, 0x0f, 0x9c, 0x35, 0x2d, 0xaf, 0xca, 0x54, 0x6c, 0x48, 0x9b, 0x28, 0x11, 0x61,
+	0x18, 0xc9, 0xc6, 0xd0, 0x65, 0xdd, 0x8c, 0x86, 0x0e, 0xff, 0x65, 0x00, 0xea, 0x2e, 0x0b, 0xd5,
+	0x29, 0xa3, 0xee, 0xd4, 0xdb, 0x00, 0xb7, 0x34, 0x5b, 0x47, 0x94, 0x24, 0x89, 0xea, 0xbb, 0xa6,
+	0x61, 0xe7, 0xfc, 0x4a, 0xcf, 0x93, 0x4d, 0x5c, 0xc8, 0x4b, 0xa6, 0x69, 0xd0, 0x11, 0xb8, 0x45,
+	0x52, 0xf8, 0xd1, 0x65, 0xf8, 0xa3, 0xa0, 0xad, 0x45, 0x6a, 0xc5, 0x96, 0x39, 0x54, 0x7d, 0x19,
+	0x68, 0x7d, 0x41, 0xef, 0xc2, 0xfe, 0x26, 0x8d, 0x12, 0x7f, 0x49, 0x97, 0x22, 0x98, 0xc3, 0x83,
+	0x35, 0x95, 0xac, 0x78, 0xa5, 0xe0, 0x21, 0xf7, 0x78, 0xc8, 0x86, 0x0e, 0x7f, 0x0f, 0xe3, 0xf6,
+	0x8e, 0xeb, 0xa9, 0x7c, 0x0a, 0x76, 0x18, 0x2f, 0xe9, 0x9d, 0x6c, 0xaf, 0x10, 0xf4, 0xc5, 0x63,
+	0x35, 0x17, 0xcf, 0x14, 0xec, 0x34, 0x4b, 0x92, 0x67, 0xde, 0xee, 0xcc, 0x62, 0x2c, 0xe1, 0x02,
+	0x9e, 0xc3, 0xa4, 0xb3, 0x07, 0x7b, 0x82, 0x35, 0xda, 0x64, 0xb6, 0xda, 0x84, 0x1f, 0xc2, 0xe8,
+	0xdb, 0x0d, 0xad, 0xc7, 0x74, 0x08, 0x83, 0xe2, 0xae, 0xba, 0x2d, 0x2e, 0x91, 0x12, 0x3e, 0x81,
+	0xc9, 0xb9, 0x5f, 0x04, 0xab, 0x86, 0xb1, 0x07, 0x8e, 0x38, 0xce, 0x3d, 0x63, 0x66, 0x1d, 0xbb,
+	0x44, 0x89, 0xf8, 0x91, 0x34, 0x27, 0x34, 0x8d, 0x2a, 0xf3, 0x0f, 0x60, 0x4f, 0x7e, 0xc0, 0x09,
+	0xfb, 0xe1, 0xd9, 0x81, 0x64, 0xb5, 0xb4, 0x20, 0xd5, 0x39, 0x1e, 0xc3, 0x01, 0xa1, 0x01, 0x0d,
+	0xab, 0x47, 0x0f, 0x5f, 0xc3, 0x84, 0x07, 0x9f, 0xeb, 0x4f, 0x4e, 0x2f, 0xab, 0xf2, 0xc2, 0xcf,
+	0x8a, 0xc7, 0x5a, 0x83, 0x35, 0x0d, 0xeb, 0x65, 0xa0, 0x11, 0x4a, 0x08, 0xf8, 0x67, 0x03, 0x26,
+	0x3c, 0xd7, 0x06, 0xfa, 0x67, 0xad, 0x17, 0xd1, 0x78, 0xcd, 0x8b, 0xd8, 0x7a, 0x0f, 0xb5, 0x81,
+	0x9a, 0xcd, 0x81, 0x1e, 0x81, 0x1b, 0xd3, 0x3b, 0x99, 0xa3, 0x48, 0xa4, 0x56, 0xe0, 0x4f, 0xe0,
+	0x5e, 0x5d, 0xe9, 0x37, 0x6c, 0xd6, 0x6f, 0xca, 0x21, 0xfc, 0x93, 0x01, 0xf7, 0xea, 0x3a, 0x84,
+	0x6f, 0xf3, 0x9e, 0x19, 0xaf, 0xb9, 0x67, 0x66, 0xe7, 0x9e, 0x9d, 0x80, 0xcd, 0x25, 0xcf, 0x7a,
+	0xe5, 0x5b, 0x4e, 0x84, 0x15, 0x7e, 0x4f, 0xce, 0x49, 0xaa, 0xcf, 0x4b, 0x6d, 0x4f, 0xd6, 0xf9,
+	0xe3, 0x3f, 0x0c, 0x18, 0x49, 0x13, 0x31, 0x98, 0x6e, 0x89, 0x35, 0x17, 0x4d, 0x9d, 0x8b, 0xed,
+	0xb5, 0x6e, 0x75, 0xd7, 0xfa, 0x21, 0x0c, 0x72, 0x1a, 0x2f, 0x69, 0x26, 0x37, 0xb3, 0x94, 0xe4,
+	0x07, 0x81, 0xad, 0x3e, 0x08, 0x98, 0xdd, 0x8a, 0x86, 0xcf, 0x57, 0x05, 0xdf, 0x07, 0x16, 0x91,
+	0x52, 0xdd, 0x5c, 0x87, 0xab, 0x85, 0xc0, 0xa6, 0x76, 0x13, 0x25, 0xc1, 0xfa, 0x2a, 0xbc, 0x55,
+	0xb7, 0xbf, 0x56, 0xe0, 0x5f, 0x0d, 0x46, 0xd9, 0x17, 0x4f, 0xc3, 0xfc, 0x15, 0x97, 0xb1, 0x95,
+	0xba, 0xa8, 0x6b, 0x4b, 0xea, 0x56, 0x23, 0x75, 0x0f, 0x9c, 0x34, 0x0b, 0x6f, 0xfd, 0x4c, 0xbd,
+	0x36, 0x4a, 0xac, 0x19, 0x6d, 0x6b, 0x8c, 0x66, 0xc9, 0x2e, 0xc3, 0x8c, 0xf2, 0x4f, 0x68, 0xf9,
+	0x09, 0x54, 0x2b, 0xf0, 0x35, 0x8c, 0x39, 0x4d, 0xf4, 0x6c, 0x4f, 0xc0, 0xe1, 0x75, 0x56, 0xb7,
+	0xf3, 0x7e, 0xf3, 0x76, 0xf2, 0x31, 0x11, 0x65, 0xa3, 0x27, 0x64, 0x36, 0x12, 0xc2, 0x1f, 0xc3,
+	0x94, 0xd0, 0x17, 0xdf, 0xd1, 0x2c, 0x7c, 0x56, 0xce, 0xb5, 0xd2, 0x7a, 0x9e, 0xe3, 0x66, 0xf1,
+	0xf8, 0x1a, 0x0e, 0x79, 0x5a, 0x5d, 0xdf, 0xf7, 0xd5, 0x44, 0xc4, 0x1d, 0xec, 0x4d, 0x4d, 0x8e,
+	0x89, 0x3d, 0x6b, 0x8c, 0xf8, 0xb9, 0x67, 0xf2, 0x75, 0x29, 0xa5, 0x33, 0x17, 0x1c, 0xb9, 0x5e,
+	0x6e, 0x06, 0xfc, 0x0f, 0xe4, 0x47, 0x7f, 0x0f, 0x00, 0xc4, 0x51, 0xef, 0x35, 0x51, 0x0e, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
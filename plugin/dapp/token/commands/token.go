@@ -44,6 +44,17 @@ func TokenCmd() *cobra.Command {
 		CreateTokenTransferExecCmd(),
 		CreateRawTokenMintTxCmd(),
 		CreateRawTokenBurnTxCmd(),
+		CreateRawTokenFreezeTxCmd(),
+		CreateRawTokenUnfreezeTxCmd(),
+		CreateRawTokenPauseTxCmd(),
+		CreateRawTokenUnpauseTxCmd(),
+		CreateRawTokenUpdateInfoTxCmd(),
+		CreateRawTokenTransferOwnershipTxCmd(),
+		CreateRawTokenSetMinterTxCmd(),
+		GetTokenMintersCmd(),
+		GetTokenFrozenAddrsCmd(),
+		GetTokenHoldersCmd(),
+		ExportTokenHolderSnapshotCmd(),
 		GetTokenLogsCmd(),
 		GetTokenCmd(),
 		QueryTxCmd(),
@@ -374,6 +385,7 @@ func addTokenPrecreatedFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("total")
 
 	cmd.Flags().Int32P("category", "c", 0, "token category")
+	cmd.Flags().Int64P("max_supply", "m", 0, "max supply of the token, no limit if 0")
 
 	cmd.Flags().Float64P("fee", "f", 0, "token transaction fee")
 }
@@ -387,6 +399,7 @@ func tokenPrecreated(cmd *cobra.Command, args []string) {
 	price, _ := cmd.Flags().GetFloat64("price")
 	total, _ := cmd.Flags().GetInt64("total")
 	category, _ := cmd.Flags().GetInt32("category")
+	maxSupply, _ := cmd.Flags().GetInt64("max_supply")
 
 	priceInt64 := int64((price + 0.000001) * 1e4)
 	params := &tokenty.TokenPreCreate{
@@ -397,6 +410,7 @@ func tokenPrecreated(cmd *cobra.Command, args []string) {
 		Owner:        ownerAddr,
 		Total:        total * types.TokenPrecision,
 		Category:     category,
+		MaxSupply:    maxSupply * types.TokenPrecision,
 	}
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenPreCreateTx", params, nil)
 	ctx.RunWithoutMarshal()
@@ -541,6 +555,396 @@ func tokenBurn(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateRawTokenFreezeTxCmd create raw token freeze address transaction
+func CreateRawTokenFreezeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "Create a transaction to freeze token of address",
+		Run:   tokenFreeze,
+	}
+	addTokenFreezeFlags(cmd)
+	return cmd
+}
+
+func addTokenFreezeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("addr", "a", "", "address to freeze or unfreeze")
+	cmd.MarkFlagRequired("addr")
+}
+
+func tokenFreeze(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	params := &tokenty.TokenFreeze{
+		Symbol: symbol,
+		Addr:   addr,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenFreezeTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenUnfreezeTxCmd create raw token unfreeze address transaction
+func CreateRawTokenUnfreezeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze",
+		Short: "Create a transaction to unfreeze token of address",
+		Run:   tokenUnfreeze,
+	}
+	addTokenFreezeFlags(cmd)
+	return cmd
+}
+
+func tokenUnfreeze(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	params := &tokenty.TokenUnfreeze{
+		Symbol: symbol,
+		Addr:   addr,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenUnfreezeTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenPauseTxCmd create raw token pause transaction
+func CreateRawTokenPauseTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Create a transaction to pause all transfers of token",
+		Run:   tokenPause,
+	}
+	addTokenPauseFlags(cmd)
+	return cmd
+}
+
+func addTokenPauseFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+}
+
+func tokenPause(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+
+	params := &tokenty.TokenPause{
+		Symbol: symbol,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenPauseTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenUnpauseTxCmd create raw token unpause transaction
+func CreateRawTokenUnpauseTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause",
+		Short: "Create a transaction to resume transfers of token",
+		Run:   tokenUnpause,
+	}
+	addTokenPauseFlags(cmd)
+	return cmd
+}
+
+func tokenUnpause(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+
+	params := &tokenty.TokenUnpause{
+		Symbol: symbol,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenUnpauseTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenUpdateInfoTxCmd create raw token update info transaction
+func CreateRawTokenUpdateInfoTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update_info",
+		Short: "Create a transaction to update name, introduction, icon or url of token",
+		Run:   tokenUpdateInfo,
+	}
+	addTokenUpdateInfoFlags(cmd)
+	return cmd
+}
+
+func addTokenUpdateInfoFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("name", "n", "", "new token name, unchanged if empty")
+	cmd.Flags().StringP("introduction", "i", "", "new token introduction, unchanged if empty")
+	cmd.Flags().StringP("icon", "c", "", "new token icon url, unchanged if empty")
+	cmd.Flags().StringP("url", "u", "", "new token website url, unchanged if empty")
+}
+
+func tokenUpdateInfo(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	name, _ := cmd.Flags().GetString("name")
+	introduction, _ := cmd.Flags().GetString("introduction")
+	icon, _ := cmd.Flags().GetString("icon")
+	url, _ := cmd.Flags().GetString("url")
+
+	params := &tokenty.TokenUpdateInfo{
+		Symbol:       symbol,
+		Name:         name,
+		Introduction: introduction,
+		Icon:         icon,
+		Url:          url,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenUpdateInfoTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenTransferOwnershipTxCmd create raw token transfer ownership transaction
+func CreateRawTokenTransferOwnershipTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_ownership",
+		Short: "Create a transaction to transfer ownership of token",
+		Run:   tokenTransferOwnership,
+	}
+	addTokenTransferOwnershipFlags(cmd)
+	return cmd
+}
+
+func addTokenTransferOwnershipFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("new_owner", "a", "", "address of new owner")
+	cmd.MarkFlagRequired("new_owner")
+}
+
+func tokenTransferOwnership(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	newOwner, _ := cmd.Flags().GetString("new_owner")
+
+	params := &tokenty.TokenTransferOwnership{
+		Symbol:   symbol,
+		NewOwner: newOwner,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenTransferOwnershipTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenSetMinterTxCmd create raw token set minter transaction
+func CreateRawTokenSetMinterTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set_minter",
+		Short: "Create a transaction to set mint quota of minter address",
+		Run:   tokenSetMinter,
+	}
+	addTokenSetMinterFlags(cmd)
+	return cmd
+}
+
+func addTokenSetMinterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("minter", "a", "", "minter address")
+	cmd.MarkFlagRequired("minter")
+
+	cmd.Flags().Float64P("quota", "q", 0, "mint quota in each period, 0 to remove the minter")
+	cmd.Flags().Int64P("period", "p", 0, "period in blocks, quota is not renewed if 0")
+}
+
+func tokenSetMinter(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	minter, _ := cmd.Flags().GetString("minter")
+	quota, _ := cmd.Flags().GetFloat64("quota")
+	period, _ := cmd.Flags().GetInt64("period")
+
+	params := &tokenty.TokenSetMinter{
+		Symbol: symbol,
+		Minter: minter,
+		Quota:  int64((quota+0.000001)*1e4) * 1e4,
+		Period: period,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenSetMinterTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// GetTokenMintersCmd get minters of token
+func GetTokenMintersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minters",
+		Short: "Get minter addresses and quotas of token",
+		Run:   getTokenMinters,
+	}
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+	return cmd
+}
+
+func getTokenMinters(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetTokenMinters"
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: symbol})
+	var res tokenty.ReplyTokenMinters
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// GetTokenFrozenAddrsCmd get frozen addresses of token
+func GetTokenFrozenAddrsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen_addrs",
+		Short: "Get frozen addresses of token",
+		Run:   getTokenFrozenAddrs,
+	}
+	addGetTokenFrozenAddrsFlags(cmd)
+	return cmd
+}
+
+func addGetTokenFrozenAddrsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("from", "f", "", "start from the address of last query")
+	cmd.Flags().Int32P("count", "c", 10, "count of addresses")
+	cmd.Flags().Int32P("direction", "d", 0, "query direction, 0: desc, 1: asc")
+}
+
+func getTokenFrozenAddrs(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	from, _ := cmd.Flags().GetString("from")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetFrozenAddrs"
+	params.Payload = types.MustPBToJSON(&tokenty.ReqFrozenAddrs{Symbol: symbol, FromKey: from, Count: count, Direction: direction})
+	var res tokenty.ReplyFrozenAddrs
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// GetTokenHoldersCmd get top holders of token
+func GetTokenHoldersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holders",
+		Short: "Get holders of token sorted by balance",
+		Run:   getTokenHolders,
+	}
+	addGetTokenHoldersFlags(cmd)
+	return cmd
+}
+
+func addGetTokenHoldersFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("from", "f", "", "start from the address of last query")
+	cmd.Flags().Int32P("count", "c", 10, "count of holders")
+}
+
+func getTokenHolders(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	from, _ := cmd.Flags().GetString("from")
+	count, _ := cmd.Flags().GetInt32("count")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetTokenHolders"
+	params.Payload = types.MustPBToJSON(&tokenty.ReqTokenHolders{Symbol: symbol, FromKey: from, Count: count})
+	var res tokenty.ReplyTokenHolders
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// ExportTokenHolderSnapshotCmd export holders of token at height
+func ExportTokenHolderSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Export holders and balances of token at height as csv",
+		Run:   exportTokenHolderSnapshot,
+	}
+	addExportTokenHolderSnapshotFlags(cmd)
+	return cmd
+}
+
+func addExportTokenHolderSnapshotFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().Int64P("height", "t", 0, "block height of snapshot")
+	cmd.MarkFlagRequired("height")
+
+	cmd.Flags().StringP("output", "o", "", "output file, print to stdout if empty")
+}
+
+func exportTokenHolderSnapshot(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	height, _ := cmd.Flags().GetInt64("height")
+	output, _ := cmd.Flags().GetString("output")
+
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	out := os.Stdout
+	if output != "" {
+		out, err = os.Create(output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		defer out.Close()
+	}
+
+	fmt.Fprintln(out, "addr,balance")
+	req := &tokenty.ReqTokenHolderSnapshot{Symbol: symbol, Height: height, Count: tokenty.TokenHoldersCountLimit}
+	for {
+		var params rpctypes.Query4Jrpc
+		params.Execer = getRealExecName(paraName, "token")
+		params.FuncName = "GetTokenHolderSnapshot"
+		params.Payload = types.MustPBToJSON(req)
+		var res tokenty.ReplyTokenHolders
+		err = rpc.Call("Chain33.Query", params, &res)
+		if err != nil {
+			//最后一页之后返回ErrNotFound
+			if !strings.Contains(err.Error(), types.ErrNotFound.Error()) {
+				fmt.Fprintln(os.Stderr, err)
+			}
+			return
+		}
+		for _, holder := range res.Holders {
+			fmt.Fprintf(out, "%s,%d.%08d\n", holder.Addr, holder.Balance/types.TokenPrecision, holder.Balance%types.TokenPrecision)
+		}
+		if int32(len(res.Holders)) < req.Count {
+			return
+		}
+		req.FromKey = res.Holders[len(res.Holders)-1].Addr
+	}
+}
+
 // GetTokenLogsCmd get logs of token
 func GetTokenLogsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -83,3 +83,59 @@ func (t *token) Exec_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transaction
 	action := newTokenAction(t, "", tx)
 	return action.burn(payload)
 }
+
+func (t *token) Exec_TokenFreeze(payload *tokenty.TokenFreeze, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !t.GetAPI().GetConfig().IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenFreezeX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.freeze(payload)
+}
+
+func (t *token) Exec_TokenUnfreeze(payload *tokenty.TokenUnfreeze, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !t.GetAPI().GetConfig().IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenFreezeX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.unfreeze(payload)
+}
+
+func (t *token) Exec_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !t.GetAPI().GetConfig().IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenFreezeX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.pause(payload)
+}
+
+func (t *token) Exec_TokenUnpause(payload *tokenty.TokenUnpause, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !t.GetAPI().GetConfig().IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenFreezeX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.unpause(payload)
+}
+
+func (t *token) Exec_TokenUpdateInfo(payload *tokenty.TokenUpdateInfo, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !t.GetAPI().GetConfig().IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenOwnershipX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.updateInfo(payload)
+}
+
+func (t *token) Exec_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !t.GetAPI().GetConfig().IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenOwnershipX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.transferOwnership(payload)
+}
+
+func (t *token) Exec_TokenSetMinter(payload *tokenty.TokenSetMinter, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !t.GetAPI().GetConfig().IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenMinterX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.setMinter(payload)
+}
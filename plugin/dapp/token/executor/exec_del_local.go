@@ -32,6 +32,11 @@ func (t *token) ExecDelLocal_Transfer(payload *types.AssetsTransfer, tx *types.T
 	if err != nil {
 		return nil, err
 	}
+	kvs, err := t.updateHolders(payload.Cointoken, receiptData, index, true)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, kvs...)
 	if subCfg.SaveTokenTxList {
 		tokenAction := tokenty.TokenAction{
 			Ty: tokenty.ActionTransfer,
@@ -53,6 +58,11 @@ func (t *token) ExecDelLocal_Withdraw(payload *types.AssetsWithdraw, tx *types.T
 	if err != nil {
 		return nil, err
 	}
+	kvs, err := t.updateHolders(payload.Cointoken, receiptData, index, true)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, kvs...)
 	if subCfg.SaveTokenTxList {
 		tokenAction := tokenty.TokenAction{
 			Ty: tokenty.ActionWithdraw,
@@ -74,6 +84,11 @@ func (t *token) ExecDelLocal_TransferToExec(payload *types.AssetsTransferToExec,
 	if err != nil {
 		return nil, err
 	}
+	kvs, err := t.updateHolders(payload.Cointoken, receiptData, index, true)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, kvs...)
 	if subCfg.SaveTokenTxList {
 		tokenAction := tokenty.TokenAction{
 			Ty: tokenty.TokenActionTransferToExec,
@@ -121,6 +136,12 @@ func (t *token) ExecDelLocal_TokenFinishCreate(payload *tokenty.TokenFinishCreat
 	}
 	set = append(set, kv...)
 
+	kv, err = t.updateHolders(payload.Symbol, receiptData, index, true)
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
 	return &types.LocalDBSet{KV: set}, nil
 }
 
@@ -139,12 +160,14 @@ func (t *token) ExecDelLocal_TokenRevokeCreate(payload *tokenty.TokenRevokeCreat
 }
 
 func (t *token) ExecDelLocal_TokenMint(payload *tokenty.TokenMint, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	localToken, err := loadLocalToken(payload.Symbol, tx.From(), tokenty.TokenStatusCreated, t.GetLocalDB())
+	//铸币地址不一定是owner，从回执中获取owner
+	owner := mintOwner(tx, receiptData)
+	localToken, err := loadLocalToken(payload.Symbol, owner, tokenty.TokenStatusCreated, t.GetLocalDB())
 	if err != nil {
 		return nil, err
 	}
 	localToken = resetMint(localToken, t.GetHeight(), t.GetBlockTime(), payload.Amount)
-	key := calcTokenStatusKeyLocal(payload.Symbol, tx.From(), tokenty.TokenStatusCreated)
+	key := calcTokenStatusKeyLocal(payload.Symbol, owner, tokenty.TokenStatusCreated)
 	var set []*types.KeyValue
 	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
 
@@ -160,6 +183,17 @@ func (t *token) ExecDelLocal_TokenMint(payload *tokenty.TokenMint, tx *types.Tra
 	}
 	set = append(set, kv...)
 
+	kv, err = t.updateHolders(payload.Symbol, receiptData, index, true)
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+	minterSet, err := t.execLocalMinter(receiptData, true)
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, minterSet.KV...)
+
 	return &types.LocalDBSet{KV: set}, nil
 }
 
@@ -185,5 +219,39 @@ func (t *token) ExecDelLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Tra
 	}
 	set = append(set, kv...)
 
+	kv, err = t.updateHolders(payload.Symbol, receiptData, index, true)
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+func (t *token) ExecDelLocal_TokenFreeze(payload *tokenty.TokenFreeze, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalFrozen(receiptData, true)
+}
+
+func (t *token) ExecDelLocal_TokenUnfreeze(payload *tokenty.TokenUnfreeze, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalFrozen(receiptData, true)
+}
+
+func (t *token) ExecDelLocal_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalPaused(payload.Symbol, tx.From(), false)
+}
+
+func (t *token) ExecDelLocal_TokenUnpause(payload *tokenty.TokenUnpause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalPaused(payload.Symbol, tx.From(), true)
+}
+
+func (t *token) ExecDelLocal_TokenUpdateInfo(payload *tokenty.TokenUpdateInfo, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalTokenInfo(tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalTokenInfo(tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenSetMinter(payload *tokenty.TokenSetMinter, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalMinter(receiptData, true)
+}
@@ -24,6 +24,11 @@ func (t *token) ExecLocal_Transfer(payload *types.AssetsTransfer, tx *types.Tran
 	if kv != nil {
 		set.KV = append(set.KV, kv...)
 	}
+	kvs, err := t.updateHolders(payload.Cointoken, receiptData, index, false)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, kvs...)
 	if subCfg.SaveTokenTxList {
 		tokenAction := tokenty.TokenAction{
 			Ty: tokenty.ActionTransfer,
@@ -50,6 +55,11 @@ func (t *token) ExecLocal_Withdraw(payload *types.AssetsWithdraw, tx *types.Tran
 	if kv != nil {
 		set.KV = append(set.KV, kv...)
 	}
+	kvs, err := t.updateHolders(payload.Cointoken, receiptData, index, false)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, kvs...)
 	if subCfg.SaveTokenTxList {
 		tokenAction := tokenty.TokenAction{
 			Ty: tokenty.ActionWithdraw,
@@ -71,6 +81,11 @@ func (t *token) ExecLocal_TransferToExec(payload *types.AssetsTransferToExec, tx
 	if err != nil {
 		return nil, err
 	}
+	kvs, err := t.updateHolders(payload.Cointoken, receiptData, index, false)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, kvs...)
 	if subCfg.SaveTokenTxList {
 		tokenAction := tokenty.TokenAction{
 			Ty: tokenty.TokenActionTransferToExec,
@@ -90,6 +105,9 @@ func (t *token) ExecLocal_TransferToExec(payload *types.AssetsTransferToExec, tx
 func (t *token) ExecLocal_TokenPreCreate(payload *tokenty.TokenPreCreate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	localToken := newLocalToken(payload)
 	localToken = setPrepare(localToken, tx.From(), t.GetHeight(), t.GetBlockTime())
+	if t.GetAPI().GetConfig().IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenMinterX) {
+		localToken.MaxSupply = payload.MaxSupply
+	}
 	key := calcTokenStatusKeyLocal(payload.Symbol, payload.Owner, tokenty.TokenStatusPreCreated)
 
 	var set []*types.KeyValue
@@ -123,6 +141,12 @@ func (t *token) ExecLocal_TokenFinishCreate(payload *tokenty.TokenFinishCreate,
 	}
 	set = append(set, kv...)
 
+	kv, err = t.updateHolders(payload.Symbol, receiptData, index, false)
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
 	return &types.LocalDBSet{KV: set}, nil
 }
 
@@ -233,13 +257,15 @@ func resetBurn(t *tokenty.LocalToken, height, time, amount int64) *tokenty.Local
 }
 
 func (t *token) ExecLocal_TokenMint(payload *tokenty.TokenMint, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	localToken, err := loadLocalToken(payload.Symbol, tx.From(), tokenty.TokenStatusCreated, t.GetLocalDB())
+	//铸币地址不一定是owner，从回执中获取owner
+	owner := mintOwner(tx, receiptData)
+	localToken, err := loadLocalToken(payload.Symbol, owner, tokenty.TokenStatusCreated, t.GetLocalDB())
 	if err != nil {
 		return nil, err
 	}
 	localToken = setMint(localToken, t.GetHeight(), t.GetBlockTime(), payload.Amount)
 	var set []*types.KeyValue
-	key := calcTokenStatusKeyLocal(payload.Symbol, tx.From(), tokenty.TokenStatusCreated)
+	key := calcTokenStatusKeyLocal(payload.Symbol, owner, tokenty.TokenStatusCreated)
 	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
 
 	table := NewLogsTable(t.GetLocalDB())
@@ -254,6 +280,17 @@ func (t *token) ExecLocal_TokenMint(payload *tokenty.TokenMint, tx *types.Transa
 	}
 	set = append(set, kv...)
 
+	kv, err = t.updateHolders(payload.Symbol, receiptData, index, false)
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+	minterSet, err := t.execLocalMinter(receiptData, false)
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, minterSet.KV...)
+
 	return &types.LocalDBSet{KV: set}, nil
 }
 
@@ -279,5 +316,159 @@ func (t *token) ExecLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transa
 	}
 	set = append(set, kv...)
 
+	kv, err = t.updateHolders(payload.Symbol, receiptData, index, false)
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+func (t *token) ExecLocal_TokenFreeze(payload *tokenty.TokenFreeze, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalFrozen(receiptData, false)
+}
+
+func (t *token) ExecLocal_TokenUnfreeze(payload *tokenty.TokenUnfreeze, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalFrozen(receiptData, false)
+}
+
+func (t *token) ExecLocal_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalPaused(payload.Symbol, tx.From(), true)
+}
+
+func (t *token) ExecLocal_TokenUnpause(payload *tokenty.TokenUnpause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalPaused(payload.Symbol, tx.From(), false)
+}
+
+// 根据冻结和解冻的回执更新冻结地址表，isDel为true时回滚
+func (t *token) execLocalFrozen(receiptData *types.ReceiptData, isDel bool) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{}
+	for _, item := range receiptData.Logs {
+		if item.Ty != tokenty.TyLogTokenFreeze && item.Ty != tokenty.TyLogTokenUnfreeze {
+			continue
+		}
+		var receipt tokenty.ReceiptTokenFreeze
+		err := types.Decode(item.Log, &receipt)
+		if err != nil {
+			return nil, err
+		}
+		kvs, err := updateFrozenTable(t.GetLocalDB(), &receipt, isDel)
+		if err != nil {
+			return nil, err
+		}
+		set.KV = append(set.KV, kvs...)
+	}
+	return set, nil
+}
+
+// 更新本地token信息的暂停状态，回滚时传入相反的状态
+func (t *token) execLocalPaused(symbol, owner string, paused bool) (*types.LocalDBSet, error) {
+	localToken, err := loadLocalToken(symbol, owner, tokenty.TokenStatusCreated, t.GetLocalDB())
+	if err != nil {
+		return nil, err
+	}
+	localToken.Paused = paused
+	key := calcTokenStatusKeyLocal(symbol, owner, tokenty.TokenStatusCreated)
+	return &types.LocalDBSet{KV: []*types.KeyValue{{Key: key, Value: types.Encode(localToken)}}}, nil
+}
+
+func (t *token) ExecLocal_TokenUpdateInfo(payload *tokenty.TokenUpdateInfo, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalTokenInfo(tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalTokenInfo(tx, receiptData, index, false)
+}
+
+// 根据更新信息和转移所有权的回执更新本地token信息，并记录到token的更改记录中，isDel为true时回滚
+func (t *token) execLocalTokenInfo(tx *types.Transaction, receiptData *types.ReceiptData, index int, isDel bool) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{}
+	table := NewLogsTable(t.GetLocalDB())
+	for _, item := range receiptData.Logs {
+		if item.Ty != tokenty.TyLogTokenUpdateInfo && item.Ty != tokenty.TyLogTokenTransferOwnership {
+			continue
+		}
+		var receipt tokenty.ReceiptTokenAmount
+		err := types.Decode(item.Log, &receipt)
+		if err != nil {
+			return nil, err
+		}
+		from, to := receipt.Prev, receipt.Current
+		if isDel {
+			from, to = receipt.Current, receipt.Prev
+		}
+		localToken, err := loadLocalToken(from.Symbol, from.Owner, tokenty.TokenStatusCreated, t.GetLocalDB())
+		if err != nil {
+			return nil, err
+		}
+		localToken.Name = to.Name
+		localToken.Introduction = to.Introduction
+		localToken.Icon = to.Icon
+		localToken.Url = to.Url
+		localToken.Owner = to.Owner
+		if from.Owner != to.Owner {
+			set.KV = append(set.KV, &types.KeyValue{Key: calcTokenStatusKeyLocal(from.Symbol, from.Owner, tokenty.TokenStatusCreated), Value: nil})
+		}
+		set.KV = append(set.KV, &types.KeyValue{Key: calcTokenStatusKeyLocal(to.Symbol, to.Owner, tokenty.TokenStatusCreated), Value: types.Encode(localToken)})
+
+		actionType := int32(tokenty.TokenActionUpdateInfo)
+		if item.Ty == tokenty.TyLogTokenTransferOwnership {
+			actionType = tokenty.TokenActionTransferOwnership
+		}
+		txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+		if isDel {
+			err = table.Del([]byte(txIndex))
+		} else {
+			err = table.Add(&tokenty.LocalLogs{Symbol: to.Symbol, TxIndex: txIndex, ActionType: actionType, TxHash: "0x" + hex.EncodeToString(tx.Hash())})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	kv, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, kv...)
+	return set, nil
+}
+
+func (t *token) ExecLocal_TokenSetMinter(payload *tokenty.TokenSetMinter, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalMinter(receiptData, false)
+}
+
+// 根据铸币地址额度变化的回执更新铸币地址表，isDel为true时回滚
+func (t *token) execLocalMinter(receiptData *types.ReceiptData, isDel bool) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{}
+	for _, item := range receiptData.Logs {
+		if item.Ty != tokenty.TyLogTokenMinter {
+			continue
+		}
+		var receipt tokenty.ReceiptTokenMinter
+		err := types.Decode(item.Log, &receipt)
+		if err != nil {
+			return nil, err
+		}
+		kvs, err := updateMinterTable(t.GetLocalDB(), &receipt, isDel)
+		if err != nil {
+			return nil, err
+		}
+		set.KV = append(set.KV, kvs...)
+	}
+	return set, nil
+}
+
+// 从铸币回执中获取token的owner，没有回执时为交易发送者
+func mintOwner(tx *types.Transaction, receiptData *types.ReceiptData) string {
+	for _, item := range receiptData.Logs {
+		if item.Ty != tokenty.TyLogTokenMint {
+			continue
+		}
+		var receipt tokenty.ReceiptTokenAmount
+		if err := types.Decode(item.Log, &receipt); err == nil && receipt.Current != nil {
+			return receipt.Current.Owner
+		}
+	}
+	return tx.From()
+}
@@ -0,0 +1,225 @@
+package executor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type tokenTester struct {
+	t       *testing.T
+	cfg     *types.Chain33Config
+	api     *apimock.QueueProtocolAPI
+	stateDB dbm.DB
+	localDB dbm.KVDB
+	height  int64
+	//交易执行时的高度，回滚时使用
+	heights map[string]int64
+}
+
+func newTokenTester(t *testing.T) *tokenTester {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	InitExecType()
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	memDB, _ := dbm.NewGoMemDB("test", "", 0)
+	localDB := dbm.NewLocalDB(memDB, false)
+
+	items := []*types.ConfigItem{
+		{Key: "mavl-manage-token-blacklist", Value: &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{"bty"}}}},
+		{Key: "mavl-manage-token-finisher", Value: &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{string(Nodes[0])}}}},
+	}
+	for _, item := range items {
+		stateDB.Set([]byte(item.Key), types.Encode(item))
+	}
+	// 从测试用到的最高分叉高度开始执行
+	var height int64
+	for _, fork := range []string{pty.ForkTokenCheckX, pty.ForkTokenFreezeX, pty.ForkTokenOwnershipX, pty.ForkTokenMinterX} {
+		if h := cfg.GetDappFork(pty.TokenX, fork); h > height {
+			height = h
+		}
+	}
+	return &tokenTester{t: t, cfg: cfg, api: api, stateDB: stateDB, localDB: localDB, height: height, heights: make(map[string]int64)}
+}
+
+func (tt *tokenTester) newExec() drivers.Driver {
+	return tt.newExecAt(tt.height)
+}
+
+func (tt *tokenTester) newExecAt(height int64) drivers.Driver {
+	exec := newToken()
+	exec.SetAPI(tt.api)
+	exec.SetStateDB(tt.stateDB)
+	exec.SetLocalDB(tt.localDB)
+	exec.SetEnv(height, 1539918074+height, 0)
+	return exec
+}
+
+func (tt *tokenTester) createTx(action string, param types.Message, to string, privKey string) *types.Transaction {
+	tx, err := types.CallCreateTransaction(pty.TokenX, action, param)
+	assert.Nil(tt.t, err)
+	if to != "" {
+		tx.To = to
+	}
+	tx, err = signTx(tx, privKey)
+	assert.Nil(tt.t, err)
+	return tx
+}
+
+// 执行交易并写入状态数据库和本地数据库，返回交易和回执用于回滚
+func (tt *tokenTester) exec(action string, param types.Message, to string, privKey string) (*types.Transaction, *types.ReceiptData, error) {
+	tt.height++
+	tx := tt.createTx(action, param, to, privKey)
+	exec := tt.newExec()
+	receipt, err := exec.Exec(tx, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, kv := range receipt.KV {
+		tt.stateDB.Set(kv.Key, kv.Value)
+	}
+	tt.heights[string(tx.Hash())] = tt.height
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := exec.ExecLocal(tx, receiptData, 1)
+	assert.Nil(tt.t, err)
+	for _, kv := range set.KV {
+		tt.localDB.Set(kv.Key, kv.Value)
+	}
+	return tx, receiptData, nil
+}
+
+func (tt *tokenTester) execDelLocal(tx *types.Transaction, receiptData *types.ReceiptData) {
+	set, err := tt.newExecAt(tt.heights[string(tx.Hash())]).ExecDelLocal(tx, receiptData, 1)
+	assert.Nil(tt.t, err)
+	for _, kv := range set.KV {
+		tt.localDB.Set(kv.Key, kv.Value)
+	}
+}
+
+func (tt *tokenTester) createToken(symbol string, total int64) {
+	_, _, err := tt.exec("TokenPreCreate", &pty.TokenPreCreate{Name: symbol, Symbol: symbol, Introduction: symbol, Total: total, Owner: string(Nodes[0])}, "", PrivKeyA)
+	assert.Nil(tt.t, err)
+	_, _, err = tt.exec("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: symbol, Owner: string(Nodes[0])}, "", PrivKeyA)
+	assert.Nil(tt.t, err)
+}
+
+func (tt *tokenTester) transfer(symbol string, to string, amount int64, privKey string) error {
+	_, _, err := tt.exec("Transfer", &types.AssetsTransfer{Cointoken: symbol, Amount: amount, To: to}, to, privKey)
+	return err
+}
+
+func (tt *tokenTester) frozenAddrs(symbol string) []string {
+	reply, err := tt.newExec().(*token).Query_GetFrozenAddrs(&pty.ReqFrozenAddrs{Symbol: symbol, Direction: 1})
+	if err == types.ErrNotFound {
+		return nil
+	}
+	assert.Nil(tt.t, err)
+	var addrs []string
+	for _, frozen := range reply.(*pty.ReplyFrozenAddrs).Addrs {
+		assert.True(tt.t, frozen.Frozen)
+		addrs = append(addrs, frozen.Addr)
+	}
+	return addrs
+}
+
+func TestTokenFreeze(t *testing.T) {
+	tt := newTokenTester(t)
+	tt.createToken(Symbol, 10000*types.Coin)
+	tt.createToken(Symbol+"A", 10000*types.Coin)
+	accDB, _ := account.NewAccountDB(tt.cfg, pty.TokenX, Symbol, tt.stateDB)
+	assert.Nil(t, tt.transfer(Symbol, string(Nodes[1]), 100*types.Coin, PrivKeyA))
+
+	//只有owner可以冻结地址，不能冻结owner自身
+	_, _, err := tt.exec("TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: string(Nodes[1])}, "", PrivKeyB)
+	assert.Equal(t, pty.ErrTokenOwner, err)
+	_, _, err = tt.exec("TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: string(Nodes[0])}, "", PrivKeyA)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, _, err = tt.exec("TokenFreeze", &pty.TokenFreeze{Symbol: "NOTEXIST", Addr: string(Nodes[1])}, "", PrivKeyA)
+	assert.Equal(t, pty.ErrTokenNotExist, err)
+	_, _, err = tt.exec("TokenUnfreeze", &pty.TokenUnfreeze{Symbol: Symbol, Addr: string(Nodes[1])}, "", PrivKeyA)
+	assert.Equal(t, pty.ErrTokenAddrNotFrozen, err)
+
+	freezeTx, freezeReceipt, err := tt.exec("TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: string(Nodes[1])}, "", PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = tt.exec("TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: string(Nodes[1])}, "", PrivKeyA)
+	assert.Equal(t, pty.ErrTokenAddrFrozen, err)
+	assert.Equal(t, []string{string(Nodes[1])}, tt.frozenAddrs(Symbol))
+	assert.Nil(t, tt.frozenAddrs(Symbol+"A"))
+
+	//被冻结的地址不能转出、转入、转到合约或者从合约取回
+	err = tt.transfer(Symbol, string(Nodes[2]), types.Coin, PrivKeyB)
+	assert.Equal(t, pty.ErrTokenAddrFrozen, err)
+	err = tt.transfer(Symbol, string(Nodes[1]), types.Coin, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenAddrFrozen, err)
+	execAddr := address.ExecAddress("trade")
+	_, _, err = tt.exec("TransferToExec", &types.AssetsTransferToExec{Cointoken: Symbol, Amount: types.Coin, ExecName: "trade", To: execAddr}, execAddr, PrivKeyB)
+	assert.Equal(t, pty.ErrTokenAddrFrozen, err)
+	_, _, err = tt.exec("Withdraw", &types.AssetsWithdraw{Cointoken: Symbol, Amount: types.Coin, ExecName: "trade", To: execAddr}, execAddr, PrivKeyB)
+	assert.Equal(t, pty.ErrTokenAddrFrozen, err)
+	assert.Equal(t, 100*types.Coin, accDB.LoadAccount(string(Nodes[1])).Balance)
+	//其他token不受影响
+	assert.Nil(t, tt.transfer(Symbol+"A", string(Nodes[1]), types.Coin, PrivKeyA))
+	assert.Nil(t, tt.transfer(Symbol, string(Nodes[2]), types.Coin, PrivKeyA))
+
+	//回滚冻结后本地数据库中的冻结地址被删除
+	tt.execDelLocal(freezeTx, freezeReceipt)
+	assert.Nil(t, tt.frozenAddrs(Symbol))
+	set, err := tt.newExecAt(tt.heights[string(freezeTx.Hash())]).ExecLocal(freezeTx, freezeReceipt, 1)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		tt.localDB.Set(kv.Key, kv.Value)
+	}
+	assert.Equal(t, 1, len(tt.frozenAddrs(Symbol)))
+
+	unfreezeTx, unfreezeReceipt, err := tt.exec("TokenUnfreeze", &pty.TokenUnfreeze{Symbol: Symbol, Addr: string(Nodes[1])}, "", PrivKeyA)
+	assert.Nil(t, err)
+	assert.Nil(t, tt.frozenAddrs(Symbol))
+	assert.Nil(t, tt.transfer(Symbol, string(Nodes[2]), types.Coin, PrivKeyB))
+	assert.Equal(t, 99*types.Coin, accDB.LoadAccount(string(Nodes[1])).Balance)
+	//回滚解冻后恢复冻结地址
+	tt.execDelLocal(unfreezeTx, unfreezeReceipt)
+	assert.Equal(t, []string{string(Nodes[1])}, tt.frozenAddrs(Symbol))
+}
+
+func TestTokenPause(t *testing.T) {
+	tt := newTokenTester(t)
+	tt.createToken(Symbol, 10000*types.Coin)
+	assert.Nil(t, tt.transfer(Symbol, string(Nodes[1]), 100*types.Coin, PrivKeyA))
+
+	_, _, err := tt.exec("TokenPause", &pty.TokenPause{Symbol: Symbol}, "", PrivKeyB)
+	assert.Equal(t, pty.ErrTokenOwner, err)
+	_, _, err = tt.exec("TokenUnpause", &pty.TokenUnpause{Symbol: Symbol}, "", PrivKeyA)
+	assert.Equal(t, pty.ErrTokenNotPaused, err)
+	pauseTx, pauseReceipt, err := tt.exec("TokenPause", &pty.TokenPause{Symbol: Symbol}, "", PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = tt.exec("TokenPause", &pty.TokenPause{Symbol: Symbol}, "", PrivKeyA)
+	assert.Equal(t, pty.ErrTokenPaused, err)
+
+	//暂停后包括owner在内的所有地址都不能转账
+	err = tt.transfer(Symbol, string(Nodes[2]), types.Coin, PrivKeyB)
+	assert.Equal(t, pty.ErrTokenPaused, err)
+	err = tt.transfer(Symbol, string(Nodes[2]), types.Coin, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenPaused, err)
+	info, err := tt.newExec().(*token).getTokenInfo(Symbol)
+	assert.Nil(t, err)
+	assert.True(t, info.(*pty.LocalToken).Paused)
+
+	tt.execDelLocal(pauseTx, pauseReceipt)
+	info, err = tt.newExec().(*token).getTokenInfo(Symbol)
+	assert.Nil(t, err)
+	assert.False(t, info.(*pty.LocalToken).Paused)
+
+	_, _, err = tt.exec("TokenUnpause", &pty.TokenUnpause{Symbol: Symbol}, "", PrivKeyA)
+	assert.Nil(t, err)
+	assert.Nil(t, tt.transfer(Symbol, string(Nodes[2]), types.Coin, PrivKeyB))
+}
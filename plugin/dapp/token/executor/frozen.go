@@ -0,0 +1,118 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 记录token被冻结的地址，解冻后从表中删除
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+var opt_frozen_table = &table.Option{
+	Prefix:  "LODB-token",
+	Name:    "frozen",
+	Primary: "key",
+
+	Index: []string{
+		"symbol",
+	},
+}
+
+// FrozenRow row
+type FrozenRow struct {
+	*pty.TokenFrozenAddr
+}
+
+// NewFrozenRow create row
+func NewFrozenRow() *FrozenRow {
+	return &FrozenRow{TokenFrozenAddr: nil}
+}
+
+// CreateRow create row
+func (r *FrozenRow) CreateRow() *table.Row {
+	return &table.Row{Data: &pty.TokenFrozenAddr{}}
+}
+
+// SetPayload set payload
+func (r *FrozenRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*pty.TokenFrozenAddr); ok {
+		r.TokenFrozenAddr = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+// Get get index key
+func (r *FrozenRow) Get(key string) ([]byte, error) {
+	switch key {
+	case "key":
+		return calcFrozenPrimary(r.Symbol, r.Addr), nil
+	case "symbol":
+		return calcFrozenSymbolIndex(r.Symbol), nil
+	default:
+		return nil, types.ErrNotFound
+	}
+}
+
+func calcFrozenPrimary(symbol, addr string) []byte {
+	return []byte(fmt.Sprintf("%s-%s", symbol, addr))
+}
+
+//索引以分隔符结尾，避免按前缀查询时匹配到以该symbol开头的其他token
+func calcFrozenSymbolIndex(symbol string) []byte {
+	return []byte(symbol + ":")
+}
+
+// NewFrozenTable create table
+func NewFrozenTable(kvdb dbm.KV) *table.Table {
+	rowMeta := NewFrozenRow()
+	err := rowMeta.SetPayload(&pty.TokenFrozenAddr{})
+	if err != nil {
+		panic(err)
+	}
+	t, err := table.NewTable(rowMeta, kvdb, opt_frozen_table)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// 根据冻结和解冻的回执更新本地数据库，回滚时恢复到冻结或者解冻之前的状态
+func updateFrozenTable(db dbm.KV, receipt *pty.ReceiptTokenFreeze, isDel bool) ([]*types.KeyValue, error) {
+	table := NewFrozenTable(db)
+	frozen := receipt.Current
+	if isDel {
+		frozen = receipt.Prev
+	}
+	var err error
+	if frozen.Frozen {
+		err = table.Replace(frozen)
+	} else {
+		err = table.Del(calcFrozenPrimary(frozen.Symbol, frozen.Addr))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return table.Save()
+}
+
+func listFrozenAddrs(db dbm.KVDB, req *pty.ReqFrozenAddrs) ([]*table.Row, error) {
+	query := NewFrozenTable(db).GetQuery(db)
+	var primary []byte
+	if len(req.FromKey) > 0 {
+		primary = calcFrozenPrimary(req.Symbol, req.FromKey)
+	}
+	rows, err := query.ListIndex("symbol", calcFrozenSymbolIndex(req.Symbol), primary, req.Count, req.Direction)
+	if err != nil {
+		tokenlog.Error("listFrozenAddrs failed", "symbol", req.Symbol, "fromKey", req.FromKey, "err", err)
+		return nil, err
+	}
+	return rows, nil
+}
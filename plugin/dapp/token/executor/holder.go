@@ -0,0 +1,265 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 记录token的持有人及其在token合约下的余额（不包括转入其他合约的部分），
+// 持有人表保存当前余额，按余额建立索引用于查询大户；
+// 另外按 symbol:地址:高度:交易序号 记录每次余额变化，用于查询指定高度的持有人快照
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+var opt_holder_table = &table.Option{
+	Prefix:  "LODB-token",
+	Name:    "holder",
+	Primary: "key",
+
+	Index: []string{
+		"symbol",
+		"balance",
+	},
+}
+
+var holderHistoryPrefix = "LODB-token-holder-history:"
+
+// HolderRow row
+type HolderRow struct {
+	*pty.TokenHolder
+}
+
+// NewHolderRow create row
+func NewHolderRow() *HolderRow {
+	return &HolderRow{TokenHolder: nil}
+}
+
+// CreateRow create row
+func (r *HolderRow) CreateRow() *table.Row {
+	return &table.Row{Data: &pty.TokenHolder{}}
+}
+
+// SetPayload set payload
+func (r *HolderRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*pty.TokenHolder); ok {
+		r.TokenHolder = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+// Get get index key
+func (r *HolderRow) Get(key string) ([]byte, error) {
+	switch key {
+	case "key":
+		return calcHolderPrimary(r.Symbol, r.Addr), nil
+	case "symbol":
+		return calcHolderSymbolIndex(r.Symbol), nil
+	case "balance":
+		return []byte(fmt.Sprintf("%s%020d", calcHolderSymbolIndex(r.Symbol), r.Balance)), nil
+	default:
+		return nil, types.ErrNotFound
+	}
+}
+
+func calcHolderPrimary(symbol, addr string) []byte {
+	return []byte(fmt.Sprintf("%s-%s", symbol, addr))
+}
+
+//索引以分隔符结尾，避免按前缀查询时匹配到以该symbol开头的其他token
+func calcHolderSymbolIndex(symbol string) []byte {
+	return []byte(symbol + ":")
+}
+
+func calcHolderHistoryPrefix(symbol, addr string) string {
+	return fmt.Sprintf("%s%s:%s:", holderHistoryPrefix, symbol, addr)
+}
+
+func calcHolderHistoryKey(symbol, addr string, height int64, index int) []byte {
+	return []byte(fmt.Sprintf("%s%012d:%06d", calcHolderHistoryPrefix(symbol, addr), height, index))
+}
+
+// NewHolderTable create table
+func NewHolderTable(kvdb dbm.KV) *table.Table {
+	rowMeta := NewHolderRow()
+	err := rowMeta.SetPayload(&pty.TokenHolder{})
+	if err != nil {
+		panic(err)
+	}
+	t, err := table.NewTable(rowMeta, kvdb, opt_holder_table)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// 根据交易回执中token账户的余额变化更新持有人表和余额历史，isDel为true时回滚到交易之前的余额
+func (t *token) updateHolders(symbol string, receiptData *types.ReceiptData, index int, isDel bool) ([]*types.KeyValue, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return nil, nil
+	}
+	//同一个地址在回执中出现多次时，正常执行取最后的余额，回滚时取第一条回执之前的余额
+	var addrs []string
+	balances := make(map[string]int64)
+	for _, item := range receiptData.Logs {
+		switch item.Ty {
+		case types.TyLogTransfer, types.TyLogDeposit, types.TyLogGenesisTransfer, types.TyLogMint, types.TyLogBurn:
+			var receipt types.ReceiptAccountTransfer
+			err := types.Decode(item.Log, &receipt)
+			if err != nil {
+				return nil, err
+			}
+			addr := receipt.Current.GetAddr()
+			_, ok := balances[addr]
+			if !ok {
+				addrs = append(addrs, addr)
+			}
+			if !isDel {
+				balances[addr] = receipt.Current.GetBalance()
+			} else if !ok {
+				balances[addr] = receipt.Prev.GetBalance()
+			}
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, nil
+	}
+
+	var kvs []*types.KeyValue
+	table := NewHolderTable(t.GetLocalDB())
+	for _, addr := range addrs {
+		holder := &pty.TokenHolder{Symbol: symbol, Addr: addr, Balance: balances[addr]}
+		key := calcHolderHistoryKey(symbol, addr, t.GetHeight(), index)
+		if isDel {
+			kvs = append(kvs, &types.KeyValue{Key: key, Value: nil})
+		} else {
+			kvs = append(kvs, &types.KeyValue{Key: key, Value: types.Encode(holder)})
+		}
+		err := table.Replace(holder)
+		if err != nil {
+			return nil, err
+		}
+	}
+	kv, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	return append(kvs, kv...), nil
+}
+
+func holderCount(count int32) int32 {
+	if count <= 0 || count > pty.TokenHoldersCountLimit {
+		return pty.TokenHoldersCountLimit
+	}
+	return count
+}
+
+// 按余额从高到低列出持有人，余额为0的地址不返回
+func listTokenHolders(db dbm.KVDB, req *pty.ReqTokenHolders) (*pty.ReplyTokenHolders, error) {
+	query := NewHolderTable(db).GetQuery(db)
+	var primary []byte
+	if len(req.FromKey) > 0 {
+		primary = calcHolderPrimary(req.Symbol, req.FromKey)
+	}
+	rows, err := query.ListIndex("balance", calcHolderSymbolIndex(req.Symbol), primary, holderCount(req.Count), dbm.ListDESC)
+	if err != nil {
+		tokenlog.Error("listTokenHolders failed", "symbol", req.Symbol, "fromKey", req.FromKey, "err", err)
+		return nil, err
+	}
+	reply := &pty.ReplyTokenHolders{}
+	for _, row := range rows {
+		holder := row.Data.(*pty.TokenHolder)
+		if holder.Balance == 0 {
+			break
+		}
+		reply.Holders = append(reply.Holders, holder)
+	}
+	if len(reply.Holders) == 0 {
+		return nil, types.ErrNotFound
+	}
+	return reply, nil
+}
+
+// 获取地址在指定高度的余额，即该高度及之前最后一次余额变化后的值
+func getHolderBalanceAt(db dbm.KVDB, symbol, addr string, height int64) (int64, error) {
+	prefix := calcHolderHistoryPrefix(symbol, addr)
+	start := []byte(prefix + fmt.Sprintf("%012d", height+1))
+	values, err := db.List([]byte(prefix), start, 1, dbm.ListDESC|dbm.ListWithKey)
+	if err != nil && err != types.ErrNotFound {
+		return 0, err
+	}
+	//起始位置之后不存在任何key时List返回空，这时取最后一次余额变化
+	if len(values) == 0 {
+		values, err = db.List([]byte(prefix), nil, 1, dbm.ListDESC|dbm.ListWithKey)
+		if err != nil && err != types.ErrNotFound {
+			return 0, err
+		}
+	}
+	if len(values) == 0 {
+		return 0, nil
+	}
+	var kv types.KeyValue
+	err = types.Decode(values[0], &kv)
+	if err != nil {
+		return 0, err
+	}
+	//取到的是指定高度之后的余额变化，说明在该高度及之前没有余额
+	if string(kv.Key) >= string(start) {
+		return 0, nil
+	}
+	var holder pty.TokenHolder
+	err = types.Decode(kv.Value, &holder)
+	if err != nil {
+		return 0, err
+	}
+	return holder.Balance, nil
+}
+
+// 按地址顺序列出指定高度余额大于0的持有人
+func listTokenHolderSnapshot(db dbm.KVDB, req *pty.ReqTokenHolderSnapshot) (*pty.ReplyTokenHolders, error) {
+	query := NewHolderTable(db).GetQuery(db)
+	count := holderCount(req.Count)
+	var primary []byte
+	if len(req.FromKey) > 0 {
+		primary = calcHolderPrimary(req.Symbol, req.FromKey)
+	}
+	reply := &pty.ReplyTokenHolders{}
+	for {
+		rows, err := query.ListIndex("symbol", calcHolderSymbolIndex(req.Symbol), primary, count, dbm.ListASC)
+		if err == types.ErrNotFound {
+			break
+		}
+		if err != nil {
+			tokenlog.Error("listTokenHolderSnapshot failed", "symbol", req.Symbol, "fromKey", req.FromKey, "err", err)
+			return nil, err
+		}
+		for _, row := range rows {
+			holder := row.Data.(*pty.TokenHolder)
+			balance, err := getHolderBalanceAt(db, req.Symbol, holder.Addr, req.Height)
+			if err != nil {
+				return nil, err
+			}
+			if balance == 0 {
+				continue
+			}
+			reply.Holders = append(reply.Holders, &pty.TokenHolder{Symbol: req.Symbol, Addr: holder.Addr, Balance: balance})
+			if int32(len(reply.Holders)) == count {
+				return reply, nil
+			}
+		}
+		if int32(len(rows)) < count {
+			break
+		}
+		primary = rows[len(rows)-1].Primary
+	}
+	if len(reply.Holders) == 0 {
+		return nil, types.ErrNotFound
+	}
+	return reply, nil
+}
@@ -0,0 +1,85 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func holderBalances(reply types.Message) map[string]int64 {
+	balances := make(map[string]int64)
+	for _, holder := range reply.(*pty.ReplyTokenHolders).Holders {
+		balances[holder.Addr] = holder.Balance
+	}
+	return balances
+}
+
+func TestTokenHolders(t *testing.T) {
+	tt := newTokenTester(t)
+	tt.createToken(Symbol, 10000*types.Coin)
+	createHeight := tt.height
+	tt.createToken(Symbol+"A", 10000*types.Coin)
+	assert.Nil(t, tt.transfer(Symbol, string(Nodes[1]), 100*types.Coin, PrivKeyA))
+	assert.Nil(t, tt.transfer(Symbol, string(Nodes[2]), 200*types.Coin, PrivKeyA))
+	assert.Nil(t, tt.transfer(Symbol+"A", string(Nodes[1]), 500*types.Coin, PrivKeyA))
+	transferHeight := tt.height
+	tx, receipt, err := tt.exec("Transfer", &types.AssetsTransfer{Cointoken: Symbol, Amount: 100 * types.Coin, To: string(Nodes[2])}, string(Nodes[2]), PrivKeyB)
+	assert.Nil(t, err)
+
+	//按余额从高到低返回，余额为0的地址不返回
+	exec := tt.newExec().(*token)
+	reply, err := exec.Query_GetTokenHolders(&pty.ReqTokenHolders{Symbol: Symbol})
+	assert.Nil(t, err)
+	holders := reply.(*pty.ReplyTokenHolders).Holders
+	assert.Equal(t, 2, len(holders))
+	assert.Equal(t, string(Nodes[0]), holders[0].Addr)
+	assert.Equal(t, 9700*types.Coin, holders[0].Balance)
+	assert.Equal(t, string(Nodes[2]), holders[1].Addr)
+	assert.Equal(t, 300*types.Coin, holders[1].Balance)
+
+	//分页查询
+	reply, err = exec.Query_GetTokenHolders(&pty.ReqTokenHolders{Symbol: Symbol, Count: 1, FromKey: string(Nodes[0])})
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[2]), reply.(*pty.ReplyTokenHolders).Holders[0].Addr)
+
+	//指定高度的快照
+	_, err = exec.Query_GetTokenHolderSnapshot(&pty.ReqTokenHolderSnapshot{Symbol: Symbol, Height: createHeight - 1})
+	assert.Equal(t, types.ErrNotFound, err)
+	reply, err = exec.Query_GetTokenHolderSnapshot(&pty.ReqTokenHolderSnapshot{Symbol: Symbol, Height: createHeight})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int64{string(Nodes[0]): 10000 * types.Coin}, holderBalances(reply))
+	reply, err = exec.Query_GetTokenHolderSnapshot(&pty.ReqTokenHolderSnapshot{Symbol: Symbol, Height: transferHeight})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int64{string(Nodes[0]): 9700 * types.Coin, string(Nodes[1]): 100 * types.Coin, string(Nodes[2]): 200 * types.Coin}, holderBalances(reply))
+	reply, err = exec.Query_GetTokenHolderSnapshot(&pty.ReqTokenHolderSnapshot{Symbol: Symbol, Height: tt.height + 100})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int64{string(Nodes[0]): 9700 * types.Coin, string(Nodes[2]): 300 * types.Coin}, holderBalances(reply))
+	reply, err = exec.Query_GetTokenHolderSnapshot(&pty.ReqTokenHolderSnapshot{Symbol: Symbol + "A", Height: tt.height})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int64{string(Nodes[0]): 9500 * types.Coin, string(Nodes[1]): 500 * types.Coin}, holderBalances(reply))
+
+	//快照分页时跳过该高度没有余额的地址
+	var all []*pty.TokenHolder
+	req := &pty.ReqTokenHolderSnapshot{Symbol: Symbol, Height: createHeight, Count: 1}
+	for {
+		reply, err = exec.Query_GetTokenHolderSnapshot(req)
+		if err == types.ErrNotFound {
+			break
+		}
+		assert.Nil(t, err)
+		all = append(all, reply.(*pty.ReplyTokenHolders).Holders...)
+		req.FromKey = all[len(all)-1].Addr
+	}
+	assert.Equal(t, 1, len(all))
+
+	//回滚后恢复交易之前的余额和快照
+	tt.execDelLocal(tx, receipt)
+	reply, err = tt.newExec().(*token).Query_GetTokenHolders(&pty.ReqTokenHolders{Symbol: Symbol})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int64{string(Nodes[0]): 9700 * types.Coin, string(Nodes[1]): 100 * types.Coin, string(Nodes[2]): 200 * types.Coin}, holderBalances(reply))
+	reply, err = tt.newExec().(*token).Query_GetTokenHolderSnapshot(&pty.ReqTokenHolderSnapshot{Symbol: Symbol, Height: tt.height})
+	assert.Nil(t, err)
+	assert.Equal(t, 100*types.Coin, holderBalances(reply)[string(Nodes[1])])
+}
@@ -16,6 +16,9 @@ var (
 	tokenPreCreatedSTONew = "mavl-token-create-sto-"
 
 	tokenPreCreatedSTONewLocal = "LODB-token-create-sto-"
+
+	tokenFrozenAddr = "mavl-token-frozen-"
+	tokenMinter     = "mavl-token-minter-"
 )
 
 func calcTokenKey(token string) (key []byte) {
@@ -54,3 +57,12 @@ func calcTokenStatusTokenKeyPrefixLocal(status int32, token string) []byte {
 func calcAddrKey(token string, addr string) []byte {
 	return []byte(fmt.Sprintf("LODB-token-%s-Addr:%s", token, addr))
 }
+
+//存储token被冻结的地址
+func calcTokenFrozenAddrKey(token string, addr string) []byte {
+	return []byte(fmt.Sprintf(tokenFrozenAddr+"%s-%s", token, addr))
+}
+
+func calcTokenMinterKey(token string, addr string) []byte {
+	return []byte(fmt.Sprintf(tokenMinter+"%s-%s", token, addr))
+}
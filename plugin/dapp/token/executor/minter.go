@@ -0,0 +1,118 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 记录token的铸币地址及其额度，取消铸币权限后从表中删除
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+var opt_minter_table = &table.Option{
+	Prefix:  "LODB-token",
+	Name:    "minter",
+	Primary: "key",
+
+	Index: []string{
+		"symbol",
+	},
+}
+
+// MinterRow row
+type MinterRow struct {
+	*pty.TokenMinter
+}
+
+// NewMinterRow create row
+func NewMinterRow() *MinterRow {
+	return &MinterRow{TokenMinter: nil}
+}
+
+// CreateRow create row
+func (r *MinterRow) CreateRow() *table.Row {
+	return &table.Row{Data: &pty.TokenMinter{}}
+}
+
+// SetPayload set payload
+func (r *MinterRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*pty.TokenMinter); ok {
+		r.TokenMinter = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+// Get get index key
+func (r *MinterRow) Get(key string) ([]byte, error) {
+	switch key {
+	case "key":
+		return calcMinterPrimary(r.Symbol, r.Addr), nil
+	case "symbol":
+		return calcMinterSymbolIndex(r.Symbol), nil
+	default:
+		return nil, types.ErrNotFound
+	}
+}
+
+func calcMinterPrimary(symbol, addr string) []byte {
+	return []byte(fmt.Sprintf("%s-%s", symbol, addr))
+}
+
+//索引以分隔符结尾，避免按前缀查询时匹配到以该symbol开头的其他token
+func calcMinterSymbolIndex(symbol string) []byte {
+	return []byte(symbol + ":")
+}
+
+// NewMinterTable create table
+func NewMinterTable(kvdb dbm.KV) *table.Table {
+	rowMeta := NewMinterRow()
+	err := rowMeta.SetPayload(&pty.TokenMinter{})
+	if err != nil {
+		panic(err)
+	}
+	t, err := table.NewTable(rowMeta, kvdb, opt_minter_table)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// 根据铸币地址额度变化的回执更新本地数据库，回滚时恢复到之前的额度
+func updateMinterTable(db dbm.KV, receipt *pty.ReceiptTokenMinter, isDel bool) ([]*types.KeyValue, error) {
+	table := NewMinterTable(db)
+	minter := receipt.Current
+	if isDel {
+		minter = receipt.Prev
+	}
+	var err error
+	if minter.Quota > 0 {
+		err = table.Replace(minter)
+	} else {
+		err = table.Del(calcMinterPrimary(minter.Symbol, minter.Addr))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return table.Save()
+}
+
+func listMinters(db dbm.KVDB, symbol string) (*pty.ReplyTokenMinters, error) {
+	query := NewMinterTable(db).GetQuery(db)
+	rows, err := query.ListIndex("symbol", calcMinterSymbolIndex(symbol), nil, 0, dbm.ListASC)
+	if err != nil {
+		tokenlog.Error("listMinters failed", "symbol", symbol, "err", err)
+		return nil, err
+	}
+	reply := &pty.ReplyTokenMinters{}
+	for _, row := range rows {
+		reply.Minters = append(reply.Minters, row.Data.(*pty.TokenMinter))
+	}
+	return reply, nil
+}
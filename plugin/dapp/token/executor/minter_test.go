@@ -0,0 +1,92 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func (tt *tokenTester) createMintableToken(symbol string, total, maxSupply int64) error {
+	_, _, err := tt.exec("TokenPreCreate", &pty.TokenPreCreate{Name: symbol, Symbol: symbol, Introduction: symbol, Total: total, Owner: string(Nodes[0]), Category: pty.CategoryMintBurnSupport, MaxSupply: maxSupply}, "", PrivKeyA)
+	if err != nil {
+		return err
+	}
+	_, _, err = tt.exec("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: symbol, Owner: string(Nodes[0])}, "", PrivKeyA)
+	return err
+}
+
+func (tt *tokenTester) minters(symbol string) []*pty.TokenMinter {
+	reply, err := tt.newExec().(*token).Query_GetTokenMinters(&types.ReqString{Data: symbol})
+	if err == types.ErrNotFound {
+		return nil
+	}
+	assert.Nil(tt.t, err)
+	return reply.(*pty.ReplyTokenMinters).Minters
+}
+
+func TestTokenMinter(t *testing.T) {
+	tt := newTokenTester(t)
+	assert.Equal(t, pty.ErrTokenMaxSupply, tt.createMintableToken(Symbol, 1000*types.Coin, 999*types.Coin))
+	assert.Nil(t, tt.createMintableToken(Symbol, 1000*types.Coin, 2000*types.Coin))
+	assert.Equal(t, 2000*types.Coin, tt.tokenInfo(Symbol).MaxSupply)
+	accDB, _ := account.NewAccountDB(tt.cfg, pty.TokenX, Symbol, tt.stateDB)
+	mint := func(amount int64, privKey string) (*types.Transaction, *types.ReceiptData, error) {
+		return tt.exec("TokenMint", &pty.TokenMint{Symbol: Symbol, Amount: amount}, "", privKey)
+	}
+
+	//只有owner可以设置铸币地址
+	_, _, err := mint(types.Coin, PrivKeyB)
+	assert.Equal(t, types.ErrNotAllow, err)
+	_, _, err = tt.exec("TokenSetMinter", &pty.TokenSetMinter{Symbol: Symbol, Minter: string(Nodes[1]), Quota: 100 * types.Coin, Period: 10}, "", PrivKeyB)
+	assert.Equal(t, pty.ErrTokenOwner, err)
+	_, _, err = tt.exec("TokenSetMinter", &pty.TokenSetMinter{Symbol: Symbol, Minter: string(Nodes[0]), Quota: 100 * types.Coin, Period: 10}, "", PrivKeyA)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, _, err = tt.exec("TokenSetMinter", &pty.TokenSetMinter{Symbol: Symbol, Minter: string(Nodes[1]), Quota: 100 * types.Coin, Period: 10}, "", PrivKeyA)
+	assert.Nil(t, err)
+
+	//每个周期内铸币不能超过额度
+	_, _, err = mint(60*types.Coin, PrivKeyB)
+	assert.Nil(t, err)
+	_, _, err = mint(50*types.Coin, PrivKeyB)
+	assert.Equal(t, pty.ErrTokenMinterQuota, err)
+	mintTx, mintReceipt, err := mint(40*types.Coin, PrivKeyB)
+	assert.Nil(t, err)
+	assert.Equal(t, 100*types.Coin, accDB.LoadAccount(string(Nodes[1])).Balance)
+	assert.Equal(t, 1100*types.Coin, tt.tokenInfo(Symbol).Total)
+	minters := tt.minters(Symbol)
+	assert.Equal(t, 1, len(minters))
+	assert.Equal(t, 100*types.Coin, minters[0].Minted)
+
+	//回滚后恢复已铸币数量
+	tt.execDelLocal(mintTx, mintReceipt)
+	assert.Equal(t, 60*types.Coin, tt.minters(Symbol)[0].Minted)
+	assert.Equal(t, 1060*types.Coin, tt.tokenInfo(Symbol).Total)
+	set, err := tt.newExecAt(tt.heights[string(mintTx.Hash())]).ExecLocal(mintTx, mintReceipt, 1)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		tt.localDB.Set(kv.Key, kv.Value)
+	}
+
+	//下一个周期重新计算额度
+	tt.height += 10
+	_, _, err = mint(100*types.Coin, PrivKeyB)
+	assert.Nil(t, err)
+	assert.Equal(t, 100*types.Coin, tt.minters(Symbol)[0].Minted)
+
+	//总量不能超过最大发行量
+	_, _, err = mint(700*types.Coin, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = mint(200*types.Coin, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenMaxSupply, err)
+	assert.Equal(t, 1900*types.Coin, tt.tokenInfo(Symbol).Total)
+
+	//额度为0时取消铸币权限
+	_, _, err = tt.exec("TokenSetMinter", &pty.TokenSetMinter{Symbol: Symbol, Minter: string(Nodes[1])}, "", PrivKeyA)
+	assert.Nil(t, err)
+	assert.Nil(t, tt.minters(Symbol))
+	_, _, err = mint(types.Coin, PrivKeyB)
+	assert.Equal(t, types.ErrNotAllow, err)
+}
@@ -0,0 +1,99 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func (tt *tokenTester) tokenInfo(symbol string) *pty.LocalToken {
+	info, err := tt.newExec().(*token).getTokenInfo(symbol)
+	assert.Nil(tt.t, err)
+	return info.(*pty.LocalToken)
+}
+
+func (tt *tokenTester) historyActions(symbol string) []int32 {
+	reply, err := tt.newExec().(*token).Query_GetTokenHistory(&types.ReqString{Data: symbol})
+	assert.Nil(tt.t, err)
+	var actions []int32
+	for _, log := range reply.(*pty.ReplyTokenLogs).Logs {
+		actions = append(actions, log.ActionType)
+	}
+	return actions
+}
+
+func TestTokenUpdateInfo(t *testing.T) {
+	tt := newTokenTester(t)
+	tt.createToken(Symbol, 10000*types.Coin)
+
+	_, _, err := tt.exec("TokenUpdateInfo", &pty.TokenUpdateInfo{Symbol: Symbol, Name: "new name"}, "", PrivKeyB)
+	assert.Equal(t, pty.ErrTokenOwner, err)
+	_, _, err = tt.exec("TokenUpdateInfo", &pty.TokenUpdateInfo{Symbol: Symbol}, "", PrivKeyA)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, _, err = tt.exec("TokenUpdateInfo", &pty.TokenUpdateInfo{Symbol: Symbol, Icon: string(make([]byte, pty.TokenURLLenLimit+1))}, "", PrivKeyA)
+	assert.Equal(t, pty.ErrTokenURLLen, err)
+
+	updateTx, updateReceipt, err := tt.exec("TokenUpdateInfo", &pty.TokenUpdateInfo{Symbol: Symbol, Name: "new name", Icon: "https://icon", Url: "https://url"}, "", PrivKeyA)
+	assert.Nil(t, err)
+	//为空的字段保持不变
+	info := tt.tokenInfo(Symbol)
+	assert.Equal(t, "new name", info.Name)
+	assert.Equal(t, Symbol, info.Introduction)
+	assert.Equal(t, "https://icon", info.Icon)
+	assert.Equal(t, "https://url", info.Url)
+	assert.Equal(t, []int32{pty.TokenActionUpdateInfo, pty.TokenActionFinishCreate}, tt.historyActions(Symbol))
+	token, err := loadTokenDB(tt.stateDB, Symbol)
+	assert.Nil(t, err)
+	assert.Equal(t, "new name", token.token.Name)
+
+	//回滚后恢复原来的信息
+	tt.execDelLocal(updateTx, updateReceipt)
+	info = tt.tokenInfo(Symbol)
+	assert.Equal(t, Symbol, info.Name)
+	assert.Equal(t, "", info.Icon)
+	assert.Equal(t, []int32{pty.TokenActionFinishCreate}, tt.historyActions(Symbol))
+}
+
+func TestTokenTransferOwnership(t *testing.T) {
+	tt := newTokenTester(t)
+	tt.createToken(Symbol, 10000*types.Coin)
+
+	_, _, err := tt.exec("TokenTransferOwnership", &pty.TokenTransferOwnership{Symbol: Symbol, NewOwner: string(Nodes[1])}, "", PrivKeyB)
+	assert.Equal(t, pty.ErrTokenOwner, err)
+	_, _, err = tt.exec("TokenTransferOwnership", &pty.TokenTransferOwnership{Symbol: Symbol, NewOwner: string(Nodes[0])}, "", PrivKeyA)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, _, err = tt.exec("TokenTransferOwnership", &pty.TokenTransferOwnership{Symbol: Symbol, NewOwner: "bad address"}, "", PrivKeyA)
+	assert.NotNil(t, err)
+
+	transferTx, transferReceipt, err := tt.exec("TokenTransferOwnership", &pty.TokenTransferOwnership{Symbol: Symbol, NewOwner: string(Nodes[1])}, "", PrivKeyA)
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[1]), tt.tokenInfo(Symbol).Owner)
+	assert.Equal(t, []int32{pty.TokenActionTransferOwnership, pty.TokenActionFinishCreate}, tt.historyActions(Symbol))
+	//原owner名下的记录同步改写，不再保留转移前的状态
+	token, err := getTokenFromDB(tt.stateDB, Symbol, string(Nodes[0]))
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[1]), token.Owner)
+
+	//转移后原owner不能再管理token，由新owner管理
+	_, _, err = tt.exec("TokenPause", &pty.TokenPause{Symbol: Symbol}, "", PrivKeyA)
+	assert.Equal(t, pty.ErrTokenOwner, err)
+	updateTx, updateReceipt, err := tt.exec("TokenUpdateInfo", &pty.TokenUpdateInfo{Symbol: Symbol, Url: "https://url"}, "", PrivKeyB)
+	assert.Nil(t, err)
+	pauseTx, pauseReceipt, err := tt.exec("TokenPause", &pty.TokenPause{Symbol: Symbol}, "", PrivKeyB)
+	assert.Nil(t, err)
+	assert.True(t, tt.tokenInfo(Symbol).Paused)
+
+	//按执行的逆序回滚后token信息回到原owner名下
+	tt.execDelLocal(pauseTx, pauseReceipt)
+	tt.execDelLocal(updateTx, updateReceipt)
+	assert.Equal(t, "", tt.tokenInfo(Symbol).Url)
+	tt.execDelLocal(transferTx, transferReceipt)
+	info := tt.tokenInfo(Symbol)
+	assert.Equal(t, string(Nodes[0]), info.Owner)
+	assert.False(t, info.Paused)
+	assert.Equal(t, []int32{pty.TokenActionFinishCreate}, tt.historyActions(Symbol))
+	_, err = loadLocalToken(Symbol, string(Nodes[1]), pty.TokenStatusCreated, tt.localDB)
+	assert.Equal(t, types.ErrNotFound, err)
+}
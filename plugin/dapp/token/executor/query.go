@@ -91,3 +91,49 @@ func (t *token) Query_GetTokenHistory(in *types.ReqString) (types.Message, error
 	}
 	return &replys, nil
 }
+
+// Query_GetFrozenAddrs 获取token被冻结的地址列表
+func (t *token) Query_GetFrozenAddrs(in *tokenty.ReqFrozenAddrs) (types.Message, error) {
+	if in == nil || in.Symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	rows, err := listFrozenAddrs(t.GetLocalDB(), in)
+	if err != nil {
+		tokenlog.Error("Query_GetFrozenAddrs", "err", err)
+		return nil, err
+	}
+	var reply tokenty.ReplyFrozenAddrs
+	for _, row := range rows {
+		o, ok := row.Data.(*tokenty.TokenFrozenAddr)
+		if !ok {
+			tokenlog.Error("Query_GetFrozenAddrs", "err", "bad row type")
+			return nil, types.ErrTypeAsset
+		}
+		reply.Addrs = append(reply.Addrs, o)
+	}
+	return &reply, nil
+}
+
+// Query_GetTokenHolders 按余额从高到低获取token的持有人
+func (t *token) Query_GetTokenHolders(in *tokenty.ReqTokenHolders) (types.Message, error) {
+	if in == nil || in.Symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	return listTokenHolders(t.GetLocalDB(), in)
+}
+
+// Query_GetTokenHolderSnapshot 获取token在指定高度的持有人快照
+func (t *token) Query_GetTokenHolderSnapshot(in *tokenty.ReqTokenHolderSnapshot) (types.Message, error) {
+	if in == nil || in.Symbol == "" || in.Height < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	return listTokenHolderSnapshot(t.GetLocalDB(), in)
+}
+
+// Query_GetTokenMinters 获取token的铸币地址及额度
+func (t *token) Query_GetTokenMinters(in *types.ReqString) (types.Message, error) {
+	if in == nil || in.Data == "" {
+		return nil, types.ErrInvalidParam
+	}
+	return listMinters(t.GetLocalDB(), in.Data)
+}
@@ -36,6 +36,9 @@ func newTokenDB(cfg *types.Chain33Config, preCreate *pty.TokenPreCreate, creator
 	if cfg.IsDappFork(height, pty.TokenX, pty.ForkTokenSymbolWithNumberX) {
 		t.token.Category = preCreate.Category
 	}
+	if cfg.IsDappFork(height, pty.TokenX, pty.ForkTokenMinterX) {
+		t.token.MaxSupply = preCreate.MaxSupply
+	}
 	return t
 }
 
@@ -83,9 +86,17 @@ func (t *tokenDB) mint(db dbm.KV, addr string, amount int64) ([]*types.KeyValue,
 	if t.token.Owner != addr {
 		return nil, nil, types.ErrNotAllow
 	}
+	return t.mintBy(amount)
+}
+
+//铸币数量的检查，设置了最大发行量时总量不能超过最大发行量
+func (t *tokenDB) mintBy(amount int64) ([]*types.KeyValue, []*types.ReceiptLog, error) {
 	if t.token.Total+amount > types.MaxTokenBalance {
 		return nil, nil, types.ErrAmount
 	}
+	if t.token.MaxSupply > 0 && t.token.Total+amount > t.token.MaxSupply {
+		return nil, nil, pty.ErrTokenMaxSupply
+	}
 	prevToken := t.token
 	t.token.Total += amount
 
@@ -165,6 +176,11 @@ func (action *tokenAction) preCreate(token *pty.TokenPreCreate) (*types.Receipt,
 			return nil, err
 		}
 	}
+	if cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenMinterX) {
+		if token.MaxSupply < 0 || (token.MaxSupply > 0 && token.MaxSupply < token.Total) {
+			return nil, pty.ErrTokenMaxSupply
+		}
+	}
 	if !cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenSymbolWithNumberX) {
 		if token.Category != 0 {
 			return nil, types.ErrNotSupport
@@ -569,13 +585,29 @@ func (action *tokenAction) mint(mint *pty.TokenMint) (*types.Receipt, error) {
 		return nil, types.ErrNotSupport
 	}
 
-	kvs, logs, err := tokendb.mint(action.db, action.fromaddr, mint.Amount)
+	var kvs []*types.KeyValue
+	var logs []*types.ReceiptLog
+	cfg := action.api.GetConfig()
+	if cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenMinterX) && action.fromaddr != tokendb.token.Owner {
+		kvs, logs, err = action.useMinterQuota(mint.Symbol, mint.Amount)
+		if err != nil {
+			return nil, err
+		}
+		kvsMint, logsMint, err := tokendb.mintBy(mint.Amount)
+		if err != nil {
+			tokenlog.Error("token mint ", "symbol", mint.GetSymbol(), "error", err, "minter", action.fromaddr)
+			return nil, err
+		}
+		kvs = append(kvs, kvsMint...)
+		logs = append(logs, logsMint...)
+	} else {
+		kvs, logs, err = tokendb.mint(action.db, action.fromaddr, mint.Amount)
+	}
 	if err != nil {
 		tokenlog.Error("token mint ", "symbol", mint.GetSymbol(), "error", err, "from", action.fromaddr, "owner", tokendb.token.Owner)
 		return nil, err
 	}
 
-	cfg := action.api.GetConfig()
 	tokenAccount, err := account.NewAccountDB(cfg, "token", mint.GetSymbol(), action.db)
 	if err != nil {
 		return nil, err
@@ -631,3 +663,313 @@ func (action *tokenAction) burn(burn *pty.TokenBurn) (*types.Receipt, error) {
 
 	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
 }
+
+func getFrozenAddr(db dbm.KV, symbol, addr string) (*pty.TokenFrozenAddr, error) {
+	value, err := db.Get(calcTokenFrozenAddrKey(symbol, addr))
+	if err == types.ErrNotFound {
+		return &pty.TokenFrozenAddr{Symbol: symbol, Addr: addr}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var frozen pty.TokenFrozenAddr
+	err = types.Decode(value, &frozen)
+	if err != nil {
+		tokenlog.Error("getFrozenAddr", "Fail to decode TokenFrozenAddr, symbol", symbol, "addr", addr, "err", err)
+		return nil, err
+	}
+	return &frozen, nil
+}
+
+// checkTransferable 检查token是否暂停转账，以及转出和转入地址是否被冻结，to为空时不检查转入地址
+func checkTransferable(db dbm.KV, symbol, from, to string) error {
+	tokendb, err := loadTokenDB(db, symbol)
+	if err == pty.ErrTokenNotExist {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if tokendb.token.Paused {
+		return pty.ErrTokenPaused
+	}
+	for _, addr := range []string{from, to} {
+		if addr == "" {
+			continue
+		}
+		frozen, err := getFrozenAddr(db, symbol, addr)
+		if err != nil {
+			return err
+		}
+		if frozen.Frozen {
+			tokenlog.Error("checkTransferable", "symbol", symbol, "frozen addr", addr)
+			return pty.ErrTokenAddrFrozen
+		}
+	}
+	return nil
+}
+
+// 加载token并检查操作者是否为token的owner
+func (action *tokenAction) loadOwnedToken(symbol string) (*tokenDB, error) {
+	if symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := loadTokenDB(action.db, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Owner != action.fromaddr {
+		tokenlog.Error("token owner action", "symbol", symbol, "from", action.fromaddr, "owner", tokendb.token.Owner)
+		return nil, pty.ErrTokenOwner
+	}
+	return tokendb, nil
+}
+
+// 冻结或者解冻地址，被冻结的地址不能转出和转入该token
+func (action *tokenAction) setFrozen(symbol, addr string, frozen bool) (*types.Receipt, error) {
+	tokendb, err := action.loadOwnedToken(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if err := address.CheckAddress(addr); err != nil {
+		return nil, err
+	}
+	if addr == tokendb.token.Owner {
+		return nil, types.ErrInvalidParam
+	}
+	prev, err := getFrozenAddr(action.db, symbol, addr)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Frozen == frozen {
+		if frozen {
+			return nil, pty.ErrTokenAddrFrozen
+		}
+		return nil, pty.ErrTokenAddrNotFrozen
+	}
+	current := &pty.TokenFrozenAddr{Symbol: symbol, Addr: addr, Frozen: frozen, Height: action.height}
+	key := calcTokenFrozenAddrKey(symbol, addr)
+	value := types.Encode(current)
+	if err := action.db.Set(key, value); err != nil {
+		return nil, err
+	}
+	ty := int32(pty.TyLogTokenFreeze)
+	if !frozen {
+		ty = pty.TyLogTokenUnfreeze
+	}
+	kvs := []*types.KeyValue{{Key: key, Value: value}}
+	logs := []*types.ReceiptLog{{Ty: ty, Log: types.Encode(&pty.ReceiptTokenFreeze{Prev: prev, Current: current})}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) freeze(freeze *pty.TokenFreeze) (*types.Receipt, error) {
+	if freeze == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return action.setFrozen(freeze.GetSymbol(), freeze.GetAddr(), true)
+}
+
+func (action *tokenAction) unfreeze(unfreeze *pty.TokenUnfreeze) (*types.Receipt, error) {
+	if unfreeze == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return action.setFrozen(unfreeze.GetSymbol(), unfreeze.GetAddr(), false)
+}
+
+// 暂停或者恢复token的所有转账
+func (action *tokenAction) setPaused(symbol string, paused bool) (*types.Receipt, error) {
+	tokendb, err := action.loadOwnedToken(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Paused == paused {
+		if paused {
+			return nil, pty.ErrTokenPaused
+		}
+		return nil, pty.ErrTokenNotPaused
+	}
+	tokendb.token.Paused = paused
+	tokendb.save(action.db, calcTokenKey(symbol))
+	tokendb.save(action.db, calcTokenAddrNewKeyS(symbol, tokendb.token.Owner))
+
+	ty := int32(pty.TyLogTokenPause)
+	if !paused {
+		ty = pty.TyLogTokenUnpause
+	}
+	kvs := append(tokendb.getKVSet(calcTokenKey(symbol)), tokendb.getKVSet(calcTokenAddrNewKeyS(symbol, tokendb.token.Owner))...)
+	logs := []*types.ReceiptLog{{Ty: ty, Log: types.Encode(&pty.ReceiptTokenPause{Symbol: symbol, Owner: tokendb.token.Owner, Paused: paused})}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) pause(pause *pty.TokenPause) (*types.Receipt, error) {
+	if pause == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return action.setPaused(pause.GetSymbol(), true)
+}
+
+func (action *tokenAction) unpause(unpause *pty.TokenUnpause) (*types.Receipt, error) {
+	if unpause == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return action.setPaused(unpause.GetSymbol(), false)
+}
+
+// 更新token的名称、简介、图标和链接，为空的字段保持不变
+func (action *tokenAction) updateInfo(update *pty.TokenUpdateInfo) (*types.Receipt, error) {
+	if update == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if update.Name == "" && update.Introduction == "" && update.Icon == "" && update.Url == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if len(update.Name) > pty.TokenNameLenLimit {
+		return nil, pty.ErrTokenNameLen
+	}
+	if len(update.Introduction) > pty.TokenIntroLenLimit {
+		return nil, pty.ErrTokenIntroLen
+	}
+	if len(update.Icon) > pty.TokenURLLenLimit || len(update.Url) > pty.TokenURLLenLimit {
+		return nil, pty.ErrTokenURLLen
+	}
+	tokendb, err := action.loadOwnedToken(update.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	prevToken := tokendb.token
+	if update.Name != "" {
+		tokendb.token.Name = update.Name
+	}
+	if update.Introduction != "" {
+		tokendb.token.Introduction = update.Introduction
+	}
+	if update.Icon != "" {
+		tokendb.token.Icon = update.Icon
+	}
+	if update.Url != "" {
+		tokendb.token.Url = update.Url
+	}
+	tokendb.save(action.db, calcTokenKey(update.Symbol))
+	tokendb.save(action.db, calcTokenAddrNewKeyS(update.Symbol, tokendb.token.Owner))
+
+	kvs := append(tokendb.getKVSet(calcTokenKey(update.Symbol)), tokendb.getKVSet(calcTokenAddrNewKeyS(update.Symbol, tokendb.token.Owner))...)
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenUpdateInfo, Log: types.Encode(&pty.ReceiptTokenAmount{Prev: &prevToken, Current: &tokendb.token})}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+// 将token的所有权转移给新的地址，转移后由新owner进行铸币、冻结和暂停等操作
+func (action *tokenAction) transferOwnership(transfer *pty.TokenTransferOwnership) (*types.Receipt, error) {
+	if transfer == nil {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := action.loadOwnedToken(transfer.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	if err := address.CheckAddress(transfer.NewOwner); err != nil {
+		return nil, err
+	}
+	if transfer.NewOwner == tokendb.token.Owner {
+		return nil, types.ErrInvalidParam
+	}
+	prevToken := tokendb.token
+	tokendb.token.Owner = transfer.NewOwner
+	// 原owner名下的记录同时改写为转移后的token，避免按原owner查询时仍得到旧的状态
+	keys := [][]byte{
+		calcTokenKey(transfer.Symbol),
+		calcTokenAddrNewKeyS(transfer.Symbol, prevToken.Owner),
+		calcTokenAddrNewKeyS(transfer.Symbol, transfer.NewOwner),
+	}
+	var kvs []*types.KeyValue
+	for _, key := range keys {
+		tokendb.save(action.db, key)
+		kvs = append(kvs, tokendb.getKVSet(key)...)
+	}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenTransferOwnership, Log: types.Encode(&pty.ReceiptTokenAmount{Prev: &prevToken, Current: &tokendb.token})}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func getMinter(db dbm.KV, symbol, addr string) (*pty.TokenMinter, error) {
+	value, err := db.Get(calcTokenMinterKey(symbol, addr))
+	if err == types.ErrNotFound {
+		return &pty.TokenMinter{Symbol: symbol, Addr: addr}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var minter pty.TokenMinter
+	err = types.Decode(value, &minter)
+	if err != nil {
+		tokenlog.Error("getMinter", "Fail to decode TokenMinter, symbol", symbol, "addr", addr, "err", err)
+		return nil, err
+	}
+	return &minter, nil
+}
+
+func (action *tokenAction) saveMinter(prev, current *pty.TokenMinter) ([]*types.KeyValue, []*types.ReceiptLog, error) {
+	key := calcTokenMinterKey(current.Symbol, current.Addr)
+	value := types.Encode(current)
+	if err := action.db.Set(key, value); err != nil {
+		return nil, nil, err
+	}
+	kvs := []*types.KeyValue{{Key: key, Value: value}}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenMinter, Log: types.Encode(&pty.ReceiptTokenMinter{Prev: prev, Current: current})}}
+	return kvs, logs, nil
+}
+
+// 设置铸币地址的额度，重新设置后从当前高度开始新的周期
+func (action *tokenAction) setMinter(set *pty.TokenSetMinter) (*types.Receipt, error) {
+	if set == nil || set.Quota < 0 || set.Quota > types.MaxTokenBalance || set.Period < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := action.loadOwnedToken(set.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Category&pty.CategoryMintBurnSupport == 0 {
+		tokenlog.Error("Can't set minter category", "category", tokendb.token.Category, "support", pty.CategoryMintBurnSupport)
+		return nil, types.ErrNotSupport
+	}
+	if err := address.CheckAddress(set.Minter); err != nil {
+		return nil, err
+	}
+	if set.Minter == tokendb.token.Owner {
+		return nil, types.ErrInvalidParam
+	}
+	prev, err := getMinter(action.db, set.Symbol, set.Minter)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Quota == 0 && set.Quota == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	current := &pty.TokenMinter{Symbol: set.Symbol, Addr: set.Minter, Quota: set.Quota, Period: set.Period, PeriodStart: action.height}
+	kvs, logs, err := action.saveMinter(prev, current)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+// 扣减铸币地址在当前周期内的额度，超过周期后重新开始计算
+func (action *tokenAction) useMinterQuota(symbol string, amount int64) ([]*types.KeyValue, []*types.ReceiptLog, error) {
+	prev, err := getMinter(action.db, symbol, action.fromaddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if prev.Quota == 0 {
+		tokenlog.Error("token mint", "symbol", symbol, "not owner or minter", action.fromaddr)
+		return nil, nil, types.ErrNotAllow
+	}
+	current := *prev
+	if current.Period > 0 && action.height >= current.PeriodStart+current.Period {
+		current.PeriodStart = action.height - (action.height-current.PeriodStart)%current.Period
+		current.Minted = 0
+	}
+	if current.Minted+amount > current.Quota {
+		tokenlog.Error("token mint", "symbol", symbol, "minter", action.fromaddr, "minted", current.Minted, "amount", amount, "quota", current.Quota)
+		return nil, nil, pty.ErrTokenMinterQuota
+	}
+	current.Minted += amount
+	return action.saveMinter(prev, &current)
+}
@@ -15,6 +15,11 @@ import (
 
 func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction, action *tokenty.TokenAction, index int) (*types.Receipt, error) {
 	cfg := t.GetAPI().GetConfig()
+	if cfg.IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenFreezeX) {
+		if err := t.checkTokenTransfer(tx, action); err != nil {
+			return nil, err
+		}
+	}
 	if (action.Ty == tokenty.ActionTransfer) && action.GetTransfer() != nil {
 		transfer := action.GetTransfer()
 		from := tx.From()
@@ -59,6 +64,24 @@ func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction,
 	}
 }
 
+//token暂停或者地址被冻结时不允许转账，转账到合约时只检查转出地址
+func (t *token) checkTokenTransfer(tx *types.Transaction, action *tokenty.TokenAction) error {
+	var symbol, to string
+	if action.Ty == tokenty.ActionTransfer && action.GetTransfer() != nil {
+		symbol = action.GetTransfer().Cointoken
+		if !drivers.IsDriverAddress(tx.GetRealToAddr(), t.GetHeight()) {
+			to = tx.GetRealToAddr()
+		}
+	} else if action.Ty == tokenty.ActionWithdraw && action.GetWithdraw() != nil {
+		symbol = action.GetWithdraw().Cointoken
+	} else if action.Ty == tokenty.TokenActionTransferToExec && action.GetTransferToExec() != nil {
+		symbol = action.GetTransferToExec().Cointoken
+	} else {
+		return nil
+	}
+	return checkTransferable(t.GetStateDB(), symbol, tx.From(), to)
+}
+
 func isExecAddrMatch(name string, to string) bool {
 	toaddr := address.ExecAddress(name)
 	return toaddr == to
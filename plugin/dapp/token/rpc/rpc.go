@@ -149,3 +149,101 @@ func (c *Jrpc) CreateRawTokenBurnTx(param *tokenty.TokenBurn, result *interface{
 	*result = hex.EncodeToString(data)
 	return nil
 }
+
+// CreateRawTokenFreezeTx 创建未签名的冻结token地址交易
+func (c *Jrpc) CreateRawTokenFreezeTx(param *tokenty.TokenFreeze, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.Addr == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenFreeze", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenUnfreezeTx 创建未签名的解冻token地址交易
+func (c *Jrpc) CreateRawTokenUnfreezeTx(param *tokenty.TokenUnfreeze, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.Addr == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenUnfreeze", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenPauseTx 创建未签名的暂停token转账交易
+func (c *Jrpc) CreateRawTokenPauseTx(param *tokenty.TokenPause, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenPause", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenUnpauseTx 创建未签名的恢复token转账交易
+func (c *Jrpc) CreateRawTokenUnpauseTx(param *tokenty.TokenUnpause, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenUnpause", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenUpdateInfoTx 创建未签名的更新token信息交易
+func (c *Jrpc) CreateRawTokenUpdateInfoTx(param *tokenty.TokenUpdateInfo, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenUpdateInfo", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenTransferOwnershipTx 创建未签名的转移token所有权交易
+func (c *Jrpc) CreateRawTokenTransferOwnershipTx(param *tokenty.TokenTransferOwnership, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.NewOwner == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenTransferOwnership", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenSetMinterTx 创建未签名的设置铸币地址额度交易
+func (c *Jrpc) CreateRawTokenSetMinterTx(param *tokenty.TokenSetMinter, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.Minter == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenSetMinter", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
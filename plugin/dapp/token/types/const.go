@@ -23,6 +23,20 @@ const (
 	TokenActionMint = 12
 	// TokenActionBurn for token burn
 	TokenActionBurn = 13
+	// TokenActionFreeze for token freeze address
+	TokenActionFreeze = 14
+	// TokenActionUnfreeze for token unfreeze address
+	TokenActionUnfreeze = 15
+	// TokenActionPause for token pause
+	TokenActionPause = 16
+	// TokenActionUnpause for token unpause
+	TokenActionUnpause = 17
+	// TokenActionUpdateInfo for token update info
+	TokenActionUpdateInfo = 18
+	// TokenActionTransferOwnership for token transfer ownership
+	TokenActionTransferOwnership = 19
+	// TokenActionSetMinter for token set minter
+	TokenActionSetMinter = 20
 )
 
 // token status
@@ -49,6 +63,12 @@ var (
 	ForkTokenSymbolWithNumberX = "ForkTokenSymbolWithNumber"
 	// ForkTokenCheckX  fork check impl bug
 	ForkTokenCheckX = "ForkTokenCheck"
+	// ForkTokenFreezeX fork const, 支持冻结地址和暂停转账
+	ForkTokenFreezeX = "ForkTokenFreeze"
+	// ForkTokenOwnershipX fork const, 支持更新token信息和转移所有权
+	ForkTokenOwnershipX = "ForkTokenOwnership"
+	// ForkTokenMinterX fork const, 支持最大发行量和铸币地址额度
+	ForkTokenMinterX = "ForkTokenMinter"
 )
 
 const (
@@ -82,6 +102,20 @@ const (
 	TyLogTokenMint = 323
 	// TyLogTokenBurn log for token burn
 	TyLogTokenBurn = 324
+	// TyLogTokenFreeze log for token freeze address
+	TyLogTokenFreeze = 325
+	// TyLogTokenUnfreeze log for token unfreeze address
+	TyLogTokenUnfreeze = 326
+	// TyLogTokenPause log for token pause
+	TyLogTokenPause = 327
+	// TyLogTokenUnpause log for token unpause
+	TyLogTokenUnpause = 328
+	// TyLogTokenUpdateInfo log for token update info
+	TyLogTokenUpdateInfo = 329
+	// TyLogTokenTransferOwnership log for token transfer ownership
+	TyLogTokenTransferOwnership = 330
+	// TyLogTokenMinter log for token minter quota change
+	TyLogTokenMinter = 331
 )
 
 const (
@@ -91,6 +125,10 @@ const (
 	TokenSymbolLenLimit = 16
 	// TokenIntroLenLimit token introduction length limit
 	TokenIntroLenLimit = 1024
+	// TokenURLLenLimit token icon and url length limit
+	TokenURLLenLimit = 256
+	// TokenHoldersCountLimit token holders query count limit
+	TokenHoldersCountLimit = 100
 )
 
 const (
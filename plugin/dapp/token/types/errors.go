@@ -35,4 +35,18 @@ var (
 	ErrTokenBlacklist = errors.New("ErrTokenBlacklist")
 	// ErrTokenNotExist error token symbol not exist
 	ErrTokenNotExist = errors.New("ErrTokenSymbolNotExist")
+	// ErrTokenAddrFrozen error token address frozen
+	ErrTokenAddrFrozen = errors.New("ErrTokenAddrFrozen")
+	// ErrTokenAddrNotFrozen error token address not frozen
+	ErrTokenAddrNotFrozen = errors.New("ErrTokenAddrNotFrozen")
+	// ErrTokenPaused error token paused
+	ErrTokenPaused = errors.New("ErrTokenPaused")
+	// ErrTokenNotPaused error token not paused
+	ErrTokenNotPaused = errors.New("ErrTokenNotPaused")
+	// ErrTokenURLLen error token icon or url length
+	ErrTokenURLLen = errors.New("ErrTokenURLLength")
+	// ErrTokenMaxSupply error token total exceeds max supply
+	ErrTokenMaxSupply = errors.New("ErrTokenMaxSupply")
+	// ErrTokenMinterQuota error minter quota exceeded
+	ErrTokenMinterQuota = errors.New("ErrTokenMinterQuota")
 )
@@ -38,6 +38,13 @@ type TokenAction struct {
 	//	*TokenAction_TransferToExec
 	//	*TokenAction_TokenMint
 	//	*TokenAction_TokenBurn
+	//	*TokenAction_TokenFreeze
+	//	*TokenAction_TokenUnfreeze
+	//	*TokenAction_TokenPause
+	//	*TokenAction_TokenUnpause
+	//	*TokenAction_TokenUpdateInfo
+	//	*TokenAction_TokenTransferOwnership
+	//	*TokenAction_TokenSetMinter
 	Value                isTokenAction_Value `protobuf_oneof:"value"`
 	Ty                   int32               `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -110,6 +117,34 @@ type TokenAction_TokenBurn struct {
 	TokenBurn *TokenBurn `protobuf:"bytes,10,opt,name=tokenBurn,proto3,oneof"`
 }
 
+type TokenAction_TokenFreeze struct {
+	TokenFreeze *TokenFreeze `protobuf:"bytes,11,opt,name=tokenFreeze,proto3,oneof"`
+}
+
+type TokenAction_TokenUnfreeze struct {
+	TokenUnfreeze *TokenUnfreeze `protobuf:"bytes,12,opt,name=tokenUnfreeze,proto3,oneof"`
+}
+
+type TokenAction_TokenPause struct {
+	TokenPause *TokenPause `protobuf:"bytes,13,opt,name=tokenPause,proto3,oneof"`
+}
+
+type TokenAction_TokenUnpause struct {
+	TokenUnpause *TokenUnpause `protobuf:"bytes,14,opt,name=tokenUnpause,proto3,oneof"`
+}
+
+type TokenAction_TokenUpdateInfo struct {
+	TokenUpdateInfo *TokenUpdateInfo `protobuf:"bytes,15,opt,name=tokenUpdateInfo,proto3,oneof"`
+}
+
+type TokenAction_TokenTransferOwnership struct {
+	TokenTransferOwnership *TokenTransferOwnership `protobuf:"bytes,16,opt,name=tokenTransferOwnership,proto3,oneof"`
+}
+
+type TokenAction_TokenSetMinter struct {
+	TokenSetMinter *TokenSetMinter `protobuf:"bytes,17,opt,name=tokenSetMinter,proto3,oneof"`
+}
+
 func (*TokenAction_TokenPreCreate) isTokenAction_Value() {}
 
 func (*TokenAction_TokenFinishCreate) isTokenAction_Value() {}
@@ -128,6 +163,20 @@ func (*TokenAction_TokenMint) isTokenAction_Value() {}
 
 func (*TokenAction_TokenBurn) isTokenAction_Value() {}
 
+func (*TokenAction_TokenFreeze) isTokenAction_Value() {}
+
+func (*TokenAction_TokenUnfreeze) isTokenAction_Value() {}
+
+func (*TokenAction_TokenPause) isTokenAction_Value() {}
+
+func (*TokenAction_TokenUnpause) isTokenAction_Value() {}
+
+func (*TokenAction_TokenUpdateInfo) isTokenAction_Value() {}
+
+func (*TokenAction_TokenTransferOwnership) isTokenAction_Value() {}
+
+func (*TokenAction_TokenSetMinter) isTokenAction_Value() {}
+
 func (m *TokenAction) GetValue() isTokenAction_Value {
 	if m != nil {
 		return m.Value
@@ -198,6 +247,55 @@ func (m *TokenAction) GetTokenBurn() *TokenBurn {
 	return nil
 }
 
+func (m *TokenAction) GetTokenFreeze() *TokenFreeze {
+	if x, ok := m.GetValue().(*TokenAction_TokenFreeze); ok {
+		return x.TokenFreeze
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenUnfreeze() *TokenUnfreeze {
+	if x, ok := m.GetValue().(*TokenAction_TokenUnfreeze); ok {
+		return x.TokenUnfreeze
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenPause() *TokenPause {
+	if x, ok := m.GetValue().(*TokenAction_TokenPause); ok {
+		return x.TokenPause
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenUnpause() *TokenUnpause {
+	if x, ok := m.GetValue().(*TokenAction_TokenUnpause); ok {
+		return x.TokenUnpause
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenUpdateInfo() *TokenUpdateInfo {
+	if x, ok := m.GetValue().(*TokenAction_TokenUpdateInfo); ok {
+		return x.TokenUpdateInfo
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenTransferOwnership() *TokenTransferOwnership {
+	if x, ok := m.GetValue().(*TokenAction_TokenTransferOwnership); ok {
+		return x.TokenTransferOwnership
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenSetMinter() *TokenSetMinter {
+	if x, ok := m.GetValue().(*TokenAction_TokenSetMinter); ok {
+		return x.TokenSetMinter
+	}
+	return nil
+}
+
 func (m *TokenAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -217,18 +315,27 @@ func (*TokenAction) XXX_OneofWrappers() []interface{} {
 		(*TokenAction_TransferToExec)(nil),
 		(*TokenAction_TokenMint)(nil),
 		(*TokenAction_TokenBurn)(nil),
+		(*TokenAction_TokenFreeze)(nil),
+		(*TokenAction_TokenUnfreeze)(nil),
+		(*TokenAction_TokenPause)(nil),
+		(*TokenAction_TokenUnpause)(nil),
+		(*TokenAction_TokenUpdateInfo)(nil),
+		(*TokenAction_TokenTransferOwnership)(nil),
+		(*TokenAction_TokenSetMinter)(nil),
 	}
 }
 
 //创建token，支持最大精确度是8位小数,即存入数据库的实际总额需要放大1e8倍
 type TokenPreCreate struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Introduction         string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
-	Total                int64    `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Price                int64    `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	Owner                string   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
-	Category             int32    `protobuf:"varint,7,opt,name=category,proto3" json:"category,omitempty"`
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol       string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Introduction string `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	Total        int64  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Price        int64  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Owner        string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Category     int32  `protobuf:"varint,7,opt,name=category,proto3" json:"category,omitempty"`
+	// 最大发行量，为0时不限制
+	MaxSupply            int64    `protobuf:"varint,8,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -308,6 +415,13 @@ func (m *TokenPreCreate) GetCategory() int32 {
 	return 0
 }
 
+func (m *TokenPreCreate) GetMaxSupply() int64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
 type TokenFinishCreate struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -496,6 +610,364 @@ func (m *TokenBurn) GetAmount() int64 {
 	return 0
 }
 
+// 冻结地址，被冻结的地址不能转出该token
+type TokenFreeze struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenFreeze) Reset()         { *m = TokenFreeze{} }
+func (m *TokenFreeze) String() string { return proto.CompactTextString(m) }
+func (*TokenFreeze) ProtoMessage()    {}
+func (*TokenFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{6}
+}
+
+func (m *TokenFreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenFreeze.Unmarshal(m, b)
+}
+func (m *TokenFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenFreeze.Marshal(b, m, deterministic)
+}
+func (m *TokenFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenFreeze.Merge(m, src)
+}
+func (m *TokenFreeze) XXX_Size() int {
+	return xxx_messageInfo_TokenFreeze.Size(m)
+}
+func (m *TokenFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenFreeze proto.InternalMessageInfo
+
+func (m *TokenFreeze) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenFreeze) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type TokenUnfreeze struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenUnfreeze) Reset()         { *m = TokenUnfreeze{} }
+func (m *TokenUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*TokenUnfreeze) ProtoMessage()    {}
+func (*TokenUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{7}
+}
+
+func (m *TokenUnfreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenUnfreeze.Unmarshal(m, b)
+}
+func (m *TokenUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenUnfreeze.Marshal(b, m, deterministic)
+}
+func (m *TokenUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUnfreeze.Merge(m, src)
+}
+func (m *TokenUnfreeze) XXX_Size() int {
+	return xxx_messageInfo_TokenUnfreeze.Size(m)
+}
+func (m *TokenUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUnfreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUnfreeze proto.InternalMessageInfo
+
+func (m *TokenUnfreeze) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenUnfreeze) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+// 暂停token的所有转账
+type TokenPause struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenPause) Reset()         { *m = TokenPause{} }
+func (m *TokenPause) String() string { return proto.CompactTextString(m) }
+func (*TokenPause) ProtoMessage()    {}
+func (*TokenPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{8}
+}
+
+func (m *TokenPause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenPause.Unmarshal(m, b)
+}
+func (m *TokenPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenPause.Marshal(b, m, deterministic)
+}
+func (m *TokenPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPause.Merge(m, src)
+}
+func (m *TokenPause) XXX_Size() int {
+	return xxx_messageInfo_TokenPause.Size(m)
+}
+func (m *TokenPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPause proto.InternalMessageInfo
+
+func (m *TokenPause) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+type TokenUnpause struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenUnpause) Reset()         { *m = TokenUnpause{} }
+func (m *TokenUnpause) String() string { return proto.CompactTextString(m) }
+func (*TokenUnpause) ProtoMessage()    {}
+func (*TokenUnpause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{9}
+}
+
+func (m *TokenUnpause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenUnpause.Unmarshal(m, b)
+}
+func (m *TokenUnpause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenUnpause.Marshal(b, m, deterministic)
+}
+func (m *TokenUnpause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUnpause.Merge(m, src)
+}
+func (m *TokenUnpause) XXX_Size() int {
+	return xxx_messageInfo_TokenUnpause.Size(m)
+}
+func (m *TokenUnpause) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUnpause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUnpause proto.InternalMessageInfo
+
+func (m *TokenUnpause) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+// 更新token信息，为空的字段保持不变
+type TokenUpdateInfo struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Introduction         string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	Icon                 string   `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Url                  string   `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenUpdateInfo) Reset()         { *m = TokenUpdateInfo{} }
+func (m *TokenUpdateInfo) String() string { return proto.CompactTextString(m) }
+func (*TokenUpdateInfo) ProtoMessage()    {}
+func (*TokenUpdateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{10}
+}
+
+func (m *TokenUpdateInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenUpdateInfo.Unmarshal(m, b)
+}
+func (m *TokenUpdateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenUpdateInfo.Marshal(b, m, deterministic)
+}
+func (m *TokenUpdateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpdateInfo.Merge(m, src)
+}
+func (m *TokenUpdateInfo) XXX_Size() int {
+	return xxx_messageInfo_TokenUpdateInfo.Size(m)
+}
+func (m *TokenUpdateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpdateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUpdateInfo proto.InternalMessageInfo
+
+func (m *TokenUpdateInfo) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenUpdateInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TokenUpdateInfo) GetIntroduction() string {
+	if m != nil {
+		return m.Introduction
+	}
+	return ""
+}
+
+func (m *TokenUpdateInfo) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *TokenUpdateInfo) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+// 转移token的所有权，新的owner获得铸币、销毁、冻结等权限
+type TokenTransferOwnership struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	NewOwner             string   `protobuf:"bytes,2,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenTransferOwnership) Reset()         { *m = TokenTransferOwnership{} }
+func (m *TokenTransferOwnership) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferOwnership) ProtoMessage()    {}
+func (*TokenTransferOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{11}
+}
+
+func (m *TokenTransferOwnership) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenTransferOwnership.Unmarshal(m, b)
+}
+func (m *TokenTransferOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenTransferOwnership.Marshal(b, m, deterministic)
+}
+func (m *TokenTransferOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferOwnership.Merge(m, src)
+}
+func (m *TokenTransferOwnership) XXX_Size() int {
+	return xxx_messageInfo_TokenTransferOwnership.Size(m)
+}
+func (m *TokenTransferOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferOwnership proto.InternalMessageInfo
+
+func (m *TokenTransferOwnership) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenTransferOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+// 设置铸币地址，每period个区块内最多铸币quota，period为0时quota为总额度，quota为0时取消铸币权限
+type TokenSetMinter struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Minter               string   `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Quota                int64    `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	Period               int64    `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenSetMinter) Reset()         { *m = TokenSetMinter{} }
+func (m *TokenSetMinter) String() string { return proto.CompactTextString(m) }
+func (*TokenSetMinter) ProtoMessage()    {}
+func (*TokenSetMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{12}
+}
+
+func (m *TokenSetMinter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenSetMinter.Unmarshal(m, b)
+}
+func (m *TokenSetMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenSetMinter.Marshal(b, m, deterministic)
+}
+func (m *TokenSetMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSetMinter.Merge(m, src)
+}
+func (m *TokenSetMinter) XXX_Size() int {
+	return xxx_messageInfo_TokenSetMinter.Size(m)
+}
+func (m *TokenSetMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSetMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenSetMinter proto.InternalMessageInfo
+
+func (m *TokenSetMinter) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenSetMinter) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *TokenSetMinter) GetQuota() int64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+func (m *TokenSetMinter) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
 // state db
 type Token struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -507,6 +979,10 @@ type Token struct {
 	Creator              string   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
 	Status               int32    `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
 	Category             int32    `protobuf:"varint,9,opt,name=category,proto3" json:"category,omitempty"`
+	Paused               bool     `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`
+	Icon                 string   `protobuf:"bytes,11,opt,name=icon,proto3" json:"icon,omitempty"`
+	Url                  string   `protobuf:"bytes,12,opt,name=url,proto3" json:"url,omitempty"`
+	MaxSupply            int64    `protobuf:"varint,13,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -516,7 +992,7 @@ func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{6}
+	return fileDescriptor_3aff0bcd502840ab, []int{13}
 }
 
 func (m *Token) XXX_Unmarshal(b []byte) error {
@@ -600,6 +1076,34 @@ func (m *Token) GetCategory() int32 {
 	return 0
 }
 
+func (m *Token) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Token) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *Token) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Token) GetMaxSupply() int64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
 // log
 type ReceiptToken struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
@@ -614,7 +1118,7 @@ func (m *ReceiptToken) Reset()         { *m = ReceiptToken{} }
 func (m *ReceiptToken) String() string { return proto.CompactTextString(m) }
 func (*ReceiptToken) ProtoMessage()    {}
 func (*ReceiptToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{7}
+	return fileDescriptor_3aff0bcd502840ab, []int{14}
 }
 
 func (m *ReceiptToken) XXX_Unmarshal(b []byte) error {
@@ -668,7 +1172,7 @@ func (m *ReceiptTokenAmount) Reset()         { *m = ReceiptTokenAmount{} }
 func (m *ReceiptTokenAmount) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTokenAmount) ProtoMessage()    {}
 func (*ReceiptTokenAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{8}
+	return fileDescriptor_3aff0bcd502840ab, []int{15}
 }
 
 func (m *ReceiptTokenAmount) XXX_Unmarshal(b []byte) error {
@@ -677,30 +1181,323 @@ func (m *ReceiptTokenAmount) XXX_Unmarshal(b []byte) error {
 func (m *ReceiptTokenAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptTokenAmount.Marshal(b, m, deterministic)
 }
-func (m *ReceiptTokenAmount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptTokenAmount.Merge(m, src)
+func (m *ReceiptTokenAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenAmount.Merge(m, src)
+}
+func (m *ReceiptTokenAmount) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenAmount.Size(m)
+}
+func (m *ReceiptTokenAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenAmount proto.InternalMessageInfo
+
+func (m *ReceiptTokenAmount) GetPrev() *Token {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTokenAmount) GetCurrent() *Token {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+// 冻结地址的状态，同时用于状态数据库、回执和本地数据库
+type TokenFrozenAddr struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Frozen               bool     `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenFrozenAddr) Reset()         { *m = TokenFrozenAddr{} }
+func (m *TokenFrozenAddr) String() string { return proto.CompactTextString(m) }
+func (*TokenFrozenAddr) ProtoMessage()    {}
+func (*TokenFrozenAddr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{16}
+}
+
+func (m *TokenFrozenAddr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenFrozenAddr.Unmarshal(m, b)
+}
+func (m *TokenFrozenAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenFrozenAddr.Marshal(b, m, deterministic)
+}
+func (m *TokenFrozenAddr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenFrozenAddr.Merge(m, src)
+}
+func (m *TokenFrozenAddr) XXX_Size() int {
+	return xxx_messageInfo_TokenFrozenAddr.Size(m)
+}
+func (m *TokenFrozenAddr) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenFrozenAddr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenFrozenAddr proto.InternalMessageInfo
+
+func (m *TokenFrozenAddr) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenFrozenAddr) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TokenFrozenAddr) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *TokenFrozenAddr) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// 铸币地址的额度和当前周期内已经铸币的数量，同时用于状态数据库、回执和本地数据库
+type TokenMinter struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Quota                int64    `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	Period               int64    `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	PeriodStart          int64    `protobuf:"varint,5,opt,name=periodStart,proto3" json:"periodStart,omitempty"`
+	Minted               int64    `protobuf:"varint,6,opt,name=minted,proto3" json:"minted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenMinter) Reset()         { *m = TokenMinter{} }
+func (m *TokenMinter) String() string { return proto.CompactTextString(m) }
+func (*TokenMinter) ProtoMessage()    {}
+func (*TokenMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{17}
+}
+
+func (m *TokenMinter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenMinter.Unmarshal(m, b)
+}
+func (m *TokenMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenMinter.Marshal(b, m, deterministic)
+}
+func (m *TokenMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMinter.Merge(m, src)
+}
+func (m *TokenMinter) XXX_Size() int {
+	return xxx_messageInfo_TokenMinter.Size(m)
+}
+func (m *TokenMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenMinter proto.InternalMessageInfo
+
+func (m *TokenMinter) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenMinter) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TokenMinter) GetQuota() int64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+func (m *TokenMinter) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *TokenMinter) GetPeriodStart() int64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func (m *TokenMinter) GetMinted() int64 {
+	if m != nil {
+		return m.Minted
+	}
+	return 0
+}
+
+type ReceiptTokenMinter struct {
+	Prev                 *TokenMinter `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *TokenMinter `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReceiptTokenMinter) Reset()         { *m = ReceiptTokenMinter{} }
+func (m *ReceiptTokenMinter) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenMinter) ProtoMessage()    {}
+func (*ReceiptTokenMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{18}
+}
+
+func (m *ReceiptTokenMinter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenMinter.Unmarshal(m, b)
+}
+func (m *ReceiptTokenMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenMinter.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenMinter.Merge(m, src)
+}
+func (m *ReceiptTokenMinter) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenMinter.Size(m)
+}
+func (m *ReceiptTokenMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenMinter proto.InternalMessageInfo
+
+func (m *ReceiptTokenMinter) GetPrev() *TokenMinter {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTokenMinter) GetCurrent() *TokenMinter {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptTokenFreeze struct {
+	Prev                 *TokenFrozenAddr `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *TokenFrozenAddr `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReceiptTokenFreeze) Reset()         { *m = ReceiptTokenFreeze{} }
+func (m *ReceiptTokenFreeze) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenFreeze) ProtoMessage()    {}
+func (*ReceiptTokenFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{19}
+}
+
+func (m *ReceiptTokenFreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenFreeze.Unmarshal(m, b)
+}
+func (m *ReceiptTokenFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenFreeze.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenFreeze.Merge(m, src)
+}
+func (m *ReceiptTokenFreeze) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenFreeze.Size(m)
+}
+func (m *ReceiptTokenFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenFreeze proto.InternalMessageInfo
+
+func (m *ReceiptTokenFreeze) GetPrev() *TokenFrozenAddr {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTokenFreeze) GetCurrent() *TokenFrozenAddr {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptTokenPause struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Paused               bool     `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTokenPause) Reset()         { *m = ReceiptTokenPause{} }
+func (m *ReceiptTokenPause) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenPause) ProtoMessage()    {}
+func (*ReceiptTokenPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{20}
+}
+
+func (m *ReceiptTokenPause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenPause.Unmarshal(m, b)
+}
+func (m *ReceiptTokenPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenPause.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenPause.Merge(m, src)
 }
-func (m *ReceiptTokenAmount) XXX_Size() int {
-	return xxx_messageInfo_ReceiptTokenAmount.Size(m)
+func (m *ReceiptTokenPause) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenPause.Size(m)
 }
-func (m *ReceiptTokenAmount) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReceiptTokenAmount.DiscardUnknown(m)
+func (m *ReceiptTokenPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenPause.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReceiptTokenAmount proto.InternalMessageInfo
+var xxx_messageInfo_ReceiptTokenPause proto.InternalMessageInfo
 
-func (m *ReceiptTokenAmount) GetPrev() *Token {
+func (m *ReceiptTokenPause) GetSymbol() string {
 	if m != nil {
-		return m.Prev
+		return m.Symbol
 	}
-	return nil
+	return ""
 }
 
-func (m *ReceiptTokenAmount) GetCurrent() *Token {
+func (m *ReceiptTokenPause) GetOwner() string {
 	if m != nil {
-		return m.Current
+		return m.Owner
 	}
-	return nil
+	return ""
+}
+
+func (m *ReceiptTokenPause) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
 
 // local
@@ -723,6 +1520,10 @@ type LocalToken struct {
 	RevokedHeight        int64    `protobuf:"varint,15,opt,name=revokedHeight,proto3" json:"revokedHeight,omitempty"`
 	RevokedTime          int64    `protobuf:"varint,16,opt,name=revokedTime,proto3" json:"revokedTime,omitempty"`
 	Category             int32    `protobuf:"varint,17,opt,name=category,proto3" json:"category,omitempty"`
+	Paused               bool     `protobuf:"varint,18,opt,name=paused,proto3" json:"paused,omitempty"`
+	Icon                 string   `protobuf:"bytes,19,opt,name=icon,proto3" json:"icon,omitempty"`
+	Url                  string   `protobuf:"bytes,20,opt,name=url,proto3" json:"url,omitempty"`
+	MaxSupply            int64    `protobuf:"varint,21,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -732,7 +1533,7 @@ func (m *LocalToken) Reset()         { *m = LocalToken{} }
 func (m *LocalToken) String() string { return proto.CompactTextString(m) }
 func (*LocalToken) ProtoMessage()    {}
 func (*LocalToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{9}
+	return fileDescriptor_3aff0bcd502840ab, []int{21}
 }
 
 func (m *LocalToken) XXX_Unmarshal(b []byte) error {
@@ -872,6 +1673,34 @@ func (m *LocalToken) GetCategory() int32 {
 	return 0
 }
 
+func (m *LocalToken) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *LocalToken) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *LocalToken) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *LocalToken) GetMaxSupply() int64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
 type LocalLogs struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	TxIndex              string   `protobuf:"bytes,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
@@ -886,7 +1715,7 @@ func (m *LocalLogs) Reset()         { *m = LocalLogs{} }
 func (m *LocalLogs) String() string { return proto.CompactTextString(m) }
 func (*LocalLogs) ProtoMessage()    {}
 func (*LocalLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{10}
+	return fileDescriptor_3aff0bcd502840ab, []int{22}
 }
 
 func (m *LocalLogs) XXX_Unmarshal(b []byte) error {
@@ -950,7 +1779,7 @@ func (m *ReqTokens) Reset()         { *m = ReqTokens{} }
 func (m *ReqTokens) String() string { return proto.CompactTextString(m) }
 func (*ReqTokens) ProtoMessage()    {}
 func (*ReqTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{11}
+	return fileDescriptor_3aff0bcd502840ab, []int{23}
 }
 
 func (m *ReqTokens) XXX_Unmarshal(b []byte) error {
@@ -1010,7 +1839,7 @@ func (m *ReplyTokens) Reset()         { *m = ReplyTokens{} }
 func (m *ReplyTokens) String() string { return proto.CompactTextString(m) }
 func (*ReplyTokens) ProtoMessage()    {}
 func (*ReplyTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{12}
+	return fileDescriptor_3aff0bcd502840ab, []int{24}
 }
 
 func (m *ReplyTokens) XXX_Unmarshal(b []byte) error {
@@ -1050,7 +1879,7 @@ func (m *TokenRecv) Reset()         { *m = TokenRecv{} }
 func (m *TokenRecv) String() string { return proto.CompactTextString(m) }
 func (*TokenRecv) ProtoMessage()    {}
 func (*TokenRecv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{13}
+	return fileDescriptor_3aff0bcd502840ab, []int{25}
 }
 
 func (m *TokenRecv) XXX_Unmarshal(b []byte) error {
@@ -1096,7 +1925,7 @@ func (m *ReplyAddrRecvForTokens) Reset()         { *m = ReplyAddrRecvForTokens{}
 func (m *ReplyAddrRecvForTokens) String() string { return proto.CompactTextString(m) }
 func (*ReplyAddrRecvForTokens) ProtoMessage()    {}
 func (*ReplyAddrRecvForTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{14}
+	return fileDescriptor_3aff0bcd502840ab, []int{26}
 }
 
 func (m *ReplyAddrRecvForTokens) XXX_Unmarshal(b []byte) error {
@@ -1137,7 +1966,7 @@ func (m *ReqTokenBalance) Reset()         { *m = ReqTokenBalance{} }
 func (m *ReqTokenBalance) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenBalance) ProtoMessage()    {}
 func (*ReqTokenBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{15}
+	return fileDescriptor_3aff0bcd502840ab, []int{27}
 }
 
 func (m *ReqTokenBalance) XXX_Unmarshal(b []byte) error {
@@ -1191,7 +2020,7 @@ func (m *ReqAccountTokenAssets) Reset()         { *m = ReqAccountTokenAssets{} }
 func (m *ReqAccountTokenAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAccountTokenAssets) ProtoMessage()    {}
 func (*ReqAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{16}
+	return fileDescriptor_3aff0bcd502840ab, []int{28}
 }
 
 func (m *ReqAccountTokenAssets) XXX_Unmarshal(b []byte) error {
@@ -1238,7 +2067,7 @@ func (m *TokenAsset) Reset()         { *m = TokenAsset{} }
 func (m *TokenAsset) String() string { return proto.CompactTextString(m) }
 func (*TokenAsset) ProtoMessage()    {}
 func (*TokenAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{17}
+	return fileDescriptor_3aff0bcd502840ab, []int{29}
 }
 
 func (m *TokenAsset) XXX_Unmarshal(b []byte) error {
@@ -1284,7 +2113,7 @@ func (m *ReplyAccountTokenAssets) Reset()         { *m = ReplyAccountTokenAssets
 func (m *ReplyAccountTokenAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyAccountTokenAssets) ProtoMessage()    {}
 func (*ReplyAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{18}
+	return fileDescriptor_3aff0bcd502840ab, []int{30}
 }
 
 func (m *ReplyAccountTokenAssets) XXX_Unmarshal(b []byte) error {
@@ -1328,7 +2157,7 @@ func (m *ReqAddrTokens) Reset()         { *m = ReqAddrTokens{} }
 func (m *ReqAddrTokens) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrTokens) ProtoMessage()    {}
 func (*ReqAddrTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{19}
+	return fileDescriptor_3aff0bcd502840ab, []int{31}
 }
 
 func (m *ReqAddrTokens) XXX_Unmarshal(b []byte) error {
@@ -1409,7 +2238,7 @@ func (m *ReqTokenTx) Reset()         { *m = ReqTokenTx{} }
 func (m *ReqTokenTx) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenTx) ProtoMessage()    {}
 func (*ReqTokenTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{20}
+	return fileDescriptor_3aff0bcd502840ab, []int{32}
 }
 
 func (m *ReqTokenTx) XXX_Unmarshal(b []byte) error {
@@ -1490,7 +2319,7 @@ func (m *ReplyTokenLogs) Reset()         { *m = ReplyTokenLogs{} }
 func (m *ReplyTokenLogs) String() string { return proto.CompactTextString(m) }
 func (*ReplyTokenLogs) ProtoMessage()    {}
 func (*ReplyTokenLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{21}
+	return fileDescriptor_3aff0bcd502840ab, []int{33}
 }
 
 func (m *ReplyTokenLogs) XXX_Unmarshal(b []byte) error {
@@ -1518,6 +2347,362 @@ func (m *ReplyTokenLogs) GetLogs() []*LocalLogs {
 	return nil
 }
 
+type ReqFrozenAddrs struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	FromKey              string   `protobuf:"bytes,2,opt,name=fromKey,proto3" json:"fromKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqFrozenAddrs) Reset()         { *m = ReqFrozenAddrs{} }
+func (m *ReqFrozenAddrs) String() string { return proto.CompactTextString(m) }
+func (*ReqFrozenAddrs) ProtoMessage()    {}
+func (*ReqFrozenAddrs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{34}
+}
+
+func (m *ReqFrozenAddrs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqFrozenAddrs.Unmarshal(m, b)
+}
+func (m *ReqFrozenAddrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqFrozenAddrs.Marshal(b, m, deterministic)
+}
+func (m *ReqFrozenAddrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqFrozenAddrs.Merge(m, src)
+}
+func (m *ReqFrozenAddrs) XXX_Size() int {
+	return xxx_messageInfo_ReqFrozenAddrs.Size(m)
+}
+func (m *ReqFrozenAddrs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqFrozenAddrs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqFrozenAddrs proto.InternalMessageInfo
+
+func (m *ReqFrozenAddrs) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqFrozenAddrs) GetFromKey() string {
+	if m != nil {
+		return m.FromKey
+	}
+	return ""
+}
+
+func (m *ReqFrozenAddrs) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqFrozenAddrs) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyFrozenAddrs struct {
+	Addrs                []*TokenFrozenAddr `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReplyFrozenAddrs) Reset()         { *m = ReplyFrozenAddrs{} }
+func (m *ReplyFrozenAddrs) String() string { return proto.CompactTextString(m) }
+func (*ReplyFrozenAddrs) ProtoMessage()    {}
+func (*ReplyFrozenAddrs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{35}
+}
+
+func (m *ReplyFrozenAddrs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyFrozenAddrs.Unmarshal(m, b)
+}
+func (m *ReplyFrozenAddrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyFrozenAddrs.Marshal(b, m, deterministic)
+}
+func (m *ReplyFrozenAddrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyFrozenAddrs.Merge(m, src)
+}
+func (m *ReplyFrozenAddrs) XXX_Size() int {
+	return xxx_messageInfo_ReplyFrozenAddrs.Size(m)
+}
+func (m *ReplyFrozenAddrs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyFrozenAddrs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyFrozenAddrs proto.InternalMessageInfo
+
+func (m *ReplyFrozenAddrs) GetAddrs() []*TokenFrozenAddr {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+type ReplyTokenMinters struct {
+	Minters              []*TokenMinter `protobuf:"bytes,1,rep,name=minters,proto3" json:"minters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReplyTokenMinters) Reset()         { *m = ReplyTokenMinters{} }
+func (m *ReplyTokenMinters) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenMinters) ProtoMessage()    {}
+func (*ReplyTokenMinters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{36}
+}
+
+func (m *ReplyTokenMinters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenMinters.Unmarshal(m, b)
+}
+func (m *ReplyTokenMinters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenMinters.Marshal(b, m, deterministic)
+}
+func (m *ReplyTokenMinters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenMinters.Merge(m, src)
+}
+func (m *ReplyTokenMinters) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenMinters.Size(m)
+}
+func (m *ReplyTokenMinters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenMinters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTokenMinters proto.InternalMessageInfo
+
+func (m *ReplyTokenMinters) GetMinters() []*TokenMinter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+// token持有人的余额，用于本地数据库中的持有人索引和快照
+type TokenHolder struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Balance              int64    `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenHolder) Reset()         { *m = TokenHolder{} }
+func (m *TokenHolder) String() string { return proto.CompactTextString(m) }
+func (*TokenHolder) ProtoMessage()    {}
+func (*TokenHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{37}
+}
+
+func (m *TokenHolder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenHolder.Unmarshal(m, b)
+}
+func (m *TokenHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenHolder.Marshal(b, m, deterministic)
+}
+func (m *TokenHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenHolder.Merge(m, src)
+}
+func (m *TokenHolder) XXX_Size() int {
+	return xxx_messageInfo_TokenHolder.Size(m)
+}
+func (m *TokenHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenHolder proto.InternalMessageInfo
+
+func (m *TokenHolder) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenHolder) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TokenHolder) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+// 按余额从高到低查询持有人，fromKey为上一页最后一个地址
+type ReqTokenHolders struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	FromKey              string   `protobuf:"bytes,2,opt,name=fromKey,proto3" json:"fromKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenHolders) Reset()         { *m = ReqTokenHolders{} }
+func (m *ReqTokenHolders) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenHolders) ProtoMessage()    {}
+func (*ReqTokenHolders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{38}
+}
+
+func (m *ReqTokenHolders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenHolders.Unmarshal(m, b)
+}
+func (m *ReqTokenHolders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenHolders.Marshal(b, m, deterministic)
+}
+func (m *ReqTokenHolders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenHolders.Merge(m, src)
+}
+func (m *ReqTokenHolders) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenHolders.Size(m)
+}
+func (m *ReqTokenHolders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenHolders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokenHolders proto.InternalMessageInfo
+
+func (m *ReqTokenHolders) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqTokenHolders) GetFromKey() string {
+	if m != nil {
+		return m.FromKey
+	}
+	return ""
+}
+
+func (m *ReqTokenHolders) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// 查询指定高度的持有人快照，按地址排序，fromKey为上一页最后一个地址
+type ReqTokenHolderSnapshot struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	FromKey              string   `protobuf:"bytes,3,opt,name=fromKey,proto3" json:"fromKey,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenHolderSnapshot) Reset()         { *m = ReqTokenHolderSnapshot{} }
+func (m *ReqTokenHolderSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenHolderSnapshot) ProtoMessage()    {}
+func (*ReqTokenHolderSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{39}
+}
+
+func (m *ReqTokenHolderSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenHolderSnapshot.Unmarshal(m, b)
+}
+func (m *ReqTokenHolderSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenHolderSnapshot.Marshal(b, m, deterministic)
+}
+func (m *ReqTokenHolderSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenHolderSnapshot.Merge(m, src)
+}
+func (m *ReqTokenHolderSnapshot) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenHolderSnapshot.Size(m)
+}
+func (m *ReqTokenHolderSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenHolderSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokenHolderSnapshot proto.InternalMessageInfo
+
+func (m *ReqTokenHolderSnapshot) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqTokenHolderSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqTokenHolderSnapshot) GetFromKey() string {
+	if m != nil {
+		return m.FromKey
+	}
+	return ""
+}
+
+func (m *ReqTokenHolderSnapshot) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyTokenHolders struct {
+	Holders              []*TokenHolder `protobuf:"bytes,1,rep,name=holders,proto3" json:"holders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReplyTokenHolders) Reset()         { *m = ReplyTokenHolders{} }
+func (m *ReplyTokenHolders) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenHolders) ProtoMessage()    {}
+func (*ReplyTokenHolders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{40}
+}
+
+func (m *ReplyTokenHolders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenHolders.Unmarshal(m, b)
+}
+func (m *ReplyTokenHolders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenHolders.Marshal(b, m, deterministic)
+}
+func (m *ReplyTokenHolders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenHolders.Merge(m, src)
+}
+func (m *ReplyTokenHolders) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenHolders.Size(m)
+}
+func (m *ReplyTokenHolders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenHolders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTokenHolders proto.InternalMessageInfo
+
+func (m *ReplyTokenHolders) GetHolders() []*TokenHolder {
+	if m != nil {
+		return m.Holders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TokenAction)(nil), "types.TokenAction")
 	proto.RegisterType((*TokenPreCreate)(nil), "types.TokenPreCreate")
@@ -1525,9 +2710,21 @@ func init() {
 	proto.RegisterType((*TokenRevokeCreate)(nil), "types.TokenRevokeCreate")
 	proto.RegisterType((*TokenMint)(nil), "types.TokenMint")
 	proto.RegisterType((*TokenBurn)(nil), "types.TokenBurn")
+	proto.RegisterType((*TokenFreeze)(nil), "types.TokenFreeze")
+	proto.RegisterType((*TokenUnfreeze)(nil), "types.TokenUnfreeze")
+	proto.RegisterType((*TokenPause)(nil), "types.TokenPause")
+	proto.RegisterType((*TokenUnpause)(nil), "types.TokenUnpause")
+	proto.RegisterType((*TokenUpdateInfo)(nil), "types.TokenUpdateInfo")
+	proto.RegisterType((*TokenTransferOwnership)(nil), "types.TokenTransferOwnership")
+	proto.RegisterType((*TokenSetMinter)(nil), "types.TokenSetMinter")
 	proto.RegisterType((*Token)(nil), "types.Token")
 	proto.RegisterType((*ReceiptToken)(nil), "types.ReceiptToken")
 	proto.RegisterType((*ReceiptTokenAmount)(nil), "types.ReceiptTokenAmount")
+	proto.RegisterType((*TokenFrozenAddr)(nil), "types.TokenFrozenAddr")
+	proto.RegisterType((*TokenMinter)(nil), "types.TokenMinter")
+	proto.RegisterType((*ReceiptTokenMinter)(nil), "types.ReceiptTokenMinter")
+	proto.RegisterType((*ReceiptTokenFreeze)(nil), "types.ReceiptTokenFreeze")
+	proto.RegisterType((*ReceiptTokenPause)(nil), "types.ReceiptTokenPause")
 	proto.RegisterType((*LocalToken)(nil), "types.LocalToken")
 	proto.RegisterType((*LocalLogs)(nil), "types.LocalLogs")
 	proto.RegisterType((*ReqTokens)(nil), "types.ReqTokens")
@@ -1541,6 +2738,13 @@ func init() {
 	proto.RegisterType((*ReqAddrTokens)(nil), "types.ReqAddrTokens")
 	proto.RegisterType((*ReqTokenTx)(nil), "types.ReqTokenTx")
 	proto.RegisterType((*ReplyTokenLogs)(nil), "types.ReplyTokenLogs")
+	proto.RegisterType((*ReqFrozenAddrs)(nil), "types.ReqFrozenAddrs")
+	proto.RegisterType((*ReplyFrozenAddrs)(nil), "types.ReplyFrozenAddrs")
+	proto.RegisterType((*ReplyTokenMinters)(nil), "types.ReplyTokenMinters")
+	proto.RegisterType((*TokenHolder)(nil), "types.TokenHolder")
+	proto.RegisterType((*ReqTokenHolders)(nil), "types.ReqTokenHolders")
+	proto.RegisterType((*ReqTokenHolderSnapshot)(nil), "types.ReqTokenHolderSnapshot")
+	proto.RegisterType((*ReplyTokenHolders)(nil), "types.ReplyTokenHolders")
 }
 
 func init() {
@@ -1548,77 +2752,113 @@ func init() {
 }
 
 var fileDescriptor_3aff0bcd502840ab = []byte{
-	// 1115 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xdd, 0x6a, 0xe3, 0xc6,
-	0x17, 0xb7, 0x2d, 0x7f, 0x44, 0xc7, 0x89, 0x13, 0xcf, 0x7f, 0x37, 0x7f, 0x91, 0x2e, 0x8b, 0x11,
-	0x4b, 0x49, 0xa1, 0x84, 0x90, 0xd0, 0x52, 0x68, 0xa1, 0x38, 0x25, 0xbb, 0xde, 0x76, 0xbb, 0x2d,
-	0x53, 0x43, 0xef, 0x0a, 0x5a, 0xe9, 0xc4, 0x11, 0xb1, 0x25, 0x65, 0x34, 0x76, 0xec, 0xa7, 0xe9,
-	0x7d, 0x2f, 0xfa, 0x08, 0xbd, 0xe8, 0xcb, 0xf4, 0x35, 0xca, 0x9c, 0x19, 0xc9, 0x23, 0x3b, 0x2e,
-	0xe4, 0xae, 0xf4, 0x4e, 0xe7, 0xeb, 0x37, 0x73, 0xce, 0xf9, 0x9d, 0xe3, 0x31, 0x74, 0x65, 0x7a,
-	0x87, 0xc9, 0x59, 0x26, 0x52, 0x99, 0xb2, 0x96, 0x5c, 0x65, 0x98, 0x9f, 0xf4, 0xa5, 0x08, 0x92,
-	0x3c, 0x08, 0x65, 0x9c, 0x1a, 0xcb, 0xc9, 0x41, 0x10, 0x86, 0xe9, 0x3c, 0x91, 0x5a, 0xf4, 0xff,
-	0x6c, 0x42, 0x77, 0xac, 0x02, 0x87, 0xe4, 0xc4, 0xbe, 0x86, 0x1e, 0xe1, 0xfc, 0x28, 0xf0, 0x1b,
-	0x81, 0x81, 0x44, 0xaf, 0x3e, 0xa8, 0x9f, 0x76, 0x2f, 0x9e, 0x9f, 0x11, 0xe2, 0xd9, 0xb8, 0x62,
-	0x1c, 0xd5, 0xf8, 0x86, 0x3b, 0x1b, 0x41, 0x9f, 0x34, 0xaf, 0xe3, 0x24, 0xce, 0x6f, 0x0d, 0x46,
-	0x83, 0x30, 0x3c, 0x1b, 0xc3, 0xb6, 0x8f, 0x6a, 0x7c, 0x3b, 0xa8, 0x44, 0xe2, 0xb8, 0x48, 0xef,
-	0x8a, 0xdb, 0x38, 0xdb, 0x48, 0xb6, 0xbd, 0x44, 0xb2, 0x95, 0xec, 0x12, 0xf6, 0xa8, 0x10, 0x37,
-	0x28, 0xbc, 0x66, 0x25, 0x9d, 0x61, 0x9e, 0xa3, 0xcc, 0xc7, 0xc6, 0x38, 0xaa, 0xf1, 0xd2, 0x51,
-	0x05, 0x3d, 0xc4, 0xf2, 0x36, 0x12, 0xc1, 0x83, 0xd7, 0x7a, 0x24, 0xe8, 0x67, 0x63, 0x54, 0x41,
-	0x85, 0x23, 0x3b, 0x87, 0xce, 0x04, 0x13, 0xcc, 0xe3, 0xdc, 0x6b, 0x53, 0xcc, 0xb3, 0x4a, 0xcc,
-	0x1b, 0x6d, 0x1b, 0xd5, 0x78, 0xe1, 0xc6, 0xae, 0xa1, 0x57, 0x1c, 0x39, 0x4e, 0xaf, 0x97, 0x18,
-	0x7a, 0x7b, 0x14, 0xf8, 0xd1, 0xa3, 0x37, 0xd4, 0x2e, 0x54, 0xf6, 0x8a, 0x86, 0x9d, 0x83, 0x4b,
-	0x79, 0x7f, 0x1f, 0x27, 0xd2, 0x73, 0x09, 0xe1, 0xc8, 0x2e, 0x92, 0xd2, 0x8f, 0x6a, 0x7c, 0xed,
-	0x54, 0x46, 0x5c, 0xcd, 0x45, 0xe2, 0xc1, 0x76, 0x84, 0xd2, 0x97, 0x11, 0x4a, 0x60, 0x3d, 0x68,
-	0x8c, 0x57, 0x5e, 0x67, 0x50, 0x3f, 0x6d, 0xf1, 0xc6, 0x78, 0x75, 0xd5, 0x81, 0xd6, 0x22, 0x98,
-	0xce, 0xd1, 0xff, 0xa3, 0x0e, 0xbd, 0x2a, 0x31, 0x18, 0x83, 0x66, 0x12, 0xcc, 0x34, 0x7b, 0x5c,
-	0x4e, 0xdf, 0xec, 0x18, 0xda, 0xf9, 0x6a, 0xf6, 0x21, 0x9d, 0x12, 0x1f, 0x5c, 0x6e, 0x24, 0xe6,
-	0xc3, 0x7e, 0x9c, 0x48, 0x91, 0x46, 0x73, 0xe2, 0x20, 0xf5, 0xd8, 0xe5, 0x15, 0x1d, 0x7b, 0x06,
-	0x2d, 0x99, 0xca, 0x60, 0x4a, 0xfd, 0x73, 0xb8, 0x16, 0x94, 0x36, 0x13, 0x71, 0x88, 0xd4, 0x20,
-	0x87, 0x6b, 0x41, 0x69, 0xd3, 0x87, 0x04, 0x05, 0xb5, 0xc0, 0xe5, 0x5a, 0x60, 0x27, 0xb0, 0x17,
-	0x06, 0x12, 0x27, 0xa9, 0x28, 0x72, 0x28, 0x65, 0x7f, 0x08, 0xfd, 0x2d, 0x52, 0x5a, 0xd7, 0xad,
-	0x57, 0xae, 0x5b, 0xc2, 0x37, 0x2c, 0xf8, 0x12, 0xa2, 0x42, 0xbc, 0xa7, 0x41, 0x7c, 0x09, 0x6e,
-	0xd9, 0xab, 0x9d, 0xa1, 0xc7, 0xd0, 0x0e, 0x66, 0x6a, 0x80, 0x29, 0xd6, 0xe1, 0x46, 0x2a, 0x83,
-	0xa9, 0x53, 0x4f, 0x0d, 0xfe, 0xab, 0x0e, 0x2d, 0x8a, 0xfe, 0x17, 0xf6, 0xcd, 0x83, 0x4e, 0xa8,
-	0xaa, 0x99, 0x0a, 0x6a, 0x9b, 0xcb, 0x0b, 0x91, 0xee, 0x25, 0x03, 0x39, 0xcf, 0x69, 0x64, 0x5a,
-	0xdc, 0x48, 0x95, 0x4e, 0xbb, 0x1b, 0x9d, 0x1e, 0xc3, 0x3e, 0xc7, 0x10, 0xe3, 0x4c, 0xea, 0x7c,
-	0x9f, 0xd4, 0x21, 0xeb, 0x44, 0xc7, 0x3e, 0xd1, 0xff, 0x05, 0x98, 0x8d, 0x3a, 0xa4, 0xaa, 0xb2,
-	0x01, 0x34, 0x33, 0x81, 0x0b, 0xb3, 0x41, 0xf7, 0x2b, 0x3b, 0x8b, 0x2c, 0xec, 0x63, 0xe8, 0x84,
-	0x73, 0x21, 0xd0, 0x34, 0x64, 0xd3, 0xa9, 0x30, 0xfa, 0xbf, 0x35, 0x01, 0xde, 0xa5, 0x61, 0x30,
-	0xfd, 0xef, 0x34, 0xe9, 0x15, 0x1c, 0x90, 0x0b, 0x46, 0x23, 0x8c, 0x27, 0xb7, 0x7a, 0x69, 0x39,
-	0xbc, 0xaa, 0x64, 0x03, 0xe8, 0x1a, 0xc5, 0x38, 0x9e, 0x21, 0xad, 0x29, 0x87, 0xdb, 0x2a, 0x76,
-	0x0e, 0xff, 0xcb, 0x04, 0x66, 0x41, 0xf9, 0x93, 0xa4, 0xd1, 0xba, 0xe4, 0xf9, 0x98, 0x89, 0x7d,
-	0x0a, 0xfd, 0x8a, 0x9a, 0x90, 0xf7, 0xc9, 0x7f, 0xdb, 0xc0, 0x5e, 0x80, 0x9b, 0x09, 0x0c, 0xe3,
-	0x5c, 0x15, 0xef, 0x80, 0x52, 0x58, 0x2b, 0xd8, 0x19, 0x30, 0x2a, 0x56, 0xb9, 0x9f, 0xe3, 0x19,
-	0xe6, 0x5e, 0x8f, 0xc0, 0x1e, 0xb1, 0xa8, 0xac, 0x05, 0x2d, 0x88, 0x22, 0xeb, 0x43, 0x9d, 0x75,
-	0x45, 0xa9, 0xb2, 0x36, 0x0a, 0xba, 0xdb, 0x91, 0xce, 0xda, 0x52, 0x55, 0x28, 0xde, 0xdf, 0xa0,
-	0xf8, 0x1c, 0x5c, 0xe2, 0xca, 0xbb, 0x74, 0x92, 0xef, 0xe4, 0xb7, 0x07, 0x1d, 0xb9, 0x7c, 0x9b,
-	0x44, 0xb8, 0x34, 0x7c, 0x29, 0x44, 0xf6, 0x12, 0x40, 0x3f, 0x18, 0xc6, 0xab, 0x0c, 0x0d, 0xcf,
-	0x2d, 0x8d, 0x42, 0x94, 0xcb, 0x51, 0x90, 0xdf, 0x12, 0x5b, 0x5c, 0x6e, 0x24, 0xff, 0x01, 0x5c,
-	0x8e, 0xf7, 0x44, 0x50, 0x1a, 0xc1, 0xfb, 0x39, 0x8a, 0xd5, 0x70, 0xaa, 0x0f, 0xde, 0xe3, 0xa5,
-	0x6c, 0x31, 0xa2, 0x51, 0x61, 0x84, 0x02, 0xa6, 0x68, 0xcf, 0x19, 0x38, 0x04, 0xac, 0xb1, 0x5e,
-	0x02, 0xe8, 0x4b, 0xff, 0x90, 0x4c, 0x57, 0x74, 0xe8, 0x1e, 0xb7, 0x34, 0xfe, 0x17, 0xd0, 0xe5,
-	0x98, 0x4d, 0x57, 0xe6, 0xe8, 0x4f, 0x4a, 0x98, 0xfa, 0xc0, 0x39, 0xed, 0x5e, 0xf4, 0xcd, 0x48,
-	0xad, 0xe7, 0xa7, 0x40, 0xf6, 0x3f, 0x33, 0x3b, 0x93, 0x63, 0xb8, 0xd0, 0x43, 0x70, 0x87, 0x89,
-	0x29, 0x94, 0x16, 0xd4, 0xa8, 0x09, 0x0c, 0x17, 0x66, 0x5f, 0xd2, 0xb7, 0xff, 0x2d, 0x1c, 0xd3,
-	0x81, 0xc3, 0x28, 0x12, 0x2a, 0xf4, 0x75, 0x2a, 0xcc, 0xd9, 0xe7, 0x00, 0xb2, 0x00, 0x2c, 0xce,
-	0x3f, 0xaa, 0xbe, 0x55, 0xc2, 0x05, 0xb7, 0x7c, 0xfc, 0x18, 0x0e, 0x8b, 0xaa, 0x5d, 0x05, 0xd3,
-	0x20, 0x09, 0x89, 0x71, 0x41, 0x14, 0x09, 0xcc, 0x73, 0xd4, 0x18, 0x2e, 0x5f, 0x2b, 0x14, 0x37,
-	0x28, 0xfc, 0x27, 0x7b, 0xd8, 0x6d, 0x95, 0xaa, 0x23, 0x2e, 0x31, 0x44, 0x61, 0x66, 0xdd, 0x48,
-	0xfe, 0x5b, 0x78, 0xce, 0xf1, 0x7e, 0xa8, 0x9f, 0x7f, 0x7a, 0x4f, 0xd1, 0xdb, 0x42, 0x71, 0xc1,
-	0xe0, 0x9b, 0xdc, 0x0b, 0xd1, 0x82, 0x6a, 0x54, 0xa0, 0xde, 0x03, 0xac, 0x01, 0x76, 0x72, 0xec,
-	0x14, 0x3a, 0xe6, 0xb1, 0x69, 0xb6, 0x5b, 0xaf, 0x78, 0xd3, 0x68, 0x2d, 0x2f, 0xcc, 0xfe, 0x7b,
-	0xf8, 0xbf, 0xae, 0xe8, 0xf6, 0xe5, 0x2e, 0x4d, 0xbe, 0x5a, 0xdc, 0xe8, 0xe9, 0xda, 0x91, 0xdb,
-	0x5e, 0xfe, 0xaf, 0x75, 0x38, 0x50, 0xb9, 0x46, 0x51, 0xd1, 0x19, 0x06, 0x4d, 0x95, 0x54, 0xb1,
-	0x32, 0xd5, 0xf7, 0x4e, 0x22, 0x96, 0x4c, 0xd0, 0x3c, 0x34, 0x4c, 0x78, 0x01, 0x6e, 0x14, 0x0b,
-	0xd4, 0x5b, 0xb4, 0xa9, 0x17, 0x41, 0xa9, 0x50, 0x31, 0x3a, 0xd3, 0x16, 0x59, 0xb4, 0xa0, 0x2a,
-	0x7b, 0x23, 0xd2, 0xd9, 0x77, 0xb8, 0x32, 0xeb, 0xb2, 0x10, 0xfd, 0xdf, 0xeb, 0x00, 0x45, 0xe3,
-	0xc7, 0xcb, 0x9d, 0x25, 0x64, 0xd0, 0xbc, 0x99, 0x06, 0x13, 0x73, 0x41, 0xfa, 0x5e, 0x1f, 0xe5,
-	0xd8, 0x47, 0xfd, 0xf3, 0xf5, 0x8e, 0xa1, 0x7d, 0xab, 0x17, 0x8e, 0x5e, 0xe6, 0x46, 0x52, 0x58,
-	0x31, 0x2d, 0x81, 0xb6, 0xde, 0xf1, 0x24, 0x94, 0xc5, 0xea, 0xac, 0x8b, 0xe5, 0x7f, 0x0e, 0xbd,
-	0xf5, 0x94, 0xd1, 0x6a, 0x79, 0x05, 0xcd, 0x69, 0x3a, 0xd9, 0xa4, 0x79, 0xb9, 0x7a, 0x38, 0x59,
-	0x2f, 0xae, 0x4d, 0x31, 0xd9, 0x57, 0x70, 0xf8, 0x06, 0x65, 0x85, 0xe9, 0xc7, 0x26, 0x66, 0x63,
-	0x02, 0x4e, 0x0e, 0xab, 0x3c, 0xc9, 0xfd, 0xda, 0x87, 0x36, 0xfd, 0x5d, 0xb9, 0xfc, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xe2, 0xae, 0x58, 0x34, 0xe6, 0x0c, 0x00, 0x00,
+	// 1696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xef, 0x6e, 0xdb, 0x46,
+	0x12, 0x97, 0x44, 0xc9, 0x12, 0x47, 0x92, 0x6d, 0x6d, 0x12, 0x1d, 0xe1, 0xbb, 0x0b, 0x0c, 0x22,
+	0x08, 0x72, 0x07, 0xc3, 0x30, 0x62, 0x5c, 0x70, 0x41, 0x02, 0xdc, 0xd9, 0x87, 0x38, 0xca, 0x5d,
+	0x2e, 0x29, 0xd6, 0x2a, 0x82, 0x7c, 0x29, 0xc0, 0x90, 0x6b, 0x8b, 0x8d, 0x44, 0xd2, 0x4b, 0x4a,
+	0x96, 0xf2, 0x00, 0xe9, 0x63, 0x14, 0x7d, 0x81, 0xbe, 0x44, 0x5f, 0xa3, 0x0f, 0x53, 0xec, 0xec,
+	0x92, 0xdc, 0xd5, 0x1f, 0xb7, 0x2e, 0xfa, 0xa1, 0xe8, 0x37, 0xce, 0xec, 0xfc, 0xd9, 0x99, 0x9d,
+	0xdf, 0x4f, 0xbb, 0x82, 0x76, 0x16, 0x7f, 0x64, 0xd1, 0x61, 0xc2, 0xe3, 0x2c, 0x26, 0x8d, 0x6c,
+	0x91, 0xb0, 0x74, 0xaf, 0x97, 0x71, 0x2f, 0x4a, 0x3d, 0x3f, 0x0b, 0x63, 0xb5, 0xb2, 0xd7, 0xf5,
+	0x7c, 0x3f, 0x9e, 0x46, 0x99, 0x14, 0xdd, 0xcf, 0x2d, 0x68, 0x0f, 0x85, 0xe3, 0x09, 0x1a, 0x91,
+	0x7f, 0xc1, 0x36, 0xc6, 0xf9, 0x82, 0xb3, 0xff, 0x70, 0xe6, 0x65, 0xcc, 0xa9, 0xee, 0x57, 0x1f,
+	0xb5, 0x1f, 0xdf, 0x3b, 0xc4, 0x88, 0x87, 0x43, 0x63, 0x71, 0x50, 0xa1, 0x4b, 0xe6, 0x64, 0x00,
+	0x3d, 0xd4, 0x9c, 0x85, 0x51, 0x98, 0x8e, 0x54, 0x8c, 0x1a, 0xc6, 0x70, 0xf4, 0x18, 0xfa, 0xfa,
+	0xa0, 0x42, 0x57, 0x9d, 0x8a, 0x48, 0x94, 0xcd, 0xe2, 0x8f, 0xf9, 0x6e, 0xac, 0xd5, 0x48, 0xfa,
+	0x7a, 0x11, 0x49, 0x57, 0x92, 0x63, 0x68, 0x61, 0x23, 0x2e, 0x18, 0x77, 0xea, 0x46, 0x39, 0x27,
+	0x69, 0xca, 0xb2, 0x74, 0xa8, 0x16, 0x07, 0x15, 0x5a, 0x18, 0x0a, 0xa7, 0xeb, 0x30, 0x1b, 0x05,
+	0xdc, 0xbb, 0x76, 0x1a, 0x6b, 0x9c, 0xde, 0xa9, 0x45, 0xe1, 0x94, 0x1b, 0x92, 0x23, 0x68, 0x5e,
+	0xb2, 0x88, 0xa5, 0x61, 0xea, 0x6c, 0xa1, 0xcf, 0x5d, 0xc3, 0xe7, 0xa5, 0x5c, 0x1b, 0x54, 0x68,
+	0x6e, 0x46, 0x5e, 0xc0, 0x76, 0x9e, 0x72, 0x18, 0xbf, 0x98, 0x33, 0xdf, 0x69, 0xa1, 0xe3, 0x9f,
+	0xd7, 0xee, 0x50, 0x9a, 0x60, 0xdb, 0x0d, 0x0d, 0x39, 0x02, 0x1b, 0xeb, 0xfe, 0x7f, 0x18, 0x65,
+	0x8e, 0x8d, 0x11, 0x76, 0xf5, 0x26, 0x09, 0xfd, 0xa0, 0x42, 0x4b, 0xa3, 0xc2, 0xe3, 0x74, 0xca,
+	0x23, 0x07, 0x56, 0x3d, 0x84, 0xbe, 0xf0, 0x10, 0x02, 0x79, 0xa2, 0x66, 0xec, 0x8c, 0x33, 0xf6,
+	0x89, 0x39, 0x6d, 0xf4, 0x21, 0xc6, 0xa1, 0xe2, 0xca, 0xa0, 0x42, 0x75, 0x43, 0xf2, 0x1c, 0xba,
+	0x28, 0x7e, 0x19, 0x5d, 0x48, 0xcf, 0x8e, 0xd1, 0x9a, 0xa1, 0xbe, 0x36, 0xa8, 0x50, 0xd3, 0x98,
+	0x1c, 0x03, 0xc8, 0x11, 0xf3, 0xa6, 0x29, 0x73, 0xba, 0xe8, 0xda, 0x33, 0xa6, 0x51, 0x2c, 0x0c,
+	0x2a, 0x54, 0x33, 0x23, 0x4f, 0xa1, 0xa3, 0xa2, 0x24, 0xe8, 0xb6, 0x8d, 0x6e, 0x77, 0xcc, 0x8c,
+	0x89, 0x72, 0x34, 0x4c, 0xc9, 0x29, 0xec, 0x48, 0x39, 0x09, 0xbc, 0x8c, 0xbd, 0x8a, 0x2e, 0x62,
+	0x67, 0x07, 0xbd, 0xfb, 0x86, 0x77, 0xb1, 0x3a, 0xa8, 0xd0, 0x65, 0x07, 0xf2, 0x0e, 0xfa, 0xa8,
+	0xca, 0x8f, 0xed, 0xed, 0x75, 0xc4, 0x78, 0x3a, 0x0a, 0x13, 0x67, 0x17, 0x43, 0xfd, 0x55, 0x0f,
+	0xb5, 0x62, 0x34, 0xa8, 0xd0, 0x0d, 0xee, 0x05, 0x3c, 0xcf, 0x59, 0x26, 0x0e, 0x91, 0x71, 0xa7,
+	0xb7, 0x0a, 0xcf, 0x62, 0xb1, 0x80, 0x67, 0xa1, 0x21, 0xdb, 0x50, 0x1b, 0x2e, 0x9c, 0xe6, 0x7e,
+	0xf5, 0x51, 0x83, 0xd6, 0x86, 0x8b, 0xd3, 0x26, 0x34, 0x66, 0xde, 0x78, 0xca, 0xdc, 0x1f, 0xab,
+	0xb0, 0x6d, 0x82, 0x9b, 0x10, 0xa8, 0x47, 0xde, 0x44, 0x32, 0x80, 0x4d, 0xf1, 0x9b, 0xf4, 0x61,
+	0x2b, 0x5d, 0x4c, 0x3e, 0xc4, 0x63, 0xc4, 0xb4, 0x4d, 0x95, 0x44, 0x5c, 0xe8, 0x84, 0x51, 0xc6,
+	0xe3, 0x60, 0x8a, 0x3c, 0x82, 0x38, 0xb5, 0xa9, 0xa1, 0x23, 0x77, 0xa1, 0x91, 0xc5, 0x99, 0x37,
+	0x46, 0x0c, 0x5a, 0x54, 0x0a, 0x42, 0x9b, 0xf0, 0xd0, 0x67, 0x08, 0x32, 0x8b, 0x4a, 0x41, 0x68,
+	0x63, 0x51, 0x35, 0xc2, 0xc8, 0xa6, 0x52, 0x20, 0x7b, 0xd0, 0xf2, 0xbd, 0x8c, 0x5d, 0xc6, 0x3c,
+	0xaf, 0xa1, 0x90, 0xc9, 0x5f, 0xc0, 0x9e, 0x78, 0xf3, 0xf3, 0x69, 0x92, 0x8c, 0x17, 0x88, 0x21,
+	0x8b, 0x96, 0x0a, 0xf7, 0x04, 0x7a, 0x2b, 0xb4, 0xa3, 0x15, 0x53, 0x35, 0x8a, 0x29, 0x92, 0xd7,
+	0xb4, 0xe4, 0x45, 0x08, 0x83, 0x5a, 0x6e, 0x17, 0xe2, 0x19, 0xd8, 0x05, 0x1a, 0x37, 0xba, 0xf6,
+	0x61, 0xcb, 0x9b, 0x08, 0x8a, 0x46, 0x5f, 0x8b, 0x2a, 0xa9, 0x70, 0x46, 0x2c, 0xde, 0xd6, 0xf9,
+	0xa9, 0xa2, 0x79, 0x05, 0xc9, 0x4d, 0xee, 0x04, 0xea, 0x5e, 0x10, 0xe4, 0xbb, 0xc6, 0x6f, 0xf7,
+	0x19, 0x74, 0x0d, 0x88, 0xde, 0xca, 0xf9, 0x01, 0x40, 0x09, 0xd2, 0x4d, 0x9e, 0xee, 0x43, 0xe8,
+	0xe8, 0x98, 0xdc, 0x68, 0xf7, 0x4d, 0x15, 0x76, 0x96, 0xe0, 0x77, 0xd3, 0x6e, 0x70, 0x7a, 0x6b,
+	0xda, 0xf4, 0xfe, 0x92, 0x29, 0x25, 0x50, 0x0f, 0xfd, 0x38, 0xc2, 0x21, 0xb5, 0x29, 0x7e, 0x93,
+	0x5d, 0xb0, 0xa6, 0x7c, 0x8c, 0x13, 0x6a, 0x53, 0xf1, 0xe9, 0xbe, 0x86, 0xfe, 0x7a, 0xf0, 0x6e,
+	0xdc, 0xcf, 0x1e, 0xb4, 0x22, 0x76, 0xfd, 0x56, 0x1b, 0x8a, 0x42, 0x76, 0x23, 0x85, 0xbd, 0x12,
+	0xa7, 0x37, 0x9c, 0xef, 0x44, 0x02, 0x5f, 0xe1, 0x4f, 0x4a, 0x62, 0xde, 0xae, 0xa6, 0x71, 0xe6,
+	0x61, 0x49, 0x16, 0x95, 0x82, 0xb0, 0x4e, 0x18, 0x0f, 0xe3, 0x40, 0x41, 0x4e, 0x49, 0xee, 0x0f,
+	0x35, 0x68, 0x60, 0xc2, 0xdf, 0x21, 0xc6, 0x1d, 0x68, 0xfa, 0x02, 0x5b, 0x31, 0x47, 0x88, 0xdb,
+	0x34, 0x17, 0x71, 0x5f, 0x99, 0x97, 0x4d, 0x53, 0x84, 0x77, 0x83, 0x2a, 0xc9, 0x60, 0x05, 0x7b,
+	0x89, 0x15, 0x44, 0x07, 0xc4, 0x48, 0x05, 0xf8, 0x13, 0xd7, 0xa2, 0x4a, 0x2a, 0x4e, 0xb9, 0xbd,
+	0x7a, 0xca, 0x9d, 0xe2, 0x94, 0x4d, 0x4e, 0xe9, 0x2e, 0x73, 0xca, 0x10, 0x3a, 0x94, 0xf9, 0x2c,
+	0x4c, 0x32, 0xd9, 0xcb, 0x5b, 0x71, 0x81, 0x56, 0x8d, 0xa5, 0x57, 0xe3, 0x7e, 0x05, 0x44, 0x8f,
+	0x7a, 0x82, 0xf8, 0x25, 0xfb, 0x50, 0x4f, 0x38, 0x9b, 0xa9, 0xdb, 0x58, 0xc7, 0xb8, 0xff, 0xe0,
+	0x0a, 0x79, 0x08, 0x4d, 0x7f, 0xca, 0x39, 0x53, 0xd0, 0x5f, 0x36, 0xca, 0x17, 0xdd, 0x89, 0x82,
+	0xd0, 0x19, 0x8f, 0x3f, 0xb1, 0xe8, 0x24, 0x08, 0xf8, 0x6d, 0x00, 0x2d, 0x6c, 0x2f, 0xd0, 0x13,
+	0xb7, 0xdd, 0xa2, 0x4a, 0x12, 0xfa, 0x11, 0x0b, 0x2f, 0x47, 0x59, 0x3e, 0x6a, 0x52, 0x72, 0xbf,
+	0xab, 0x2a, 0xe6, 0xf9, 0x99, 0xc1, 0x5e, 0x97, 0xeb, 0x56, 0x43, 0x4d, 0xf6, 0xa1, 0x2d, 0xbf,
+	0xce, 0x33, 0x8f, 0x67, 0x6a, 0xd4, 0x74, 0x55, 0x01, 0x9e, 0x00, 0x27, 0xce, 0x52, 0xe0, 0x09,
+	0xdc, 0xaf, 0xcd, 0x96, 0xab, 0x9d, 0x3e, 0x34, 0x5a, 0x4e, 0x96, 0x6f, 0x53, 0x8c, 0xab, 0xc6,
+	0x1f, 0x2c, 0x37, 0x7e, 0x9d, 0x69, 0xd1, 0x7e, 0x6e, 0xe6, 0x52, 0x7c, 0xfc, 0x77, 0x23, 0x57,
+	0xdf, 0xbc, 0x53, 0xe5, 0xe7, 0xa4, 0xf2, 0x1d, 0x2d, 0xe7, 0xdb, 0x64, 0x5e, 0xe4, 0x7c, 0x0f,
+	0x3d, 0x3d, 0xe7, 0x8d, 0x5c, 0xbc, 0x79, 0x5a, 0x15, 0x8e, 0x2c, 0x1d, 0x47, 0xee, 0xe7, 0x06,
+	0xc0, 0xeb, 0xd8, 0xf7, 0xc6, 0x7f, 0x1c, 0x3a, 0x79, 0x00, 0x5d, 0x34, 0x61, 0xc1, 0x40, 0x0e,
+	0xb4, 0x8d, 0x59, 0x4c, 0xa5, 0x98, 0x36, 0xa5, 0x18, 0x86, 0x13, 0x86, 0xec, 0x62, 0x51, 0x5d,
+	0x45, 0x8e, 0xe0, 0x4e, 0xc2, 0x59, 0xe2, 0x15, 0x8f, 0x25, 0x19, 0xad, 0x8d, 0x96, 0xeb, 0x96,
+	0xc8, 0x01, 0xf4, 0x0c, 0x35, 0x46, 0xee, 0xa0, 0xfd, 0xea, 0x82, 0x20, 0xa7, 0x84, 0x33, 0x3f,
+	0x4c, 0x45, 0xf3, 0xba, 0x58, 0x42, 0xa9, 0x20, 0x87, 0x40, 0xb0, 0x59, 0xc5, 0xcb, 0x21, 0x9c,
+	0xb0, 0x14, 0xef, 0xc1, 0x16, 0x5d, 0xb3, 0x22, 0xaa, 0xe6, 0x78, 0xb1, 0xc9, 0xab, 0xde, 0x91,
+	0x55, 0x1b, 0x4a, 0x51, 0xb5, 0x52, 0xe0, 0xde, 0x76, 0x65, 0xd5, 0x9a, 0xca, 0x20, 0xe3, 0xde,
+	0x46, 0x32, 0x26, 0x6b, 0xc9, 0xf8, 0xce, 0x2a, 0x19, 0xdf, 0xdd, 0x40, 0xc6, 0xf7, 0x96, 0xc9,
+	0x78, 0x0a, 0x36, 0xce, 0xe1, 0xeb, 0xf8, 0x32, 0xdd, 0x38, 0xdb, 0x0e, 0x34, 0xb3, 0xf9, 0xab,
+	0x28, 0x60, 0x73, 0x35, 0x8b, 0xb9, 0x48, 0xee, 0x03, 0xc8, 0x67, 0xf2, 0x70, 0x91, 0x30, 0xc5,
+	0xc8, 0x9a, 0x46, 0x44, 0xcc, 0xe6, 0x03, 0x2f, 0x1d, 0xa9, 0x7b, 0x81, 0x92, 0xdc, 0x6b, 0xb0,
+	0x29, 0xbb, 0xc2, 0xe1, 0xc7, 0x1f, 0xa2, 0xab, 0x29, 0xe3, 0x8b, 0x93, 0xb1, 0x4c, 0xdc, 0xa2,
+	0x85, 0xac, 0x4d, 0x5b, 0xcd, 0x98, 0x36, 0x11, 0x18, 0xbd, 0x1d, 0x6b, 0xdf, 0xc2, 0xc0, 0x32,
+	0xd6, 0x7d, 0x00, 0xb9, 0xe9, 0xb7, 0xd1, 0x78, 0x81, 0x49, 0x5b, 0x54, 0xd3, 0xb8, 0xff, 0x84,
+	0x36, 0x65, 0xc9, 0x78, 0xa1, 0x52, 0xff, 0xad, 0x08, 0x53, 0xdd, 0xb7, 0xb4, 0x17, 0x52, 0x89,
+	0xcd, 0x3c, 0xb2, 0xfb, 0x0f, 0x75, 0x8f, 0xa4, 0xcc, 0x9f, 0x49, 0x80, 0x7d, 0x64, 0x91, 0x6a,
+	0x94, 0x14, 0xc4, 0x81, 0x70, 0xe6, 0xcf, 0xd4, 0x1d, 0x12, 0xbf, 0xdd, 0xff, 0x42, 0x1f, 0x13,
+	0x22, 0xb5, 0x30, 0x7f, 0x76, 0x16, 0x73, 0x95, 0xfb, 0x48, 0xbd, 0xd0, 0x84, 0x36, 0xcf, 0xbf,
+	0x6b, 0xbe, 0xd0, 0xfd, 0x19, 0xd5, 0x6c, 0xdc, 0x10, 0x76, 0xf2, 0xae, 0x9d, 0x7a, 0x63, 0x2f,
+	0xf2, 0x71, 0x9a, 0x05, 0xe7, 0xb3, 0x34, 0x65, 0x32, 0x86, 0x4d, 0x4b, 0x85, 0x98, 0x3b, 0xf9,
+	0x90, 0xd1, 0x89, 0x44, 0x57, 0x89, 0x3e, 0xb2, 0x39, 0xf3, 0x19, 0x57, 0x3c, 0xa2, 0x24, 0xf7,
+	0x15, 0xdc, 0xa3, 0xec, 0xea, 0x44, 0xfe, 0xe9, 0x21, 0x7f, 0x51, 0xf1, 0x45, 0x2d, 0x66, 0x41,
+	0xc5, 0x57, 0xb5, 0xe7, 0xa2, 0x16, 0xaa, 0x66, 0x84, 0x7a, 0x03, 0x50, 0x06, 0xd8, 0x38, 0x63,
+	0x8f, 0xa0, 0xa9, 0xfe, 0x62, 0x51, 0xf4, 0xbc, 0x9d, 0xbf, 0xe4, 0xa5, 0x96, 0xe6, 0xcb, 0xee,
+	0x1b, 0xf8, 0x93, 0xec, 0xe8, 0xea, 0xe6, 0x8e, 0x55, 0xbd, 0x52, 0x5c, 0x3a, 0xd3, 0xd2, 0x90,
+	0xea, 0x56, 0xee, 0xb7, 0x55, 0xe8, 0x8a, 0x5a, 0x83, 0x20, 0x3f, 0x99, 0xfc, 0x47, 0xb5, 0x6a,
+	0xfe, 0x80, 0xaf, 0x1d, 0xc4, 0x62, 0x12, 0xe4, 0x1c, 0x4a, 0x41, 0x1c, 0x4b, 0x10, 0x72, 0x26,
+	0x19, 0xba, 0x2e, 0x49, 0xa6, 0x50, 0x08, 0x1f, 0x59, 0x69, 0x03, 0x57, 0xa4, 0x20, 0x3a, 0x7b,
+	0xc1, 0xe3, 0xc9, 0xff, 0xd8, 0x42, 0x51, 0x71, 0x2e, 0xba, 0xdf, 0x57, 0x01, 0xf2, 0x83, 0x1f,
+	0xce, 0x6f, 0xba, 0x0b, 0x5c, 0x8c, 0xbd, 0x4b, 0xb5, 0x41, 0xfc, 0x2e, 0x53, 0x59, 0x7a, 0xaa,
+	0x9b, 0xb7, 0x57, 0xde, 0x49, 0x1a, 0xfa, 0x9d, 0x44, 0xc4, 0x0a, 0x91, 0x04, 0xe4, 0x35, 0x40,
+	0x0a, 0x45, 0xb3, 0x9a, 0xda, 0xf3, 0xe5, 0x09, 0x6c, 0x97, 0x28, 0x43, 0x6a, 0x79, 0x00, 0xf5,
+	0x71, 0x7c, 0xb9, 0x3c, 0xe6, 0x05, 0xf5, 0x50, 0x5c, 0x75, 0x67, 0xc2, 0xef, 0xaa, 0xfc, 0x2d,
+	0xbe, 0x91, 0x92, 0xf2, 0x66, 0xd5, 0x8c, 0x66, 0xfd, 0x9a, 0x8a, 0xdd, 0x7f, 0xc3, 0x2e, 0xee,
+	0x57, 0xcf, 0x7c, 0x00, 0x0d, 0x51, 0x4b, 0xbe, 0xe5, 0x4d, 0xb7, 0x05, 0x69, 0x24, 0x5e, 0xb9,
+	0x65, 0xc5, 0xf2, 0xf2, 0x22, 0x42, 0x34, 0xe5, 0x3b, 0x23, 0x0f, 0xb2, 0xf6, 0x8a, 0xa3, 0x4c,
+	0xdc, 0x73, 0x75, 0xe3, 0x1b, 0xc4, 0xe3, 0xe0, 0x96, 0x37, 0x3e, 0x07, 0x9a, 0x1f, 0x24, 0x21,
+	0xa8, 0x3b, 0x5f, 0x2e, 0xba, 0xef, 0x4b, 0xca, 0x90, 0x71, 0x7f, 0xb3, 0x96, 0xba, 0x73, 0xe8,
+	0x9b, 0xa1, 0xcf, 0x23, 0x2f, 0x49, 0x47, 0xf1, 0x8d, 0x4f, 0x74, 0x35, 0x58, 0x35, 0x63, 0xb0,
+	0xb4, 0xcc, 0xd6, 0x86, 0xcc, 0x75, 0x3d, 0xb3, 0xd1, 0xec, 0xbc, 0xac, 0x03, 0x68, 0x8e, 0xe4,
+	0xe7, 0xba, 0x66, 0x4b, 0x2b, 0x9a, 0x9b, 0x3c, 0x7e, 0xa1, 0x60, 0x4b, 0x9e, 0xc3, 0xce, 0x4b,
+	0x96, 0x19, 0x9c, 0x9a, 0x1f, 0xf5, 0x12, 0xd7, 0xee, 0xed, 0x98, 0x8c, 0x94, 0xba, 0x95, 0x0f,
+	0x5b, 0xf8, 0x77, 0xf0, 0xf1, 0x4f, 0x03, 0x00, 0x72, 0x30, 0x3a, 0x8f, 0x46, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
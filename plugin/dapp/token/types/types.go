@@ -28,6 +28,9 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(TokenX, ForkTokenPriceX, 560000)
 	cfg.RegisterDappFork(TokenX, ForkTokenSymbolWithNumberX, 1298600)
 	cfg.RegisterDappFork(TokenX, ForkTokenCheckX, 1600000)
+	cfg.RegisterDappFork(TokenX, ForkTokenFreezeX, 4600000)
+	cfg.RegisterDappFork(TokenX, ForkTokenOwnershipX, 4600000)
+	cfg.RegisterDappFork(TokenX, ForkTokenMinterX, 4600000)
 }
 
 func InitExecutor(cfg *types.Chain33Config) {
@@ -60,35 +63,49 @@ func (t *TokenType) GetPayload() types.Message {
 // GetTypeMap 根据action的name获取type
 func (t *TokenType) GetTypeMap() map[string]int32 {
 	return map[string]int32{
-		"Transfer":          ActionTransfer,
-		"Genesis":           ActionGenesis,
-		"Withdraw":          ActionWithdraw,
-		"TokenPreCreate":    TokenActionPreCreate,
-		"TokenFinishCreate": TokenActionFinishCreate,
-		"TokenRevokeCreate": TokenActionRevokeCreate,
-		"TransferToExec":    TokenActionTransferToExec,
-		"TokenMint":         TokenActionMint,
-		"TokenBurn":         TokenActionBurn,
+		"Transfer":               ActionTransfer,
+		"Genesis":                ActionGenesis,
+		"Withdraw":               ActionWithdraw,
+		"TokenPreCreate":         TokenActionPreCreate,
+		"TokenFinishCreate":      TokenActionFinishCreate,
+		"TokenRevokeCreate":      TokenActionRevokeCreate,
+		"TransferToExec":         TokenActionTransferToExec,
+		"TokenMint":              TokenActionMint,
+		"TokenBurn":              TokenActionBurn,
+		"TokenFreeze":            TokenActionFreeze,
+		"TokenUnfreeze":          TokenActionUnfreeze,
+		"TokenPause":             TokenActionPause,
+		"TokenUnpause":           TokenActionUnpause,
+		"TokenUpdateInfo":        TokenActionUpdateInfo,
+		"TokenTransferOwnership": TokenActionTransferOwnership,
+		"TokenSetMinter":         TokenActionSetMinter,
 	}
 }
 
 // GetLogMap 获取log的映射对应关系
 func (t *TokenType) GetLogMap() map[int64]*types.LogInfo {
 	return map[int64]*types.LogInfo{
-		TyLogTokenTransfer:        {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenTransfer"},
-		TyLogTokenDeposit:         {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenDeposit"},
-		TyLogTokenExecTransfer:    {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecTransfer"},
-		TyLogTokenExecWithdraw:    {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecWithdraw"},
-		TyLogTokenExecDeposit:     {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecDeposit"},
-		TyLogTokenExecFrozen:      {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecFrozen"},
-		TyLogTokenExecActive:      {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecActive"},
-		TyLogTokenGenesisTransfer: {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenGenesisTransfer"},
-		TyLogTokenGenesisDeposit:  {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenGenesisDeposit"},
-		TyLogPreCreateToken:       {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogPreCreateToken"},
-		TyLogFinishCreateToken:    {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogFinishCreateToken"},
-		TyLogRevokeCreateToken:    {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogRevokeCreateToken"},
-		TyLogTokenMint:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogMintToken"},
-		TyLogTokenBurn:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogBurnToken"},
+		TyLogTokenTransfer:          {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenTransfer"},
+		TyLogTokenDeposit:           {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenDeposit"},
+		TyLogTokenExecTransfer:      {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecTransfer"},
+		TyLogTokenExecWithdraw:      {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecWithdraw"},
+		TyLogTokenExecDeposit:       {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecDeposit"},
+		TyLogTokenExecFrozen:        {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecFrozen"},
+		TyLogTokenExecActive:        {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecActive"},
+		TyLogTokenGenesisTransfer:   {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenGenesisTransfer"},
+		TyLogTokenGenesisDeposit:    {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenGenesisDeposit"},
+		TyLogPreCreateToken:         {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogPreCreateToken"},
+		TyLogFinishCreateToken:      {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogFinishCreateToken"},
+		TyLogRevokeCreateToken:      {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogRevokeCreateToken"},
+		TyLogTokenMint:              {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogMintToken"},
+		TyLogTokenBurn:              {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogBurnToken"},
+		TyLogTokenFreeze:            {Ty: reflect.TypeOf(ReceiptTokenFreeze{}), Name: "LogFreezeToken"},
+		TyLogTokenUnfreeze:          {Ty: reflect.TypeOf(ReceiptTokenFreeze{}), Name: "LogUnfreezeToken"},
+		TyLogTokenPause:             {Ty: reflect.TypeOf(ReceiptTokenPause{}), Name: "LogPauseToken"},
+		TyLogTokenUnpause:           {Ty: reflect.TypeOf(ReceiptTokenPause{}), Name: "LogUnpauseToken"},
+		TyLogTokenUpdateInfo:        {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogUpdateTokenInfo"},
+		TyLogTokenTransferOwnership: {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogTransferTokenOwnership"},
+		TyLogTokenMinter:            {Ty: reflect.TypeOf(ReceiptTokenMinter{}), Name: "LogTokenMinter"},
 	}
 }
 
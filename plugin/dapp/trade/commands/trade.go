@@ -33,6 +33,7 @@ func TradeCmd() *cobra.Command {
 		CreateRawBuyLimitTxCmd(),
 		CreateRawSellMarketTxCmd(),
 		CreateRawBuyRevokeTxCmd(),
+		CreateRawExpireTxCmd(),
 
 		ShowOnesSellOrdersCmd(),
 		ShowOnesSellOrdersStatusCmd(),
@@ -43,6 +44,7 @@ func TradeCmd() *cobra.Command {
 		ShowTokenBuyOrdersStatusCmd(),
 
 		ShowOnesOrdersStatusCmd(),
+		ShowOnesExpiringOrdersCmd(),
 	)
 
 	return cmd
@@ -429,6 +431,7 @@ func parseTradeOrders(arg interface{}) (interface{}, error) {
 			MinBoardlot:    o.MinBoardlot,
 			TotalBoardlot:  o.TotalBoardlot,
 			TradedBoardlot: o.TradedBoardlot,
+			ExpireHeight:   o.ExpireHeight,
 		}
 		order.AmountPerBoardlot = strconv.FormatFloat(float64(o.AmountPerBoardlot)/float64(types.Coin), 'f', 4, 64)
 		order.PricePerBoardlot = strconv.FormatFloat(float64(o.PricePerBoardlot)/float64(types.Coin), 'f', 4, 64)
@@ -437,6 +440,48 @@ func parseTradeOrders(arg interface{}) (interface{}, error) {
 	return result, nil
 }
 
+// ShowOnesExpiringOrdersCmd : show one's orders which expire within the window
+func ShowOnesExpiringOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring_order",
+		Short: "Show one's unfinished orders near expiry",
+		Long: "Show one's unfinished orders near expiry, including expired orders not yet released by the keeper.",
+		Run: showOnesExpiringOrders,
+	}
+	addShowOnesExpiringOrdersFlags(cmd)
+	return cmd
+}
+
+func addShowOnesExpiringOrdersFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("address", "a", "", "user address")
+	cmd.MarkFlagRequired("address")
+	cmd.Flags().Int64P("window", "w", 0, "expire within blocks from current height, expired orders are always listed")
+	cmd.Flags().Int32P("count", "c", 10, "order count")
+	cmd.Flags().StringP("from", "f", "", "start from key (not required)")
+}
+
+func showOnesExpiringOrders(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("address")
+	window, _ := cmd.Flags().GetInt64("window")
+	count, _ := cmd.Flags().GetInt32("count")
+	from, _ := cmd.Flags().GetString("from")
+	req := &pty.ReqAddrExpiringOrders{
+		Addr:    addr,
+		Window:  window,
+		Count:   count,
+		FromKey: from,
+	}
+	var params rpctypes.Query4Jrpc
+	params.Execer = "trade"
+	params.FuncName = "GetOnesExpiringOrders"
+	params.Payload = types.MustPBToJSON(req)
+	var res pty.ReplyTradeOrders
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.SetResultCb(parseTradeOrders)
+	ctx.Run()
+}
+
 /************* create trade transactions *************/
 
 // CreateRawTradeSellTxCmd : create raw sell token transaction
@@ -468,6 +513,8 @@ func addTokenSellFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("asset_exec", "e", "", "asset exec, default: token")
 	cmd.Flags().StringP("price_exec", "", "", "price exec")
 	cmd.Flags().StringP("price_symbol", "", "", "price symbol")
+	cmd.Flags().Int64P("expire", "", 0, "expire height, 0 means never expire")
+	cmd.Flags().BoolP("auto_match", "", false, "match crossing buy orders when placing the order, in multiples of the least common multiple of both amounts per boardlot")
 }
 
 func tokenSell(cmd *cobra.Command, args []string) {
@@ -483,6 +530,8 @@ func tokenSell(cmd *cobra.Command, args []string) {
 	if exec == "" {
 		exec = "token"
 	}
+	expire, _ := cmd.Flags().GetInt64("expire")
+	autoMatch, _ := cmd.Flags().GetBool("auto_match")
 
 	priceInt64 := int64(price * 1e4)
 	feeInt64 := int64(fee * 1e4)
@@ -497,6 +546,8 @@ func tokenSell(cmd *cobra.Command, args []string) {
 		AssetExec:         exec,
 		PriceExec:         priceExec,
 		PriceSymbol:       priceSymbol,
+		ExpireHeight:      expire,
+		AutoMatch:         autoMatch,
 	}
 
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeSellTx", params, nil)
@@ -603,6 +654,8 @@ func addTokenBuyLimitFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("asset_exec", "e", "", "asset exec, default: token")
 	cmd.Flags().StringP("price_exec", "", "", "price exec")
 	cmd.Flags().StringP("price_symbol", "", "", "price symbol")
+	cmd.Flags().Int64P("expire", "", 0, "expire height, 0 means never expire")
+	cmd.Flags().BoolP("auto_match", "", false, "match crossing sell orders when placing the order, in multiples of the least common multiple of both amounts per boardlot")
 }
 
 func tokenBuyLimit(cmd *cobra.Command, args []string) {
@@ -618,6 +671,8 @@ func tokenBuyLimit(cmd *cobra.Command, args []string) {
 	if exec == "" {
 		exec = "token"
 	}
+	expire, _ := cmd.Flags().GetInt64("expire")
+	autoMatch, _ := cmd.Flags().GetBool("auto_match")
 
 	priceInt64 := int64(price * 1e4)
 	feeInt64 := int64(fee * 1e4)
@@ -632,6 +687,8 @@ func tokenBuyLimit(cmd *cobra.Command, args []string) {
 		AssetExec:         exec,
 		PriceExec:         priceExec,
 		PriceSymbol:       priceSymbol,
+		ExpireHeight:      expire,
+		AutoMatch:         autoMatch,
 	}
 
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeBuyLimitTx", params, nil)
@@ -706,3 +763,44 @@ func buyRevoke(cmd *cobra.Command, args []string) {
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeRevokeBuyTx", params, nil)
 	ctx.RunWithoutMarshal()
 }
+
+// CreateRawExpireTxCmd : create raw expire orders transaction
+// expired orders are not released during block execution, a keeper needs to send this transaction
+func CreateRawExpireTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expire",
+		Short: "Create a keeper transaction to release expired orders",
+		Long: "Expired orders can not be traded any more, but the frozen assets are only released by this keeper transaction,\n" +
+			"they are not released automatically during block execution. Any address can act as the keeper and send it.",
+		Run: orderExpire,
+	}
+	addOrderExpireFlags(cmd)
+	return cmd
+}
+
+func addOrderExpireFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceP("sell_ids", "s", nil, "sell ids, separated by comma")
+	cmd.Flags().StringSliceP("buy_ids", "b", nil, "buy ids, separated by comma")
+	cmd.Flags().Float64P("fee", "f", 0, "transaction fee")
+}
+
+func orderExpire(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	sellIDs, _ := cmd.Flags().GetStringSlice("sell_ids")
+	buyIDs, _ := cmd.Flags().GetStringSlice("buy_ids")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+	if len(sellIDs) == 0 && len(buyIDs) == 0 {
+		fmt.Fprintln(os.Stderr, "sell_ids or buy_ids required")
+		return
+	}
+
+	feeInt64 := int64(fee * 1e4)
+	params := &pty.TradeExpireTx{
+		SellIDs: sellIDs,
+		BuyIDs:  buyIDs,
+		Fee:     feeInt64 * 1e4,
+	}
+
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeExpireTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
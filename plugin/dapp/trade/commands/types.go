@@ -20,6 +20,7 @@ type tradeOrderResult struct {
 	Key               string `json:"key"`
 	BlockTime         int64  `json:"blockTime"`
 	IsSellOrder       bool   `json:"isSellOrder"`
+	ExpireHeight      int64  `json:"expireHeight,omitempty"`
 }
 
 type replySellOrdersResult struct {
@@ -38,3 +38,12 @@ func (t *trade) Exec_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.Transact
 	action := newTradeAction(t, tx)
 	return action.tradeRevokeBuyLimit(revoke)
 }
+
+func (t *trade) Exec_Expire(expire *pty.TradeForExpire, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := t.GetAPI().GetConfig()
+	if !cfg.IsDappFork(t.GetHeight(), pty.TradeX, pty.ForkTradeExpireX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTradeAction(t, tx)
+	return action.tradeExpire(expire)
+}
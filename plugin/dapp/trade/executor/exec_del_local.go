@@ -34,11 +34,29 @@ func (t *trade) ExecDelLocal_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.
 	return t.localDelLog(tx, receipt, index, 0)
 }
 
+func (t *trade) ExecDelLocal_Expire(expire *pty.TradeForExpire, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.localDelLog(tx, receipt, index, 0)
+}
+
 func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, index int, tradedBoardlot int64) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
 	table := NewOrderTableV2(t.GetLocalDB())
 	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
 
+	// 自动撮合时一笔交易会和多个订单成交, 回滚时各订单按各自的成交手数回退
+	matched := make(map[string]int64)
+	for _, item := range receipt.Logs {
+		if item.Ty == pty.TyLogTradeMatch {
+			var match pty.ReceiptTradeMatch
+			err := types.Decode(item.Log, &match)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			matched[match.SellID] = match.BoardlotCnt
+			matched[match.BuyID] = match.BuyBoardlotCnt
+		}
+	}
+
 	for i := 0; i < len(receipt.Logs); i++ {
 		item := receipt.Logs[i]
 		if item.Ty == pty.TyLogTradeSellLimit {
@@ -47,7 +65,11 @@ func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, i
 			if err != nil {
 				panic(err) //数据错误了，已经被修改了
 			}
-			t.deleteSell(receipt.Base, item.Ty, tx, txIndex, table, tradedBoardlot)
+			traded := tradedBoardlot
+			if cnt, ok := matched[receipt.Base.SellID]; ok {
+				traded = cnt
+			}
+			t.deleteSell(receipt.Base, item.Ty, tx, txIndex, table, traded)
 		} else if item.Ty == pty.TyLogTradeSellRevoke {
 			var receipt pty.ReceiptTradeSellRevoke
 			err := types.Decode(item.Log, &receipt)
@@ -55,6 +77,13 @@ func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, i
 				panic(err) //数据错误了，已经被修改了
 			}
 			t.deleteSell(receipt.Base, item.Ty, tx, txIndex, table, tradedBoardlot)
+		} else if item.Ty == pty.TyLogTradeSellExpire {
+			var receipt pty.ReceiptTradeSellExpire
+			err := types.Decode(item.Log, &receipt)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			t.deleteSell(receipt.Base, item.Ty, tx, txIndex, table, tradedBoardlot)
 		} else if item.Ty == pty.TyLogTradeBuyMarket {
 			var receipt pty.ReceiptTradeBuyMarket
 			err := types.Decode(item.Log, &receipt)
@@ -69,13 +98,24 @@ func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, i
 				panic(err) //数据错误了，已经被修改了
 			}
 			t.deleteBuyLimit(receipt.Base, item.Ty, tx, txIndex, table, tradedBoardlot)
+		} else if item.Ty == pty.TyLogTradeBuyExpire {
+			var receipt pty.ReceiptTradeBuyExpire
+			err := types.Decode(item.Log, &receipt)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			t.deleteBuyLimit(receipt.Base, item.Ty, tx, txIndex, table, tradedBoardlot)
 		} else if item.Ty == pty.TyLogTradeBuyLimit {
 			var receipt pty.ReceiptTradeBuyLimit
 			err := types.Decode(item.Log, &receipt)
 			if err != nil {
 				panic(err) //数据错误了，已经被修改了
 			}
-			t.deleteBuyLimit(receipt.Base, item.Ty, tx, txIndex, table, tradedBoardlot)
+			traded := tradedBoardlot
+			if cnt, ok := matched[receipt.Base.BuyID]; ok {
+				traded = cnt
+			}
+			t.deleteBuyLimit(receipt.Base, item.Ty, tx, txIndex, table, traded)
 		} else if item.Ty == pty.TyLogTradeSellMarket {
 			var receipt pty.ReceiptSellMarket
 			err := types.Decode(item.Log, &receipt)
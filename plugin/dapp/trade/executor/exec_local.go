@@ -34,6 +34,10 @@ func (t *trade) ExecLocal_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.Tra
 	return t.localAddLog(tx, receipt, index)
 }
 
+func (t *trade) ExecLocal_Expire(expire *pty.TradeForExpire, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.localAddLog(tx, receipt, index)
+}
+
 func (t *trade) localAddLog(tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
 	table := NewOrderTableV2(t.GetLocalDB())
@@ -54,6 +58,13 @@ func (t *trade) localAddLog(tx *types.Transaction, receipt *types.ReceiptData, i
 				panic(err) //数据错误了，已经被修改了
 			}
 			t.saveSell(receipt.Base, item.Ty, tx, txIndex, table)
+		} else if item.Ty == pty.TyLogTradeSellExpire {
+			var receipt pty.ReceiptTradeSellExpire
+			err := types.Decode(item.Log, &receipt)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			t.saveSell(receipt.Base, item.Ty, tx, txIndex, table)
 		} else if item.Ty == pty.TyLogTradeBuyMarket {
 			var receipt pty.ReceiptTradeBuyMarket
 			err := types.Decode(item.Log, &receipt)
@@ -68,6 +79,13 @@ func (t *trade) localAddLog(tx *types.Transaction, receipt *types.ReceiptData, i
 				panic(err) //数据错误了，已经被修改了
 			}
 
+			t.saveBuyLimit(receipt.Base, item.Ty, tx, txIndex, table)
+		} else if item.Ty == pty.TyLogTradeBuyExpire {
+			var receipt pty.ReceiptTradeBuyExpire
+			err := types.Decode(item.Log, &receipt)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
 			t.saveBuyLimit(receipt.Base, item.Ty, tx, txIndex, table)
 		} else if item.Ty == pty.TyLogTradeBuyLimit {
 			var receipt pty.ReceiptTradeBuyLimit
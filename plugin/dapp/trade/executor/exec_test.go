@@ -15,6 +15,7 @@ import (
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
@@ -533,3 +534,450 @@ func TestTradeSellFixAssetDB(t *testing.T) {
 	assert.Equal(t, 1, len(orders.Orders))
 	ldb.Close()
 }
+
+func TestTradeOrderExpire(t *testing.T) {
+	total := int64(100000)
+	accountA := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[0]),
+	}
+	accountB := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[1]),
+	}
+
+	env := execEnv{
+		1539918074,
+		chain33TestCfg.GetDappFork("trade", pty.ForkTradeExpireX),
+		2,
+		1539918074,
+		"hash",
+	}
+	expireHeight := env.blockHeight + 10
+
+	_, ldb, kvdb := util.CreateTestDB()
+	accB := account.NewCoinsAccount(chain33TestCfg)
+	accB.SetDB(kvdb)
+	accB.SaveExecAccount(address.ExecAddress("trade"), &accountB)
+
+	accA, _ := account.NewAccountDB(chain33TestCfg, AssetExecToken, Symbol, kvdb)
+	accA.SaveExecAccount(address.ExecAddress("trade"), &accountA)
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chain33TestCfg, nil)
+	driver := newTrade()
+	driver.SetAPI(api)
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetStateDB(kvdb)
+	driver.SetLocalDB(kvdb)
+
+	sell := &pty.TradeSellTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: 100,
+		MinBoardlot:       1,
+		PricePerBoardlot:  2,
+		TotalBoardlot:     100,
+		AssetExec:         AssetExecToken,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+		ExpireHeight:      env.blockHeight,
+	}
+	tx, _ := pty.CreateRawTradeSellTx(chain33TestCfg, sell)
+	tx, _ = signTx(tx, PrivKeyA)
+	_, err := driver.Exec(tx, env.index)
+	assert.Equal(t, pty.ErrTOrderExpireHeight, err)
+
+	sell.ExpireHeight = expireHeight
+	tx, _ = pty.CreateRawTradeSellTx(chain33TestCfg, sell)
+	tx, _ = signTx(tx, PrivKeyA)
+	receipt, err := driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	var sellOrder pty.SellOrder
+	err = types.Decode(receipt.KV[1].Value, &sellOrder)
+	assert.Nil(t, err)
+	assert.Equal(t, expireHeight, sellOrder.ExpireHeight)
+	_, err = driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+	assert.Nil(t, err)
+
+	buy := &pty.TradeBuyLimitTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: 100,
+		MinBoardlot:       1,
+		PricePerBoardlot:  2,
+		TotalBoardlot:     100,
+		AssetExec:         AssetExecPara,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+		ExpireHeight:      expireHeight,
+	}
+	tx, _ = pty.CreateRawTradeBuyLimitTx(chain33TestCfg, buy)
+	tx, _ = signTx(tx, PrivKeyB)
+	receipt, err = driver.Exec(tx, env.index+1)
+	assert.Nil(t, err)
+	var buyOrder pty.BuyLimitOrder
+	err = types.Decode(receipt.KV[1].Value, &buyOrder)
+	assert.Nil(t, err)
+	assert.Equal(t, expireHeight, buyOrder.ExpireHeight)
+	_, err = driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index+1)
+	assert.Nil(t, err)
+
+	// 到期的订单才能释放
+	sellID := sellOrder.SellID[len("mavl-trade-sell-"):]
+	buyID := buyOrder.BuyID[len("mavl-trade-buy-"):]
+	expire := &pty.TradeExpireTx{SellIDs: []string{sellID}, BuyIDs: []string{buyID}}
+	tx, _ = pty.CreateRawTradeExpireTx(chain33TestCfg, expire)
+	tx, _ = signTx(tx, PrivKeyC)
+	_, err = driver.Exec(tx, env.index)
+	assert.Equal(t, pty.ErrTOrderNotExpired, err)
+	// 分叉之前不支持释放到期订单
+	driver.SetEnv(env.blockHeight-1, env.blockTime, env.difficulty)
+	_, err = driver.Exec(tx, env.index)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+
+	req := &pty.ReqAddrExpiringOrders{Addr: string(Nodes[0]), Window: 9, Count: 10}
+	_, err = driver.Query("GetOnesExpiringOrders", types.Encode(req))
+	assert.Equal(t, types.ErrNotFound, err)
+	req.Window = 10
+	resp, err := driver.Query("GetOnesExpiringOrders", types.Encode(req))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.(*pty.ReplyTradeOrders).Orders))
+	assert.Equal(t, expireHeight, resp.(*pty.ReplyTradeOrders).Orders[0].ExpireHeight)
+
+	// 到期后不能再成交, 任何地址都可以释放冻结的资产
+	driver.SetEnv(expireHeight, env.blockTime+10, env.difficulty)
+	buyMarket := &pty.TradeBuyTx{SellID: sellID, BoardlotCnt: 1}
+	tx, _ = pty.CreateRawTradeBuyTx(chain33TestCfg, buyMarket)
+	tx, _ = signTx(tx, PrivKeyB)
+	_, err = driver.Exec(tx, env.index)
+	assert.Equal(t, pty.ErrTSellOrderExpired, err)
+
+	tx, _ = pty.CreateRawTradeExpireTx(chain33TestCfg, expire)
+	tx, _ = signTx(tx, PrivKeyC)
+	receipt, err = driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	accountA = *accA.LoadExecAccount(string(Nodes[0]), address.ExecAddress("trade"))
+	assert.Equal(t, total, accountA.Balance)
+	assert.Equal(t, int64(0), accountA.Frozen)
+	accountB = *accB.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, total, accountB.Balance)
+	assert.Equal(t, int64(0), accountB.Frozen)
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	_, err = driver.ExecLocal(tx, receiptData, env.index)
+	assert.Nil(t, err)
+
+	_, err = driver.Query("GetOnesExpiringOrders", types.Encode(req))
+	assert.Equal(t, types.ErrNotFound, err)
+	order, err := driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: dapp.HeightIndexStr(env.blockHeight, int64(env.index))}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.TradeOrderStatusExpired), order.(*pty.ReplyTradeOrder).Status)
+
+	// 回滚后订单恢复为未完成
+	_, err = driver.ExecDelLocal(tx, receiptData, env.index)
+	assert.Nil(t, err)
+	resp, err = driver.Query("GetOnesExpiringOrders", types.Encode(req))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.(*pty.ReplyTradeOrders).Orders))
+	ldb.Close()
+}
+
+func TestTradeAutoMatch(t *testing.T) {
+	total := int64(100000)
+	accountA := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[0]),
+	}
+	accountB := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[1]),
+	}
+
+	env := execEnv{
+		1539918074,
+		chain33TestCfg.GetDappFork("trade", pty.ForkTradeMatchX),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	_, ldb, kvdb := util.CreateTestDB()
+	accB := account.NewCoinsAccount(chain33TestCfg)
+	accB.SetDB(kvdb)
+	accB.SaveExecAccount(address.ExecAddress("trade"), &accountB)
+
+	accA, _ := account.NewAccountDB(chain33TestCfg, AssetExecPara, Symbol, kvdb)
+	accA.SaveExecAccount(address.ExecAddress("trade"), &accountA)
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chain33TestCfg, nil)
+	driver := newTrade()
+	driver.SetAPI(api)
+	driver.SetEnv(env.blockHeight-1, env.blockTime, env.difficulty)
+	assert.NotEqual(t, int64(dapp.ExecLocalSameTime), driver.ExecutorOrder())
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetStateDB(kvdb)
+	driver.SetLocalDB(kvdb)
+	assert.Equal(t, int64(dapp.ExecLocalSameTime), driver.ExecutorOrder())
+
+	sell := &pty.TradeSellTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: 100,
+		MinBoardlot:       1,
+		PricePerBoardlot:  2,
+		TotalBoardlot:     10,
+		AssetExec:         AssetExecPara,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+	}
+	tx, _ := pty.CreateRawTradeSellTx(chain33TestCfg, sell)
+	tx, _ = signTx(tx, PrivKeyA)
+	receipt, err := driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	_, err = driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+	assert.Nil(t, err)
+
+	// 买价高于卖价, 按卖单价格成交, 差价返还给买方
+	buy := &pty.TradeBuyLimitTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: 100,
+		MinBoardlot:       1,
+		PricePerBoardlot:  3,
+		TotalBoardlot:     4,
+		AssetExec:         AssetExecPara,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+		AutoMatch:         true,
+	}
+	tx, _ = pty.CreateRawTradeBuyLimitTx(chain33TestCfg, buy)
+	tx, _ = signTx(tx, PrivKeyB)
+	receipt, err = driver.Exec(tx, env.index+1)
+	assert.Nil(t, err)
+	accountA = *accA.LoadExecAccount(string(Nodes[0]), address.ExecAddress("trade"))
+	assert.Equal(t, total-1000, accountA.Balance)
+	assert.Equal(t, int64(600), accountA.Frozen)
+	accountB = *accB.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, total-8, accountB.Balance)
+	assert.Equal(t, int64(0), accountB.Frozen)
+	tokenB := accA.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, int64(400), tokenB.Balance)
+
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	_, err = driver.ExecLocal(tx, receiptData, env.index+1)
+	assert.Nil(t, err)
+	sellIndex := dapp.HeightIndexStr(env.blockHeight, int64(env.index))
+	buyIndex := dapp.HeightIndexStr(env.blockHeight, int64(env.index+1))
+	order, err := driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: sellIndex}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), order.(*pty.ReplyTradeOrder).TradedBoardlot)
+	order, err = driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: buyIndex}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.TradeOrderStatusBoughtOut), order.(*pty.ReplyTradeOrder).Status)
+
+	// 回滚后卖单成交数量恢复, 新买单删除
+	_, err = driver.ExecDelLocal(tx, receiptData, env.index+1)
+	assert.Nil(t, err)
+	order, err = driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: sellIndex}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), order.(*pty.ReplyTradeOrder).TradedBoardlot)
+	_, err = driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: buyIndex}))
+	assert.NotNil(t, err)
+	_, err = driver.ExecLocal(tx, receiptData, env.index+1)
+	assert.Nil(t, err)
+
+	// 新卖单与价格不低于卖价的买单成交, 剩余部分继续挂单
+	buy.PricePerBoardlot = 2
+	buy.AutoMatch = false
+	tx, _ = pty.CreateRawTradeBuyLimitTx(chain33TestCfg, buy)
+	tx, _ = signTx(tx, PrivKeyB)
+	receipt, err = driver.Exec(tx, env.index+2)
+	assert.Nil(t, err)
+	_, err = driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index+2)
+	assert.Nil(t, err)
+
+	sell.PricePerBoardlot = 1
+	sell.TotalBoardlot = 5
+	sell.AutoMatch = true
+	tx, _ = pty.CreateRawTradeSellTx(chain33TestCfg, sell)
+	tx, _ = signTx(tx, PrivKeyA)
+	receipt, err = driver.Exec(tx, env.index+3)
+	assert.Nil(t, err)
+	_, err = driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index+3)
+	assert.Nil(t, err)
+	accountA = *accA.LoadExecAccount(string(Nodes[0]), address.ExecAddress("trade"))
+	assert.Equal(t, total-1500, accountA.Balance)
+	assert.Equal(t, int64(700), accountA.Frozen)
+	accountB = *accB.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, total-16, accountB.Balance)
+	assert.Equal(t, int64(0), accountB.Frozen)
+	order, err = driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: dapp.HeightIndexStr(env.blockHeight, int64(env.index+3))}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), order.(*pty.ReplyTradeOrder).TradedBoardlot)
+	assert.Equal(t, int32(pty.TradeOrderStatusOnSale), order.(*pty.ReplyTradeOrder).Status)
+
+	// 凑不满双方每手数量的最小公倍数的订单不撮合, 遍历的订单数达到上限后停止, 后面价格交叉的卖单也不再成交
+	lowSell := &pty.TradeSellTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: 500,
+		MinBoardlot:       1,
+		PricePerBoardlot:  1,
+		TotalBoardlot:     1,
+		AssetExec:         AssetExecPara,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+	}
+	for i := 0; i < pty.MaxMatchScanOrders; i++ {
+		tx, _ = pty.CreateRawTradeSellTx(chain33TestCfg, lowSell)
+		tx, _ = signTx(tx, PrivKeyA)
+		receipt, err = driver.Exec(tx, env.index+4+i)
+		assert.Nil(t, err)
+		_, err = driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index+4+i)
+		assert.Nil(t, err)
+	}
+	buy.TotalBoardlot = 1
+	buy.PricePerBoardlot = 3
+	buy.AutoMatch = true
+	tx, _ = pty.CreateRawTradeBuyLimitTx(chain33TestCfg, buy)
+	tx, _ = signTx(tx, PrivKeyB)
+	receipt, err = driver.Exec(tx, env.index+4+pty.MaxMatchScanOrders)
+	assert.Nil(t, err)
+	for _, log := range receipt.Logs {
+		assert.NotEqual(t, int32(pty.TyLogTradeMatch), log.Ty)
+	}
+	accountB = *accB.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, int64(3), accountB.Frozen)
+	ldb.Close()
+}
+
+func TestTradeAutoMatchBoardlot(t *testing.T) {
+	total := int64(100000)
+	accountA := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[0]),
+	}
+	accountB := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[1]),
+	}
+
+	env := execEnv{
+		1539918074,
+		chain33TestCfg.GetDappFork("trade", pty.ForkTradeMatchX),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	_, ldb, kvdb := util.CreateTestDB()
+	defer ldb.Close()
+	accB := account.NewCoinsAccount(chain33TestCfg)
+	accB.SetDB(kvdb)
+	accB.SaveExecAccount(address.ExecAddress("trade"), &accountB)
+
+	accA, _ := account.NewAccountDB(chain33TestCfg, AssetExecPara, Symbol, kvdb)
+	accA.SaveExecAccount(address.ExecAddress("trade"), &accountA)
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chain33TestCfg, nil)
+	driver := newTrade()
+	driver.SetAPI(api)
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetStateDB(kvdb)
+	driver.SetLocalDB(kvdb)
+
+	sell := &pty.TradeSellTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: 200,
+		MinBoardlot:       1,
+		PricePerBoardlot:  4,
+		TotalBoardlot:     10,
+		AssetExec:         AssetExecPara,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+	}
+	tx, _ := pty.CreateRawTradeSellTx(chain33TestCfg, sell)
+	tx, _ = signTx(tx, PrivKeyA)
+	receipt, err := driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	_, err = driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+	assert.Nil(t, err)
+
+	// 每手200和每手300的订单按600个token成交: 卖单3手, 买单2手
+	buy := &pty.TradeBuyLimitTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: 300,
+		MinBoardlot:       1,
+		PricePerBoardlot:  9,
+		TotalBoardlot:     3,
+		AssetExec:         AssetExecPara,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+		AutoMatch:         true,
+	}
+	tx, _ = pty.CreateRawTradeBuyLimitTx(chain33TestCfg, buy)
+	tx, _ = signTx(tx, PrivKeyB)
+	receipt, err = driver.Exec(tx, env.index+1)
+	assert.Nil(t, err)
+	accountA = *accA.LoadExecAccount(string(Nodes[0]), address.ExecAddress("trade"))
+	assert.Equal(t, total-2000, accountA.Balance)
+	assert.Equal(t, int64(1400), accountA.Frozen)
+	tokenB := accA.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, int64(600), tokenB.Balance)
+	accountB = *accB.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, total-21, accountB.Balance)
+	assert.Equal(t, int64(9), accountB.Frozen)
+	coinsA := accB.LoadExecAccount(string(Nodes[0]), address.ExecAddress("trade"))
+	assert.Equal(t, int64(12), coinsA.Balance)
+
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	_, err = driver.ExecLocal(tx, receiptData, env.index+1)
+	assert.Nil(t, err)
+	sellIndex := dapp.HeightIndexStr(env.blockHeight, int64(env.index))
+	buyIndex := dapp.HeightIndexStr(env.blockHeight, int64(env.index+1))
+	order, err := driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: sellIndex}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), order.(*pty.ReplyTradeOrder).TradedBoardlot)
+	order, err = driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: buyIndex}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), order.(*pty.ReplyTradeOrder).TradedBoardlot)
+	assert.Equal(t, int32(pty.TradeOrderStatusOnBuy), order.(*pty.ReplyTradeOrder).Status)
+
+	// 剩余的1手300和每手100的新卖单成交: 卖单3手, 按买单价格成交
+	sell.AmountPerBoardlot = 100
+	sell.PricePerBoardlot = 2
+	sell.TotalBoardlot = 3
+	sell.AutoMatch = true
+	tx, _ = pty.CreateRawTradeSellTx(chain33TestCfg, sell)
+	tx, _ = signTx(tx, PrivKeyA)
+	receipt, err = driver.Exec(tx, env.index+2)
+	assert.Nil(t, err)
+	receiptData = &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	_, err = driver.ExecLocal(tx, receiptData, env.index+2)
+	assert.Nil(t, err)
+	tokenB = accA.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, int64(900), tokenB.Balance)
+	accountB = *accB.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, total-21, accountB.Balance)
+	assert.Equal(t, int64(0), accountB.Frozen)
+	coinsA = accB.LoadExecAccount(string(Nodes[0]), address.ExecAddress("trade"))
+	assert.Equal(t, int64(21), coinsA.Balance)
+	order, err = driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: buyIndex}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.TradeOrderStatusBoughtOut), order.(*pty.ReplyTradeOrder).Status)
+	order, err = driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: dapp.HeightIndexStr(env.blockHeight, int64(env.index+2))}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.TradeOrderStatusSoldOut), order.(*pty.ReplyTradeOrder).Status)
+
+	// 回滚时买单按买单自己的成交手数回退
+	_, err = driver.ExecDelLocal(tx, receiptData, env.index+2)
+	assert.Nil(t, err)
+	order, err = driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: buyIndex}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), order.(*pty.ReplyTradeOrder).TradedBoardlot)
+	assert.Equal(t, int32(pty.TradeOrderStatusOnBuy), order.(*pty.ReplyTradeOrder).Status)
+}
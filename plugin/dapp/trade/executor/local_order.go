@@ -193,9 +193,10 @@ func (t *trade) genSellLimit(tx *types.Transaction, sell *pty.ReceiptSellBase,
 		BlockTime:         t.GetBlockTime(),
 		IsSellOrder:       true,
 		AssetExec:         sellorder.AssetExec,
-		IsFinished:        false,
+		IsFinished:        sellorder.Status == pty.TradeOrderStatusSoldOut,
 		PriceExec:         sellorder.PriceExec,
 		PriceSymbol:       sellorder.PriceSymbol,
+		ExpireHeight:      sellorder.ExpireHeight,
 	}
 	return order
 }
@@ -300,6 +301,11 @@ func (t *trade) genSellMarket(tx *types.Transaction, sell *pty.ReceiptSellBase,
 }
 
 func (t *trade) genBuyLimit(tx *types.Transaction, buy *pty.ReceiptBuyBase, txIndex string) *pty.LocalOrder {
+	// 自动撮合的买单在创建时就可能已全部成交
+	status := int32(pty.TradeOrderStatusOnBuy)
+	if buy.Status == pty.SellOrderStatus[pty.TradeOrderStatusBoughtOut] {
+		status = pty.TradeOrderStatusBoughtOut
+	}
 	order := &pty.LocalOrder{
 		AssetSymbol:       buy.TokenSymbol,
 		TxIndex:           txIndex,
@@ -310,7 +316,7 @@ func (t *trade) genBuyLimit(tx *types.Transaction, buy *pty.ReceiptBuyBase, txIn
 		TotalBoardlot:     buy.TotalBoardlot,
 		TradedBoardlot:    buy.BoughtBoardlot,
 		BuyID:             buy.BuyID,
-		Status:            pty.TradeOrderStatusOnBuy,
+		Status:            status,
 		SellID:            "",
 		TxHash:            []string{common.ToHex(tx.Hash())},
 		Height:            buy.Height,
@@ -318,9 +324,10 @@ func (t *trade) genBuyLimit(tx *types.Transaction, buy *pty.ReceiptBuyBase, txIn
 		BlockTime:         t.GetBlockTime(),
 		IsSellOrder:       false,
 		AssetExec:         buy.AssetExec,
-		IsFinished:        false,
+		IsFinished:        status == pty.TradeOrderStatusBoughtOut,
 		PriceExec:         buy.PriceExec,
 		PriceSymbol:       buy.PriceSymbol,
+		ExpireHeight:      buy.ExpireHeight,
 	}
 	return order
 }
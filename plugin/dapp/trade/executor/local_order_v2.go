@@ -67,6 +67,8 @@ var optV2 = &table.Option{
 		"asset_isSell_status_price",
 		// 文档1.2 文档1.5 按 用户状态来 addr-status buy or sell
 		"owner_isSell_status",
+		// 用户未完成且设置了到期高度的订单, 按到期高度排序
+		"owner_expire",
 	},
 }
 
@@ -130,6 +132,8 @@ func (r *OrderV2Row) Get(key string) ([]byte, error) {
 		return []byte(fmt.Sprintf("%s_%d", r.Owner, r.isFinished())), nil
 	case "asset_isSell_status_price":
 		return []byte(fmt.Sprintf("%s_%d_%s_%s", r.asset(), r.isSell(), r.status(), r.price())), nil
+	case "owner_expire":
+		return []byte(fmt.Sprintf("%s_%s", r.Owner, r.expire())), nil
 	default:
 		return nil, types.ErrNotFound
 	}
@@ -163,9 +167,17 @@ func (r *OrderV2Row) price() string {
 	return fmt.Sprintf("%018d", p)
 }
 
+// 未完成且设置了到期高度的订单返回 1_到期高度, 其他返回 0
+func (r *OrderV2Row) expire() string {
+	if r.IsFinished || r.ExpireHeight == 0 {
+		return "0"
+	}
+	return fmt.Sprintf("1_%018d", r.ExpireHeight)
+}
+
 // status: 设计为可以同时查询几种的并集 , 存储为前缀， 需要提前设计需要合并的， 用前缀表示
-//    进行中，  撤销，  部分成交 ， 全部成交，  完成状态统一前缀. 数字和原来不一样
-//      01     10     11          12        19 -> 1*
+//    进行中，  撤销，  部分成交 ， 全部成交，  到期，  完成状态统一前缀. 数字和原来不一样
+//      01     10     11          12        13      19 -> 1*
 func (r *OrderV2Row) status() string {
 	if r.Status == pty.TradeOrderStatusOnBuy || r.Status == pty.TradeOrderStatusOnSale {
 		return "01" // 试图用1 可以匹配所有完成的
@@ -175,6 +187,8 @@ func (r *OrderV2Row) status() string {
 		return "10"
 	} else if r.Status == pty.TradeOrderStatusSellHalfRevoked || r.Status == pty.TradeOrderStatusBuyHalfRevoked {
 		return "11"
+	} else if r.Status == pty.TradeOrderStatusExpired || r.Status == pty.TradeOrderStatusBuyExpired {
+		return "13"
 	} else if r.Status == pty.TradeOrderStatusGroupComplete {
 		return "1" // 1* match complete
 	}
@@ -5,6 +5,9 @@
 package executor
 
 import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
 )
@@ -30,6 +33,11 @@ func (t *trade) Query_GetOneOrder(req *pty.ReqAddrAssets) (types.Message, error)
 	return t.GetOneOrder(req)
 }
 
+// 地址未完成且在window个区块内到期的订单, 包括已到期但还未被keeper释放的
+func (t *trade) Query_GetOnesExpiringOrders(req *pty.ReqAddrExpiringOrders) (types.Message, error) {
+	return t.GetOnesExpiringOrders(req)
+}
+
 // query reply utils
 
 const (
@@ -89,6 +97,37 @@ func (t *trade) GetOnesOrderWithStatus(req *pty.ReqAddrAssets) (types.Message, e
 	return t.toTradeOrders(rows)
 }
 
+// GetOnesExpiringOrders by address-expireHeight
+func (t *trade) GetOnesExpiringOrders(req *pty.ReqAddrExpiringOrders) (types.Message, error) {
+	if req.Addr == "" || req.Window < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	var primary []byte
+	if len(req.FromKey) > 0 {
+		primary = []byte(req.FromKey)
+	}
+	query := NewOrderTableV2(t.GetLocalDB()).GetQuery(t.GetLocalDB())
+	prefix := []byte(fmt.Sprintf("%s_1_", req.Addr))
+	rows, err := query.ListIndex("owner_expire", prefix, primary, req.Count, dbm.ListASC)
+	if err != nil {
+		tradelog.Error("GetOnesExpiringOrders", "err", err)
+		return nil, err
+	}
+
+	// 索引按到期高度升序, 遇到超出范围的订单后面的都不需要了
+	height := t.GetHeight() + req.Window
+	for i, row := range rows {
+		if o, ok := row.Data.(*pty.LocalOrder); ok && o.ExpireHeight > height {
+			rows = rows[:i]
+			break
+		}
+	}
+	if len(rows) == 0 {
+		return nil, types.ErrNotFound
+	}
+	return t.toTradeOrders(rows)
+}
+
 func fmtReply(cfg *types.Chain33Config, order *pty.LocalOrder) *pty.ReplyTradeOrder {
 	priceExec := order.PriceExec
 	priceSymbol := order.PriceSymbol
@@ -116,6 +155,7 @@ func fmtReply(cfg *types.Chain33Config, order *pty.LocalOrder) *pty.ReplyTradeOr
 		AssetExec:         order.AssetExec,
 		PriceExec:         priceExec,
 		PriceSymbol:       priceSymbol,
+		ExpireHeight:      order.ExpireHeight,
 	}
 }
 
@@ -63,6 +63,15 @@ func (t *trade) GetDriverName() string {
 	return driverName
 }
 
+// ExecutorOrder 自动撮合在执行时需要读取localdb中的订单索引
+func (t *trade) ExecutorOrder() int64 {
+	cfg := t.GetAPI().GetConfig()
+	if cfg.IsDappFork(t.GetHeight(), pty.TradeX, pty.ForkTradeMatchX) {
+		return drivers.ExecLocalSameTime
+	}
+	return t.DriverBase.ExecutorOrder()
+}
+
 func (t *trade) getSellOrderFromDb(sellID []byte) *pty.SellOrder {
 	value, err := t.GetStateDB().Get(sellID)
 	if err != nil {
@@ -73,11 +82,20 @@ func (t *trade) getSellOrderFromDb(sellID []byte) *pty.SellOrder {
 	return &sellorder
 }
 
+// 挂单交易生成的日志, 自动撮合时新订单在创建时就可能已部分成交
+func isNewSellLog(base *pty.ReceiptSellBase, ty int32) bool {
+	return ty == pty.TyLogTradeSellLimit && base.SellID == calcTokenSellID(base.TxHash)
+}
+
+func isNewBuyLimitLog(base *pty.ReceiptBuyBase, ty int32) bool {
+	return ty == pty.TyLogTradeBuyLimit && base.BuyID == calcTokenBuyID(base.TxHash)
+}
+
 // sell limit
 func (t *trade) saveSell(base *pty.ReceiptSellBase, ty int32, tx *types.Transaction, txIndex string, ldb *table.Table) {
 	sellorder := t.getSellOrderFromDb([]byte(base.SellID))
 
-	if ty == pty.TyLogTradeSellLimit && sellorder.SoldBoardlot == 0 {
+	if ty == pty.TyLogTradeSellLimit && sellorder.SoldBoardlot == 0 || isNewSellLog(base, ty) {
 		newOrder := t.genSellLimit(tx, base, sellorder, txIndex)
 		tradelog.Info("Table", "sell-add", newOrder)
 		ldb.Add(newOrder)
@@ -88,7 +106,7 @@ func (t *trade) saveSell(base *pty.ReceiptSellBase, ty int32, tx *types.Transact
 
 func (t *trade) deleteSell(base *pty.ReceiptSellBase, ty int32, tx *types.Transaction, txIndex string, ldb *table.Table, tradedBoardlot int64) {
 	sellorder := t.getSellOrderFromDb([]byte(base.SellID))
-	if ty == pty.TyLogTradeSellLimit && sellorder.SoldBoardlot == 0 {
+	if ty == pty.TyLogTradeSellLimit && sellorder.SoldBoardlot == 0 || isNewSellLog(base, ty) {
 		ldb.Del([]byte(txIndex))
 	} else {
 		t.rollBackSellLimit(tx, base, sellorder, txIndex, ldb, tradedBoardlot)
@@ -119,7 +137,7 @@ func (t *trade) getBuyOrderFromDb(buyID []byte) *pty.BuyLimitOrder {
 func (t *trade) saveBuyLimit(buy *pty.ReceiptBuyBase, ty int32, tx *types.Transaction, txIndex string, ldb *table.Table) {
 	buyOrder := t.getBuyOrderFromDb([]byte(buy.BuyID))
 	tradelog.Debug("Table", "buy-add", buyOrder)
-	if buyOrder.Status == pty.TradeOrderStatusOnBuy && buy.BoughtBoardlot == 0 {
+	if buyOrder.Status == pty.TradeOrderStatusOnBuy && buy.BoughtBoardlot == 0 || isNewBuyLimitLog(buy, ty) {
 		order := t.genBuyLimit(tx, buy, txIndex)
 		tradelog.Info("Table", "buy-add", order)
 		ldb.Add(order)
@@ -130,7 +148,7 @@ func (t *trade) saveBuyLimit(buy *pty.ReceiptBuyBase, ty int32, tx *types.Transa
 
 func (t *trade) deleteBuyLimit(buy *pty.ReceiptBuyBase, ty int32, tx *types.Transaction, txIndex string, ldb *table.Table, traded int64) {
 	buyOrder := t.getBuyOrderFromDb([]byte(buy.BuyID))
-	if ty == pty.TyLogTradeBuyLimit && buy.BoughtBoardlot == 0 {
+	if ty == pty.TyLogTradeBuyLimit && buy.BoughtBoardlot == 0 || isNewBuyLimitLog(buy, ty) {
 		ldb.Del([]byte(txIndex))
 	} else {
 		t.rollbackBuyLimit(tx, buy, buyOrder, txIndex, ldb, traded)
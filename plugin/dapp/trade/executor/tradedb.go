@@ -61,6 +61,7 @@ func (selldb *sellDB) getSellLogs(tradeType int32, txhash string) *types.Receipt
 		AssetExec:         selldb.AssetExec,
 		PriceExec:         selldb.GetPriceExec(),
 		PriceSymbol:       selldb.GetPriceSymbol(),
+		ExpireHeight:      selldb.ExpireHeight,
 	}
 	if pty.TyLogTradeSellLimit == tradeType {
 		receiptTrade := &pty.ReceiptTradeSellLimit{Base: base}
@@ -69,6 +70,9 @@ func (selldb *sellDB) getSellLogs(tradeType int32, txhash string) *types.Receipt
 	} else if pty.TyLogTradeSellRevoke == tradeType {
 		receiptTrade := &pty.ReceiptTradeSellRevoke{Base: base}
 		log.Log = types.Encode(receiptTrade)
+	} else if pty.TyLogTradeSellExpire == tradeType {
+		receiptTrade := &pty.ReceiptTradeSellExpire{Base: base}
+		log.Log = types.Encode(receiptTrade)
 	}
 
 	return log
@@ -166,6 +170,7 @@ func (buydb *buyDB) getBuyLogs(tradeType int32, txhash string) *types.ReceiptLog
 		AssetExec:         buydb.AssetExec,
 		PriceExec:         buydb.PriceExec,
 		PriceSymbol:       buydb.PriceSymbol,
+		ExpireHeight:      buydb.ExpireHeight,
 	}
 	if pty.TyLogTradeBuyLimit == tradeType {
 		receiptTrade := &pty.ReceiptTradeBuyLimit{Base: base}
@@ -174,6 +179,9 @@ func (buydb *buyDB) getBuyLogs(tradeType int32, txhash string) *types.ReceiptLog
 	} else if pty.TyLogTradeBuyRevoke == tradeType {
 		receiptTrade := &pty.ReceiptTradeBuyRevoke{Base: base}
 		log.Log = types.Encode(receiptTrade)
+	} else if pty.TyLogTradeBuyExpire == tradeType {
+		receiptTrade := &pty.ReceiptTradeBuyExpire{Base: base}
+		log.Log = types.Encode(receiptTrade)
 	}
 
 	return log
@@ -230,6 +238,7 @@ type tradeAction struct {
 	blocktime int64
 	height    int64
 	execaddr  string
+	localdb   dbm.KVDB
 	api       client.QueueProtocolAPI
 }
 
@@ -237,7 +246,7 @@ func newTradeAction(t *trade, tx *types.Transaction) *tradeAction {
 	hash := hex.EncodeToString(tx.Hash())
 	fromaddr := tx.From()
 	return &tradeAction{t.GetStateDB(), hash, fromaddr,
-		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer)), t.GetAPI()}
+		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer)), t.GetLocalDB(), t.GetAPI()}
 }
 
 func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, error) {
@@ -255,6 +264,10 @@ func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, er
 	if !notSameAsset(cfg, action.height, sell.AssetExec, sell.TokenSymbol, sell.PriceExec, sell.PriceExec) {
 		return nil, pty.ErrAssetAndPriceSame
 	}
+	expireHeight, err := action.checkExpireHeight(sell.ExpireHeight)
+	if err != nil {
+		return nil, err
+	}
 
 	accDB, err := createAccountDB(cfg, action.height, action.db, sell.AssetExec, sell.TokenSymbol)
 	if err != nil {
@@ -287,13 +300,22 @@ func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, er
 		AssetExec:         sell.AssetExec,
 		PriceExec:         sell.GetPriceExec(),
 		PriceSymbol:       sell.GetPriceSymbol(),
+		ExpireHeight:      expireHeight,
 	}
 
 	tokendb := newSellDB(sellOrder)
-	sellOrderKV := tokendb.save(action.db)
 	logs = append(logs, receipt.Logs...)
-	logs = append(logs, tokendb.getSellLogs(pty.TyLogTradeSellLimit, action.txhash))
 	kv = append(kv, receipt.KV...)
+	if sell.AutoMatch && tokendb.Status == pty.TradeOrderStatusOnSale && cfg.IsDappFork(action.height, pty.TradeX, pty.ForkTradeMatchX) {
+		matchReceipt, err := action.matchSell(&tokendb.SellOrder)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, matchReceipt.Logs...)
+		kv = append(kv, matchReceipt.KV...)
+	}
+	sellOrderKV := tokendb.save(action.db)
+	logs = append(logs, tokendb.getSellLogs(pty.TyLogTradeSellLimit, action.txhash))
 	kv = append(kv, sellOrderKV...)
 
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
@@ -323,7 +345,7 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 		return nil, pty.ErrTSellOrderNotEnough
 	} else if sellOrder.Status == pty.TradeOrderStatusRevoked {
 		return nil, pty.ErrTSellOrderRevoked
-	} else if sellOrder.Status == pty.TradeOrderStatusExpired {
+	} else if sellOrder.Status == pty.TradeOrderStatusExpired || isOrderExpired(sellOrder.ExpireHeight, action.height) {
 		return nil, pty.ErrTSellOrderExpired
 	} else if sellOrder.Status == pty.TradeOrderStatusOnSale && buyOrder.BoardlotCnt < sellOrder.MinBoardlot {
 		return nil, pty.ErrTCntLessThanMinBoardlot
@@ -471,6 +493,10 @@ func (action *tradeAction) tradeBuyLimit(buy *pty.TradeForBuyLimit) (*types.Rece
 	if !notSameAsset(cfg, action.height, buy.AssetExec, buy.TokenSymbol, buy.PriceExec, buy.PriceExec) {
 		return nil, pty.ErrAssetAndPriceSame
 	}
+	expireHeight, err := action.checkExpireHeight(buy.ExpireHeight)
+	if err != nil {
+		return nil, err
+	}
 
 	priceAcc, err := createPriceDB(cfg, action.height, action.db, buy.PriceExec, buy.PriceSymbol)
 	if err != nil {
@@ -500,13 +526,22 @@ func (action *tradeAction) tradeBuyLimit(buy *pty.TradeForBuyLimit) (*types.Rece
 		AssetExec:         buy.AssetExec,
 		PriceExec:         buy.PriceExec,
 		PriceSymbol:       buy.PriceSymbol,
+		ExpireHeight:      expireHeight,
 	}
 
 	tokendb := newBuyDB(buyOrder)
-	buyOrderKV := tokendb.save(action.db)
 	logs = append(logs, receipt.Logs...)
-	logs = append(logs, tokendb.getBuyLogs(pty.TyLogTradeBuyLimit, action.txhash))
 	kv = append(kv, receipt.KV...)
+	if buy.AutoMatch && cfg.IsDappFork(action.height, pty.TradeX, pty.ForkTradeMatchX) {
+		matchReceipt, err := action.matchBuyLimit(&tokendb.BuyLimitOrder)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, matchReceipt.Logs...)
+		kv = append(kv, matchReceipt.KV...)
+	}
+	buyOrderKV := tokendb.save(action.db)
+	logs = append(logs, tokendb.getBuyLogs(pty.TyLogTradeBuyLimit, action.txhash))
 	kv = append(kv, buyOrderKV...)
 
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
@@ -533,6 +568,8 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 		return nil, pty.ErrTBuyOrderSoldout
 	} else if buyOrder.Status == pty.TradeOrderStatusRevoked {
 		return nil, pty.ErrTBuyOrderRevoked
+	} else if buyOrder.Status == pty.TradeOrderStatusBuyExpired || isOrderExpired(buyOrder.ExpireHeight, action.height) {
+		return nil, pty.ErrTBuyOrderExpired
 	} else if buyOrder.Status == pty.TradeOrderStatusOnBuy && buyOrder.TotalBoardlot-buyOrder.BoughtBoardlot < sellOrder.BoardlotCnt {
 		return nil, pty.ErrTBuyOrderNotEnough
 	} else if buyOrder.Status == pty.TradeOrderStatusOnBuy && sellOrder.BoardlotCnt < buyOrder.MinBoardlot {
@@ -611,6 +648,8 @@ func (action *tradeAction) tradeRevokeBuyLimit(revoke *pty.TradeForRevokeBuy) (*
 		return nil, pty.ErrTBuyOrderSoldout
 	} else if buyOrder.Status == pty.TradeOrderStatusBuyRevoked {
 		return nil, pty.ErrTBuyOrderRevoked
+	} else if buyOrder.Status == pty.TradeOrderStatusBuyExpired {
+		return nil, pty.ErrTBuyOrderExpired
 	}
 
 	if action.fromaddr != buyOrder.Address {
@@ -642,3 +681,380 @@ func (action *tradeAction) tradeRevokeBuyLimit(revoke *pty.TradeForRevokeBuy) (*
 	kv = append(kv, sellOrderKV...)
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
+
+//到期高度在分叉后才生效，必须晚于挂单所在的高度
+func (action *tradeAction) checkExpireHeight(expireHeight int64) (int64, error) {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, pty.TradeX, pty.ForkTradeExpireX) {
+		return 0, nil
+	}
+	if expireHeight < 0 || (expireHeight > 0 && expireHeight <= action.height) {
+		return 0, pty.ErrTOrderExpireHeight
+	}
+	return expireHeight, nil
+}
+
+func isOrderExpired(expireHeight, height int64) bool {
+	return expireHeight > 0 && height >= expireHeight
+}
+
+// 到期的订单不能再成交, 但冻结的资产不会在区块执行时自动释放, 只能通过这个keeper交易释放
+// 订单一般由挂单者自己撤销, 这里允许任何地址作为keeper批量释放, 方便由第三方定期清理
+func (action *tradeAction) tradeExpire(expire *pty.TradeForExpire) (*types.Receipt, error) {
+	count := len(expire.SellIDs) + len(expire.BuyIDs)
+	if count == 0 || count > pty.MaxExpireOrders {
+		return nil, types.ErrInvalidParam
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	for _, id := range expire.SellIDs {
+		receipt, err := action.expireSell(calcTokenSellID(id))
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+	for _, id := range expire.BuyIDs {
+		receipt, err := action.expireBuyLimit(calcTokenBuyID(id))
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (action *tradeAction) expireSell(sellID string) (*types.Receipt, error) {
+	sellOrder, err := getSellOrderFromID([]byte(sellID), action.db)
+	if err != nil {
+		return nil, pty.ErrTSellOrderNotExist
+	}
+
+	if sellOrder.Status == pty.TradeOrderStatusSoldOut {
+		return nil, pty.ErrTSellOrderSoldout
+	} else if sellOrder.Status == pty.TradeOrderStatusRevoked {
+		return nil, pty.ErrTSellOrderRevoked
+	} else if sellOrder.Status == pty.TradeOrderStatusExpired {
+		return nil, pty.ErrTSellOrderExpired
+	} else if !isOrderExpired(sellOrder.ExpireHeight, action.height) {
+		return nil, pty.ErrTOrderNotExpired
+	}
+
+	cfg := action.api.GetConfig()
+	accDB, err := createAccountDB(cfg, action.height, action.db, sellOrder.AssetExec, sellOrder.TokenSymbol)
+	if err != nil {
+		return nil, err
+	}
+	tradeRest := (sellOrder.TotalBoardlot - sellOrder.SoldBoardlot) * sellOrder.AmountPerBoardlot
+	receiptFromExecAcc, err := accDB.ExecActive(sellOrder.Address, action.execaddr, tradeRest)
+	if err != nil {
+		tradelog.Error("account.ExecActive token ", "addrFrom", sellOrder.Address, "execaddr", action.execaddr, "amount", tradeRest)
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	//newSellDB会把带起始时间的卖单重置为未开始，到期状态需要在之后设置
+	tokendb := newSellDB(*sellOrder)
+	tokendb.Status = pty.TradeOrderStatusExpired
+	sellOrderKV := tokendb.save(action.db)
+
+	logs = append(logs, receiptFromExecAcc.Logs...)
+	logs = append(logs, tokendb.getSellLogs(pty.TyLogTradeSellExpire, action.txhash))
+	kv = append(kv, receiptFromExecAcc.KV...)
+	kv = append(kv, sellOrderKV...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (action *tradeAction) expireBuyLimit(buyID string) (*types.Receipt, error) {
+	buyOrder, err := getBuyOrderFromID([]byte(buyID), action.db)
+	if err != nil {
+		return nil, pty.ErrTBuyOrderNotExist
+	}
+
+	if buyOrder.Status == pty.TradeOrderStatusBoughtOut {
+		return nil, pty.ErrTBuyOrderSoldout
+	} else if buyOrder.Status == pty.TradeOrderStatusBuyRevoked {
+		return nil, pty.ErrTBuyOrderRevoked
+	} else if buyOrder.Status == pty.TradeOrderStatusBuyExpired {
+		return nil, pty.ErrTBuyOrderExpired
+	} else if !isOrderExpired(buyOrder.ExpireHeight, action.height) {
+		return nil, pty.ErrTOrderNotExpired
+	}
+
+	cfg := action.api.GetConfig()
+	priceAcc, err := createPriceDB(cfg, action.height, action.db, buyOrder.PriceExec, buyOrder.PriceSymbol)
+	if err != nil {
+		return nil, err
+	}
+	tradeRest := (buyOrder.TotalBoardlot - buyOrder.BoughtBoardlot) * buyOrder.PricePerBoardlot
+	receiptFromExecAcc, err := priceAcc.ExecActive(buyOrder.Address, action.execaddr, tradeRest)
+	if err != nil {
+		tradelog.Error("account.ExecActive bty ", "addrFrom", buyOrder.Address, "execaddr", action.execaddr, "amount", tradeRest)
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	buyOrder.Status = pty.TradeOrderStatusBuyExpired
+	tokendb := newBuyDB(*buyOrder)
+	buyOrderKV := tokendb.save(action.db)
+
+	logs = append(logs, receiptFromExecAcc.Logs...)
+	logs = append(logs, tokendb.getBuyLogs(pty.TyLogTradeBuyExpire, action.txhash))
+	kv = append(kv, receiptFromExecAcc.KV...)
+	kv = append(kv, buyOrderKV...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func getMatchLog(sellOrder *pty.SellOrder, buyOrder *pty.BuyLimitOrder, sellCnt, buyCnt, price int64, txhash string) *types.ReceiptLog {
+	match := &pty.ReceiptTradeMatch{
+		SellID:            sellOrder.SellID,
+		BuyID:             buyOrder.BuyID,
+		Seller:            sellOrder.Address,
+		Buyer:             buyOrder.Address,
+		BoardlotCnt:       sellCnt,
+		AmountPerBoardlot: sellOrder.AmountPerBoardlot,
+		PricePerBoardlot:  price,
+		TxHash:            txhash,
+		BuyBoardlotCnt:    buyCnt,
+	}
+	return &types.ReceiptLog{Ty: pty.TyLogTradeMatch, Log: types.Encode(match)}
+}
+
+// 按价格优先的顺序遍历本地索引中对手方进行中的订单, fn 返回 false 时停止
+// 本地索引只用来确定撮合顺序, 订单的状态和剩余数量以状态数据库为准
+// 跳过的订单不计入成交数, 所以同时限制遍历的订单数, 避免大量不能成交的订单拖慢交易执行
+func (action *tradeAction) walkMatchOrders(cond *pty.LocalOrder, direction int32, fn func(order *pty.LocalOrder) (bool, error)) error {
+	scanned := 0
+	for {
+		rows, err := listV2(action.localdb, "asset_isSell_status_price", cond, pty.MaxMatchOrders, direction)
+		if err == types.ErrNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if scanned >= pty.MaxMatchScanOrders {
+				return nil
+			}
+			scanned++
+			order := row.Data.(*pty.LocalOrder)
+			goon, err := fn(order)
+			if err != nil || !goon {
+				return err
+			}
+		}
+		if len(rows) < pty.MaxMatchOrders {
+			return nil
+		}
+		cond.TxIndex = rows[len(rows)-1].Data.(*pty.LocalOrder).TxIndex
+	}
+}
+
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// 计算撮合时双方成交的手数, 成交的token数量为双方每手数量的最小公倍数的整数倍
+// 一方的每手数量是另一方的整数倍时, 即按较大的一手成交
+func matchBoardlot(sellAmount, sellRest, buyAmount, buyRest int64) (sellCnt int64, buyCnt int64) {
+	g := gcd(sellAmount, buyAmount)
+	sellPerUnit, buyPerUnit := buyAmount/g, sellAmount/g
+	units := sellRest / sellPerUnit
+	if n := buyRest / buyPerUnit; n < units {
+		units = n
+	}
+	return units * sellPerUnit, units * buyPerUnit
+}
+
+// 新卖单自动撮合: 按价格从高到低成交价格不低于卖价的买单, 成交价为买单的挂单价
+// 每手数量不同时按双方每手数量的最小公倍数成交, 凑不满一份或按手计价后低于卖价的订单跳过
+// 卖出的token和买单的定价资产都已冻结, 直接在冻结资产之间划转
+func (action *tradeAction) matchSell(sellOrder *pty.SellOrder) (*types.Receipt, error) {
+	cfg := action.api.GetConfig()
+	accDB, err := createAccountDB(cfg, action.height, action.db, sellOrder.AssetExec, sellOrder.TokenSymbol)
+	if err != nil {
+		return nil, err
+	}
+	priceAcc, err := createPriceDB(cfg, action.height, action.db, sellOrder.PriceExec, sellOrder.PriceSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	matched := 0
+	sellPrice := calcPriceOfToken(sellOrder.PricePerBoardlot, sellOrder.AmountPerBoardlot)
+	cond := &pty.LocalOrder{
+		AssetSymbol: sellOrder.TokenSymbol,
+		AssetExec:   sellOrder.AssetExec,
+		PriceExec:   sellOrder.PriceExec,
+		PriceSymbol: sellOrder.PriceSymbol,
+		IsSellOrder: false,
+		Status:      pty.TradeOrderStatusOnBuy,
+	}
+	err = action.walkMatchOrders(cond, dbm.ListDESC, func(order *pty.LocalOrder) (bool, error) {
+		if order.AmountPerBoardlot == 0 || calcPriceOfToken(order.PricePerBoardlot, order.AmountPerBoardlot) < sellPrice {
+			return false, nil
+		}
+		if order.Owner == sellOrder.Address {
+			return true, nil
+		}
+		buyOrder, err := getBuyOrderFromID([]byte(order.BuyID), action.db)
+		if err != nil || buyOrder.Status != pty.TradeOrderStatusOnBuy || isOrderExpired(buyOrder.ExpireHeight, action.height) {
+			return true, nil
+		}
+		sellCnt, buyCnt := matchBoardlot(sellOrder.AmountPerBoardlot, sellOrder.TotalBoardlot-sellOrder.SoldBoardlot,
+			buyOrder.AmountPerBoardlot, buyOrder.TotalBoardlot-buyOrder.BoughtBoardlot)
+		if sellCnt == 0 || sellCnt < sellOrder.MinBoardlot || buyCnt < buyOrder.MinBoardlot {
+			return true, nil
+		}
+		amount := buyCnt * buyOrder.PricePerBoardlot
+		if amount < sellCnt*sellOrder.PricePerBoardlot {
+			return true, nil
+		}
+
+		amountToken := sellCnt * sellOrder.AmountPerBoardlot
+		receiptToken, err := accDB.ExecTransferFrozen(sellOrder.Address, buyOrder.Address, action.execaddr, amountToken)
+		if err != nil {
+			tradelog.Error("matchSell ExecTransferFrozen token", "addrFrom", sellOrder.Address, "addrTo", buyOrder.Address,
+				"amount", amountToken, "err", err)
+			return false, err
+		}
+		receiptPrice, err := priceAcc.ExecTransferFrozen(buyOrder.Address, sellOrder.Address, action.execaddr, amount)
+		if err != nil {
+			tradelog.Error("matchSell ExecTransferFrozen price", "addrFrom", buyOrder.Address, "addrTo", sellOrder.Address,
+				"amount", amount, "err", err)
+			return false, err
+		}
+
+		buyOrder.BoughtBoardlot += buyCnt
+		if buyOrder.BoughtBoardlot == buyOrder.TotalBoardlot {
+			buyOrder.Status = pty.TradeOrderStatusBoughtOut
+		}
+		sellOrder.SoldBoardlot += sellCnt
+		buydb := newBuyDB(*buyOrder)
+		logs = append(logs, receiptToken.Logs...)
+		logs = append(logs, receiptPrice.Logs...)
+		logs = append(logs, buydb.getBuyLogs(pty.TyLogTradeBuyLimit, action.txhash))
+		logs = append(logs, getMatchLog(sellOrder, buyOrder, sellCnt, buyCnt, buyOrder.PricePerBoardlot, action.txhash))
+		kv = append(kv, receiptToken.KV...)
+		kv = append(kv, receiptPrice.KV...)
+		kv = append(kv, buydb.save(action.db)...)
+
+		matched++
+		return sellOrder.SoldBoardlot < sellOrder.TotalBoardlot && matched < pty.MaxMatchOrders, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sellOrder.SoldBoardlot == sellOrder.TotalBoardlot {
+		sellOrder.Status = pty.TradeOrderStatusSoldOut
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+// 新买单自动撮合: 按价格从低到高成交价格不高于买价的卖单, 成交价为卖单的挂单价
+// 每手数量不同时按双方每手数量的最小公倍数成交, 凑不满一份或按手计价后高于买价的订单跳过
+// 买单按自己的价格冻结, 以更低价格成交时差额解冻返还给买方
+func (action *tradeAction) matchBuyLimit(buyOrder *pty.BuyLimitOrder) (*types.Receipt, error) {
+	cfg := action.api.GetConfig()
+	accDB, err := createAccountDB(cfg, action.height, action.db, buyOrder.AssetExec, buyOrder.TokenSymbol)
+	if err != nil {
+		return nil, err
+	}
+	priceAcc, err := createPriceDB(cfg, action.height, action.db, buyOrder.PriceExec, buyOrder.PriceSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	matched := 0
+	buyPrice := calcPriceOfToken(buyOrder.PricePerBoardlot, buyOrder.AmountPerBoardlot)
+	cond := &pty.LocalOrder{
+		AssetSymbol: buyOrder.TokenSymbol,
+		AssetExec:   buyOrder.AssetExec,
+		PriceExec:   buyOrder.PriceExec,
+		PriceSymbol: buyOrder.PriceSymbol,
+		IsSellOrder: true,
+		Status:      pty.TradeOrderStatusOnSale,
+	}
+	err = action.walkMatchOrders(cond, dbm.ListASC, func(order *pty.LocalOrder) (bool, error) {
+		if order.AmountPerBoardlot == 0 || calcPriceOfToken(order.PricePerBoardlot, order.AmountPerBoardlot) > buyPrice {
+			return false, nil
+		}
+		if order.Owner == buyOrder.Address {
+			return true, nil
+		}
+		sellOrder, err := getSellOrderFromID([]byte(order.SellID), action.db)
+		if err != nil || sellOrder.Status != pty.TradeOrderStatusOnSale || isOrderExpired(sellOrder.ExpireHeight, action.height) {
+			return true, nil
+		}
+		sellCnt, buyCnt := matchBoardlot(sellOrder.AmountPerBoardlot, sellOrder.TotalBoardlot-sellOrder.SoldBoardlot,
+			buyOrder.AmountPerBoardlot, buyOrder.TotalBoardlot-buyOrder.BoughtBoardlot)
+		if buyCnt == 0 || buyCnt < buyOrder.MinBoardlot || sellCnt < sellOrder.MinBoardlot {
+			return true, nil
+		}
+		amount := sellCnt * sellOrder.PricePerBoardlot
+		refund := buyCnt*buyOrder.PricePerBoardlot - amount
+		if refund < 0 {
+			return true, nil
+		}
+
+		receiptPrice, err := priceAcc.ExecTransferFrozen(buyOrder.Address, sellOrder.Address, action.execaddr, amount)
+		if err != nil {
+			tradelog.Error("matchBuyLimit ExecTransferFrozen price", "addrFrom", buyOrder.Address, "addrTo", sellOrder.Address,
+				"amount", amount, "err", err)
+			return false, err
+		}
+		amountToken := sellCnt * sellOrder.AmountPerBoardlot
+		receiptToken, err := accDB.ExecTransferFrozen(sellOrder.Address, buyOrder.Address, action.execaddr, amountToken)
+		if err != nil {
+			tradelog.Error("matchBuyLimit ExecTransferFrozen token", "addrFrom", sellOrder.Address, "addrTo", buyOrder.Address,
+				"amount", amountToken, "err", err)
+			return false, err
+		}
+		logs = append(logs, receiptPrice.Logs...)
+		logs = append(logs, receiptToken.Logs...)
+		kv = append(kv, receiptPrice.KV...)
+		kv = append(kv, receiptToken.KV...)
+		if refund > 0 {
+			receiptRefund, err := priceAcc.ExecActive(buyOrder.Address, action.execaddr, refund)
+			if err != nil {
+				tradelog.Error("matchBuyLimit ExecActive", "addr", buyOrder.Address, "amount", refund, "err", err)
+				return false, err
+			}
+			logs = append(logs, receiptRefund.Logs...)
+			kv = append(kv, receiptRefund.KV...)
+		}
+
+		sellOrder.SoldBoardlot += sellCnt
+		if sellOrder.SoldBoardlot == sellOrder.TotalBoardlot {
+			sellOrder.Status = pty.TradeOrderStatusSoldOut
+		}
+		buyOrder.BoughtBoardlot += buyCnt
+		selldb := newSellDB(*sellOrder)
+		logs = append(logs, selldb.getSellLogs(pty.TyLogTradeSellLimit, action.txhash))
+		logs = append(logs, getMatchLog(sellOrder, buyOrder, sellCnt, buyCnt, sellOrder.PricePerBoardlot, action.txhash))
+		kv = append(kv, selldb.save(action.db)...)
+
+		matched++
+		return buyOrder.BoughtBoardlot < buyOrder.TotalBoardlot && matched < pty.MaxMatchOrders, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if buyOrder.BoughtBoardlot == buyOrder.TotalBoardlot {
+		buyOrder.Status = pty.TradeOrderStatusBoughtOut
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
@@ -30,6 +30,8 @@ func (jrpc *Jrpc) CreateRawTradeSellTx(in *ptypes.TradeSellTx, result *interface
 		AssetExec:         in.AssetExec,
 		PriceExec:         in.PriceExec,
 		PriceSymbol:       in.PriceSymbol,
+		ExpireHeight:      in.ExpireHeight,
+		AutoMatch:         in.AutoMatch,
 	}
 
 	reply, err := jrpc.cli.CreateRawTradeSellTx(context.Background(), param)
@@ -89,6 +91,8 @@ func (jrpc *Jrpc) CreateRawTradeBuyLimitTx(in *ptypes.TradeBuyLimitTx, result *i
 		AssetExec:         in.AssetExec,
 		PriceExec:         in.PriceExec,
 		PriceSymbol:       in.PriceSymbol,
+		ExpireHeight:      in.ExpireHeight,
+		AutoMatch:         in.AutoMatch,
 	}
 
 	reply, err := jrpc.cli.CreateRawTradeBuyLimitTx(context.Background(), param)
@@ -133,3 +137,22 @@ func (jrpc *Jrpc) CreateRawTradeRevokeBuyTx(in *ptypes.TradeRevokeBuyTx, result
 	*result = hex.EncodeToString(reply.Data)
 	return nil
 }
+
+//CreateRawTradeExpireTx : 释放已到期的卖单和买单
+//到期的订单不会在区块执行时自动释放，需要keeper发送此交易释放冻结的资产，任何地址都可以作为keeper发送
+func (jrpc *Jrpc) CreateRawTradeExpireTx(in *ptypes.TradeExpireTx, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	param := &ptypes.TradeForExpire{
+		SellIDs: in.SellIDs,
+		BuyIDs:  in.BuyIDs,
+	}
+
+	reply, err := jrpc.cli.CreateRawTradeExpireTx(context.Background(), param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(reply.Data)
+	return nil
+}
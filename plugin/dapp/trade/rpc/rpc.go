@@ -118,3 +118,21 @@ func (cc *channelClient) CreateRawTradeRevokeBuyTx(ctx context.Context, in *ptyp
 	data := types.Encode(tx)
 	return &types.UnsignTx{Data: data}, nil
 }
+
+//CreateRawTradeExpireTx : 构造释放到期订单的keeper交易，到期订单不会自动释放
+func (cc *channelClient) CreateRawTradeExpireTx(ctx context.Context, in *ptypes.TradeForExpire) (*types.UnsignTx, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	expire := &ptypes.Trade{
+		Ty:    ptypes.TradeExpire,
+		Value: &ptypes.Trade_Expire{Expire: in},
+	}
+	cfg := cc.GetConfig()
+	tx, err := types.CreateFormatTx(cfg, cfg.ExecName(ptypes.TradeX), types.Encode(expire))
+	if err != nil {
+		return nil, err
+	}
+	data := types.Encode(tx)
+	return &types.UnsignTx{Data: data}, nil
+}
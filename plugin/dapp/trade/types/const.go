@@ -12,6 +12,7 @@ const (
 	TradeSellMarket
 	TradeBuyLimit
 	TradeRevokeBuy
+	TradeExpire
 )
 
 // log
@@ -19,10 +20,14 @@ const (
 	TyLogTradeSellLimit  = 310
 	TyLogTradeBuyMarket  = 311
 	TyLogTradeSellRevoke = 312
+	TyLogTradeSellExpire = 313
 
 	TyLogTradeSellMarket = 330
 	TyLogTradeBuyLimit   = 331
 	TyLogTradeBuyRevoke  = 332
+	TyLogTradeBuyExpire  = 333
+
+	TyLogTradeMatch = 340
 )
 
 // 0->not start, 1->on sale, 2->sold out, 3->revoke, 4->expired
@@ -38,6 +43,7 @@ const (
 	TradeOrderStatusSellHalfRevoked
 	TradeOrderStatusBuyHalfRevoked
 	TradeOrderStatusGroupComplete
+	TradeOrderStatusBuyExpired
 )
 
 //SellOrderStatus : sell order status map
@@ -50,6 +56,7 @@ var SellOrderStatus = map[int32]string{
 	TradeOrderStatusOnBuy:      "OnBuy",
 	TradeOrderStatusBoughtOut:  "BoughtOut",
 	TradeOrderStatusBuyRevoked: "BuyRevoked",
+	TradeOrderStatusBuyExpired: "BuyExpired",
 }
 
 //SellOrderStatus2Int : SellOrderStatus info to value in int32
@@ -62,6 +69,7 @@ var SellOrderStatus2Int = map[string]int32{
 	"OnBuy":      TradeOrderStatusOnBuy,
 	"BoughtOut":  TradeOrderStatusBoughtOut,
 	"BuyRevoked": TradeOrderStatusBuyRevoked,
+	"BuyExpired": TradeOrderStatusBuyExpired,
 }
 
 //MapSellOrderStatusStr2Int :
@@ -81,6 +89,12 @@ var MapBuyOrderStatusStr2Int = map[string]int32{
 const (
 	//InvalidStartTime :
 	InvalidStartTime = 0
+	//MaxExpireOrders : 一笔释放交易最多处理的订单数
+	MaxExpireOrders = 100
+	//MaxMatchOrders : 自动撮合时一笔交易最多成交的对手订单数
+	MaxMatchOrders = 20
+	//MaxMatchScanOrders : 自动撮合时一笔交易最多遍历的对手订单数, 包括每手数量不同等跳过的订单
+	MaxMatchScanOrders = 100
 )
 
 const (
@@ -94,4 +108,8 @@ const (
 	ForkTradeFixAssetDBX = "ForkTradeFixAssetDB"
 	// ForkTradePriceX all asset can be price
 	ForkTradePriceX = "ForkTradePrice"
+	// ForkTradeExpireX order expire height
+	ForkTradeExpireX = "ForkTradeExpire"
+	// ForkTradeMatchX auto match crossing orders when placing an order
+	ForkTradeMatchX = "ForkTradeMatch"
 )
@@ -37,6 +37,12 @@ var (
 	ErrTBuyOrderRevoke = errors.New("ErrTradeBuyOrderRevokeNotAllowed")
 	//ErrTCntLessThanMinBoardlot :
 	ErrTCntLessThanMinBoardlot = errors.New("ErrTradeCountLessThanMinBoardlot")
+	//ErrTBuyOrderExpired :
+	ErrTBuyOrderExpired = errors.New("ErrTradeBuyOrderExpired")
+	//ErrTOrderExpireHeight :
+	ErrTOrderExpireHeight = errors.New("ErrTradeOrderExpireHeightInvalid")
+	//ErrTOrderNotExpired :
+	ErrTOrderNotExpired = errors.New("ErrTradeOrderNotExpired")
 	// ErrAssetAndPriceSame :
 	ErrAssetAndPriceSame = errors.New("ErrAssetAndPriceSame")
 )
@@ -24,6 +24,7 @@ var (
 		"BuyLimit":   TradeBuyLimit,
 		"SellMarket": TradeSellMarket,
 		"RevokeBuy":  TradeRevokeBuy,
+		"Expire":     TradeExpire,
 	}
 
 	logInfo = map[int64]*types.LogInfo{
@@ -33,6 +34,9 @@ var (
 		TyLogTradeSellMarket: {Ty: reflect.TypeOf(ReceiptSellMarket{}), Name: "LogTradeSellMarket"},
 		TyLogTradeBuyLimit:   {Ty: reflect.TypeOf(ReceiptTradeBuyLimit{}), Name: "LogTradeBuyLimit"},
 		TyLogTradeBuyRevoke:  {Ty: reflect.TypeOf(ReceiptTradeBuyRevoke{}), Name: "LogTradeBuyRevoke"},
+		TyLogTradeSellExpire: {Ty: reflect.TypeOf(ReceiptTradeSellExpire{}), Name: "LogTradeSellExpire"},
+		TyLogTradeBuyExpire:  {Ty: reflect.TypeOf(ReceiptTradeBuyExpire{}), Name: "LogTradeBuyExpire"},
+		TyLogTradeMatch:      {Ty: reflect.TypeOf(ReceiptTradeMatch{}), Name: "LogTradeMatch"},
 	}
 )
 
@@ -61,6 +65,8 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(TradeX, ForkTradeIDX, 1450000)
 	cfg.RegisterDappFork(TradeX, ForkTradeFixAssetDBX, 2500000)
 	cfg.RegisterDappFork(TradeX, ForkTradePriceX, 3150000)
+	cfg.RegisterDappFork(TradeX, ForkTradeExpireX, 4600000)
+	cfg.RegisterDappFork(TradeX, ForkTradeMatchX, 4600000)
 }
 
 func InitExecutor(cfg *types.Chain33Config) {
@@ -101,6 +107,8 @@ func (t *TradeType) ActionName(tx *types.Transaction) string {
 		return "sellmarkettoken"
 	} else if action.Ty == TradeRevokeBuy && action.GetRevokeBuy() != nil {
 		return "revokebuytoken"
+	} else if action.Ty == TradeExpire && action.GetExpire() != nil {
+		return "expireorder"
 	}
 	return "unknown"
 }
@@ -174,6 +182,14 @@ func (t *TradeType) CreateTx(action string, message json.RawMessage) (*types.Tra
 			return nil, types.ErrInvalidParam
 		}
 		return CreateRawTradeRevokeBuyTx(cfg, &param)
+	} else if action == "TradeExpire" {
+		var param TradeExpireTx
+		err := json.Unmarshal(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateRawTradeExpireTx(cfg, &param)
 	}
 
 	return nil, types.ErrNotSupport
@@ -196,6 +212,8 @@ func CreateRawTradeSellTx(cfg *types.Chain33Config, parm *TradeSellTx) (*types.T
 		AssetExec:         parm.AssetExec,
 		PriceExec:         parm.PriceExec,
 		PriceSymbol:       parm.PriceSymbol,
+		ExpireHeight:      parm.ExpireHeight,
+		AutoMatch:         parm.AutoMatch,
 	}
 	sell := &Trade{
 		Ty:    TradeSellLimit,
@@ -245,6 +263,8 @@ func CreateRawTradeBuyLimitTx(cfg *types.Chain33Config, parm *TradeBuyLimitTx) (
 		AssetExec:         parm.AssetExec,
 		PriceExec:         parm.PriceExec,
 		PriceSymbol:       parm.PriceSymbol,
+		ExpireHeight:      parm.ExpireHeight,
+		AutoMatch:         parm.AutoMatch,
 	}
 	buyLimit := &Trade{
 		Ty:    TradeBuyLimit,
@@ -279,3 +299,17 @@ func CreateRawTradeRevokeBuyTx(cfg *types.Chain33Config, parm *TradeRevokeBuyTx)
 	}
 	return types.CreateFormatTx(cfg, cfg.ExecName(TradeX), types.Encode(buy))
 }
+
+//CreateRawTradeExpireTx : 释放已到期的订单
+func CreateRawTradeExpireTx(cfg *types.Chain33Config, parm *TradeExpireTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+
+	v := &TradeForExpire{SellIDs: parm.SellIDs, BuyIDs: parm.BuyIDs}
+	expire := &Trade{
+		Ty:    TradeExpire,
+		Value: &Trade_Expire{v},
+	}
+	return types.CreateFormatTx(cfg, cfg.ExecName(TradeX), types.Encode(expire))
+}
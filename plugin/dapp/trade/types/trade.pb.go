@@ -27,7 +27,6 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // trade 交易部分
-//
 type Trade struct {
 	// Types that are valid to be assigned to Value:
 	//	*Trade_SellLimit
@@ -36,6 +35,7 @@ type Trade struct {
 	//	*Trade_BuyLimit
 	//	*Trade_SellMarket
 	//	*Trade_RevokeBuy
+	//	*Trade_Expire
 	Value                isTrade_Value `protobuf_oneof:"value"`
 	Ty                   int32         `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -96,6 +96,10 @@ type Trade_RevokeBuy struct {
 	RevokeBuy *TradeForRevokeBuy `protobuf:"bytes,7,opt,name=revokeBuy,proto3,oneof"`
 }
 
+type Trade_Expire struct {
+	Expire *TradeForExpire `protobuf:"bytes,8,opt,name=expire,proto3,oneof"`
+}
+
 func (*Trade_SellLimit) isTrade_Value() {}
 
 func (*Trade_BuyMarket) isTrade_Value() {}
@@ -108,6 +112,8 @@ func (*Trade_SellMarket) isTrade_Value() {}
 
 func (*Trade_RevokeBuy) isTrade_Value() {}
 
+func (*Trade_Expire) isTrade_Value() {}
+
 func (m *Trade) GetValue() isTrade_Value {
 	if m != nil {
 		return m.Value
@@ -157,6 +163,13 @@ func (m *Trade) GetRevokeBuy() *TradeForRevokeBuy {
 	return nil
 }
 
+func (m *Trade) GetExpire() *TradeForExpire {
+	if x, ok := m.GetValue().(*Trade_Expire); ok {
+		return x.Expire
+	}
+	return nil
+}
+
 func (m *Trade) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -173,28 +186,33 @@ func (*Trade) XXX_OneofWrappers() []interface{} {
 		(*Trade_BuyLimit)(nil),
 		(*Trade_SellMarket)(nil),
 		(*Trade_RevokeBuy)(nil),
+		(*Trade_Expire)(nil),
 	}
 }
 
 // 创建众筹交易,确定一手交易的token的数量，单价以及总共有多少手token可以进行众筹
 type TradeForSell struct {
 	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
-	// 每一手出售的token的数量
+	//每一手出售的token的数量
 	AmountPerBoardlot int64 `protobuf:"varint,2,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
 	// 起卖手数,必须达到这个门槛才允许进行交易
 	MinBoardlot int64 `protobuf:"varint,3,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
-	// 每一手token的价格
+	//每一手token的价格
 	PricePerBoardlot int64 `protobuf:"varint,4,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
 	TotalBoardlot    int64 `protobuf:"varint,5,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
-	// 此次出售的起始时间，如果非众筹则可以忽略此时间
+	//此次出售的起始时间，如果非众筹则可以忽略此时间
 	Starttime int64 `protobuf:"varint,6,opt,name=starttime,proto3" json:"starttime,omitempty"`
 	Stoptime  int64 `protobuf:"varint,7,opt,name=stoptime,proto3" json:"stoptime,omitempty"`
 	Crowdfund bool  `protobuf:"varint,8,opt,name=crowdfund,proto3" json:"crowdfund,omitempty"`
 	// 资产来源
 	AssetExec string `protobuf:"bytes,9,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	// 定价资产
-	PriceExec            string   `protobuf:"bytes,10,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,11,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	PriceExec   string `protobuf:"bytes,10,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol string `protobuf:"bytes,11,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	// 到期高度，达到该高度后不能再成交，为0表示不过期
+	ExpireHeight int64 `protobuf:"varint,12,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	// 挂单时自动与价格交叉的买单撮合成交，每手数量不同时按双方每手数量的最小公倍数成交
+	AutoMatch            bool     `protobuf:"varint,13,opt,name=autoMatch,proto3" json:"autoMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -302,6 +320,20 @@ func (m *TradeForSell) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *TradeForSell) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *TradeForSell) GetAutoMatch() bool {
+	if m != nil {
+		return m.AutoMatch
+	}
+	return false
+}
+
 // 购买者发起交易用来购买token持有者之前挂单出售的token
 // 其中的hash为token出售者发起出售交易的hash
 type TradeForBuy struct {
@@ -400,8 +432,12 @@ type TradeForBuyLimit struct {
 	TotalBoardlot     int64  `protobuf:"varint,5,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
 	AssetExec         string `protobuf:"bytes,6,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	// 定价资产
-	PriceExec            string   `protobuf:"bytes,7,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,8,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	PriceExec   string `protobuf:"bytes,7,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol string `protobuf:"bytes,8,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	// 到期高度，达到该高度后不能再成交，为0表示不过期
+	ExpireHeight int64 `protobuf:"varint,9,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	// 挂单时自动与价格交叉的卖单撮合成交，每手数量不同时按双方每手数量的最小公倍数成交
+	AutoMatch            bool     `protobuf:"varint,10,opt,name=autoMatch,proto3" json:"autoMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -488,6 +524,20 @@ func (m *TradeForBuyLimit) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *TradeForBuyLimit) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *TradeForBuyLimit) GetAutoMatch() bool {
+	if m != nil {
+		return m.AutoMatch
+	}
+	return false
+}
+
 // 现价卖单
 type TradeForSellMarket struct {
 	BuyID                string   `protobuf:"bytes,1,opt,name=buyID,proto3" json:"buyID,omitempty"`
@@ -576,6 +626,54 @@ func (m *TradeForRevokeBuy) GetBuyID() string {
 	return ""
 }
 
+// 释放已到期的卖单和买单，任何地址都可以发起
+type TradeForExpire struct {
+	SellIDs              []string `protobuf:"bytes,1,rep,name=sellIDs,proto3" json:"sellIDs,omitempty"`
+	BuyIDs               []string `protobuf:"bytes,2,rep,name=buyIDs,proto3" json:"buyIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeForExpire) Reset()         { *m = TradeForExpire{} }
+func (m *TradeForExpire) String() string { return proto.CompactTextString(m) }
+func (*TradeForExpire) ProtoMessage()    {}
+func (*TradeForExpire) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{7}
+}
+
+func (m *TradeForExpire) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeForExpire.Unmarshal(m, b)
+}
+func (m *TradeForExpire) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeForExpire.Marshal(b, m, deterministic)
+}
+func (m *TradeForExpire) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForExpire.Merge(m, src)
+}
+func (m *TradeForExpire) XXX_Size() int {
+	return xxx_messageInfo_TradeForExpire.Size(m)
+}
+func (m *TradeForExpire) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeForExpire.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeForExpire proto.InternalMessageInfo
+
+func (m *TradeForExpire) GetSellIDs() []string {
+	if m != nil {
+		return m.SellIDs
+	}
+	return nil
+}
+
+func (m *TradeForExpire) GetBuyIDs() []string {
+	if m != nil {
+		return m.BuyIDs
+	}
+	return nil
+}
+
 // 数据库部分
 type SellOrder struct {
 	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
@@ -598,6 +696,7 @@ type SellOrder struct {
 	AssetExec            string   `protobuf:"bytes,14,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	PriceExec            string   `protobuf:"bytes,15,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,16,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,17,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -607,7 +706,7 @@ func (m *SellOrder) Reset()         { *m = SellOrder{} }
 func (m *SellOrder) String() string { return proto.CompactTextString(m) }
 func (*SellOrder) ProtoMessage()    {}
 func (*SellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{7}
+	return fileDescriptor_ee944bd90e8a0312, []int{8}
 }
 
 func (m *SellOrder) XXX_Unmarshal(b []byte) error {
@@ -740,6 +839,13 @@ func (m *SellOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *SellOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
 // 限价买单数据库记录
 type BuyLimitOrder struct {
 	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
@@ -755,6 +861,7 @@ type BuyLimitOrder struct {
 	AssetExec            string   `protobuf:"bytes,11,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	PriceExec            string   `protobuf:"bytes,12,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,13,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,14,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -764,7 +871,7 @@ func (m *BuyLimitOrder) Reset()         { *m = BuyLimitOrder{} }
 func (m *BuyLimitOrder) String() string { return proto.CompactTextString(m) }
 func (*BuyLimitOrder) ProtoMessage()    {}
 func (*BuyLimitOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{8}
+	return fileDescriptor_ee944bd90e8a0312, []int{9}
 }
 
 func (m *BuyLimitOrder) XXX_Unmarshal(b []byte) error {
@@ -876,6 +983,13 @@ func (m *BuyLimitOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *BuyLimitOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
 // 执行器日志部分
 type ReceiptBuyBase struct {
 	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
@@ -893,6 +1007,7 @@ type ReceiptBuyBase struct {
 	AssetExec            string   `protobuf:"bytes,13,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	PriceExec            string   `protobuf:"bytes,14,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,15,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,16,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -902,7 +1017,7 @@ func (m *ReceiptBuyBase) Reset()         { *m = ReceiptBuyBase{} }
 func (m *ReceiptBuyBase) String() string { return proto.CompactTextString(m) }
 func (*ReceiptBuyBase) ProtoMessage()    {}
 func (*ReceiptBuyBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{9}
+	return fileDescriptor_ee944bd90e8a0312, []int{10}
 }
 
 func (m *ReceiptBuyBase) XXX_Unmarshal(b []byte) error {
@@ -1028,6 +1143,13 @@ func (m *ReceiptBuyBase) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *ReceiptBuyBase) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
 type ReceiptSellBase struct {
 	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
 	Owner       string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -1052,6 +1174,7 @@ type ReceiptSellBase struct {
 	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	PriceExec            string   `protobuf:"bytes,17,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,18,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,19,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1061,7 +1184,7 @@ func (m *ReceiptSellBase) Reset()         { *m = ReceiptSellBase{} }
 func (m *ReceiptSellBase) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSellBase) ProtoMessage()    {}
 func (*ReceiptSellBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{10}
+	return fileDescriptor_ee944bd90e8a0312, []int{11}
 }
 
 func (m *ReceiptSellBase) XXX_Unmarshal(b []byte) error {
@@ -1208,6 +1331,13 @@ func (m *ReceiptSellBase) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *ReceiptSellBase) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
 type ReceiptTradeBuyMarket struct {
 	Base                 *ReceiptBuyBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -1219,7 +1349,7 @@ func (m *ReceiptTradeBuyMarket) Reset()         { *m = ReceiptTradeBuyMarket{} }
 func (m *ReceiptTradeBuyMarket) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyMarket) ProtoMessage()    {}
 func (*ReceiptTradeBuyMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{11}
+	return fileDescriptor_ee944bd90e8a0312, []int{12}
 }
 
 func (m *ReceiptTradeBuyMarket) XXX_Unmarshal(b []byte) error {
@@ -1258,7 +1388,7 @@ func (m *ReceiptTradeBuyLimit) Reset()         { *m = ReceiptTradeBuyLimit{} }
 func (m *ReceiptTradeBuyLimit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyLimit) ProtoMessage()    {}
 func (*ReceiptTradeBuyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{12}
+	return fileDescriptor_ee944bd90e8a0312, []int{13}
 }
 
 func (m *ReceiptTradeBuyLimit) XXX_Unmarshal(b []byte) error {
@@ -1297,7 +1427,7 @@ func (m *ReceiptTradeBuyRevoke) Reset()         { *m = ReceiptTradeBuyRevoke{} }
 func (m *ReceiptTradeBuyRevoke) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyRevoke) ProtoMessage()    {}
 func (*ReceiptTradeBuyRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{13}
+	return fileDescriptor_ee944bd90e8a0312, []int{14}
 }
 
 func (m *ReceiptTradeBuyRevoke) XXX_Unmarshal(b []byte) error {
@@ -1336,7 +1466,7 @@ func (m *ReceiptTradeSellLimit) Reset()         { *m = ReceiptTradeSellLimit{} }
 func (m *ReceiptTradeSellLimit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeSellLimit) ProtoMessage()    {}
 func (*ReceiptTradeSellLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{14}
+	return fileDescriptor_ee944bd90e8a0312, []int{15}
 }
 
 func (m *ReceiptTradeSellLimit) XXX_Unmarshal(b []byte) error {
@@ -1375,7 +1505,7 @@ func (m *ReceiptSellMarket) Reset()         { *m = ReceiptSellMarket{} }
 func (m *ReceiptSellMarket) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSellMarket) ProtoMessage()    {}
 func (*ReceiptSellMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{15}
+	return fileDescriptor_ee944bd90e8a0312, []int{16}
 }
 
 func (m *ReceiptSellMarket) XXX_Unmarshal(b []byte) error {
@@ -1414,7 +1544,7 @@ func (m *ReceiptTradeSellRevoke) Reset()         { *m = ReceiptTradeSellRevoke{}
 func (m *ReceiptTradeSellRevoke) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeSellRevoke) ProtoMessage()    {}
 func (*ReceiptTradeSellRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{16}
+	return fileDescriptor_ee944bd90e8a0312, []int{17}
 }
 
 func (m *ReceiptTradeSellRevoke) XXX_Unmarshal(b []byte) error {
@@ -1442,6 +1572,191 @@ func (m *ReceiptTradeSellRevoke) GetBase() *ReceiptSellBase {
 	return nil
 }
 
+type ReceiptTradeSellExpire struct {
+	Base                 *ReceiptSellBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReceiptTradeSellExpire) Reset()         { *m = ReceiptTradeSellExpire{} }
+func (m *ReceiptTradeSellExpire) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTradeSellExpire) ProtoMessage()    {}
+func (*ReceiptTradeSellExpire) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{18}
+}
+
+func (m *ReceiptTradeSellExpire) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTradeSellExpire.Unmarshal(m, b)
+}
+func (m *ReceiptTradeSellExpire) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTradeSellExpire.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTradeSellExpire) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeSellExpire.Merge(m, src)
+}
+func (m *ReceiptTradeSellExpire) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTradeSellExpire.Size(m)
+}
+func (m *ReceiptTradeSellExpire) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTradeSellExpire.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTradeSellExpire proto.InternalMessageInfo
+
+func (m *ReceiptTradeSellExpire) GetBase() *ReceiptSellBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ReceiptTradeBuyExpire struct {
+	Base                 *ReceiptBuyBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReceiptTradeBuyExpire) Reset()         { *m = ReceiptTradeBuyExpire{} }
+func (m *ReceiptTradeBuyExpire) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTradeBuyExpire) ProtoMessage()    {}
+func (*ReceiptTradeBuyExpire) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{19}
+}
+
+func (m *ReceiptTradeBuyExpire) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTradeBuyExpire.Unmarshal(m, b)
+}
+func (m *ReceiptTradeBuyExpire) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTradeBuyExpire.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTradeBuyExpire) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeBuyExpire.Merge(m, src)
+}
+func (m *ReceiptTradeBuyExpire) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTradeBuyExpire.Size(m)
+}
+func (m *ReceiptTradeBuyExpire) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTradeBuyExpire.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTradeBuyExpire proto.InternalMessageInfo
+
+func (m *ReceiptTradeBuyExpire) GetBase() *ReceiptBuyBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+// 自动撮合的一笔成交, 按挂单方的价格成交
+type ReceiptTradeMatch struct {
+	SellID string `protobuf:"bytes,1,opt,name=sellID,proto3" json:"sellID,omitempty"`
+	BuyID  string `protobuf:"bytes,2,opt,name=buyID,proto3" json:"buyID,omitempty"`
+	Seller string `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer  string `protobuf:"bytes,4,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// 卖单成交的手数和每手数量
+	BoardlotCnt       int64 `protobuf:"varint,5,opt,name=boardlotCnt,proto3" json:"boardlotCnt,omitempty"`
+	AmountPerBoardlot int64 `protobuf:"varint,6,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	// 挂单方每手的价格
+	PricePerBoardlot int64  `protobuf:"varint,7,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	TxHash           string `protobuf:"bytes,8,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	// 买单成交的手数, 双方每手数量不同时和卖单成交的手数不同
+	BuyBoardlotCnt       int64    `protobuf:"varint,9,opt,name=buyBoardlotCnt,proto3" json:"buyBoardlotCnt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTradeMatch) Reset()         { *m = ReceiptTradeMatch{} }
+func (m *ReceiptTradeMatch) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTradeMatch) ProtoMessage()    {}
+func (*ReceiptTradeMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{20}
+}
+
+func (m *ReceiptTradeMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTradeMatch.Unmarshal(m, b)
+}
+func (m *ReceiptTradeMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTradeMatch.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTradeMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeMatch.Merge(m, src)
+}
+func (m *ReceiptTradeMatch) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTradeMatch.Size(m)
+}
+func (m *ReceiptTradeMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTradeMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTradeMatch proto.InternalMessageInfo
+
+func (m *ReceiptTradeMatch) GetSellID() string {
+	if m != nil {
+		return m.SellID
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetBuyID() string {
+	if m != nil {
+		return m.BuyID
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetBoardlotCnt() int64 {
+	if m != nil {
+		return m.BoardlotCnt
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetAmountPerBoardlot() int64 {
+	if m != nil {
+		return m.AmountPerBoardlot
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetPricePerBoardlot() int64 {
+	if m != nil {
+		return m.PricePerBoardlot
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetBuyBoardlotCnt() int64 {
+	if m != nil {
+		return m.BuyBoardlotCnt
+	}
+	return 0
+}
+
 type ReqAddrAssets struct {
 	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	Status int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1459,7 +1774,7 @@ func (m *ReqAddrAssets) Reset()         { *m = ReqAddrAssets{} }
 func (m *ReqAddrAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrAssets) ProtoMessage()    {}
 func (*ReqAddrAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{17}
+	return fileDescriptor_ee944bd90e8a0312, []int{21}
 }
 
 func (m *ReqAddrAssets) XXX_Unmarshal(b []byte) error {
@@ -1523,7 +1838,9 @@ func (m *ReqAddrAssets) GetFromKey() string {
 }
 
 // 获取Token未完成卖单的交易列表
-// 	 fromKey : 第一次传参为空，获取卖单单价最低的列表。 当要获得下一页时，
+//
+//	fromKey : 第一次传参为空，获取卖单单价最低的列表。 当要获得下一页时，
+//
 // 传当前页最后一个；当要获得上一页时， 传当前页第一个。 	 count
 // :获取交易列表的个数。 	 direction :查找方式；0，上一页；1，下一页。
 // 越靠后的也单价越贵
@@ -1542,7 +1859,7 @@ func (m *ReqTokenSellOrder) Reset()         { *m = ReqTokenSellOrder{} }
 func (m *ReqTokenSellOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenSellOrder) ProtoMessage()    {}
 func (*ReqTokenSellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{18}
+	return fileDescriptor_ee944bd90e8a0312, []int{22}
 }
 
 func (m *ReqTokenSellOrder) XXX_Unmarshal(b []byte) error {
@@ -1613,7 +1930,7 @@ func (m *ReqTokenBuyOrder) Reset()         { *m = ReqTokenBuyOrder{} }
 func (m *ReqTokenBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenBuyOrder) ProtoMessage()    {}
 func (*ReqTokenBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{19}
+	return fileDescriptor_ee944bd90e8a0312, []int{23}
 }
 
 func (m *ReqTokenBuyOrder) XXX_Unmarshal(b []byte) error {
@@ -1693,7 +2010,7 @@ func (m *ReplyBuyOrder) Reset()         { *m = ReplyBuyOrder{} }
 func (m *ReplyBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplyBuyOrder) ProtoMessage()    {}
 func (*ReplyBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{20}
+	return fileDescriptor_ee944bd90e8a0312, []int{24}
 }
 
 func (m *ReplyBuyOrder) XXX_Unmarshal(b []byte) error {
@@ -1836,7 +2153,7 @@ func (m *ReplySellOrder) Reset()         { *m = ReplySellOrder{} }
 func (m *ReplySellOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplySellOrder) ProtoMessage()    {}
 func (*ReplySellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{21}
+	return fileDescriptor_ee944bd90e8a0312, []int{25}
 }
 
 func (m *ReplySellOrder) XXX_Unmarshal(b []byte) error {
@@ -1966,7 +2283,7 @@ func (m *ReplySellOrders) Reset()         { *m = ReplySellOrders{} }
 func (m *ReplySellOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplySellOrders) ProtoMessage()    {}
 func (*ReplySellOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{22}
+	return fileDescriptor_ee944bd90e8a0312, []int{26}
 }
 
 func (m *ReplySellOrders) XXX_Unmarshal(b []byte) error {
@@ -2005,7 +2322,7 @@ func (m *ReplyBuyOrders) Reset()         { *m = ReplyBuyOrders{} }
 func (m *ReplyBuyOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplyBuyOrders) ProtoMessage()    {}
 func (*ReplyBuyOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{23}
+	return fileDescriptor_ee944bd90e8a0312, []int{27}
 }
 
 func (m *ReplyBuyOrders) XXX_Unmarshal(b []byte) error {
@@ -2052,6 +2369,7 @@ type ReplyTradeOrder struct {
 	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	PriceExec            string   `protobuf:"bytes,17,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,18,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,19,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2061,7 +2379,7 @@ func (m *ReplyTradeOrder) Reset()         { *m = ReplyTradeOrder{} }
 func (m *ReplyTradeOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplyTradeOrder) ProtoMessage()    {}
 func (*ReplyTradeOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{24}
+	return fileDescriptor_ee944bd90e8a0312, []int{28}
 }
 
 func (m *ReplyTradeOrder) XXX_Unmarshal(b []byte) error {
@@ -2208,6 +2526,13 @@ func (m *ReplyTradeOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *ReplyTradeOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
 type ReplyTradeOrders struct {
 	Orders               []*ReplyTradeOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -2219,7 +2544,7 @@ func (m *ReplyTradeOrders) Reset()         { *m = ReplyTradeOrders{} }
 func (m *ReplyTradeOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplyTradeOrders) ProtoMessage()    {}
 func (*ReplyTradeOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{25}
+	return fileDescriptor_ee944bd90e8a0312, []int{29}
 }
 
 func (m *ReplyTradeOrders) XXX_Unmarshal(b []byte) error {
@@ -2247,6 +2572,71 @@ func (m *ReplyTradeOrders) GetOrders() []*ReplyTradeOrder {
 	return nil
 }
 
+// 获取地址在指定高度范围内到期、尚未释放的订单，按到期高度升序
+// window : 当前高度之后的区块数，已到期未释放的订单总是包含在内
+type ReqAddrExpiringOrders struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Window               int64    `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	FromKey              string   `protobuf:"bytes,4,opt,name=fromKey,proto3" json:"fromKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqAddrExpiringOrders) Reset()         { *m = ReqAddrExpiringOrders{} }
+func (m *ReqAddrExpiringOrders) String() string { return proto.CompactTextString(m) }
+func (*ReqAddrExpiringOrders) ProtoMessage()    {}
+func (*ReqAddrExpiringOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{30}
+}
+
+func (m *ReqAddrExpiringOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqAddrExpiringOrders.Unmarshal(m, b)
+}
+func (m *ReqAddrExpiringOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqAddrExpiringOrders.Marshal(b, m, deterministic)
+}
+func (m *ReqAddrExpiringOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqAddrExpiringOrders.Merge(m, src)
+}
+func (m *ReqAddrExpiringOrders) XXX_Size() int {
+	return xxx_messageInfo_ReqAddrExpiringOrders.Size(m)
+}
+func (m *ReqAddrExpiringOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqAddrExpiringOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqAddrExpiringOrders proto.InternalMessageInfo
+
+func (m *ReqAddrExpiringOrders) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqAddrExpiringOrders) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *ReqAddrExpiringOrders) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqAddrExpiringOrders) GetFromKey() string {
+	if m != nil {
+		return m.FromKey
+	}
+	return ""
+}
+
 type ReqSellToken struct {
 	Sell                 *TradeForSell `protobuf:"bytes,1,opt,name=sell,proto3" json:"sell,omitempty"`
 	Owner                string        `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -2259,7 +2649,7 @@ func (m *ReqSellToken) Reset()         { *m = ReqSellToken{} }
 func (m *ReqSellToken) String() string { return proto.CompactTextString(m) }
 func (*ReqSellToken) ProtoMessage()    {}
 func (*ReqSellToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{26}
+	return fileDescriptor_ee944bd90e8a0312, []int{31}
 }
 
 func (m *ReqSellToken) XXX_Unmarshal(b []byte) error {
@@ -2306,7 +2696,7 @@ func (m *ReqRevokeSell) Reset()         { *m = ReqRevokeSell{} }
 func (m *ReqRevokeSell) String() string { return proto.CompactTextString(m) }
 func (*ReqRevokeSell) ProtoMessage()    {}
 func (*ReqRevokeSell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{27}
+	return fileDescriptor_ee944bd90e8a0312, []int{32}
 }
 
 func (m *ReqRevokeSell) XXX_Unmarshal(b []byte) error {
@@ -2353,7 +2743,7 @@ func (m *ReqBuyToken) Reset()         { *m = ReqBuyToken{} }
 func (m *ReqBuyToken) String() string { return proto.CompactTextString(m) }
 func (*ReqBuyToken) ProtoMessage()    {}
 func (*ReqBuyToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{28}
+	return fileDescriptor_ee944bd90e8a0312, []int{33}
 }
 
 func (m *ReqBuyToken) XXX_Unmarshal(b []byte) error {
@@ -2409,6 +2799,7 @@ type LocalOrder struct {
 	IsFinished           bool     `protobuf:"varint,18,opt,name=isFinished,proto3" json:"isFinished,omitempty"`
 	PriceExec            string   `protobuf:"bytes,19,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,20,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,21,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2418,7 +2809,7 @@ func (m *LocalOrder) Reset()         { *m = LocalOrder{} }
 func (m *LocalOrder) String() string { return proto.CompactTextString(m) }
 func (*LocalOrder) ProtoMessage()    {}
 func (*LocalOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{29}
+	return fileDescriptor_ee944bd90e8a0312, []int{34}
 }
 
 func (m *LocalOrder) XXX_Unmarshal(b []byte) error {
@@ -2579,6 +2970,13 @@ func (m *LocalOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *LocalOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Trade)(nil), "types.Trade")
 	proto.RegisterType((*TradeForSell)(nil), "types.TradeForSell")
@@ -2587,6 +2985,7 @@ func init() {
 	proto.RegisterType((*TradeForBuyLimit)(nil), "types.TradeForBuyLimit")
 	proto.RegisterType((*TradeForSellMarket)(nil), "types.TradeForSellMarket")
 	proto.RegisterType((*TradeForRevokeBuy)(nil), "types.TradeForRevokeBuy")
+	proto.RegisterType((*TradeForExpire)(nil), "types.TradeForExpire")
 	proto.RegisterType((*SellOrder)(nil), "types.SellOrder")
 	proto.RegisterType((*BuyLimitOrder)(nil), "types.BuyLimitOrder")
 	proto.RegisterType((*ReceiptBuyBase)(nil), "types.ReceiptBuyBase")
@@ -2597,6 +2996,9 @@ func init() {
 	proto.RegisterType((*ReceiptTradeSellLimit)(nil), "types.ReceiptTradeSellLimit")
 	proto.RegisterType((*ReceiptSellMarket)(nil), "types.ReceiptSellMarket")
 	proto.RegisterType((*ReceiptTradeSellRevoke)(nil), "types.ReceiptTradeSellRevoke")
+	proto.RegisterType((*ReceiptTradeSellExpire)(nil), "types.ReceiptTradeSellExpire")
+	proto.RegisterType((*ReceiptTradeBuyExpire)(nil), "types.ReceiptTradeBuyExpire")
+	proto.RegisterType((*ReceiptTradeMatch)(nil), "types.ReceiptTradeMatch")
 	proto.RegisterType((*ReqAddrAssets)(nil), "types.ReqAddrAssets")
 	proto.RegisterType((*ReqTokenSellOrder)(nil), "types.ReqTokenSellOrder")
 	proto.RegisterType((*ReqTokenBuyOrder)(nil), "types.ReqTokenBuyOrder")
@@ -2606,6 +3008,7 @@ func init() {
 	proto.RegisterType((*ReplyBuyOrders)(nil), "types.ReplyBuyOrders")
 	proto.RegisterType((*ReplyTradeOrder)(nil), "types.ReplyTradeOrder")
 	proto.RegisterType((*ReplyTradeOrders)(nil), "types.ReplyTradeOrders")
+	proto.RegisterType((*ReqAddrExpiringOrders)(nil), "types.ReqAddrExpiringOrders")
 	proto.RegisterType((*ReqSellToken)(nil), "types.ReqSellToken")
 	proto.RegisterType((*ReqRevokeSell)(nil), "types.ReqRevokeSell")
 	proto.RegisterType((*ReqBuyToken)(nil), "types.ReqBuyToken")
@@ -2617,93 +3020,107 @@ func init() {
 }
 
 var fileDescriptor_ee944bd90e8a0312 = []byte{
-	// 1371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xdd, 0x8a, 0xdb, 0xc6,
-	0x17, 0x5f, 0x5b, 0x96, 0xd7, 0x3a, 0xfe, 0x58, 0xef, 0xc4, 0xd9, 0xbf, 0xb2, 0xfc, 0x29, 0x46,
-	0x84, 0x36, 0x09, 0x61, 0xa1, 0x09, 0x81, 0x42, 0x4b, 0x4b, 0x9c, 0x34, 0x75, 0xda, 0x84, 0x96,
-	0x59, 0x17, 0x7a, 0x2b, 0x5b, 0x93, 0xac, 0x58, 0x59, 0xf2, 0x4a, 0xa3, 0xc4, 0x7a, 0x83, 0x42,
-	0xdf, 0xa0, 0xbd, 0xe8, 0x03, 0xf4, 0x36, 0x50, 0x42, 0xdf, 0xa7, 0x97, 0xbd, 0xea, 0x03, 0x94,
-	0x19, 0x8d, 0xa5, 0xd1, 0x97, 0x3f, 0x20, 0x85, 0x4d, 0xd2, 0x3b, 0xcf, 0x99, 0x33, 0x67, 0x8e,
-	0xce, 0xef, 0xf7, 0x9b, 0x2f, 0x43, 0x9b, 0xfa, 0xa6, 0x45, 0x4e, 0x16, 0xbe, 0x47, 0x3d, 0xa4,
-	0xd2, 0x68, 0x41, 0x82, 0xe3, 0x43, 0xea, 0x9b, 0x6e, 0x60, 0xce, 0xa8, 0xed, 0xb9, 0x71, 0x8f,
-	0xf1, 0x77, 0x1d, 0xd4, 0x09, 0xf3, 0x44, 0x77, 0x41, 0x0b, 0x88, 0xe3, 0x3c, 0xb1, 0xe7, 0x36,
-	0xd5, 0x6b, 0xc3, 0xda, 0x8d, 0xf6, 0x9d, 0x2b, 0x27, 0x7c, 0xdc, 0x09, 0x77, 0x78, 0xe4, 0xf9,
-	0xa7, 0xc4, 0x71, 0xc6, 0x7b, 0x38, 0xf5, 0x43, 0x77, 0x40, 0x9b, 0x86, 0xd1, 0x53, 0xd3, 0x3f,
-	0x27, 0x54, 0xaf, 0xf3, 0x41, 0x28, 0x37, 0x68, 0x14, 0x46, 0x6c, 0x4c, 0xe2, 0x86, 0x3e, 0x05,
-	0xf0, 0xc9, 0x0b, 0xef, 0x9c, 0xb0, 0x70, 0xba, 0xc2, 0x07, 0x5d, 0xcb, 0x0d, 0xc2, 0x89, 0xc3,
-	0x78, 0x0f, 0x4b, 0xee, 0xe8, 0x1e, 0xb4, 0xa6, 0x61, 0x14, 0x27, 0xa9, 0xf2, 0xa1, 0xff, 0x2b,
-	0xce, 0xc7, 0xbb, 0xc7, 0x7b, 0x38, 0x71, 0x65, 0x73, 0xb2, 0xa4, 0x45, 0xa2, 0xcd, 0xd2, 0x39,
-	0x4f, 0x13, 0x07, 0x36, 0x67, 0xea, 0x8e, 0x3e, 0x01, 0x2d, 0xce, 0x60, 0x14, 0x46, 0xfa, 0x3e,
-	0x1f, 0xab, 0x97, 0xe6, 0x2b, 0x3e, 0x35, 0x71, 0x46, 0x3d, 0xa8, 0xd3, 0x48, 0x6f, 0x0c, 0x6b,
-	0x37, 0x54, 0x5c, 0xa7, 0xd1, 0x68, 0x1f, 0xd4, 0x17, 0xa6, 0x13, 0x12, 0xe3, 0x47, 0x05, 0x3a,
-	0xf2, 0xbc, 0x68, 0x08, 0x6d, 0xea, 0x9d, 0x13, 0xf7, 0x34, 0x9a, 0x4f, 0x3d, 0x87, 0xd7, 0x5f,
-	0xc3, 0xb2, 0x09, 0xdd, 0x86, 0x43, 0x73, 0xee, 0x85, 0x2e, 0xfd, 0x8e, 0xf8, 0x23, 0xcf, 0xf4,
-	0x2d, 0xc7, 0x8b, 0x4b, 0xae, 0xe0, 0x62, 0x07, 0x8b, 0x37, 0xb7, 0xdd, 0xc4, 0x4f, 0xe1, 0x7e,
-	0xb2, 0x09, 0xdd, 0x82, 0xfe, 0xc2, 0xb7, 0x67, 0x44, 0x0e, 0xd7, 0xe0, 0x6e, 0x05, 0x3b, 0xba,
-	0x0e, 0x5d, 0xea, 0x51, 0xd3, 0x49, 0x1c, 0x55, 0xee, 0x98, 0x35, 0xa2, 0xff, 0x83, 0x16, 0x50,
-	0xd3, 0xa7, 0xd4, 0x9e, 0x13, 0x5e, 0x63, 0x05, 0xa7, 0x06, 0x74, 0x0c, 0xad, 0x80, 0x7a, 0x0b,
-	0xde, 0xb9, 0xcf, 0x3b, 0x93, 0x36, 0x1b, 0x39, 0xf3, 0xbd, 0x97, 0xd6, 0xb3, 0xd0, 0xb5, 0xf4,
-	0xd6, 0xb0, 0x76, 0xa3, 0x85, 0x53, 0x03, 0xeb, 0x35, 0x83, 0x80, 0xd0, 0x2f, 0x97, 0x64, 0xa6,
-	0x6b, 0xbc, 0x32, 0xa9, 0x81, 0xf5, 0xf2, 0x7c, 0x79, 0x2f, 0xc4, 0xbd, 0x89, 0x81, 0xd5, 0x81,
-	0x37, 0x44, 0x5d, 0xdb, 0x71, 0x5d, 0x25, 0x93, 0xf1, 0x15, 0xb4, 0x25, 0xea, 0xa0, 0x23, 0x68,
-	0x32, 0xe8, 0x1f, 0x3f, 0x14, 0x18, 0x88, 0x16, 0x0b, 0x34, 0x15, 0x1f, 0xfa, 0xc0, 0x5d, 0x15,
-	0x5e, 0x36, 0x19, 0xb7, 0x01, 0x15, 0xe9, 0x5b, 0x15, 0xcf, 0x78, 0x55, 0x87, 0x7e, 0x9e, 0xb2,
-	0xef, 0x0a, 0x0b, 0x52, 0xb4, 0x9a, 0x6b, 0xd1, 0xda, 0xdf, 0x80, 0x56, 0xab, 0x88, 0xd6, 0x93,
-	0xb4, 0xc8, 0xa9, 0x5e, 0xd1, 0x00, 0xd4, 0x69, 0x18, 0x25, 0x35, 0x8e, 0x1b, 0x5b, 0x40, 0x76,
-	0x13, 0x0e, 0x0b, 0x0a, 0x2e, 0x0f, 0x66, 0xfc, 0xd4, 0x00, 0x8d, 0xcd, 0xf8, 0xad, 0x6f, 0x11,
-	0x7f, 0x0b, 0xa0, 0x74, 0xd8, 0x37, 0x2d, 0xcb, 0x27, 0x41, 0xc0, 0x27, 0xd6, 0xf0, 0xaa, 0x59,
-	0x0e, 0xa1, 0xb2, 0x25, 0x84, 0x8d, 0xed, 0x20, 0x54, 0xb7, 0x85, 0xb0, 0x59, 0x06, 0xa1, 0x01,
-	0x9d, 0xc0, 0x73, 0xac, 0xc4, 0x29, 0x96, 0x6b, 0xc6, 0x96, 0x15, 0x7b, 0x6b, 0x9d, 0xd8, 0xb5,
-	0x75, 0x62, 0x87, 0xbc, 0xd8, 0x53, 0xbd, 0xb4, 0x33, 0xfa, 0x63, 0x76, 0x6a, 0xd2, 0x30, 0xd0,
-	0x3b, 0x7c, 0x39, 0x15, 0x2d, 0x66, 0x3f, 0x23, 0xf6, 0xf3, 0x33, 0xaa, 0x77, 0xf9, 0x3c, 0xa2,
-	0x95, 0xa5, 0x61, 0x6f, 0x2d, 0x0d, 0x0f, 0x36, 0xd0, 0xb0, 0x5f, 0xa4, 0xe1, 0x6b, 0x05, 0xba,
-	0x2b, 0xd5, 0xbe, 0x0f, 0x8c, 0xf8, 0x10, 0x7a, 0x53, 0x2f, 0x7c, 0x7e, 0x46, 0x73, 0x9c, 0xc8,
-	0x59, 0x53, 0xed, 0xb4, 0x64, 0x21, 0xa6, 0xd8, 0x69, 0x15, 0xd8, 0x41, 0x35, 0x76, 0xed, 0xb5,
-	0xd8, 0x75, 0x36, 0x60, 0xd7, 0x2d, 0x62, 0xf7, 0xa7, 0x02, 0x3d, 0x4c, 0x66, 0xc4, 0x5e, 0xd0,
-	0x51, 0x18, 0x8d, 0xcc, 0x80, 0x6c, 0x01, 0xde, 0x00, 0x54, 0xef, 0xa5, 0x4b, 0x7c, 0x01, 0x5d,
-	0xdc, 0xa8, 0x06, 0x4e, 0x7b, 0xb3, 0xc0, 0x69, 0x97, 0x02, 0x38, 0x4d, 0x06, 0x4e, 0x88, 0x14,
-	0xf2, 0x22, 0xa5, 0xcb, 0xb1, 0x19, 0x9c, 0xad, 0xc4, 0x1b, 0xb7, 0x24, 0xa0, 0x3b, 0xd5, 0x40,
-	0x77, 0xd7, 0x02, 0xdd, 0xdb, 0x00, 0xf4, 0x41, 0x11, 0xe8, 0x3f, 0x1a, 0x70, 0x20, 0x80, 0x66,
-	0x2b, 0xf7, 0x3b, 0x8e, 0xf4, 0xe5, 0x5f, 0xb4, 0x53, 0xfe, 0x24, 0x6c, 0xeb, 0xe6, 0xd8, 0x26,
-	0xd8, 0xd3, 0xab, 0x60, 0xcf, 0x41, 0x35, 0x7b, 0xfa, 0x6b, 0xd9, 0x73, 0xb8, 0x81, 0x3d, 0xa8,
-	0xc8, 0x9e, 0x11, 0x5c, 0x15, 0xe4, 0xe1, 0x47, 0x84, 0x51, 0x72, 0x7f, 0xb9, 0x09, 0x8d, 0xa9,
-	0x19, 0x10, 0x71, 0x47, 0xba, 0x2a, 0x6e, 0x02, 0xd9, 0x15, 0x05, 0x73, 0x17, 0xe3, 0x3e, 0x0c,
-	0x72, 0x31, 0xe2, 0x73, 0xde, 0x0e, 0x21, 0x8a, 0x69, 0xc4, 0x27, 0x95, 0x5d, 0x62, 0x3c, 0xc8,
-	0xc6, 0x38, 0x4d, 0xae, 0x6f, 0xb7, 0x32, 0x31, 0x8e, 0xb2, 0x31, 0x56, 0x9a, 0x11, 0x41, 0xbe,
-	0x80, 0x43, 0xa9, 0x43, 0xd4, 0x62, 0x97, 0x00, 0x0f, 0xe1, 0x28, 0x9f, 0x85, 0xf8, 0x94, 0x5d,
-	0xa2, 0xfc, 0x5a, 0x83, 0x2e, 0x26, 0x17, 0xf7, 0x2d, 0xcb, 0xbf, 0xcf, 0xb0, 0x0e, 0x10, 0x82,
-	0x06, 0xdb, 0x48, 0x85, 0x96, 0xf9, 0x6f, 0x89, 0x78, 0xf5, 0xcc, 0x8e, 0x33, 0x00, 0x95, 0x6b,
-	0x5d, 0x57, 0x86, 0x0a, 0x23, 0x1e, 0x6f, 0x30, 0xaa, 0x58, 0xb6, 0x4f, 0xf8, 0xbd, 0x58, 0xdc,
-	0xd6, 0x52, 0x03, 0x1b, 0x33, 0x63, 0x02, 0xe7, 0xfa, 0x54, 0x71, 0xdc, 0x60, 0xbb, 0xf9, 0x33,
-	0xdf, 0x9b, 0x7f, 0x43, 0x22, 0x71, 0xc8, 0x5d, 0x35, 0x8d, 0x5f, 0x6a, 0xac, 0x52, 0x17, 0x13,
-	0xbe, 0xa6, 0xec, 0x76, 0x62, 0x5c, 0x45, 0xac, 0x67, 0x22, 0xa6, 0x19, 0x28, 0x72, 0x06, 0xeb,
-	0xb3, 0x4e, 0x2b, 0xa0, 0xca, 0x15, 0x30, 0x7e, 0xae, 0x41, 0x7f, 0x95, 0xdd, 0x28, 0x8c, 0x2e,
-	0x57, 0x72, 0xbf, 0x2b, 0x0c, 0xdc, 0x85, 0x13, 0xed, 0x90, 0xd9, 0x8e, 0xeb, 0xf5, 0xbb, 0x7f,
-	0xa4, 0x7a, 0x23, 0x3b, 0x73, 0x1f, 0x94, 0x73, 0x12, 0x89, 0xf5, 0x99, 0xfd, 0x5c, 0x7f, 0xa0,
-	0x36, 0x5e, 0xf1, 0x43, 0xd5, 0xc2, 0x89, 0x76, 0x61, 0xfc, 0xdb, 0x0a, 0xdd, 0x36, 0x5b, 0xed,
-	0xdb, 0x01, 0xdb, 0x98, 0x9d, 0x90, 0x64, 0xd4, 0x02, 0x74, 0x2f, 0x7e, 0x2a, 0x8b, 0x5b, 0x7a,
-	0x6d, 0xa8, 0x64, 0x76, 0x17, 0xd9, 0x17, 0x4b, 0x8e, 0xc6, 0x43, 0x81, 0xff, 0x4a, 0xb9, 0x81,
-	0x78, 0x1b, 0xcc, 0xc4, 0x19, 0xc8, 0x71, 0x56, 0x9e, 0x38, 0x75, 0x33, 0x5e, 0x37, 0x44, 0x42,
-	0x7c, 0x8b, 0x78, 0x0f, 0x96, 0x00, 0xfe, 0x4a, 0x9b, 0x67, 0x52, 0xce, 0x7a, 0x99, 0xb8, 0x34,
-	0x75, 0xbc, 0xd9, 0xf9, 0x84, 0x9d, 0x10, 0x7b, 0xf1, 0xf1, 0x31, 0x31, 0xb0, 0x0a, 0xda, 0x41,
-	0x42, 0x0e, 0x7e, 0x56, 0x6b, 0x61, 0xd9, 0xf4, 0xaf, 0x1f, 0xd8, 0xfa, 0x39, 0xea, 0x04, 0xe8,
-	0x04, 0x9a, 0x9e, 0x4c, 0xc0, 0x23, 0x99, 0x80, 0xa9, 0x23, 0x16, 0x5e, 0xc6, 0x53, 0xe8, 0x60,
-	0x72, 0xc1, 0x32, 0xe6, 0x1b, 0x24, 0xfa, 0x08, 0x1a, 0xac, 0x7a, 0x6b, 0xde, 0xc3, 0x31, 0x77,
-	0x28, 0xa7, 0xa0, 0xf1, 0x03, 0x3f, 0xab, 0x48, 0xaf, 0x81, 0x1f, 0x43, 0x33, 0x7e, 0x1d, 0x16,
-	0x11, 0xab, 0xdf, 0xbd, 0xb1, 0x70, 0xac, 0x88, 0xfc, 0x18, 0xda, 0x98, 0x5c, 0x8c, 0xc2, 0x28,
-	0xce, 0xf3, 0x3a, 0x28, 0xd3, 0x30, 0x12, 0x41, 0x4b, 0x5e, 0xe0, 0x31, 0xeb, 0x16, 0x3c, 0x4a,
-	0x43, 0xf1, 0x86, 0xf1, 0x57, 0x03, 0xe0, 0x89, 0x37, 0x33, 0xd3, 0x65, 0x9b, 0x63, 0x92, 0x95,
-	0x9b, 0x64, 0xfa, 0x4f, 0x6e, 0x3b, 0xcb, 0x4d, 0xb9, 0x84, 0x72, 0xd3, 0x61, 0x9f, 0x2e, 0x1f,
-	0xbb, 0x16, 0x59, 0x0a, 0xb1, 0xad, 0x9a, 0xe8, 0x03, 0x00, 0x3b, 0x78, 0x64, 0xbb, 0x76, 0x70,
-	0x46, 0x2c, 0xae, 0xb4, 0x16, 0x96, 0x2c, 0x59, 0xa1, 0x5e, 0xd9, 0x20, 0xd4, 0x41, 0x41, 0xa8,
-	0x77, 0x7e, 0x53, 0x40, 0xe5, 0x25, 0x47, 0x9f, 0xc3, 0xe0, 0x81, 0x4f, 0x4c, 0x4a, 0xb0, 0xf9,
-	0x32, 0xb9, 0x14, 0x4c, 0x96, 0xa8, 0x4c, 0x68, 0xc7, 0x07, 0xc2, 0xf8, 0xbd, 0x1b, 0xd8, 0xcf,
-	0xdd, 0xc9, 0xd2, 0xd8, 0x43, 0x9f, 0xc1, 0x95, 0xec, 0x78, 0x26, 0x88, 0x25, 0x2a, 0x11, 0x40,
-	0xd9, 0xe8, 0x47, 0x70, 0x94, 0x1d, 0x1d, 0xab, 0x6f, 0xb2, 0x44, 0xd5, 0xb2, 0x2c, 0x8f, 0xa3,
-	0x17, 0xb2, 0xe0, 0xf7, 0xab, 0xc9, 0x12, 0x55, 0xfd, 0x3b, 0x55, 0x16, 0xe7, 0x6b, 0x38, 0x2e,
-	0x56, 0x23, 0xbe, 0x68, 0x95, 0xe4, 0x94, 0x76, 0x96, 0xc5, 0x1a, 0xc3, 0xb5, 0xb2, 0x6f, 0x8b,
-	0xeb, 0x53, 0xf9, 0xef, 0x55, 0x49, 0xa4, 0x69, 0x93, 0xff, 0x51, 0x78, 0xf7, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xe0, 0xb9, 0xad, 0xb0, 0x51, 0x1c, 0x00, 0x00,
+	// 1598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xb6, 0x44, 0x51, 0x12, 0x47, 0x96, 0x2c, 0xaf, 0x7f, 0xca, 0x18, 0x45, 0x61, 0x10, 0x41,
+	0x9b, 0x04, 0x81, 0x8b, 0x26, 0x08, 0x50, 0xa0, 0x45, 0x8b, 0x28, 0x3f, 0x55, 0xda, 0x04, 0x2d,
+	0xd6, 0x2a, 0xd0, 0x2b, 0x25, 0x6d, 0x6c, 0xc2, 0x34, 0x29, 0x93, 0xcb, 0x58, 0x7c, 0x85, 0x1e,
+	0x7b, 0x6c, 0x0f, 0x3d, 0xf5, 0xd4, 0x43, 0x2f, 0x05, 0xfa, 0x0e, 0x7d, 0x87, 0xa0, 0x8f, 0xd0,
+	0x5b, 0xcf, 0xc5, 0xfe, 0x88, 0x5c, 0x52, 0xa4, 0x7e, 0xd0, 0x1c, 0x9c, 0xa4, 0x37, 0xcf, 0xec,
+	0xec, 0x70, 0x34, 0xdf, 0x37, 0xb3, 0xb3, 0xa4, 0xa1, 0x45, 0x03, 0x7b, 0x4c, 0x8e, 0x26, 0x81,
+	0x4f, 0x7d, 0xa4, 0xd3, 0x78, 0x42, 0xc2, 0x83, 0x6d, 0x1a, 0xd8, 0x5e, 0x68, 0x8f, 0xa8, 0xe3,
+	0x7b, 0x62, 0xc5, 0xfa, 0x45, 0x03, 0x7d, 0xc0, 0x2c, 0xd1, 0x5d, 0x30, 0x42, 0xe2, 0xba, 0x4f,
+	0x9d, 0x73, 0x87, 0x9a, 0x95, 0xc3, 0xca, 0x8d, 0xd6, 0x9d, 0x9d, 0x23, 0xbe, 0xef, 0x88, 0x1b,
+	0x3c, 0xf6, 0x83, 0x63, 0xe2, 0xba, 0xfd, 0x0d, 0x9c, 0xda, 0xa1, 0x3b, 0x60, 0x0c, 0xa3, 0xf8,
+	0x99, 0x1d, 0x9c, 0x11, 0x6a, 0x56, 0xf9, 0x26, 0x94, 0xdb, 0xd4, 0x8b, 0x62, 0xb6, 0x27, 0x31,
+	0x43, 0x9f, 0x00, 0x04, 0xe4, 0x85, 0x7f, 0x46, 0x98, 0x3b, 0x53, 0xe3, 0x9b, 0xae, 0xe5, 0x36,
+	0xe1, 0xc4, 0xa0, 0xbf, 0x81, 0x15, 0x73, 0x74, 0x0f, 0x9a, 0xc3, 0x28, 0x16, 0x41, 0xea, 0x7c,
+	0xeb, 0x3b, 0xf3, 0xcf, 0xe3, 0xcb, 0xfd, 0x0d, 0x9c, 0x98, 0xb2, 0x67, 0xb2, 0xa0, 0x65, 0xa0,
+	0xf5, 0xc2, 0x67, 0x1e, 0x27, 0x06, 0xec, 0x99, 0xa9, 0x39, 0xfa, 0x18, 0x0c, 0x11, 0x41, 0x2f,
+	0x8a, 0xcd, 0x06, 0xdf, 0x6b, 0x16, 0xc6, 0x2b, 0x7f, 0x6a, 0x62, 0x8c, 0x3e, 0x84, 0x3a, 0x99,
+	0x4e, 0x9c, 0x80, 0x98, 0x4d, 0xbe, 0x6d, 0x2f, 0xb7, 0xed, 0x11, 0x5f, 0xec, 0x6f, 0x60, 0x69,
+	0x86, 0x3a, 0x50, 0xa5, 0xb1, 0x59, 0x3b, 0xac, 0xdc, 0xd0, 0x71, 0x95, 0xc6, 0xbd, 0x06, 0xe8,
+	0x2f, 0x6c, 0x37, 0x22, 0xd6, 0x9f, 0x1a, 0x6c, 0xaa, 0x81, 0xa2, 0x43, 0x68, 0x51, 0xff, 0x8c,
+	0x78, 0xc7, 0xf1, 0xf9, 0xd0, 0x77, 0x39, 0x60, 0x06, 0x56, 0x55, 0xe8, 0x36, 0x6c, 0xdb, 0xe7,
+	0x7e, 0xe4, 0xd1, 0x6f, 0x48, 0xd0, 0xf3, 0xed, 0x60, 0xec, 0xfa, 0x02, 0x23, 0x0d, 0xcf, 0x2f,
+	0x30, 0x7f, 0xe7, 0x8e, 0x97, 0xd8, 0x69, 0xdc, 0x4e, 0x55, 0xa1, 0x5b, 0xd0, 0x9d, 0x04, 0xce,
+	0x88, 0xa8, 0xee, 0x6a, 0xdc, 0x6c, 0x4e, 0x8f, 0xae, 0x43, 0x9b, 0xfa, 0xd4, 0x76, 0x13, 0x43,
+	0x9d, 0x1b, 0x66, 0x95, 0xe8, 0x5d, 0x30, 0x42, 0x6a, 0x07, 0x94, 0x3a, 0xe7, 0x84, 0x83, 0xa2,
+	0xe1, 0x54, 0x81, 0x0e, 0xa0, 0x19, 0x52, 0x7f, 0xc2, 0x17, 0x1b, 0x7c, 0x31, 0x91, 0xd9, 0xce,
+	0x51, 0xe0, 0x5f, 0x8e, 0x9f, 0x47, 0xde, 0x98, 0xe7, 0xb6, 0x89, 0x53, 0x05, 0x5b, 0xb5, 0xc3,
+	0x90, 0xd0, 0x47, 0x53, 0x32, 0x32, 0x0d, 0x9e, 0x99, 0x54, 0xc1, 0x56, 0x79, 0xbc, 0x7c, 0x15,
+	0xc4, 0x6a, 0xa2, 0x60, 0x79, 0xe0, 0x82, 0xcc, 0x6b, 0x4b, 0xe4, 0x55, 0x51, 0x21, 0x0b, 0x36,
+	0x05, 0x5a, 0x7d, 0xe2, 0x9c, 0x9c, 0x52, 0x73, 0x93, 0xc7, 0x96, 0xd1, 0xf1, 0x08, 0x22, 0xea,
+	0x3f, 0xb3, 0xe9, 0xe8, 0xd4, 0x6c, 0x8b, 0xf8, 0x12, 0x85, 0xf5, 0x05, 0xb4, 0x14, 0xb6, 0xa2,
+	0x7d, 0xa8, 0x33, 0xb6, 0x3d, 0x79, 0x28, 0x51, 0x94, 0x12, 0x0b, 0x65, 0x28, 0x53, 0xf5, 0xc0,
+	0x9b, 0x41, 0xa7, 0xaa, 0xac, 0xdb, 0x80, 0xe6, 0x2b, 0xa6, 0xcc, 0x9f, 0xf5, 0x4f, 0x15, 0xba,
+	0xf9, 0x2a, 0x79, 0x53, 0x78, 0x94, 0xe2, 0x5d, 0x5f, 0x88, 0x77, 0x63, 0x09, 0xde, 0xcd, 0xe5,
+	0x78, 0x1b, 0xcb, 0xf0, 0x86, 0x3c, 0xde, 0x4f, 0x53, 0x98, 0xd2, 0x26, 0x83, 0x76, 0x41, 0x1f,
+	0x46, 0x71, 0x82, 0x92, 0x10, 0x56, 0x00, 0xfd, 0x26, 0x6c, 0xcf, 0xb5, 0x9d, 0x62, 0x67, 0x56,
+	0x0f, 0x3a, 0xd9, 0x56, 0x83, 0x4c, 0x68, 0x08, 0x36, 0x84, 0x66, 0xe5, 0x50, 0xbb, 0x61, 0xe0,
+	0x99, 0xc8, 0x58, 0xc3, 0x37, 0x85, 0x66, 0x95, 0x2f, 0x48, 0xc9, 0xfa, 0xad, 0x06, 0x06, 0x8b,
+	0xfa, 0xeb, 0x60, 0x4c, 0x82, 0x15, 0xe8, 0x62, 0x42, 0xc3, 0x1e, 0x8f, 0x03, 0x12, 0x86, 0x3c,
+	0x78, 0x03, 0xcf, 0xc4, 0x62, 0x22, 0x69, 0x2b, 0x12, 0xa9, 0xb6, 0x1a, 0x91, 0xf4, 0x55, 0x89,
+	0x54, 0x2f, 0x22, 0x92, 0x05, 0x9b, 0xa1, 0xef, 0x8e, 0x13, 0x23, 0xd1, 0x76, 0x32, 0xba, 0x6c,
+	0xd3, 0x6a, 0x2e, 0x6a, 0x5a, 0xc6, 0xa2, 0xa6, 0x05, 0xf9, 0xa6, 0x95, 0x56, 0x6d, 0x2b, 0xd3,
+	0x05, 0x98, 0x9e, 0xda, 0x34, 0x0a, 0x79, 0xa3, 0xd1, 0xb1, 0x94, 0x98, 0xfe, 0x54, 0x10, 0xb2,
+	0xcd, 0x9f, 0x23, 0xa5, 0x6c, 0x31, 0x74, 0x16, 0x16, 0xc3, 0xd6, 0x92, 0x62, 0xe8, 0x2e, 0x2f,
+	0x86, 0xed, 0xf9, 0x62, 0xb0, 0x5e, 0x6a, 0xd0, 0x9e, 0xf5, 0x97, 0xb7, 0x81, 0x35, 0xef, 0x43,
+	0x67, 0xe8, 0x47, 0x27, 0xa7, 0x34, 0xc7, 0x9b, 0x9c, 0x36, 0xad, 0xd1, 0xa6, 0x5a, 0xf0, 0x29,
+	0xbe, 0x46, 0x09, 0xbe, 0x50, 0x8e, 0x6f, 0x6b, 0x21, 0xbe, 0x9b, 0x4b, 0xf0, 0x6d, 0x2f, 0xc7,
+	0xb7, 0x53, 0x80, 0xef, 0xf7, 0x35, 0xe8, 0x60, 0x32, 0x22, 0xce, 0x84, 0xf6, 0xa2, 0xb8, 0x67,
+	0x87, 0x64, 0x05, 0x80, 0x77, 0x41, 0xf7, 0x2f, 0x3d, 0x12, 0x48, 0x78, 0x85, 0x50, 0x0e, 0xae,
+	0xf1, 0x6a, 0xc1, 0x35, 0xae, 0x04, 0xb8, 0x86, 0x0a, 0xae, 0x2c, 0x76, 0xc8, 0x17, 0x3b, 0x9d,
+	0xf6, 0xed, 0xf0, 0x74, 0xd6, 0x04, 0x84, 0xa4, 0x90, 0x61, 0xb3, 0x9c, 0x0c, 0xed, 0x85, 0x64,
+	0xe8, 0x2c, 0x21, 0xc3, 0xd6, 0x72, 0x32, 0x74, 0x0b, 0xc8, 0xf0, 0x57, 0x0d, 0xb6, 0x24, 0x19,
+	0xd8, 0x29, 0xf1, 0x86, 0xb3, 0xe1, 0xea, 0x1f, 0x10, 0x29, 0xc7, 0x12, 0x46, 0xb6, 0x73, 0x8c,
+	0x94, 0x0c, 0xeb, 0x94, 0x30, 0x6c, 0xab, 0x9c, 0x61, 0xdd, 0x85, 0x0c, 0xdb, 0x5e, 0xc2, 0x30,
+	0xb4, 0x9c, 0x61, 0x3b, 0x05, 0x0c, 0xeb, 0xc1, 0x9e, 0x24, 0x18, 0x9f, 0x65, 0x7a, 0xc9, 0x45,
+	0xf2, 0x26, 0xd4, 0x86, 0x76, 0x48, 0xcc, 0x4a, 0xe6, 0x6e, 0x95, 0xed, 0x4c, 0x98, 0x9b, 0x58,
+	0xf7, 0x61, 0x37, 0xe7, 0x43, 0x4c, 0xbf, 0x6b, 0xb8, 0x98, 0x0f, 0x43, 0x4c, 0x5f, 0xeb, 0xf8,
+	0x78, 0x90, 0xf5, 0x71, 0x9c, 0xdc, 0xa3, 0x6f, 0x65, 0x7c, 0xec, 0x67, 0x7d, 0xcc, 0xea, 0x4a,
+	0x3a, 0xf9, 0x1c, 0xb6, 0x95, 0x05, 0x99, 0x8b, 0x75, 0x1c, 0x3c, 0x84, 0xfd, 0x7c, 0x14, 0xf2,
+	0xa7, 0xfc, 0x47, 0x2f, 0x72, 0xc6, 0x5c, 0xc7, 0xcb, 0x7c, 0x56, 0xa5, 0x93, 0x35, 0xb2, 0xfa,
+	0x6b, 0x15, 0xb6, 0x55, 0x27, 0x7c, 0xe8, 0x2e, 0xbd, 0x55, 0x25, 0x65, 0x51, 0xcd, 0x37, 0x6a,
+	0xe2, 0xba, 0x24, 0x90, 0xfd, 0x46, 0x4a, 0xd2, 0x9a, 0x04, 0x66, 0x2d, 0xb1, 0x16, 0xf3, 0x8c,
+	0x3a, 0xa4, 0xeb, 0x73, 0x43, 0x7a, 0x71, 0x2b, 0xab, 0x97, 0x4d, 0x2d, 0x45, 0x8d, 0xaa, 0x51,
+	0x32, 0x93, 0xa4, 0x05, 0xdc, 0xcc, 0x14, 0x30, 0x3b, 0xa8, 0xa2, 0xb8, 0xa7, 0x84, 0x65, 0xc8,
+	0x83, 0x2a, 0xa3, 0xb5, 0x7e, 0xae, 0x40, 0x1b, 0x93, 0x8b, 0xfb, 0xe3, 0x71, 0x70, 0x9f, 0xd5,
+	0x71, 0x88, 0x10, 0xd4, 0xd8, 0xb0, 0x25, 0xf3, 0xc4, 0xff, 0x56, 0x9a, 0x4a, 0x35, 0x33, 0x95,
+	0xec, 0x82, 0xce, 0xfb, 0xb8, 0xa9, 0xf1, 0x4b, 0x82, 0x10, 0x58, 0x1b, 0x18, 0x3b, 0x01, 0xe1,
+	0x2f, 0x96, 0xe4, 0xdb, 0x8b, 0x54, 0xc1, 0xf6, 0x8c, 0xfc, 0x48, 0xe6, 0x49, 0xc7, 0x42, 0x60,
+	0x13, 0xdf, 0xf3, 0xc0, 0x3f, 0xff, 0x8a, 0xc4, 0xf2, 0xca, 0x36, 0x13, 0xad, 0x9f, 0x2a, 0x0c,
+	0xcf, 0x8b, 0x01, 0x3f, 0x2f, 0xd6, 0xbb, 0x79, 0xcc, 0x3c, 0x56, 0x33, 0x1e, 0xd3, 0x08, 0x34,
+	0x35, 0x82, 0xc5, 0x51, 0xa7, 0x19, 0xd0, 0xd5, 0x0c, 0x58, 0x3f, 0x56, 0xa0, 0x3b, 0x8b, 0xae,
+	0x17, 0xc5, 0x57, 0x2b, 0xb8, 0x3f, 0x34, 0x06, 0xee, 0xc4, 0x8d, 0xd7, 0x88, 0x6c, 0xcd, 0xb3,
+	0xf8, 0xcd, 0x1f, 0xbb, 0x5f, 0xc9, 0x64, 0xd6, 0x05, 0xed, 0x8c, 0xc4, 0xf2, 0xec, 0x65, 0x7f,
+	0x2e, 0xbe, 0x98, 0x59, 0xbf, 0x6b, 0x6c, 0xa8, 0x9e, 0xb8, 0xf1, 0x3a, 0x8c, 0x7f, 0x5d, 0xa1,
+	0x5b, 0x65, 0x8c, 0x7a, 0x3d, 0x60, 0xeb, 0xc3, 0x56, 0x16, 0xb5, 0x10, 0xdd, 0x13, 0xef, 0x9a,
+	0x85, 0xc4, 0xdf, 0xb2, 0xa8, 0xe7, 0x97, 0x6a, 0x8b, 0x15, 0x43, 0xeb, 0x21, 0x74, 0x32, 0x95,
+	0x1b, 0xca, 0x97, 0xeb, 0x19, 0x3f, 0xbb, 0xaa, 0x9f, 0x99, 0x25, 0x4e, 0xcd, 0xac, 0x97, 0x35,
+	0x19, 0x10, 0x3f, 0x09, 0xdf, 0x82, 0x16, 0xc0, 0x3f, 0x73, 0xe4, 0x99, 0x94, 0xd3, 0x5e, 0x25,
+	0x2e, 0x0d, 0x5d, 0x7f, 0x74, 0x36, 0x60, 0xd3, 0xbf, 0xb8, 0x5a, 0xa7, 0x0a, 0x96, 0x41, 0x27,
+	0x4c, 0xc8, 0xc1, 0xe7, 0xf0, 0x26, 0x56, 0x55, 0x57, 0x62, 0x18, 0xef, 0xe6, 0xe8, 0x15, 0xa2,
+	0x23, 0xa8, 0xfb, 0x2a, 0x49, 0xf7, 0x55, 0x92, 0xa6, 0x86, 0x58, 0x5a, 0x59, 0x21, 0xec, 0xc9,
+	0x01, 0x84, 0xcf, 0x7a, 0x8e, 0x77, 0x22, 0x1d, 0x95, 0x0c, 0x22, 0x97, 0x8e, 0x37, 0xf6, 0x2f,
+	0xe5, 0xab, 0x50, 0x29, 0x95, 0x9c, 0x9a, 0xca, 0x29, 0x5b, 0xcb, 0x0e, 0x15, 0xcf, 0x60, 0x13,
+	0x93, 0x0b, 0x96, 0x4a, 0x7e, 0x72, 0xa3, 0x0f, 0xa0, 0xc6, 0x60, 0x5d, 0xf0, 0xa5, 0x0b, 0x73,
+	0x83, 0xe2, 0xda, 0xb0, 0xbe, 0xe3, 0x43, 0x94, 0xf2, 0xd2, 0xfd, 0x23, 0xa8, 0x8b, 0xef, 0x3e,
+	0x66, 0xa5, 0xf0, 0xeb, 0x52, 0x6a, 0x8a, 0xa5, 0x61, 0x89, 0xe7, 0x27, 0xd0, 0xc2, 0xe4, 0xa2,
+	0x17, 0xc5, 0x22, 0xce, 0xeb, 0xa0, 0x0d, 0xa3, 0xd8, 0xac, 0x94, 0x7d, 0x5b, 0xc3, 0x6c, 0x39,
+	0x1d, 0x53, 0xab, 0xca, 0x98, 0x6a, 0xfd, 0xa0, 0x03, 0x3c, 0xf5, 0x47, 0x76, 0x7a, 0x9e, 0x70,
+	0xb2, 0x64, 0xfb, 0x80, 0xa2, 0xfa, 0xbf, 0x0f, 0xac, 0xdd, 0x07, 0xb4, 0x2b, 0xd8, 0x07, 0x4c,
+	0x68, 0xd0, 0xe9, 0x13, 0x6f, 0x4c, 0xa6, 0xb2, 0x0b, 0xcc, 0x44, 0xf4, 0x1e, 0x80, 0x13, 0x3e,
+	0x76, 0x3c, 0x27, 0x3c, 0x25, 0x63, 0xde, 0x02, 0x9a, 0x58, 0xd1, 0x64, 0x3b, 0xc8, 0xce, 0x92,
+	0x0e, 0xb2, 0xbb, 0xbc, 0x83, 0xec, 0xcd, 0x77, 0x90, 0x3b, 0x7f, 0x6b, 0xa0, 0x73, 0x58, 0xd0,
+	0x67, 0xb0, 0xfb, 0x20, 0x20, 0x36, 0x25, 0xd8, 0xbe, 0x4c, 0xee, 0x90, 0x83, 0x29, 0x2a, 0x2a,
+	0xc6, 0x83, 0x2d, 0xa9, 0xfc, 0xd6, 0x0b, 0x9d, 0x13, 0x6f, 0x30, 0xb5, 0x36, 0xd0, 0xa7, 0xb0,
+	0x93, 0xdd, 0xcf, 0x8a, 0x66, 0x8a, 0x0a, 0x8a, 0xa4, 0x68, 0xf7, 0x63, 0xd8, 0xcf, 0xee, 0x16,
+	0x15, 0x3a, 0x98, 0xa2, 0xf2, 0xd2, 0x2d, 0xf6, 0x63, 0xce, 0x45, 0xc1, 0x2f, 0xf5, 0x83, 0x29,
+	0x2a, 0xfb, 0x36, 0x5d, 0xe4, 0xe7, 0x4b, 0x38, 0x98, 0xcf, 0x86, 0xb8, 0xdd, 0x17, 0xc4, 0x94,
+	0x2e, 0x16, 0xf9, 0xea, 0xc3, 0xb5, 0xa2, 0xdf, 0x26, 0xf2, 0x53, 0xfa, 0xed, 0xba, 0xc8, 0x53,
+	0x2f, 0x9f, 0x25, 0x71, 0x3d, 0x1f, 0x4c, 0x51, 0xf1, 0xb7, 0xec, 0x02, 0x1f, 0xc3, 0x3a, 0xff,
+	0x57, 0x83, 0xbb, 0xff, 0x0e, 0x00, 0x3c, 0x00, 0x0b, 0x63, 0x93, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2724,6 +3141,7 @@ type TradeClient interface {
 	CreateRawTradeBuyLimitTx(ctx context.Context, in *TradeForBuyLimit, opts ...grpc.CallOption) (*types.UnsignTx, error)
 	CreateRawTradeSellMarketTx(ctx context.Context, in *TradeForSellMarket, opts ...grpc.CallOption) (*types.UnsignTx, error)
 	CreateRawTradeRevokeBuyTx(ctx context.Context, in *TradeForRevokeBuy, opts ...grpc.CallOption) (*types.UnsignTx, error)
+	CreateRawTradeExpireTx(ctx context.Context, in *TradeForExpire, opts ...grpc.CallOption) (*types.UnsignTx, error)
 }
 
 type tradeClient struct {
@@ -2788,6 +3206,15 @@ func (c *tradeClient) CreateRawTradeRevokeBuyTx(ctx context.Context, in *TradeFo
 	return out, nil
 }
 
+func (c *tradeClient) CreateRawTradeExpireTx(ctx context.Context, in *TradeForExpire, opts ...grpc.CallOption) (*types.UnsignTx, error) {
+	out := new(types.UnsignTx)
+	err := c.cc.Invoke(ctx, "/types.trade/CreateRawTradeExpireTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TradeServer is the server API for Trade service.
 type TradeServer interface {
 	CreateRawTradeSellTx(context.Context, *TradeForSell) (*types.UnsignTx, error)
@@ -2796,6 +3223,7 @@ type TradeServer interface {
 	CreateRawTradeBuyLimitTx(context.Context, *TradeForBuyLimit) (*types.UnsignTx, error)
 	CreateRawTradeSellMarketTx(context.Context, *TradeForSellMarket) (*types.UnsignTx, error)
 	CreateRawTradeRevokeBuyTx(context.Context, *TradeForRevokeBuy) (*types.UnsignTx, error)
+	CreateRawTradeExpireTx(context.Context, *TradeForExpire) (*types.UnsignTx, error)
 }
 
 // UnimplementedTradeServer can be embedded to have forward compatible implementations.
@@ -2820,6 +3248,9 @@ func (*UnimplementedTradeServer) CreateRawTradeSellMarketTx(ctx context.Context,
 func (*UnimplementedTradeServer) CreateRawTradeRevokeBuyTx(ctx context.Context, req *TradeForRevokeBuy) (*types.UnsignTx, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeRevokeBuyTx not implemented")
 }
+func (*UnimplementedTradeServer) CreateRawTradeExpireTx(ctx context.Context, req *TradeForExpire) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeExpireTx not implemented")
+}
 
 func RegisterTradeServer(s *grpc.Server, srv TradeServer) {
 	s.RegisterService(&_Trade_serviceDesc, srv)
@@ -2933,6 +3364,24 @@ func _Trade_CreateRawTradeRevokeBuyTx_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trade_CreateRawTradeExpireTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeForExpire)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServer).CreateRawTradeExpireTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.trade/CreateRawTradeExpireTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServer).CreateRawTradeExpireTx(ctx, req.(*TradeForExpire))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Trade_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.trade",
 	HandlerType: (*TradeServer)(nil),
@@ -2961,6 +3410,10 @@ var _Trade_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRawTradeRevokeBuyTx",
 			Handler:    _Trade_CreateRawTradeRevokeBuyTx_Handler,
 		},
+		{
+			MethodName: "CreateRawTradeExpireTx",
+			Handler:    _Trade_CreateRawTradeExpireTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "trade.proto",
@@ -15,6 +15,8 @@ type TradeSellTx struct {
 	AssetExec         string `json:"assetExec"`
 	PriceExec         string `json:"priceExec"`
 	PriceSymbol       string `json:"priceSymbol"`
+	ExpireHeight      int64  `json:"expireHeight"`
+	AutoMatch         bool   `json:"autoMatch"`
 }
 
 //TradeBuyTx :info for buy order to speficied order
@@ -41,6 +43,8 @@ type TradeBuyLimitTx struct {
 	AssetExec         string `json:"assetExec"`
 	PriceExec         string `json:"priceExec"`
 	PriceSymbol       string `json:"priceSymbol"`
+	ExpireHeight      int64  `json:"expireHeight"`
+	AutoMatch         bool   `json:"autoMatch"`
 }
 
 //TradeSellMarketTx :用于向指定买单出售token的信息
@@ -55,3 +59,10 @@ type TradeRevokeBuyTx struct {
 	BuyID string `json:"buyID,"`
 	Fee   int64  `json:"fee"`
 }
+
+//TradeExpireTx :释放已到期的卖单和买单
+type TradeExpireTx struct {
+	SellIDs []string `json:"sellIDs"`
+	BuyIDs  []string `json:"buyIDs"`
+	Fee     int64    `json:"fee"`
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/33cn/chain33/rpc/jsonclient"
@@ -30,6 +31,8 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(createCmd())
 	cmd.AddCommand(withdrawCmd())
 	cmd.AddCommand(terminateCmd())
+	cmd.AddCommand(transferCmd())
+	cmd.AddCommand(delegateCmd())
 	cmd.AddCommand(showCmd())
 	cmd.AddCommand(queryWithdrawCmd())
 	cmd.AddCommand(listUnfreezeCmd())
@@ -44,6 +47,9 @@ func createCmd() *cobra.Command {
 
 	cmd.AddCommand(fixAmountCmd())
 	cmd.AddCommand(leftCmd())
+	cmd.AddCommand(cliffLinearCmd())
+	cmd.AddCommand(linearCmd())
+	cmd.AddCommand(customScheduleCmd())
 	return cmd
 }
 
@@ -63,6 +69,8 @@ func createFlag(cmd *cobra.Command) *cobra.Command {
 	cmd.PersistentFlags().Int64P("start_ts", "", 0, "effect, UTC timestamp")
 	//cmd.MarkFlagRequired("start_ts")
 
+	cmd.PersistentFlags().StringP("delegate", "", "", "address allowed to withdraw on behalf of beneficiary")
+
 	return cmd
 }
 
@@ -79,6 +87,7 @@ func getCreateFlags(cmd *cobra.Command) (*pty.UnfreezeCreate, error) {
 	symbol, _ := cmd.Flags().GetString("asset_symbol")
 	total, _ := cmd.Flags().GetFloat64("total")
 	startTs, _ := cmd.Flags().GetInt64("start_ts")
+	delegate, _ := cmd.Flags().GetString("delegate")
 
 	if err := checkAmount(total); err != nil {
 		return nil, types.ErrAmount
@@ -92,6 +101,7 @@ func getCreateFlags(cmd *cobra.Command) (*pty.UnfreezeCreate, error) {
 		TotalCount:  totalInt64,
 		Beneficiary: beneficiary,
 		Means:       "",
+		Delegate:    delegate,
 	}
 	return unfreeze, nil
 }
@@ -205,6 +215,148 @@ func left(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+func sendCreate(cmd *cobra.Command, create *pty.UnfreezeCreate) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(pty.UnfreezeX),
+		ActionName: pty.Action_CreateUnfreeze,
+		Payload:    types.MustPBToJSON(create),
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func cliffLinearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cliff_linear",
+		Short: "create cliff then linear means unfreeze construct",
+		Run:   cliffLinear,
+	}
+	cmd = createFlag(cmd)
+	cmd.Flags().Int64P("cliff", "c", 0, "cliff in second, nothing unfreezes before it")
+	cmd.MarkFlagRequired("cliff")
+
+	cmd.Flags().Int64P("duration", "d", 0, "duration in second to unfreeze all, from start time")
+	cmd.MarkFlagRequired("duration")
+	return cmd
+}
+
+func cliffLinear(cmd *cobra.Command, args []string) {
+	create, err := getCreateFlags(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	cliff, _ := cmd.Flags().GetInt64("cliff")
+	duration, _ := cmd.Flags().GetInt64("duration")
+	if cliff < 0 || duration <= 0 || cliff > duration {
+		fmt.Fprintln(os.Stderr, "duration must be positive integer and not less than cliff")
+		return
+	}
+
+	create.Means = pty.CliffLinearX
+	create.MeansOpt = &pty.UnfreezeCreate_CliffLinear{CliffLinear: &pty.CliffLinear{Cliff: cliff, Duration: duration}}
+	sendCreate(cmd, create)
+}
+
+func linearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "linear",
+		Short: "create per second linear means unfreeze construct",
+		Run:   linear,
+	}
+	cmd = createFlag(cmd)
+	cmd.Flags().Int64P("duration", "d", 0, "duration in second to unfreeze all, from start time")
+	cmd.MarkFlagRequired("duration")
+	return cmd
+}
+
+func linear(cmd *cobra.Command, args []string) {
+	create, err := getCreateFlags(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	duration, _ := cmd.Flags().GetInt64("duration")
+	if duration <= 0 {
+		fmt.Fprintln(os.Stderr, "duration must be positive integer")
+		return
+	}
+
+	create.Means = pty.LinearX
+	create.MeansOpt = &pty.UnfreezeCreate_Linear{Linear: &pty.Linear{Duration: duration}}
+	sendCreate(cmd, create)
+}
+
+func customScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "custom",
+		Short: "create custom schedule means unfreeze construct",
+		Run:   customSchedule,
+	}
+	cmd = createFlag(cmd)
+	cmd.Flags().StringSliceP("tranches", "r", nil, "tranches as offset:amount, offset in second from start time, eg: 0:10,3600:90")
+	cmd.MarkFlagRequired("tranches")
+	return cmd
+}
+
+func parseTranches(tranches []string) ([]*pty.Tranche, error) {
+	var result []*pty.Tranche
+	for _, t := range tranches {
+		pair := strings.Split(t, ":")
+		if len(pair) != 2 {
+			return nil, types.ErrInvalidParam
+		}
+		offset, err := strconv.ParseInt(pair[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := strconv.ParseFloat(pair[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkAmount(amount); err != nil {
+			return nil, err
+		}
+		amountInt64 := int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4
+		result = append(result, &pty.Tranche{Offset: offset, Amount: amountInt64})
+	}
+	return result, nil
+}
+
+func customSchedule(cmd *cobra.Command, args []string) {
+	create, err := getCreateFlags(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	tranchesStr, _ := cmd.Flags().GetStringSlice("tranches")
+	tranches, err := parseTranches(tranchesStr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var sum int64
+	for _, t := range tranches {
+		sum += t.Amount
+	}
+	if sum != create.TotalCount {
+		fmt.Fprintln(os.Stderr, "sum of tranches must equal to total")
+		return
+	}
+
+	create.Means = pty.CustomScheduleX
+	create.MeansOpt = &pty.UnfreezeCreate_CustomSchedule{CustomSchedule: &pty.CustomSchedule{Tranches: tranches}}
+	sendCreate(cmd, create)
+}
+
 func withdrawCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw",
@@ -229,6 +381,33 @@ func terminateCmd() *cobra.Command {
 	return cmd
 }
 
+func transferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "transfer construct to new beneficiary",
+		Run:   transfer,
+	}
+	cmd.Flags().StringP("id", "", "", "unfreeze construct id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringP("beneficiary", "b", "", "address of new beneficiary")
+	cmd.MarkFlagRequired("beneficiary")
+
+	return cmd
+}
+
+func delegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate",
+		Short: "set address allowed to withdraw on behalf of beneficiary, empty to cancel",
+		Run:   delegate,
+	}
+	cmd.Flags().StringP("id", "", "", "unfreeze construct id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringP("delegate", "d", "", "address of delegate")
+
+	return cmd
+}
+
 func showCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show",
@@ -287,6 +466,42 @@ func terminate(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+func transfer(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	id, _ := cmd.Flags().GetString("id")
+	beneficiary, _ := cmd.Flags().GetString("beneficiary")
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(pty.UnfreezeX),
+		ActionName: pty.Action_TransferUnfreeze,
+		Payload:    types.MustPBToJSON(&pty.UnfreezeTransfer{UnfreezeID: id, Beneficiary: beneficiary}),
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func delegate(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	id, _ := cmd.Flags().GetString("id")
+	addr, _ := cmd.Flags().GetString("delegate")
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(pty.UnfreezeX),
+		ActionName: pty.Action_DelegateUnfreeze,
+		Payload:    types.MustPBToJSON(&pty.UnfreezeDelegate{UnfreezeID: id, Delegate: addr}),
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
 func queryWithdraw(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	paraName, _ := cmd.Flags().GetString("paraName")
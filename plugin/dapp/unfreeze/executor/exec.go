@@ -6,6 +6,7 @@ package executor
 
 import (
 	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
@@ -57,8 +58,9 @@ func (u *Unfreeze) Exec_Withdraw(payload *pty.UnfreezeWithdraw, tx *types.Transa
 	if err != nil {
 		return nil, err
 	}
-	if unfreeze.Beneficiary != tx.From() {
-		uflog.Error("unfreeze withdraw no privilege", "beneficiary", unfreeze.Beneficiary, "txFrom", tx.From())
+	if unfreeze.Beneficiary != tx.From() && !u.isDelegate(unfreeze, tx.From()) {
+		uflog.Error("unfreeze withdraw no privilege", "beneficiary", unfreeze.Beneficiary, "delegate", unfreeze.Delegate,
+			"txFrom", tx.From())
 		return nil, pty.ErrNoPrivilege
 	}
 	if unfreeze.Remaining <= 0 {
@@ -77,7 +79,8 @@ func (u *Unfreeze) Exec_Withdraw(payload *pty.UnfreezeWithdraw, tx *types.Transa
 		return nil, err
 	}
 	execAddr := dapp.ExecAddress(string(tx.Execer))
-	receipt, err := acc.ExecTransferFrozen(unfreeze.Initiator, tx.From(), execAddr, amount)
+	// 代理提币时币仍然转给收币人
+	receipt, err := acc.ExecTransferFrozen(unfreeze.Initiator, unfreeze.Beneficiary, execAddr, amount)
 	if err != nil {
 		uflog.Error("unfreeze withdraw transfer", "execaddr", execAddr, "err", err, "from", unfreeze.Initiator,
 			"remain", unfreeze.Remaining, "withdraw", amount)
@@ -122,6 +125,89 @@ func (u *Unfreeze) Exec_Terminate(payload *pty.UnfreezeTerminate, tx *types.Tran
 	return mergeReceipt(receipt, receipt1)
 }
 
+// Exec_Transfer 执行转移收币人
+func (u *Unfreeze) Exec_Transfer(payload *pty.UnfreezeTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := u.GetAPI().GetConfig()
+	if !cfg.IsDappFork(u.GetHeight(), pty.UnfreezeX, pty.ForkUnfreezeBeneficiaryX) {
+		return nil, types.ErrActionNotSupport
+	}
+	if err := checkAddress(payload.Beneficiary); err != nil {
+		uflog.Error("unfreeze transfer beneficiary", "beneficiary", payload.Beneficiary, "err", err)
+		return nil, types.ErrInvalidAddress
+	}
+	if cfg.IsDappFork(u.GetHeight(), pty.UnfreezeX, pty.ForkUnfreezeIDX) {
+		payload.UnfreezeID = unfreezeIDFromHex(payload.UnfreezeID)
+	}
+	unfreeze, err := loadUnfreeze(payload.UnfreezeID, u.GetStateDB())
+	if err != nil {
+		return nil, err
+	}
+	if unfreeze.Beneficiary != tx.From() {
+		uflog.Error("unfreeze transfer no privilege", "beneficiary", unfreeze.Beneficiary, "txFrom", tx.From())
+		return nil, pty.ErrNoPrivilege
+	}
+	if unfreeze.Remaining <= 0 {
+		return nil, pty.ErrUnfreezeEmptied
+	}
+	if payload.Beneficiary == unfreeze.Beneficiary {
+		return nil, types.ErrInvalidParam
+	}
+
+	unfreezeOld := *unfreeze
+	unfreeze.Beneficiary = payload.Beneficiary
+	// 代理是原收币人指定的, 转移后需要新收币人重新指定
+	unfreeze.Delegate = ""
+	return u.save(&unfreezeOld, unfreeze, pty.TyLogTransferUnfreeze)
+}
+
+// Exec_Delegate 执行设置代理提币地址
+func (u *Unfreeze) Exec_Delegate(payload *pty.UnfreezeDelegate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := u.GetAPI().GetConfig()
+	if !cfg.IsDappFork(u.GetHeight(), pty.UnfreezeX, pty.ForkUnfreezeBeneficiaryX) {
+		return nil, types.ErrActionNotSupport
+	}
+	if payload.Delegate != "" {
+		if err := checkAddress(payload.Delegate); err != nil {
+			uflog.Error("unfreeze delegate address", "delegate", payload.Delegate, "err", err)
+			return nil, types.ErrInvalidAddress
+		}
+	}
+	if cfg.IsDappFork(u.GetHeight(), pty.UnfreezeX, pty.ForkUnfreezeIDX) {
+		payload.UnfreezeID = unfreezeIDFromHex(payload.UnfreezeID)
+	}
+	unfreeze, err := loadUnfreeze(payload.UnfreezeID, u.GetStateDB())
+	if err != nil {
+		return nil, err
+	}
+	if unfreeze.Beneficiary != tx.From() {
+		uflog.Error("unfreeze delegate no privilege", "beneficiary", unfreeze.Beneficiary, "txFrom", tx.From())
+		return nil, pty.ErrNoPrivilege
+	}
+	if payload.Delegate == unfreeze.Beneficiary || payload.Delegate == unfreeze.Delegate {
+		return nil, types.ErrInvalidParam
+	}
+
+	unfreezeOld := *unfreeze
+	unfreeze.Delegate = payload.Delegate
+	return u.save(&unfreezeOld, unfreeze, pty.TyLogDelegateUnfreeze)
+}
+
+// 收币人和代理可以是多签账户地址
+func checkAddress(addr string) error {
+	if err := address.CheckAddress(addr); err != nil {
+		return address.CheckMultiSignAddress(addr)
+	}
+	return nil
+}
+
+func (u *Unfreeze) isDelegate(unfreeze *pty.Unfreeze, addr string) bool {
+	cfg := u.GetAPI().GetConfig()
+	if !cfg.IsDappFork(u.GetHeight(), pty.UnfreezeX, pty.ForkUnfreezeBeneficiaryX) {
+		return false
+	}
+	return unfreeze.Delegate != "" && unfreeze.Delegate == addr
+}
+
 func (u *Unfreeze) newEntity(payload *pty.UnfreezeCreate, tx *types.Transaction) (*pty.Unfreeze, error) {
 	id := unfreezeID(tx.Hash())
 	unfreeze := &pty.Unfreeze{
@@ -139,6 +225,12 @@ func (u *Unfreeze) newEntity(payload *pty.UnfreezeCreate, tx *types.Transaction)
 		unfreeze.StartTime = u.GetBlockTime()
 	}
 	cfg := u.GetAPI().GetConfig()
+	if cfg.IsDappFork(u.GetHeight(), pty.UnfreezeX, pty.ForkUnfreezeBeneficiaryX) && payload.Delegate != "" {
+		if err := checkAddress(payload.Delegate); err != nil {
+			return nil, types.ErrInvalidAddress
+		}
+		unfreeze.Delegate = payload.Delegate
+	}
 	means, err := newMeans(cfg, payload.Means, u.GetHeight())
 	if err != nil {
 		return nil, err
@@ -165,6 +257,20 @@ func (u *Unfreeze) create(unfreeze *pty.Unfreeze) (*types.Receipt, error) {
 		KV: []*types.KeyValue{{Key: k, Value: v}}, Logs: []*types.ReceiptLog{receiptLog}}, nil
 }
 
+// 保存修改后的解冻状态
+func (u *Unfreeze) save(prev, cur *pty.Unfreeze, ty int32) (*types.Receipt, error) {
+	k := []byte(cur.UnfreezeID)
+	v := types.Encode(cur)
+	err := u.GetStateDB().Set(k, v)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptLog := getUnfreezeLog(prev, cur, ty)
+	return &types.Receipt{Ty: types.ExecOk,
+		KV: []*types.KeyValue{{Key: k, Value: v}}, Logs: []*types.ReceiptLog{receiptLog}}, nil
+}
+
 func mergeReceipt(r1 *types.Receipt, r2 *types.Receipt) (*types.Receipt, error) {
 	r1.Logs = append(r1.Logs, r2.Logs...)
 	r1.KV = append(r1.KV, r2.KV...)
@@ -272,6 +272,225 @@ func TestUnfreeze(t *testing.T) {
 	ldb.Close()
 }
 
+func TestUnfreezeTransferDelegate(t *testing.T) {
+	total := int64(100000)
+	accountA := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[0]),
+	}
+
+	execAddr := address.ExecAddress(pty.UnfreezeX)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	_, ldb, kvdb := util.CreateTestDB()
+	defer ldb.Close()
+
+	acc, _ := account.NewAccountDB(chain33TestCfg, AssetExecPara, Symbol, stateDB)
+	acc.SaveExecAccount(execAddr, &accountA)
+
+	env := execEnv{
+		10,
+		chain33TestCfg.GetDappFork(pty.UnfreezeX, pty.ForkUnfreezeBeneficiaryX),
+		1539918074,
+	}
+	ty := pty.UnfreezeType{}
+	ty.SetConfig(chain33TestCfg)
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chain33TestCfg, nil)
+	exec := newUnfreeze()
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	exec.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+
+	// A 创建, 收币人 B
+	p1 := &pty.UnfreezeCreate{
+		StartTime:   10,
+		AssetExec:   AssetExecPara,
+		AssetSymbol: Symbol,
+		TotalCount:  10000,
+		Beneficiary: string(Nodes[1]),
+		Means:       pty.FixAmountX,
+		MeansOpt:    &pty.UnfreezeCreate_FixAmount{FixAmount: &pty.FixAmount{Period: 10, Amount: 2}},
+	}
+	createTx, err := ty.RPC_UnfreezeCreateTx(p1)
+	assert.Nil(t, err)
+	createTx, err = signTx(createTx, PrivKeyA)
+	assert.Nil(t, err)
+	receipt, err := exec.Exec(createTx, 1)
+	assert.Nil(t, err)
+	_, err = exec.ExecLocal(createTx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(t, err)
+	id := hex.EncodeToString(createTx.Hash())
+
+	// 不是收币人不能设置代理
+	delegateTx, err := ty.RPC_UnfreezeDelegateTx(&pty.UnfreezeDelegate{UnfreezeID: id, Delegate: string(Nodes[3])})
+	assert.Nil(t, err)
+	delegateTx, err = signTx(delegateTx, PrivKeyC)
+	assert.Nil(t, err)
+	_, err = exec.Exec(delegateTx, 1)
+	assert.Equal(t, pty.ErrNoPrivilege, err)
+
+	// B 设置代理 D
+	delegateTx, err = signTx(delegateTx, PrivKeyB)
+	assert.Nil(t, err)
+	receipt, err = exec.Exec(delegateTx, 1)
+	assert.Nil(t, err)
+	_, err = exec.ExecLocal(delegateTx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(t, err)
+
+	// D 代理提币, 币转给 B
+	withdrawTx, err := ty.RPC_UnfreezeWithdrawTx(&pty.UnfreezeWithdraw{UnfreezeID: id})
+	assert.Nil(t, err)
+	withdrawTx, err = signTx(withdrawTx, PrivKeyD)
+	assert.Nil(t, err)
+	exec.SetEnv(env.blockHeight+1, env.blockTime+20, env.difficulty)
+	receipt, err = exec.Exec(withdrawTx, 1)
+	assert.Nil(t, err)
+	_, err = exec.ExecLocal(withdrawTx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), acc.LoadExecAccount(string(Nodes[1]), execAddr).Balance)
+	assert.Equal(t, int64(0), acc.LoadExecAccount(string(Nodes[3]), execAddr).Balance)
+
+	// B 转移给 C, 代理被清除
+	transferTx, err := ty.RPC_UnfreezeTransferTx(&pty.UnfreezeTransfer{UnfreezeID: id, Beneficiary: string(Nodes[2])})
+	assert.Nil(t, err)
+	transferTx, err = signTx(transferTx, PrivKeyB)
+	assert.Nil(t, err)
+	receipt, err = exec.Exec(transferTx, 1)
+	assert.Nil(t, err)
+	transferReceipt := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	_, err = exec.ExecLocal(transferTx, transferReceipt, 1)
+	assert.Nil(t, err)
+
+	reply, err := exec.Query("ListUnfreezeByBeneficiary", types.Encode(&pty.ReqUnfreezes{Beneficiary: string(Nodes[2])}))
+	assert.Nil(t, err)
+	resp := reply.(*pty.ReplyUnfreezes)
+	assert.Equal(t, 1, len(resp.Unfreeze))
+	assert.Equal(t, string(Nodes[2]), resp.Unfreeze[0].Beneficiary)
+	assert.Equal(t, "", resp.Unfreeze[0].Delegate)
+	_, err = exec.Query("ListUnfreezeByBeneficiary", types.Encode(&pty.ReqUnfreezes{Beneficiary: string(Nodes[1])}))
+	assert.Equal(t, types.ErrNotFound, err)
+
+	// 原收币人和原代理都不能再提币
+	exec.SetEnv(env.blockHeight+2, env.blockTime+40, env.difficulty)
+	for _, key := range []string{PrivKeyB, PrivKeyD} {
+		withdrawTx, err = signTx(withdrawTx, key)
+		assert.Nil(t, err)
+		_, err = exec.Exec(withdrawTx, 1)
+		assert.Equal(t, pty.ErrNoPrivilege, err)
+	}
+	withdrawTx, err = signTx(withdrawTx, PrivKeyC)
+	assert.Nil(t, err)
+	_, err = exec.Exec(withdrawTx, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), acc.LoadExecAccount(string(Nodes[2]), execAddr).Balance)
+
+	// 回滚转移后按原收币人可查到, 且代理恢复
+	_, err = exec.ExecDelLocal(transferTx, transferReceipt, 1)
+	assert.Nil(t, err)
+	reply, err = exec.Query("ListUnfreezeByBeneficiary", types.Encode(&pty.ReqUnfreezes{Beneficiary: string(Nodes[1])}))
+	assert.Nil(t, err)
+	resp = reply.(*pty.ReplyUnfreezes)
+	assert.Equal(t, 1, len(resp.Unfreeze))
+	assert.Equal(t, string(Nodes[3]), resp.Unfreeze[0].Delegate)
+}
+
+func TestUnfreezeMultiSigAddress(t *testing.T) {
+	accountA := types.Account{
+		Balance: 100000,
+		Frozen:  0,
+		Addr:    string(Nodes[0]),
+	}
+
+	execAddr := address.ExecAddress(pty.UnfreezeX)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	_, ldb, kvdb := util.CreateTestDB()
+	defer ldb.Close()
+
+	acc, _ := account.NewAccountDB(chain33TestCfg, AssetExecPara, Symbol, stateDB)
+	acc.SaveExecAccount(execAddr, &accountA)
+
+	env := execEnv{
+		10,
+		chain33TestCfg.GetDappFork(pty.UnfreezeX, pty.ForkUnfreezeBeneficiaryX),
+		1539918074,
+	}
+	ty := pty.UnfreezeType{}
+	ty.SetConfig(chain33TestCfg)
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chain33TestCfg, nil)
+	exec := newUnfreeze()
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	exec.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+
+	multiSigAddr := address.MultiSignAddress([]byte("unfreeze multisig beneficiary"))
+	multiSigDelegate := address.MultiSignAddress([]byte("unfreeze multisig delegate"))
+
+	// 创建时代理可以是多签账户地址
+	p1 := &pty.UnfreezeCreate{
+		StartTime:   10,
+		AssetExec:   AssetExecPara,
+		AssetSymbol: Symbol,
+		TotalCount:  10000,
+		Beneficiary: string(Nodes[1]),
+		Means:       pty.FixAmountX,
+		MeansOpt:    &pty.UnfreezeCreate_FixAmount{FixAmount: &pty.FixAmount{Period: 10, Amount: 2}},
+		Delegate:    multiSigDelegate,
+	}
+	createTx, err := ty.RPC_UnfreezeCreateTx(p1)
+	assert.Nil(t, err)
+	createTx, err = signTx(createTx, PrivKeyA)
+	assert.Nil(t, err)
+	receipt, err := exec.Exec(createTx, 1)
+	assert.Nil(t, err)
+	_, err = exec.ExecLocal(createTx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(t, err)
+	id := hex.EncodeToString(createTx.Hash())
+
+	// 非法地址仍然拒绝
+	delegateTx, err := ty.RPC_UnfreezeDelegateTx(&pty.UnfreezeDelegate{UnfreezeID: id, Delegate: "invalid"})
+	assert.Nil(t, err)
+	delegateTx, err = signTx(delegateTx, PrivKeyB)
+	assert.Nil(t, err)
+	_, err = exec.Exec(delegateTx, 1)
+	assert.Equal(t, types.ErrInvalidAddress, err)
+
+	// 代理改为另一个多签账户
+	delegateTx, err = ty.RPC_UnfreezeDelegateTx(&pty.UnfreezeDelegate{UnfreezeID: id, Delegate: multiSigAddr})
+	assert.Nil(t, err)
+	delegateTx, err = signTx(delegateTx, PrivKeyB)
+	assert.Nil(t, err)
+	receipt, err = exec.Exec(delegateTx, 1)
+	assert.Nil(t, err)
+	_, err = exec.ExecLocal(delegateTx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(t, err)
+
+	// 收币人转移给多签账户, 分叉前不支持转移
+	transferTx, err := ty.RPC_UnfreezeTransferTx(&pty.UnfreezeTransfer{UnfreezeID: id, Beneficiary: multiSigAddr})
+	assert.Nil(t, err)
+	transferTx, err = signTx(transferTx, PrivKeyB)
+	assert.Nil(t, err)
+	exec.SetEnv(env.blockHeight-1, env.blockTime, env.difficulty)
+	_, err = exec.Exec(transferTx, 1)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+	exec.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	receipt, err = exec.Exec(transferTx, 1)
+	assert.Nil(t, err)
+	_, err = exec.ExecLocal(transferTx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(t, err)
+
+	reply, err := exec.Query("ListUnfreezeByBeneficiary", types.Encode(&pty.ReqUnfreezes{Beneficiary: multiSigAddr}))
+	assert.Nil(t, err)
+	resp := reply.(*pty.ReplyUnfreezes)
+	assert.Equal(t, 1, len(resp.Unfreeze))
+	assert.Equal(t, multiSigAddr, resp.Unfreeze[0].Beneficiary)
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.New(types.GetSignName(pty.UnfreezeX, signType))
@@ -5,6 +5,8 @@
 package executor
 
 import (
+	"math/big"
+
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/unfreeze/types"
 )
@@ -16,6 +18,15 @@ type Means interface {
 }
 
 func newMeans(cfg *types.Chain33Config, means string, height int64) (Means, error) {
+	if cfg.IsDappFork(height, pty.UnfreezeX, pty.ForkUnfreezeMeansX) {
+		if means == pty.CliffLinearX {
+			return &cliffLinear{}, nil
+		} else if means == pty.LinearX {
+			return &linear{}, nil
+		} else if means == pty.CustomScheduleX {
+			return &customSchedule{}, nil
+		}
+	}
 	if cfg.IsDappFork(height, pty.UnfreezeX, "ForkTerminatePart") {
 		if means == "FixAmount" {
 			return &fixAmountV2{}, nil
@@ -158,3 +169,118 @@ func (opt *leftProportionV2) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int6
 	}
 	return int64(frozen), nil
 }
+
+// 按已经过的时间占比计算解冻额度, 避免 total * elapsed 溢出
+func linearUnfrozen(total, elapsed, duration int64) int64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	if elapsed >= duration {
+		return total
+	}
+	x := new(big.Int).Mul(big.NewInt(total), big.NewInt(elapsed))
+	return x.Div(x, big.NewInt(duration)).Int64()
+}
+
+type cliffLinear struct {
+}
+
+func (opt *cliffLinear) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	o := from.GetCliffLinear()
+	if o == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if o.Cliff < 0 || o.Duration <= 0 || o.Cliff > o.Duration {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze.MeansOpt = &pty.Unfreeze_CliffLinear{CliffLinear: o}
+	return unfreeze, nil
+}
+
+func (opt *cliffLinear) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	means := unfreeze.GetCliffLinear()
+	if means == nil {
+		return 0, types.ErrInvalidParam
+	}
+	if unfreeze.Terminated {
+		return 0, nil
+	}
+	elapsed := now - unfreeze.StartTime
+	if elapsed < means.Cliff {
+		return unfreeze.TotalCount, nil
+	}
+	return unfreeze.TotalCount - linearUnfrozen(unfreeze.TotalCount, elapsed, means.Duration), nil
+}
+
+type linear struct {
+}
+
+func (opt *linear) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	o := from.GetLinear()
+	if o == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if o.Duration <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze.MeansOpt = &pty.Unfreeze_Linear{Linear: o}
+	return unfreeze, nil
+}
+
+func (opt *linear) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	means := unfreeze.GetLinear()
+	if means == nil {
+		return 0, types.ErrInvalidParam
+	}
+	if unfreeze.Terminated {
+		return 0, nil
+	}
+	return unfreeze.TotalCount - linearUnfrozen(unfreeze.TotalCount, now-unfreeze.StartTime, means.Duration), nil
+}
+
+type customSchedule struct {
+}
+
+// 批次按时间严格递增, 各批次额度之和必须等于冻结总额
+func (opt *customSchedule) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	o := from.GetCustomSchedule()
+	if o == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if len(o.Tranches) == 0 || len(o.Tranches) > pty.MaxTranches {
+		return nil, types.ErrInvalidParam
+	}
+	var sum int64
+	for i, t := range o.Tranches {
+		if t.Offset < 0 || t.Amount <= 0 || t.Amount > unfreeze.TotalCount-sum {
+			return nil, types.ErrInvalidParam
+		}
+		if i > 0 && t.Offset <= o.Tranches[i-1].Offset {
+			return nil, types.ErrInvalidParam
+		}
+		sum += t.Amount
+	}
+	if sum != unfreeze.TotalCount {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze.MeansOpt = &pty.Unfreeze_CustomSchedule{CustomSchedule: o}
+	return unfreeze, nil
+}
+
+func (opt *customSchedule) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	means := unfreeze.GetCustomSchedule()
+	if means == nil {
+		return 0, types.ErrInvalidParam
+	}
+	if unfreeze.Terminated {
+		return 0, nil
+	}
+	frozen := unfreeze.TotalCount
+	for _, t := range means.Tranches {
+		if unfreeze.StartTime+t.Offset > now {
+			break
+		}
+		frozen -= t.Amount
+	}
+	return frozen, nil
+}
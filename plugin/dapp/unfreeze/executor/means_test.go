@@ -3,6 +3,7 @@ package executor
 import (
 	"testing"
 
+	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
 
 	pty "github.com/33cn/plugin/plugin/dapp/unfreeze/types"
@@ -233,3 +234,125 @@ func TestLeftV2(t *testing.T) {
 		})
 	}
 }
+
+func TestCliffLinear(t *testing.T) {
+	forkHeight := chain33TestCfg.GetDappFork(pty.UnfreezeX, pty.ForkUnfreezeMeansX)
+	_, err := newMeans(chain33TestCfg, pty.CliffLinearX, forkHeight-1)
+	assert.Equal(t, types.ErrNotSupport, err)
+	m, err := newMeans(chain33TestCfg, pty.CliffLinearX, forkHeight)
+	assert.Nil(t, err)
+
+	cases := []struct {
+		now      int64
+		cliff    int64
+		duration int64
+		total    int64
+		expect   int64
+	}{
+		{10099, 100, 1000, 10000, 10000},
+		{10100, 100, 1000, 10000, 9000},
+		{10500, 100, 1000, 10000, 5000},
+		{11000, 100, 1000, 10000, 0},
+		{12000, 100, 1000, 10000, 0},
+		{10001, 0, 3, 1e17, 66666666666666667},
+	}
+	for _, c := range cases {
+		create := pty.UnfreezeCreate{
+			TotalCount: c.total,
+			Means:      pty.CliffLinearX,
+			MeansOpt: &pty.UnfreezeCreate_CliffLinear{
+				CliffLinear: &pty.CliffLinear{Cliff: c.cliff, Duration: c.duration},
+			},
+		}
+		u := &pty.Unfreeze{TotalCount: c.total, Means: pty.CliffLinearX, StartTime: 10000}
+		u, err := m.setOpt(u, &create)
+		assert.Nil(t, err)
+
+		f, err := m.calcFrozen(u, c.now)
+		assert.Nil(t, err)
+		assert.Equal(t, c.expect, f)
+	}
+
+	create := pty.UnfreezeCreate{
+		TotalCount: 10000,
+		Means:      pty.CliffLinearX,
+		MeansOpt: &pty.UnfreezeCreate_CliffLinear{
+			CliffLinear: &pty.CliffLinear{Cliff: 200, Duration: 100},
+		},
+	}
+	_, err = m.setOpt(&pty.Unfreeze{TotalCount: 10000}, &create)
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestLinear(t *testing.T) {
+	m, err := newMeans(chain33TestCfg, pty.LinearX, chain33TestCfg.GetDappFork(pty.UnfreezeX, pty.ForkUnfreezeMeansX))
+	assert.Nil(t, err)
+
+	cases := []struct {
+		now      int64
+		duration int64
+		total    int64
+		expect   int64
+	}{
+		{9000, 1000, 10000, 10000},
+		{10001, 1000, 10000, 9990},
+		{10999, 1000, 10000, 10},
+		{11000, 1000, 10000, 0},
+	}
+	for _, c := range cases {
+		create := pty.UnfreezeCreate{
+			TotalCount: c.total,
+			Means:      pty.LinearX,
+			MeansOpt:   &pty.UnfreezeCreate_Linear{Linear: &pty.Linear{Duration: c.duration}},
+		}
+		u := &pty.Unfreeze{TotalCount: c.total, Means: pty.LinearX, StartTime: 10000}
+		u, err := m.setOpt(u, &create)
+		assert.Nil(t, err)
+
+		f, err := m.calcFrozen(u, c.now)
+		assert.Nil(t, err)
+		assert.Equal(t, c.expect, f)
+	}
+}
+
+func TestCustomSchedule(t *testing.T) {
+	m, err := newMeans(chain33TestCfg, pty.CustomScheduleX, chain33TestCfg.GetDappFork(pty.UnfreezeX, pty.ForkUnfreezeMeansX))
+	assert.Nil(t, err)
+
+	tranches := []*pty.Tranche{{Offset: 0, Amount: 1000}, {Offset: 100, Amount: 4000}, {Offset: 300, Amount: 5000}}
+	create := pty.UnfreezeCreate{
+		TotalCount: 10000,
+		Means:      pty.CustomScheduleX,
+		MeansOpt:   &pty.UnfreezeCreate_CustomSchedule{CustomSchedule: &pty.CustomSchedule{Tranches: tranches}},
+	}
+	u := &pty.Unfreeze{TotalCount: 10000, Means: pty.CustomScheduleX, StartTime: 10000}
+	u, err = m.setOpt(u, &create)
+	assert.Nil(t, err)
+
+	cases := []struct {
+		now    int64
+		expect int64
+	}{
+		{9999, 10000},
+		{10000, 9000},
+		{10299, 5000},
+		{10300, 0},
+	}
+	for _, c := range cases {
+		f, err := m.calcFrozen(u, c.now)
+		assert.Nil(t, err)
+		assert.Equal(t, c.expect, f)
+	}
+
+	// 额度之和不等于总额, 或时间不递增
+	bad := [][]*pty.Tranche{
+		{{Offset: 0, Amount: 1000}, {Offset: 100, Amount: 4000}},
+		{{Offset: 100, Amount: 5000}, {Offset: 100, Amount: 5000}},
+		{{Offset: 0, Amount: 20000}, {Offset: 100, Amount: -10000}},
+	}
+	for _, b := range bad {
+		create.MeansOpt = &pty.UnfreezeCreate_CustomSchedule{CustomSchedule: &pty.CustomSchedule{Tranches: b}}
+		_, err = m.setOpt(&pty.Unfreeze{TotalCount: 10000}, &create)
+		assert.Equal(t, types.ErrInvalidParam, err)
+	}
+}
@@ -5,6 +5,7 @@
 package executor
 
 import (
+	"math"
 	"time"
 
 	dbm "github.com/33cn/chain33/common/db"
@@ -54,7 +55,8 @@ func QueryWithdraw(cfg *types.Chain33Config, stateDB dbm.KV, id string) (types.M
 }
 
 func getWithdrawAvailable(cfg *types.Chain33Config, unfreeze *pty.Unfreeze, calcTime int64) (int64, error) {
-	means, err := newMeans(cfg, unfreeze.Means, 1500000)
+	// 合约已经创建, 按最新的规则计算, 以支持分叉后新增的解冻方式
+	means, err := newMeans(cfg, unfreeze.Means, math.MaxInt64)
 	if err != nil {
 		return 0, err
 	}
@@ -141,11 +143,18 @@ func fmtLocalUnfreeze(rows []*table.Row) (*pty.ReplyUnfreezes, error) {
 			Means:       r.Unfreeze.Means,
 			Terminated:  r.Unfreeze.Terminated,
 			Key:         r.TxIndex,
+			Delegate:    r.Unfreeze.Delegate,
 		}
 		if v.Means == pty.FixAmountX {
 			v.MeansOpt = &pty.ReplyUnfreeze_FixAmount{FixAmount: r.Unfreeze.GetFixAmount()}
 		} else if v.Means == pty.LeftProportionX {
 			v.MeansOpt = &pty.ReplyUnfreeze_LeftProportion{LeftProportion: r.Unfreeze.GetLeftProportion()}
+		} else if v.Means == pty.CliffLinearX {
+			v.MeansOpt = &pty.ReplyUnfreeze_CliffLinear{CliffLinear: r.Unfreeze.GetCliffLinear()}
+		} else if v.Means == pty.LinearX {
+			v.MeansOpt = &pty.ReplyUnfreeze_Linear{Linear: r.Unfreeze.GetLinear()}
+		} else if v.Means == pty.CustomScheduleX {
+			v.MeansOpt = &pty.ReplyUnfreeze_CustomSchedule{CustomSchedule: r.Unfreeze.GetCustomSchedule()}
 		}
 		results.Unfreeze = append(results.Unfreeze, v)
 	}
@@ -99,3 +99,31 @@ func (c *Jrpc) CreateRawUnfreezeTerminate(param *pty.UnfreezeTerminate, result *
 	*result = hex.EncodeToString(data)
 	return nil
 }
+
+// CreateRawUnfreezeTransfer 转移冻结合约的收币人
+func (c *Jrpc) CreateRawUnfreezeTransfer(param *pty.UnfreezeTransfer, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(pty.UnfreezeX), "Transfer", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawUnfreezeDelegate 设置冻结合约的代理提币地址
+func (c *Jrpc) CreateRawUnfreezeDelegate(param *pty.UnfreezeDelegate, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(pty.UnfreezeX), "Delegate", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
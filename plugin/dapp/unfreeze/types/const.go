@@ -9,11 +9,15 @@ const (
 	UnfreezeActionCreate = iota + 1
 	UnfreezeActionWithdraw
 	UnfreezeActionTerminate
+	UnfreezeActionTransfer
+	UnfreezeActionDelegate
 
 	//log for unfreeze
 	TyLogCreateUnfreeze    = 2001 // TODO 修改具体编号
 	TyLogWithdrawUnfreeze  = 2002
 	TyLogTerminateUnfreeze = 2003
+	TyLogTransferUnfreeze  = 2004
+	TyLogDelegateUnfreeze  = 2005
 )
 
 const (
@@ -23,6 +27,10 @@ const (
 	Action_WithdrawUnfreeze = "withdrawUnfreeze"
 	// Action_TerminateUnfreeze Action 名字
 	Action_TerminateUnfreeze = "terminateUnfreeze"
+	// Action_TransferUnfreeze Action 名字
+	Action_TransferUnfreeze = "transferUnfreeze"
+	// Action_DelegateUnfreeze Action 名字
+	Action_DelegateUnfreeze = "delegateUnfreeze"
 )
 
 const (
@@ -41,8 +49,18 @@ var (
 
 	FixAmountX      = "FixAmount"
 	LeftProportionX = "LeftProportion"
-	SupportMeans    = []string{"FixAmount", "LeftProportion"}
+	CliffLinearX    = "CliffLinear"
+	LinearX         = "Linear"
+	CustomScheduleX = "CustomSchedule"
+	SupportMeans    = []string{"FixAmount", "LeftProportion", "CliffLinear", "Linear", "CustomSchedule"}
 
-	ForkTerminatePartX = "ForkTerminatePart"
-	ForkUnfreezeIDX    = "ForkUnfreezeIDX"
+	ForkTerminatePartX       = "ForkTerminatePart"
+	ForkUnfreezeIDX          = "ForkUnfreezeIDX"
+	ForkUnfreezeMeansX       = "ForkUnfreezeMeans"
+	ForkUnfreezeBeneficiaryX = "ForkUnfreezeBeneficiary"
+)
+
+const (
+	// MaxTranches 自定义解冻时间表最多的批次数
+	MaxTranches = 100
 )
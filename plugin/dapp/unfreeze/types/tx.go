@@ -19,6 +19,10 @@ type parseUnfreezeCreate struct {
 	Means          string          `protobuf:"bytes,6,opt,name=means,proto3" json:"means,omitempty"`
 	FixAmount      *FixAmount      `json:"fixAmount,omitempty"`
 	LeftProportion *LeftProportion `json:"leftProportion,omitempty"`
+	CliffLinear    *CliffLinear    `json:"cliffLinear,omitempty"`
+	Linear         *Linear         `json:"linear,omitempty"`
+	CustomSchedule *CustomSchedule `json:"customSchedule,omitempty"`
+	Delegate       string          `protobuf:"bytes,12,opt,name=delegate,proto3" json:"delegate,omitempty"`
 }
 
 // UnmarshalJSON 解析UnfreezeCreate
@@ -32,6 +36,12 @@ func (m *UnfreezeCreate) UnmarshalJSON(v []byte) error {
 		m.MeansOpt = &UnfreezeCreate_FixAmount{FixAmount: c.FixAmount}
 	} else if c.Means == LeftProportionX && c.LeftProportion != nil {
 		m.MeansOpt = &UnfreezeCreate_LeftProportion{LeftProportion: c.LeftProportion}
+	} else if c.Means == CliffLinearX && c.CliffLinear != nil {
+		m.MeansOpt = &UnfreezeCreate_CliffLinear{CliffLinear: c.CliffLinear}
+	} else if c.Means == LinearX && c.Linear != nil {
+		m.MeansOpt = &UnfreezeCreate_Linear{Linear: c.Linear}
+	} else if c.Means == CustomScheduleX && c.CustomSchedule != nil {
+		m.MeansOpt = &UnfreezeCreate_CustomSchedule{CustomSchedule: c.CustomSchedule}
 	} else {
 		return types.ErrInvalidParam
 	}
@@ -39,5 +49,6 @@ func (m *UnfreezeCreate) UnmarshalJSON(v []byte) error {
 	m.AssetSymbol, m.AssetExec = c.AssetSymbol, c.AssetExec
 	m.TotalCount, m.Beneficiary = c.TotalCount, c.Beneficiary
 	m.Means = c.Means
+	m.Delegate = c.Delegate
 	return nil
 }
@@ -31,6 +31,8 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(name, "Enable", 0)
 	cfg.RegisterDappFork(name, ForkTerminatePartX, 1298600)
 	cfg.RegisterDappFork(name, ForkUnfreezeIDX, 1450000)
+	cfg.RegisterDappFork(name, ForkUnfreezeMeansX, 4600000)
+	cfg.RegisterDappFork(name, ForkUnfreezeBeneficiaryX, 4600000)
 }
 
 func InitExecutor(cfg *types.Chain33Config) {
@@ -66,6 +68,8 @@ func (u *UnfreezeType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogCreateUnfreeze:    {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogCreateUnfreeze"},
 		TyLogWithdrawUnfreeze:  {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogWithdrawUnfreeze"},
 		TyLogTerminateUnfreeze: {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogTerminateUnfreeze"},
+		TyLogTransferUnfreeze:  {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogTransferUnfreeze"},
+		TyLogDelegateUnfreeze:  {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogDelegateUnfreeze"},
 	}
 }
 
@@ -80,6 +84,8 @@ func (u *UnfreezeType) GetTypeMap() map[string]int32 {
 		"Create":    UnfreezeActionCreate,
 		"Withdraw":  UnfreezeActionWithdraw,
 		"Terminate": UnfreezeActionTerminate,
+		"Transfer":  UnfreezeActionTransfer,
+		"Delegate":  UnfreezeActionDelegate,
 	}
 }
 
@@ -110,6 +116,22 @@ func (u *UnfreezeType) CreateTx(action string, message json.RawMessage) (*types.
 			return nil, types.ErrInvalidParam
 		}
 		return u.RPC_UnfreezeTerminateTx(&param)
+	} else if action == Action_TransferUnfreeze {
+		var param UnfreezeTransfer
+		err := types.JSONToPB(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return u.RPC_UnfreezeTransferTx(&param)
+	} else if action == Action_DelegateUnfreeze {
+		var param UnfreezeDelegate
+		err := types.JSONToPB(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return u.RPC_UnfreezeDelegateTx(&param)
 	}
 
 	return nil, types.ErrNotSupport
@@ -208,6 +230,66 @@ func CreateUnfreezeTerminateTx(cfg *types.Chain33Config, title string, parm *Unf
 	return tx, nil
 }
 
+// RPC_UnfreezeTransferTx 创建转移收币人交易入口
+func (u *UnfreezeType) RPC_UnfreezeTransferTx(parm *UnfreezeTransfer) (*types.Transaction, error) {
+	cfg := u.GetConfig()
+	return CreateUnfreezeTransferTx(cfg, cfg.GetParaName(), parm)
+}
+
+// CreateUnfreezeTransferTx 创建转移收币人交易
+func CreateUnfreezeTransferTx(cfg *types.Chain33Config, title string, parm *UnfreezeTransfer) (*types.Transaction, error) {
+	if parm == nil || parm.Beneficiary == "" {
+		tlog.Error("RPC_UnfreezeTransferTx", "parm", parm)
+		return nil, types.ErrInvalidParam
+	}
+	v := &UnfreezeTransfer{
+		UnfreezeID:  parm.UnfreezeID,
+		Beneficiary: parm.Beneficiary,
+	}
+	transfer := &UnfreezeAction{
+		Ty:    UnfreezeActionTransfer,
+		Value: &UnfreezeAction_Transfer{v},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(getRealExecName(cfg, title)),
+		Payload: types.Encode(transfer),
+		Nonce:   rand.New(rand.NewSource(time.Now().UnixNano())).Int63(),
+		To:      address.ExecAddress(getRealExecName(cfg, cfg.GetParaName())),
+	}
+	tx.SetRealFee(cfg.GetMinTxFeeRate())
+	return tx, nil
+}
+
+// RPC_UnfreezeDelegateTx 创建设置代理提币地址交易入口
+func (u *UnfreezeType) RPC_UnfreezeDelegateTx(parm *UnfreezeDelegate) (*types.Transaction, error) {
+	cfg := u.GetConfig()
+	return CreateUnfreezeDelegateTx(cfg, cfg.GetParaName(), parm)
+}
+
+// CreateUnfreezeDelegateTx 创建设置代理提币地址交易, 代理地址为空表示取消代理
+func CreateUnfreezeDelegateTx(cfg *types.Chain33Config, title string, parm *UnfreezeDelegate) (*types.Transaction, error) {
+	if parm == nil {
+		tlog.Error("RPC_UnfreezeDelegateTx", "parm", parm)
+		return nil, types.ErrInvalidParam
+	}
+	v := &UnfreezeDelegate{
+		UnfreezeID: parm.UnfreezeID,
+		Delegate:   parm.Delegate,
+	}
+	delegate := &UnfreezeAction{
+		Ty:    UnfreezeActionDelegate,
+		Value: &UnfreezeAction_Delegate{v},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(getRealExecName(cfg, title)),
+		Payload: types.Encode(delegate),
+		Nonce:   rand.New(rand.NewSource(time.Now().UnixNano())).Int63(),
+		To:      address.ExecAddress(getRealExecName(cfg, cfg.GetParaName())),
+	}
+	tx.SetRealFee(cfg.GetMinTxFeeRate())
+	return tx, nil
+}
+
 func supportMeans(means string) bool {
 	for _, m := range SupportMeans {
 		if m == means {
@@ -47,11 +47,16 @@ type Unfreeze struct {
 	// Types that are valid to be assigned to MeansOpt:
 	//	*Unfreeze_FixAmount
 	//	*Unfreeze_LeftProportion
-	MeansOpt             isUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
-	Terminated           bool                `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	//	*Unfreeze_CliffLinear
+	//	*Unfreeze_Linear
+	//	*Unfreeze_CustomSchedule
+	MeansOpt   isUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
+	Terminated bool                `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
+	//代理提币地址, 可代替收币人发起提币, 币仍然转给收币人
+	Delegate             string   `protobuf:"bytes,16,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Unfreeze) Reset()         { *m = Unfreeze{} }
@@ -154,10 +159,28 @@ type Unfreeze_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,11,opt,name=leftProportion,proto3,oneof"`
 }
 
+type Unfreeze_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,13,opt,name=cliffLinear,proto3,oneof"`
+}
+
+type Unfreeze_Linear struct {
+	Linear *Linear `protobuf:"bytes,14,opt,name=linear,proto3,oneof"`
+}
+
+type Unfreeze_CustomSchedule struct {
+	CustomSchedule *CustomSchedule `protobuf:"bytes,15,opt,name=customSchedule,proto3,oneof"`
+}
+
 func (*Unfreeze_FixAmount) isUnfreeze_MeansOpt() {}
 
 func (*Unfreeze_LeftProportion) isUnfreeze_MeansOpt() {}
 
+func (*Unfreeze_CliffLinear) isUnfreeze_MeansOpt() {}
+
+func (*Unfreeze_Linear) isUnfreeze_MeansOpt() {}
+
+func (*Unfreeze_CustomSchedule) isUnfreeze_MeansOpt() {}
+
 func (m *Unfreeze) GetMeansOpt() isUnfreeze_MeansOpt {
 	if m != nil {
 		return m.MeansOpt
@@ -179,6 +202,27 @@ func (m *Unfreeze) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (m *Unfreeze) GetCliffLinear() *CliffLinear {
+	if x, ok := m.GetMeansOpt().(*Unfreeze_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
+func (m *Unfreeze) GetLinear() *Linear {
+	if x, ok := m.GetMeansOpt().(*Unfreeze_Linear); ok {
+		return x.Linear
+	}
+	return nil
+}
+
+func (m *Unfreeze) GetCustomSchedule() *CustomSchedule {
+	if x, ok := m.GetMeansOpt().(*Unfreeze_CustomSchedule); ok {
+		return x.CustomSchedule
+	}
+	return nil
+}
+
 func (m *Unfreeze) GetTerminated() bool {
 	if m != nil {
 		return m.Terminated
@@ -186,11 +230,21 @@ func (m *Unfreeze) GetTerminated() bool {
 	return false
 }
 
+func (m *Unfreeze) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Unfreeze) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Unfreeze_FixAmount)(nil),
 		(*Unfreeze_LeftProportion)(nil),
+		(*Unfreeze_CliffLinear)(nil),
+		(*Unfreeze_Linear)(nil),
+		(*Unfreeze_CustomSchedule)(nil),
 	}
 }
 
@@ -290,12 +344,192 @@ func (m *LeftProportion) GetTenThousandth() int64 {
 	return 0
 }
 
+// 锁定期后按秒线性解冻, 锁定期结束时解冻已经过时间对应的部分
+type CliffLinear struct {
+	// 锁定期(秒)
+	Cliff int64 `protobuf:"varint,1,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	// 从开始时间起全部解冻所需的时间(秒), 不小于锁定期
+	Duration             int64    `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CliffLinear) Reset()         { *m = CliffLinear{} }
+func (m *CliffLinear) String() string { return proto.CompactTextString(m) }
+func (*CliffLinear) ProtoMessage()    {}
+func (*CliffLinear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{3}
+}
+
+func (m *CliffLinear) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CliffLinear.Unmarshal(m, b)
+}
+func (m *CliffLinear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CliffLinear.Marshal(b, m, deterministic)
+}
+func (m *CliffLinear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CliffLinear.Merge(m, src)
+}
+func (m *CliffLinear) XXX_Size() int {
+	return xxx_messageInfo_CliffLinear.Size(m)
+}
+func (m *CliffLinear) XXX_DiscardUnknown() {
+	xxx_messageInfo_CliffLinear.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CliffLinear proto.InternalMessageInfo
+
+func (m *CliffLinear) GetCliff() int64 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *CliffLinear) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// 从开始时间起按秒线性解冻
+type Linear struct {
+	Duration             int64    `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Linear) Reset()         { *m = Linear{} }
+func (m *Linear) String() string { return proto.CompactTextString(m) }
+func (*Linear) ProtoMessage()    {}
+func (*Linear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{4}
+}
+
+func (m *Linear) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Linear.Unmarshal(m, b)
+}
+func (m *Linear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Linear.Marshal(b, m, deterministic)
+}
+func (m *Linear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Linear.Merge(m, src)
+}
+func (m *Linear) XXX_Size() int {
+	return xxx_messageInfo_Linear.Size(m)
+}
+func (m *Linear) XXX_DiscardUnknown() {
+	xxx_messageInfo_Linear.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Linear proto.InternalMessageInfo
+
+func (m *Linear) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// 按自定义的时间表分批解冻
+type CustomSchedule struct {
+	Tranches             []*Tranche `protobuf:"bytes,1,rep,name=tranches,proto3" json:"tranches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CustomSchedule) Reset()         { *m = CustomSchedule{} }
+func (m *CustomSchedule) String() string { return proto.CompactTextString(m) }
+func (*CustomSchedule) ProtoMessage()    {}
+func (*CustomSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{5}
+}
+
+func (m *CustomSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomSchedule.Unmarshal(m, b)
+}
+func (m *CustomSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomSchedule.Marshal(b, m, deterministic)
+}
+func (m *CustomSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomSchedule.Merge(m, src)
+}
+func (m *CustomSchedule) XXX_Size() int {
+	return xxx_messageInfo_CustomSchedule.Size(m)
+}
+func (m *CustomSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomSchedule proto.InternalMessageInfo
+
+func (m *CustomSchedule) GetTranches() []*Tranche {
+	if m != nil {
+		return m.Tranches
+	}
+	return nil
+}
+
+// 距开始时间 offset 秒后解冻 amount
+type Tranche struct {
+	Offset               int64    `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Tranche) Reset()         { *m = Tranche{} }
+func (m *Tranche) String() string { return proto.CompactTextString(m) }
+func (*Tranche) ProtoMessage()    {}
+func (*Tranche) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{6}
+}
+
+func (m *Tranche) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tranche.Unmarshal(m, b)
+}
+func (m *Tranche) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tranche.Marshal(b, m, deterministic)
+}
+func (m *Tranche) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tranche.Merge(m, src)
+}
+func (m *Tranche) XXX_Size() int {
+	return xxx_messageInfo_Tranche.Size(m)
+}
+func (m *Tranche) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tranche.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tranche proto.InternalMessageInfo
+
+func (m *Tranche) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Tranche) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 // message for execs.unfreeze
 type UnfreezeAction struct {
 	// Types that are valid to be assigned to Value:
 	//	*UnfreezeAction_Create
 	//	*UnfreezeAction_Withdraw
 	//	*UnfreezeAction_Terminate
+	//	*UnfreezeAction_Transfer
+	//	*UnfreezeAction_Delegate
 	Value                isUnfreezeAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                  `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -307,7 +541,7 @@ func (m *UnfreezeAction) Reset()         { *m = UnfreezeAction{} }
 func (m *UnfreezeAction) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeAction) ProtoMessage()    {}
 func (*UnfreezeAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{3}
+	return fileDescriptor_6caa0554cb0b9167, []int{7}
 }
 
 func (m *UnfreezeAction) XXX_Unmarshal(b []byte) error {
@@ -344,12 +578,24 @@ type UnfreezeAction_Terminate struct {
 	Terminate *UnfreezeTerminate `protobuf:"bytes,3,opt,name=terminate,proto3,oneof"`
 }
 
+type UnfreezeAction_Transfer struct {
+	Transfer *UnfreezeTransfer `protobuf:"bytes,5,opt,name=transfer,proto3,oneof"`
+}
+
+type UnfreezeAction_Delegate struct {
+	Delegate *UnfreezeDelegate `protobuf:"bytes,6,opt,name=delegate,proto3,oneof"`
+}
+
 func (*UnfreezeAction_Create) isUnfreezeAction_Value() {}
 
 func (*UnfreezeAction_Withdraw) isUnfreezeAction_Value() {}
 
 func (*UnfreezeAction_Terminate) isUnfreezeAction_Value() {}
 
+func (*UnfreezeAction_Transfer) isUnfreezeAction_Value() {}
+
+func (*UnfreezeAction_Delegate) isUnfreezeAction_Value() {}
+
 func (m *UnfreezeAction) GetValue() isUnfreezeAction_Value {
 	if m != nil {
 		return m.Value
@@ -378,6 +624,20 @@ func (m *UnfreezeAction) GetTerminate() *UnfreezeTerminate {
 	return nil
 }
 
+func (m *UnfreezeAction) GetTransfer() *UnfreezeTransfer {
+	if x, ok := m.GetValue().(*UnfreezeAction_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (m *UnfreezeAction) GetDelegate() *UnfreezeDelegate {
+	if x, ok := m.GetValue().(*UnfreezeAction_Delegate); ok {
+		return x.Delegate
+	}
+	return nil
+}
+
 func (m *UnfreezeAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -391,6 +651,8 @@ func (*UnfreezeAction) XXX_OneofWrappers() []interface{} {
 		(*UnfreezeAction_Create)(nil),
 		(*UnfreezeAction_Withdraw)(nil),
 		(*UnfreezeAction_Terminate)(nil),
+		(*UnfreezeAction_Transfer)(nil),
+		(*UnfreezeAction_Delegate)(nil),
 	}
 }
 
@@ -405,7 +667,11 @@ type UnfreezeCreate struct {
 	// Types that are valid to be assigned to MeansOpt:
 	//	*UnfreezeCreate_FixAmount
 	//	*UnfreezeCreate_LeftProportion
+	//	*UnfreezeCreate_CliffLinear
+	//	*UnfreezeCreate_Linear
+	//	*UnfreezeCreate_CustomSchedule
 	MeansOpt             isUnfreezeCreate_MeansOpt `protobuf_oneof:"meansOpt"`
+	Delegate             string                    `protobuf:"bytes,12,opt,name=delegate,proto3" json:"delegate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -415,7 +681,7 @@ func (m *UnfreezeCreate) Reset()         { *m = UnfreezeCreate{} }
 func (m *UnfreezeCreate) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeCreate) ProtoMessage()    {}
 func (*UnfreezeCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{4}
+	return fileDescriptor_6caa0554cb0b9167, []int{8}
 }
 
 func (m *UnfreezeCreate) XXX_Unmarshal(b []byte) error {
@@ -478,6 +744,13 @@ func (m *UnfreezeCreate) GetMeans() string {
 	return ""
 }
 
+func (m *UnfreezeCreate) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
 type isUnfreezeCreate_MeansOpt interface {
 	isUnfreezeCreate_MeansOpt()
 }
@@ -490,10 +763,28 @@ type UnfreezeCreate_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,8,opt,name=leftProportion,proto3,oneof"`
 }
 
+type UnfreezeCreate_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,9,opt,name=cliffLinear,proto3,oneof"`
+}
+
+type UnfreezeCreate_Linear struct {
+	Linear *Linear `protobuf:"bytes,10,opt,name=linear,proto3,oneof"`
+}
+
+type UnfreezeCreate_CustomSchedule struct {
+	CustomSchedule *CustomSchedule `protobuf:"bytes,11,opt,name=customSchedule,proto3,oneof"`
+}
+
 func (*UnfreezeCreate_FixAmount) isUnfreezeCreate_MeansOpt() {}
 
 func (*UnfreezeCreate_LeftProportion) isUnfreezeCreate_MeansOpt() {}
 
+func (*UnfreezeCreate_CliffLinear) isUnfreezeCreate_MeansOpt() {}
+
+func (*UnfreezeCreate_Linear) isUnfreezeCreate_MeansOpt() {}
+
+func (*UnfreezeCreate_CustomSchedule) isUnfreezeCreate_MeansOpt() {}
+
 func (m *UnfreezeCreate) GetMeansOpt() isUnfreezeCreate_MeansOpt {
 	if m != nil {
 		return m.MeansOpt
@@ -515,11 +806,35 @@ func (m *UnfreezeCreate) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (m *UnfreezeCreate) GetCliffLinear() *CliffLinear {
+	if x, ok := m.GetMeansOpt().(*UnfreezeCreate_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
+func (m *UnfreezeCreate) GetLinear() *Linear {
+	if x, ok := m.GetMeansOpt().(*UnfreezeCreate_Linear); ok {
+		return x.Linear
+	}
+	return nil
+}
+
+func (m *UnfreezeCreate) GetCustomSchedule() *CustomSchedule {
+	if x, ok := m.GetMeansOpt().(*UnfreezeCreate_CustomSchedule); ok {
+		return x.CustomSchedule
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*UnfreezeCreate) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*UnfreezeCreate_FixAmount)(nil),
 		(*UnfreezeCreate_LeftProportion)(nil),
+		(*UnfreezeCreate_CliffLinear)(nil),
+		(*UnfreezeCreate_Linear)(nil),
+		(*UnfreezeCreate_CustomSchedule)(nil),
 	}
 }
 
@@ -534,7 +849,7 @@ func (m *UnfreezeWithdraw) Reset()         { *m = UnfreezeWithdraw{} }
 func (m *UnfreezeWithdraw) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeWithdraw) ProtoMessage()    {}
 func (*UnfreezeWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{5}
+	return fileDescriptor_6caa0554cb0b9167, []int{9}
 }
 
 func (m *UnfreezeWithdraw) XXX_Unmarshal(b []byte) error {
@@ -573,7 +888,7 @@ func (m *UnfreezeTerminate) Reset()         { *m = UnfreezeTerminate{} }
 func (m *UnfreezeTerminate) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeTerminate) ProtoMessage()    {}
 func (*UnfreezeTerminate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{6}
+	return fileDescriptor_6caa0554cb0b9167, []int{10}
 }
 
 func (m *UnfreezeTerminate) XXX_Unmarshal(b []byte) error {
@@ -601,6 +916,102 @@ func (m *UnfreezeTerminate) GetUnfreezeID() string {
 	return ""
 }
 
+// 收币人把合约转给新的收币人, 转移后代理提币地址被清除
+type UnfreezeTransfer struct {
+	UnfreezeID           string   `protobuf:"bytes,1,opt,name=unfreezeID,proto3" json:"unfreezeID,omitempty"`
+	Beneficiary          string   `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeTransfer) Reset()         { *m = UnfreezeTransfer{} }
+func (m *UnfreezeTransfer) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeTransfer) ProtoMessage()    {}
+func (*UnfreezeTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{11}
+}
+
+func (m *UnfreezeTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeTransfer.Unmarshal(m, b)
+}
+func (m *UnfreezeTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeTransfer.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeTransfer.Merge(m, src)
+}
+func (m *UnfreezeTransfer) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeTransfer.Size(m)
+}
+func (m *UnfreezeTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeTransfer proto.InternalMessageInfo
+
+func (m *UnfreezeTransfer) GetUnfreezeID() string {
+	if m != nil {
+		return m.UnfreezeID
+	}
+	return ""
+}
+
+func (m *UnfreezeTransfer) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+// 收币人设置代理提币地址, 为空表示取消代理
+type UnfreezeDelegate struct {
+	UnfreezeID           string   `protobuf:"bytes,1,opt,name=unfreezeID,proto3" json:"unfreezeID,omitempty"`
+	Delegate             string   `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeDelegate) Reset()         { *m = UnfreezeDelegate{} }
+func (m *UnfreezeDelegate) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeDelegate) ProtoMessage()    {}
+func (*UnfreezeDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{12}
+}
+
+func (m *UnfreezeDelegate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeDelegate.Unmarshal(m, b)
+}
+func (m *UnfreezeDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeDelegate.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeDelegate.Merge(m, src)
+}
+func (m *UnfreezeDelegate) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeDelegate.Size(m)
+}
+func (m *UnfreezeDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeDelegate proto.InternalMessageInfo
+
+func (m *UnfreezeDelegate) GetUnfreezeID() string {
+	if m != nil {
+		return m.UnfreezeID
+	}
+	return ""
+}
+
+func (m *UnfreezeDelegate) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
 // receipt
 type ReceiptUnfreeze struct {
 	Prev                 *Unfreeze `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
@@ -614,7 +1025,7 @@ func (m *ReceiptUnfreeze) Reset()         { *m = ReceiptUnfreeze{} }
 func (m *ReceiptUnfreeze) String() string { return proto.CompactTextString(m) }
 func (*ReceiptUnfreeze) ProtoMessage()    {}
 func (*ReceiptUnfreeze) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{7}
+	return fileDescriptor_6caa0554cb0b9167, []int{13}
 }
 
 func (m *ReceiptUnfreeze) XXX_Unmarshal(b []byte) error {
@@ -661,7 +1072,7 @@ func (m *LocalUnfreeze) Reset()         { *m = LocalUnfreeze{} }
 func (m *LocalUnfreeze) String() string { return proto.CompactTextString(m) }
 func (*LocalUnfreeze) ProtoMessage()    {}
 func (*LocalUnfreeze) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{8}
+	return fileDescriptor_6caa0554cb0b9167, []int{14}
 }
 
 func (m *LocalUnfreeze) XXX_Unmarshal(b []byte) error {
@@ -709,7 +1120,7 @@ func (m *ReplyQueryUnfreezeWithdraw) Reset()         { *m = ReplyQueryUnfreezeWi
 func (m *ReplyQueryUnfreezeWithdraw) String() string { return proto.CompactTextString(m) }
 func (*ReplyQueryUnfreezeWithdraw) ProtoMessage()    {}
 func (*ReplyQueryUnfreezeWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{9}
+	return fileDescriptor_6caa0554cb0b9167, []int{15}
 }
 
 func (m *ReplyQueryUnfreezeWithdraw) XXX_Unmarshal(b []byte) error {
@@ -759,7 +1170,7 @@ func (m *ReqUnfreezes) Reset()         { *m = ReqUnfreezes{} }
 func (m *ReqUnfreezes) String() string { return proto.CompactTextString(m) }
 func (*ReqUnfreezes) ProtoMessage()    {}
 func (*ReqUnfreezes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{10}
+	return fileDescriptor_6caa0554cb0b9167, []int{16}
 }
 
 func (m *ReqUnfreezes) XXX_Unmarshal(b []byte) error {
@@ -836,9 +1247,13 @@ type ReplyUnfreeze struct {
 	// Types that are valid to be assigned to MeansOpt:
 	//	*ReplyUnfreeze_FixAmount
 	//	*ReplyUnfreeze_LeftProportion
+	//	*ReplyUnfreeze_CliffLinear
+	//	*ReplyUnfreeze_Linear
+	//	*ReplyUnfreeze_CustomSchedule
 	MeansOpt             isReplyUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
 	Terminated           bool                     `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
 	Key                  string                   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
+	Delegate             string                   `protobuf:"bytes,17,opt,name=delegate,proto3" json:"delegate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -848,7 +1263,7 @@ func (m *ReplyUnfreeze) Reset()         { *m = ReplyUnfreeze{} }
 func (m *ReplyUnfreeze) String() string { return proto.CompactTextString(m) }
 func (*ReplyUnfreeze) ProtoMessage()    {}
 func (*ReplyUnfreeze) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{11}
+	return fileDescriptor_6caa0554cb0b9167, []int{17}
 }
 
 func (m *ReplyUnfreeze) XXX_Unmarshal(b []byte) error {
@@ -944,10 +1359,28 @@ type ReplyUnfreeze_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,11,opt,name=leftProportion,proto3,oneof"`
 }
 
+type ReplyUnfreeze_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,14,opt,name=cliffLinear,proto3,oneof"`
+}
+
+type ReplyUnfreeze_Linear struct {
+	Linear *Linear `protobuf:"bytes,15,opt,name=linear,proto3,oneof"`
+}
+
+type ReplyUnfreeze_CustomSchedule struct {
+	CustomSchedule *CustomSchedule `protobuf:"bytes,16,opt,name=customSchedule,proto3,oneof"`
+}
+
 func (*ReplyUnfreeze_FixAmount) isReplyUnfreeze_MeansOpt() {}
 
 func (*ReplyUnfreeze_LeftProportion) isReplyUnfreeze_MeansOpt() {}
 
+func (*ReplyUnfreeze_CliffLinear) isReplyUnfreeze_MeansOpt() {}
+
+func (*ReplyUnfreeze_Linear) isReplyUnfreeze_MeansOpt() {}
+
+func (*ReplyUnfreeze_CustomSchedule) isReplyUnfreeze_MeansOpt() {}
+
 func (m *ReplyUnfreeze) GetMeansOpt() isReplyUnfreeze_MeansOpt {
 	if m != nil {
 		return m.MeansOpt
@@ -969,6 +1402,27 @@ func (m *ReplyUnfreeze) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (m *ReplyUnfreeze) GetCliffLinear() *CliffLinear {
+	if x, ok := m.GetMeansOpt().(*ReplyUnfreeze_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
+func (m *ReplyUnfreeze) GetLinear() *Linear {
+	if x, ok := m.GetMeansOpt().(*ReplyUnfreeze_Linear); ok {
+		return x.Linear
+	}
+	return nil
+}
+
+func (m *ReplyUnfreeze) GetCustomSchedule() *CustomSchedule {
+	if x, ok := m.GetMeansOpt().(*ReplyUnfreeze_CustomSchedule); ok {
+		return x.CustomSchedule
+	}
+	return nil
+}
+
 func (m *ReplyUnfreeze) GetTerminated() bool {
 	if m != nil {
 		return m.Terminated
@@ -983,11 +1437,21 @@ func (m *ReplyUnfreeze) GetKey() string {
 	return ""
 }
 
+func (m *ReplyUnfreeze) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ReplyUnfreeze) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ReplyUnfreeze_FixAmount)(nil),
 		(*ReplyUnfreeze_LeftProportion)(nil),
+		(*ReplyUnfreeze_CliffLinear)(nil),
+		(*ReplyUnfreeze_Linear)(nil),
+		(*ReplyUnfreeze_CustomSchedule)(nil),
 	}
 }
 
@@ -1002,7 +1466,7 @@ func (m *ReplyUnfreezes) Reset()         { *m = ReplyUnfreezes{} }
 func (m *ReplyUnfreezes) String() string { return proto.CompactTextString(m) }
 func (*ReplyUnfreezes) ProtoMessage()    {}
 func (*ReplyUnfreezes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{12}
+	return fileDescriptor_6caa0554cb0b9167, []int{18}
 }
 
 func (m *ReplyUnfreezes) XXX_Unmarshal(b []byte) error {
@@ -1034,10 +1498,16 @@ func init() {
 	proto.RegisterType((*Unfreeze)(nil), "types.Unfreeze")
 	proto.RegisterType((*FixAmount)(nil), "types.FixAmount")
 	proto.RegisterType((*LeftProportion)(nil), "types.LeftProportion")
+	proto.RegisterType((*CliffLinear)(nil), "types.CliffLinear")
+	proto.RegisterType((*Linear)(nil), "types.Linear")
+	proto.RegisterType((*CustomSchedule)(nil), "types.CustomSchedule")
+	proto.RegisterType((*Tranche)(nil), "types.Tranche")
 	proto.RegisterType((*UnfreezeAction)(nil), "types.UnfreezeAction")
 	proto.RegisterType((*UnfreezeCreate)(nil), "types.UnfreezeCreate")
 	proto.RegisterType((*UnfreezeWithdraw)(nil), "types.UnfreezeWithdraw")
 	proto.RegisterType((*UnfreezeTerminate)(nil), "types.UnfreezeTerminate")
+	proto.RegisterType((*UnfreezeTransfer)(nil), "types.UnfreezeTransfer")
+	proto.RegisterType((*UnfreezeDelegate)(nil), "types.UnfreezeDelegate")
 	proto.RegisterType((*ReceiptUnfreeze)(nil), "types.ReceiptUnfreeze")
 	proto.RegisterType((*LocalUnfreeze)(nil), "types.LocalUnfreeze")
 	proto.RegisterType((*ReplyQueryUnfreezeWithdraw)(nil), "types.ReplyQueryUnfreezeWithdraw")
@@ -1051,55 +1521,70 @@ func init() {
 }
 
 var fileDescriptor_6caa0554cb0b9167 = []byte{
-	// 755 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcb, 0x6e, 0x13, 0x4b,
-	0x10, 0xf5, 0x78, 0x3c, 0x7e, 0x94, 0x63, 0xc7, 0xb7, 0x6f, 0xee, 0x65, 0x14, 0x21, 0x64, 0x06,
-	0x16, 0x46, 0x48, 0x01, 0x39, 0x20, 0x21, 0xb1, 0x40, 0x49, 0x78, 0x38, 0x22, 0xe2, 0x31, 0x09,
-	0xb0, 0x6e, 0x8f, 0xcb, 0x49, 0x8b, 0x79, 0xa5, 0xdd, 0x4e, 0x3c, 0x7c, 0x04, 0x5f, 0xc0, 0x9f,
-	0xb0, 0x66, 0xc5, 0x8e, 0x2f, 0x42, 0xd3, 0xf3, 0x1e, 0x27, 0x58, 0x61, 0x87, 0xc4, 0xce, 0x75,
-	0xaa, 0xea, 0x74, 0x75, 0xd5, 0xa9, 0x1e, 0x43, 0x77, 0xee, 0x4e, 0x39, 0xe2, 0x27, 0xdc, 0xf2,
-	0xb9, 0x27, 0x3c, 0xa2, 0x89, 0xc0, 0xc7, 0xd9, 0xe6, 0x9a, 0xe5, 0x39, 0x8e, 0xe7, 0x46, 0xa0,
-	0xf1, 0x55, 0x85, 0xe6, 0xbb, 0x38, 0x8e, 0xdc, 0x00, 0x48, 0x72, 0xf6, 0x9f, 0xea, 0x4a, 0x5f,
-	0x19, 0xb4, 0xcc, 0x1c, 0x42, 0xae, 0x43, 0x6b, 0x26, 0x28, 0x17, 0x47, 0xcc, 0x41, 0xbd, 0xda,
-	0x57, 0x06, 0xaa, 0x99, 0x01, 0xa1, 0x97, 0xce, 0x66, 0x28, 0x9e, 0x2d, 0xd0, 0xd2, 0x55, 0x99,
-	0x9c, 0x01, 0xa4, 0x0f, 0x6d, 0x69, 0x1c, 0x06, 0xce, 0xd8, 0xb3, 0xf5, 0x9a, 0xf4, 0xe7, 0xa1,
-	0xf0, 0x74, 0xe1, 0x09, 0x6a, 0xef, 0x79, 0x73, 0x57, 0xe8, 0x9a, 0xa4, 0xcf, 0x21, 0x21, 0x3f,
-	0x73, 0x99, 0x60, 0x54, 0x78, 0x5c, 0xaf, 0x47, 0xfc, 0x29, 0x10, 0xf2, 0x8f, 0xd1, 0xc5, 0x29,
-	0xb3, 0x18, 0xe5, 0x81, 0xde, 0x88, 0xf8, 0x73, 0x50, 0x98, 0xcf, 0xd1, 0xa1, 0xcc, 0x65, 0xee,
-	0xb1, 0xde, 0x8c, 0xaa, 0x4f, 0x01, 0xb2, 0x01, 0x9a, 0x83, 0xd4, 0x9d, 0xe9, 0x2d, 0x99, 0x19,
-	0x19, 0xe4, 0x3e, 0xb4, 0xa6, 0x6c, 0xb1, 0xe3, 0xc8, 0x92, 0xa0, 0xaf, 0x0c, 0xda, 0xc3, 0xde,
-	0x96, 0xec, 0xe3, 0xd6, 0xf3, 0x04, 0x1f, 0x55, 0xcc, 0x2c, 0x88, 0x3c, 0x81, 0xae, 0x8d, 0x53,
-	0xf1, 0x86, 0x7b, 0xbe, 0xc7, 0x05, 0xf3, 0x5c, 0xbd, 0x2d, 0xd3, 0xfe, 0x8b, 0xd3, 0x0e, 0x0a,
-	0xce, 0x51, 0xc5, 0x2c, 0x85, 0xcb, 0x36, 0x20, 0x77, 0x98, 0x4b, 0x05, 0x4e, 0xf4, 0xb5, 0xbe,
-	0x32, 0x68, 0x9a, 0x39, 0x64, 0x17, 0xa0, 0x29, 0x6b, 0x7b, 0xed, 0x0b, 0xe3, 0x31, 0xb4, 0xd2,
-	0x32, 0xc8, 0xff, 0x50, 0xf7, 0x91, 0x33, 0x6f, 0x22, 0x27, 0xa7, 0x9a, 0xb1, 0x15, 0xe2, 0x34,
-	0xba, 0x40, 0x34, 0xb2, 0xd8, 0x32, 0x5e, 0x41, 0xb7, 0x58, 0xcc, 0xa5, 0x0c, 0xb7, 0xa1, 0x23,
-	0xd0, 0x3d, 0x3a, 0xf1, 0xe6, 0x33, 0xea, 0x4e, 0xc4, 0x49, 0x4c, 0x54, 0x04, 0x8d, 0xef, 0x0a,
-	0x74, 0x13, 0x29, 0xed, 0x58, 0x92, 0xf0, 0x1e, 0xd4, 0x2d, 0x8e, 0x54, 0xa0, 0x24, 0xcc, 0x9a,
-	0x90, 0x84, 0xed, 0x49, 0xe7, 0xa8, 0x62, 0xc6, 0x61, 0xe4, 0x21, 0x34, 0xcf, 0x99, 0x38, 0x99,
-	0x70, 0x7a, 0x2e, 0x0f, 0x69, 0x0f, 0xaf, 0x95, 0x52, 0x3e, 0xc4, 0xee, 0x51, 0xc5, 0x4c, 0x43,
-	0xc9, 0x23, 0x68, 0xa5, 0x1d, 0x92, 0xd2, 0x6b, 0x0f, 0xf5, 0x52, 0xde, 0x51, 0xe2, 0x0f, 0xc7,
-	0x95, 0x06, 0x93, 0x2e, 0x54, 0x45, 0x20, 0xd5, 0xa8, 0x99, 0x55, 0x11, 0xec, 0x36, 0x40, 0x3b,
-	0xa3, 0xf6, 0x1c, 0x8d, 0x6f, 0xd5, 0xec, 0x36, 0x51, 0x99, 0x45, 0xf9, 0x2b, 0xbf, 0x94, 0x7f,
-	0x75, 0x85, 0xfc, 0xd5, 0x55, 0xf2, 0xaf, 0x2d, 0xc9, 0xbf, 0x24, 0x70, 0x6d, 0x59, 0xe0, 0xa9,
-	0x84, 0xeb, 0x97, 0x4a, 0xb8, 0xf1, 0x7b, 0x12, 0x6e, 0x5e, 0x49, 0xc2, 0x05, 0x89, 0x0e, 0xa1,
-	0x57, 0x1e, 0xdd, 0xaa, 0x77, 0xc6, 0xd8, 0x86, 0x7f, 0x96, 0xc6, 0xb6, 0x32, 0x89, 0xc2, 0xba,
-	0x89, 0x16, 0x32, 0x5f, 0xa4, 0xef, 0xd9, 0x2d, 0xa8, 0xf9, 0x1c, 0xcf, 0x62, 0xf1, 0xad, 0x97,
-	0x14, 0x61, 0x4a, 0x27, 0xb9, 0x03, 0x0d, 0x6b, 0xce, 0x39, 0xc6, 0xfb, 0x71, 0x41, 0x5c, 0xe2,
-	0x37, 0xde, 0x43, 0xe7, 0xc0, 0xb3, 0xa8, 0x9d, 0x1e, 0x70, 0x17, 0x9a, 0x49, 0x05, 0x97, 0x1d,
-	0x92, 0x06, 0x10, 0x1d, 0x1a, 0x62, 0xb1, 0xef, 0x4e, 0x70, 0x11, 0xcb, 0x23, 0x31, 0x8d, 0x29,
-	0x6c, 0x9a, 0xe8, 0xdb, 0xc1, 0xdb, 0x39, 0xf2, 0xe0, 0xaa, 0xdd, 0x22, 0x03, 0x58, 0xa7, 0x67,
-	0x94, 0xd9, 0x74, 0x6c, 0xe3, 0x4e, 0x7e, 0xd1, 0xcb, 0xb0, 0xf1, 0x45, 0x81, 0x35, 0x13, 0x4f,
-	0x93, 0x13, 0x66, 0xa1, 0x66, 0x27, 0x8c, 0xa3, 0x5c, 0x56, 0xc9, 0xac, 0x99, 0x19, 0x10, 0xea,
-	0xc9, 0x4a, 0xe9, 0x34, 0x33, 0x32, 0xc2, 0x6b, 0x4c, 0xb9, 0xe7, 0xbc, 0xc4, 0x20, 0x56, 0x71,
-	0x62, 0x16, 0x1f, 0xe8, 0xda, 0x8a, 0x07, 0x7a, 0x59, 0xbf, 0xc6, 0x0f, 0x15, 0x3a, 0xb2, 0x0f,
-	0x7f, 0x3f, 0x48, 0x7f, 0xd0, 0x07, 0x89, 0xf4, 0x40, 0xfd, 0x88, 0x81, 0xde, 0x91, 0x65, 0x86,
-	0x3f, 0x0b, 0xfb, 0xbf, 0x0b, 0xdd, 0xc2, 0x4c, 0xc3, 0x2b, 0xe4, 0x97, 0x46, 0x1d, 0xb4, 0x87,
-	0x1b, 0x71, 0x29, 0x85, 0xc0, 0x6c, 0x73, 0x86, 0x9f, 0x95, 0x2c, 0x85, 0x1c, 0xc0, 0xbf, 0x2f,
-	0x50, 0x2c, 0x6d, 0x49, 0x2f, 0xe5, 0x38, 0x3d, 0x14, 0x9c, 0xb9, 0xc7, 0x9b, 0x37, 0xf3, 0xac,
-	0x17, 0xae, 0x96, 0x51, 0x21, 0x0f, 0xa0, 0x53, 0x70, 0x5d, 0xc0, 0x53, 0x5e, 0x69, 0xa3, 0x32,
-	0xae, 0xcb, 0x3f, 0x4f, 0xdb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x37, 0x34, 0x48, 0x63,
-	0x09, 0x00, 0x00,
+	// 995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x5f, 0x6f, 0xe3, 0x44,
+	0x10, 0x8f, 0x93, 0x38, 0x7f, 0x26, 0x8d, 0x93, 0x5b, 0x0e, 0xb0, 0x2a, 0x84, 0x82, 0x39, 0x89,
+	0x00, 0x52, 0x41, 0x39, 0x40, 0x20, 0x90, 0x4e, 0x6d, 0x0f, 0xe8, 0x89, 0xea, 0x00, 0x37, 0xc0,
+	0xf3, 0xd6, 0x19, 0x37, 0x2b, 0xfc, 0x27, 0xb7, 0xde, 0xb4, 0x0d, 0xaf, 0xbc, 0x23, 0x3e, 0x00,
+	0x5f, 0x84, 0x8f, 0xc6, 0x1b, 0xda, 0xf5, 0xda, 0xb1, 0x9d, 0xb4, 0x51, 0x80, 0x07, 0x1e, 0xee,
+	0x2d, 0x33, 0xf3, 0xfb, 0xcd, 0xce, 0xce, 0xce, 0xfe, 0xbc, 0x01, 0x6b, 0x19, 0xf9, 0x1c, 0xf1,
+	0x17, 0x3c, 0x5a, 0xf0, 0x58, 0xc4, 0xc4, 0x14, 0xab, 0x05, 0x26, 0x87, 0x07, 0x5e, 0x1c, 0x86,
+	0x71, 0x94, 0x3a, 0x9d, 0xbf, 0x9a, 0xd0, 0xf9, 0x41, 0xe3, 0xc8, 0x9b, 0x00, 0x19, 0xe7, 0xd9,
+	0x53, 0xdb, 0x18, 0x19, 0xe3, 0xae, 0x5b, 0xf0, 0x90, 0x37, 0xa0, 0x9b, 0x08, 0xca, 0xc5, 0x94,
+	0x85, 0x68, 0xd7, 0x47, 0xc6, 0xb8, 0xe1, 0xae, 0x1d, 0x32, 0x4a, 0x93, 0x04, 0xc5, 0x97, 0xb7,
+	0xe8, 0xd9, 0x0d, 0x45, 0x5e, 0x3b, 0xc8, 0x08, 0x7a, 0xca, 0xb8, 0x58, 0x85, 0x97, 0x71, 0x60,
+	0x37, 0x55, 0xbc, 0xe8, 0x92, 0xab, 0x8b, 0x58, 0xd0, 0xe0, 0x34, 0x5e, 0x46, 0xc2, 0x36, 0x55,
+	0xfa, 0x82, 0x47, 0xe6, 0x67, 0x11, 0x13, 0x8c, 0x8a, 0x98, 0xdb, 0xad, 0x34, 0x7f, 0xee, 0x90,
+	0xf9, 0x2f, 0x31, 0x42, 0x9f, 0x79, 0x8c, 0xf2, 0x95, 0xdd, 0x4e, 0xf3, 0x17, 0x5c, 0x92, 0xcf,
+	0x31, 0xa4, 0x2c, 0x62, 0xd1, 0x95, 0xdd, 0x49, 0xab, 0xcf, 0x1d, 0xe4, 0x21, 0x98, 0x21, 0xd2,
+	0x28, 0xb1, 0xbb, 0x8a, 0x99, 0x1a, 0xe4, 0x43, 0xe8, 0xfa, 0xec, 0xf6, 0x38, 0x54, 0x25, 0xc1,
+	0xc8, 0x18, 0xf7, 0x26, 0xc3, 0x23, 0xd5, 0xc7, 0xa3, 0xaf, 0x32, 0xff, 0x59, 0xcd, 0x5d, 0x83,
+	0xc8, 0x13, 0xb0, 0x02, 0xf4, 0xc5, 0x77, 0x3c, 0x5e, 0xc4, 0x5c, 0xb0, 0x38, 0xb2, 0x7b, 0x8a,
+	0xf6, 0xaa, 0xa6, 0x9d, 0x97, 0x82, 0x67, 0x35, 0xb7, 0x02, 0x27, 0x9f, 0x40, 0xcf, 0x0b, 0x98,
+	0xef, 0x9f, 0xb3, 0x08, 0x29, 0xb7, 0xfb, 0x8a, 0x4d, 0x34, 0xfb, 0x74, 0x1d, 0x39, 0xab, 0xb9,
+	0x45, 0x20, 0x79, 0x07, 0x5a, 0x41, 0x4a, 0xb1, 0x14, 0xa5, 0x9f, 0x2d, 0x98, 0xa1, 0x75, 0x58,
+	0x56, 0xe8, 0x2d, 0x13, 0x11, 0x87, 0x17, 0xde, 0x1c, 0x67, 0xcb, 0x00, 0xed, 0x41, 0xa9, 0xc2,
+	0xd3, 0x52, 0x50, 0x56, 0x58, 0x86, 0xab, 0x83, 0x42, 0x1e, 0xb2, 0x88, 0x0a, 0x9c, 0xd9, 0x07,
+	0x23, 0x63, 0xdc, 0x71, 0x0b, 0x1e, 0x72, 0x08, 0x9d, 0x19, 0x06, 0x78, 0x45, 0x05, 0xda, 0x43,
+	0xd5, 0xcd, 0xdc, 0x3e, 0x01, 0xe8, 0xa8, 0xce, 0x7e, 0xbb, 0x10, 0xce, 0xe7, 0xd0, 0xcd, 0x9b,
+	0x48, 0x5e, 0x83, 0xd6, 0x02, 0x39, 0x8b, 0x67, 0x6a, 0xee, 0x1a, 0xae, 0xb6, 0xa4, 0x9f, 0xa6,
+	0xed, 0x4f, 0x07, 0x4e, 0x5b, 0xce, 0x73, 0xb0, 0xca, 0xad, 0xbc, 0x33, 0xc3, 0x23, 0xe8, 0x0b,
+	0x8c, 0xa6, 0xf3, 0x78, 0x99, 0xd0, 0x68, 0x26, 0xe6, 0x3a, 0x51, 0xd9, 0xe9, 0x3c, 0x81, 0x5e,
+	0xa1, 0xb9, 0x72, 0x1c, 0x54, 0x73, 0x75, 0xae, 0xd4, 0x50, 0x3b, 0x5b, 0x72, 0xaa, 0x8e, 0x35,
+	0xcd, 0x92, 0xdb, 0xce, 0x23, 0x68, 0x69, 0x6e, 0x11, 0x65, 0x54, 0x50, 0x5f, 0x80, 0x55, 0xee,
+	0x2f, 0x79, 0x0f, 0x3a, 0x82, 0xd3, 0xc8, 0x9b, 0x63, 0x62, 0x1b, 0xa3, 0xc6, 0xb8, 0x37, 0xb1,
+	0xf4, 0x41, 0x4c, 0x53, 0xb7, 0x9b, 0xc7, 0x9d, 0xcf, 0xa0, 0xad, 0x9d, 0x72, 0xb7, 0xb1, 0xef,
+	0x27, 0x28, 0xb2, 0xdd, 0xa6, 0xd6, 0x9d, 0xfd, 0xfa, 0xb3, 0x0e, 0x56, 0x76, 0xd1, 0x8f, 0x3d,
+	0xd5, 0xb0, 0x0f, 0xa0, 0xe5, 0x71, 0x94, 0xa7, 0x64, 0x94, 0x06, 0x20, 0x83, 0x9d, 0xaa, 0xa0,
+	0x9c, 0x9c, 0x14, 0x46, 0x3e, 0x86, 0xce, 0x0d, 0x13, 0xf3, 0x19, 0xa7, 0x37, 0x2a, 0x7b, 0x6f,
+	0xf2, 0x7a, 0x85, 0xf2, 0x93, 0x0e, 0x9f, 0xd5, 0xdc, 0x1c, 0x4a, 0x3e, 0x85, 0x6e, 0x3e, 0x1d,
+	0x4a, 0x18, 0x7a, 0x13, 0xbb, 0xc2, 0x9b, 0x66, 0x71, 0x79, 0x99, 0x72, 0xb0, 0x5c, 0x50, 0xee,
+	0x3d, 0xf1, 0x91, 0xdb, 0xe6, 0xd6, 0x05, 0xa7, 0x3a, 0x2c, 0x17, 0xcc, 0xa0, 0x92, 0x96, 0x0f,
+	0x60, 0x6b, 0x2b, 0xed, 0xa9, 0x0e, 0x4b, 0x5a, 0x06, 0x25, 0x16, 0xd4, 0xc5, 0x4a, 0x29, 0x93,
+	0xe9, 0xd6, 0xc5, 0xea, 0xa4, 0x0d, 0xe6, 0x35, 0x0d, 0x96, 0xe8, 0xfc, 0xda, 0x04, 0xab, 0xdc,
+	0x94, 0xb2, 0x14, 0x1a, 0xf7, 0x4a, 0x61, 0x7d, 0x87, 0x14, 0x36, 0x76, 0x49, 0x61, 0x73, 0x43,
+	0x0a, 0x2b, 0x62, 0x67, 0x6e, 0x8a, 0x5d, 0x2e, 0x67, 0xad, 0x3b, 0xe5, 0xac, 0xfd, 0xcf, 0xe4,
+	0xac, 0xf3, 0xaf, 0xe4, 0xac, 0xbb, 0xbf, 0x9c, 0xc1, 0xbe, 0x72, 0xd6, 0xdb, 0x4f, 0xce, 0x8a,
+	0x72, 0x75, 0x70, 0x8f, 0x5c, 0x4d, 0x60, 0x58, 0x1d, 0xf3, 0x5d, 0x5f, 0x4c, 0xe7, 0x31, 0x3c,
+	0xd8, 0x18, 0xf1, 0x9d, 0xa4, 0x29, 0x0c, 0xab, 0xe3, 0xbd, 0x8b, 0x53, 0x9d, 0x88, 0xfa, 0xc6,
+	0x44, 0x38, 0xcf, 0x61, 0x58, 0x9d, 0xfe, 0x9d, 0x59, 0x8b, 0xad, 0xa9, 0x97, 0x5b, 0xe3, 0x50,
+	0x18, 0xb8, 0xe8, 0x21, 0x5b, 0x88, 0x2c, 0x2d, 0x79, 0x1b, 0x9a, 0x0b, 0x8e, 0xd7, 0x5a, 0x4e,
+	0x06, 0x95, 0x3b, 0xe7, 0xaa, 0x20, 0x79, 0x17, 0xda, 0xde, 0x92, 0x73, 0xd4, 0x0a, 0xb5, 0x05,
+	0x97, 0xc5, 0x9d, 0x1f, 0xa1, 0x7f, 0x1e, 0x7b, 0x34, 0xc8, 0x17, 0x78, 0x1f, 0x3a, 0x59, 0x75,
+	0x77, 0x2d, 0x92, 0x03, 0x88, 0x0d, 0x6d, 0x71, 0xfb, 0x2c, 0x9a, 0xe1, 0xad, 0xae, 0x3d, 0x33,
+	0x1d, 0x1f, 0x0e, 0x5d, 0x5c, 0x04, 0xab, 0xef, 0x97, 0xc8, 0x57, 0xfb, 0x9e, 0x29, 0x19, 0xc3,
+	0x80, 0x5e, 0x53, 0x16, 0xd0, 0xcb, 0x00, 0x8f, 0x8b, 0x52, 0x5b, 0x75, 0x3b, 0x7f, 0x18, 0x70,
+	0xe0, 0xe2, 0x8b, 0x6c, 0x85, 0x44, 0xea, 0xc2, 0x8c, 0x71, 0xf4, 0xf2, 0x4f, 0x83, 0xe9, 0xae,
+	0x1d, 0xea, 0x9b, 0x93, 0xa7, 0x33, 0xdd, 0xd4, 0x90, 0xdb, 0xf0, 0x79, 0x1c, 0x7e, 0x83, 0x2b,
+	0xad, 0x14, 0x99, 0x59, 0x7e, 0x10, 0x35, 0x77, 0x3c, 0x88, 0x36, 0x35, 0xc2, 0xf9, 0xdd, 0x84,
+	0xbe, 0xea, 0xc3, 0xcb, 0x07, 0xe0, 0xff, 0xf8, 0x01, 0x68, 0xed, 0xaf, 0x98, 0x83, 0x7d, 0x15,
+	0x73, 0xf8, 0xdf, 0x3e, 0x00, 0x87, 0xd0, 0xf8, 0x19, 0x57, 0xea, 0xe9, 0xda, 0x75, 0xe5, 0xcf,
+	0x92, 0x90, 0x3c, 0xb8, 0x47, 0x63, 0x4f, 0xc0, 0x2a, 0x4d, 0xa4, 0x3c, 0x80, 0xe2, 0x95, 0x97,
+	0xcf, 0xa3, 0x87, 0xba, 0xcc, 0x12, 0x70, 0x7d, 0xef, 0x27, 0xbf, 0x19, 0x6b, 0x0a, 0x39, 0x87,
+	0x57, 0xbe, 0x46, 0xb1, 0x71, 0xc7, 0x87, 0x79, 0x8e, 0x17, 0x17, 0x82, 0xb3, 0xe8, 0xea, 0xf0,
+	0xad, 0x62, 0xd6, 0xad, 0xc2, 0xe0, 0xd4, 0xc8, 0x47, 0xd0, 0x2f, 0x85, 0xb6, 0xe4, 0xa9, 0x0a,
+	0x92, 0x53, 0xbb, 0x6c, 0xa9, 0xbf, 0x5a, 0x8f, 0xff, 0x1e, 0x00, 0xec, 0xa8, 0xed, 0x2a, 0x91,
+	0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.